@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/SafeMPC/mpc-service/cmd/audit"
 	"github.com/SafeMPC/mpc-service/cmd/cert"
 	"github.com/SafeMPC/mpc-service/cmd/db"
 	"github.com/SafeMPC/mpc-service/cmd/env"
 	"github.com/SafeMPC/mpc-service/cmd/probe"
 	"github.com/SafeMPC/mpc-service/cmd/server"
+	"github.com/SafeMPC/mpc-service/cmd/wallet"
 	"github.com/SafeMPC/mpc-service/internal/config"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -32,11 +34,13 @@ func Execute() {
 
 	// attach the subcommands
 	rootCmd.AddCommand(
+		audit.New(),
 		cert.New(),
 		db.New(),
 		env.New(),
 		probe.New(),
 		server.New(),
+		wallet.New(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
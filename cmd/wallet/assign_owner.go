@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/config"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/SafeMPC/mpc-service/internal/util/command"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+type AssignOwnerFlags struct {
+	WalletID     string
+	UserID       string
+	CredentialID string
+}
+
+func newAssignOwner() *cobra.Command {
+	var flags AssignOwnerFlags
+
+	cmd := &cobra.Command{
+		Use:   "assign-owner",
+		Short: "Assigns the first owner of a wallet without members",
+		Long: `Assigns the first owner of a wallet without members
+
+	Wallets created before creators became owners have no
+	members, and wallet routes deny every caller until an owner
+	exists. This command adds the given passkey credential of
+	the given user as the owner of such a wallet.
+
+	The credential must be a registered passkey of the user
+	(Base64URL encoded without padding). Fails if the wallet
+	already has members: further changes must be proposed and
+	approved by an owner through the API. The assignment is
+	written to the audit log.`,
+		Run: func(_ *cobra.Command, _ []string /* args */) {
+			assignOwnerCmdFunc(flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.WalletID, "wallet-id", "", "ID of the wallet without members.")
+	cmd.Flags().StringVar(&flags.UserID, "user-id", "", "User ID owning the passkey credential.")
+	cmd.Flags().StringVar(&flags.CredentialID, "credential-id", "", "Base64URL encoded passkey credential ID to assign as owner.")
+
+	return cmd
+}
+
+func assignOwnerCmdFunc(flags AssignOwnerFlags) {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), func(ctx context.Context, s *api.Server) error {
+		log := util.LogFromContext(ctx)
+
+		if flags.WalletID == "" || flags.UserID == "" || flags.CredentialID == "" {
+			return errors.New("--wallet-id, --user-id and --credential-id are required")
+		}
+		if _, err := s.KeyService.GetKey(ctx, flags.WalletID); err != nil {
+			return err
+		}
+
+		change, err := s.Members.AssignOwner(ctx, flags.WalletID, flags.UserID, flags.CredentialID)
+		if err != nil {
+			return err
+		}
+
+		log.Info().
+			Str("walletId", change.WalletID).
+			Str("credentialId", change.CredentialID).
+			Str("changeId", change.ChangeID).
+			Msg("Wallet owner assigned")
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to assign wallet owner")
+	}
+}
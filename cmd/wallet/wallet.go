@@ -0,0 +1,12 @@
+package wallet
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/util/command"
+	"github.com/spf13/cobra"
+)
+
+func New() *cobra.Command {
+	return command.NewSubcommandGroup("wallet",
+		newAssignOwner(),
+	)
+}
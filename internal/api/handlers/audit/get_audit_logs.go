@@ -0,0 +1,99 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	auditlog "github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/models"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/audit"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAuditLogsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Audit.GET("", getAuditLogsHandler(s))
+}
+
+func getAuditLogsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := audit.NewGetAuditLogsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := auditlog.ListFilter{
+			EventType: swag.StringValue(params.EventType),
+			Operation: swag.StringValue(params.Operation),
+			Result:    swag.StringValue(params.Result),
+			UserID:    swag.StringValue(params.UserID),
+			KeyID:     swag.StringValue(params.KeyID),
+			SessionID: swag.StringValue(params.SessionID),
+			Limit:     int(swag.Int64Value(params.Limit)),
+			Offset:    int(swag.Int64Value(params.Offset)),
+		}
+		if params.From != nil {
+			from := time.Time(*params.From)
+			filter.From = &from
+		}
+		if params.To != nil {
+			to := time.Time(*params.To)
+			filter.To = &to
+		}
+
+		auditLogs, total, err := s.Audit.List(ctx, filter)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list audit logs")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list audit logs")
+		}
+
+		entries := make([]*types.AuditLogEntry, 0, len(auditLogs))
+		for _, auditLog := range auditLogs {
+			entries = append(entries, auditLogEntryToTypes(auditLog))
+		}
+
+		response := &types.ListAuditLogsResponse{
+			Entries: entries,
+			Total:   swag.Int64(total),
+			Limit:   params.Limit,
+			Offset:  params.Offset,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+func auditLogEntryToTypes(auditLog *models.AuditLog) *types.AuditLogEntry {
+	timestamp := strfmt.DateTime(auditLog.Timestamp)
+
+	entry := &types.AuditLogEntry{
+		ID:        swag.Int64(auditLog.ID),
+		Timestamp: &timestamp,
+		EventType: swag.String(auditLog.EventType),
+		Operation: swag.String(auditLog.Operation),
+		Result:    swag.String(auditLog.Result),
+		UserID:    auditLog.UserID.String,
+		KeyID:     auditLog.KeyID.String,
+		NodeID:    auditLog.NodeID.String,
+		SessionID: auditLog.SessionID.String,
+		IPAddress: auditLog.IPAddress.String,
+	}
+
+	if auditLog.Details.Valid {
+		var details map[string]interface{}
+		if err := json.Unmarshal(auditLog.Details.JSON, &details); err == nil {
+			entry.Details = details
+		}
+	}
+
+	return entry
+}
@@ -0,0 +1,151 @@
+package audit_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/auth"
+	"github.com/SafeMPC/mpc-service/internal/models"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/SafeMPC/mpc-service/internal/test/fixtures"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuditLogs(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		grantAuditScope(t, s)
+
+		s.Audit.Record(ctx, audit.Entry{
+			EventType: audit.EventTypeKey,
+			Operation: audit.OperationCreate,
+			Result:    audit.ResultSuccess,
+			UserID:    fix.User1.ID,
+			KeyID:     "key-1",
+			SessionID: "key-1",
+			IPAddress: "203.0.113.7",
+			Details:   map[string]interface{}{"threshold": 2},
+		})
+		s.Audit.Record(ctx, audit.Entry{
+			EventType: audit.EventTypeSigning,
+			Operation: audit.OperationSign,
+			Result:    audit.ResultFailure,
+			UserID:    fix.User1.ID,
+			KeyID:     "key-1",
+			SessionID: "session-1",
+		})
+		s.Audit.Record(ctx, audit.Entry{
+			EventType: audit.EventTypeSigning,
+			Operation: audit.OperationSign,
+			Result:    audit.ResultSuccess,
+			UserID:    fix.User2.ID,
+			KeyID:     "key-2",
+			SessionID: "session-2",
+		})
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/audit", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.ListAuditLogsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(3), *response.Total)
+		assert.Equal(t, int64(20), *response.Limit)
+		assert.Equal(t, int64(0), *response.Offset)
+		require.Len(t, response.Entries, 3)
+
+		// newest first
+		assert.Equal(t, "session-2", response.Entries[0].SessionID)
+		assert.Equal(t, "key-1", response.Entries[2].KeyID)
+		assert.Equal(t, "203.0.113.7", response.Entries[2].IPAddress)
+		assert.Equal(t, float64(2), response.Entries[2].Details["threshold"])
+
+		res = test.PerformRequestWithParams(t, s, "GET", "/api/v1/audit", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token), map[string]string{
+			"event_type": audit.EventTypeSigning,
+			"key_id":     "key-1",
+		})
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		response = types.ListAuditLogsResponse{}
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(1), *response.Total)
+		require.Len(t, response.Entries, 1)
+		assert.Equal(t, "session-1", response.Entries[0].SessionID)
+		assert.Equal(t, audit.ResultFailure, *response.Entries[0].Result)
+		assert.Equal(t, fix.User1.ID, response.Entries[0].UserID)
+
+		res = test.PerformRequestWithParams(t, s, "GET", "/api/v1/audit", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token), map[string]string{
+			"limit":  "1",
+			"offset": "1",
+		})
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		response = types.ListAuditLogsResponse{}
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(3), *response.Total)
+		require.Len(t, response.Entries, 1)
+		assert.Equal(t, "session-1", response.Entries[0].SessionID)
+
+		res = test.PerformRequestWithParams(t, s, "GET", "/api/v1/audit", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token), map[string]string{
+			"from": time.Now().Add(time.Hour).Format(time.RFC3339),
+		})
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		response = types.ListAuditLogsResponse{}
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(0), *response.Total)
+		assert.Empty(t, response.Entries)
+	})
+}
+
+func TestGetAuditLogsInvalidParams(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		grantAuditScope(t, s)
+
+		for _, params := range []map[string]string{
+			{"limit": "0"},
+			{"limit": "101"},
+			{"event_type": "unknown"},
+			{"from": "yesterday"},
+		} {
+			res := test.PerformRequestWithParams(t, s, "GET", "/api/v1/audit", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token), params)
+			assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode, params)
+		}
+	})
+}
+
+func TestGetAuditLogsMissingScope(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fix := fixtures.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/audit", nil, test.HeadersWithAuth(t, fix.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/audit", nil, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func grantAuditScope(t *testing.T, s *api.Server) {
+	t.Helper()
+
+	fix := fixtures.Fixtures()
+
+	user := *fix.User1
+	user.Scopes = append(user.Scopes, auth.ScopeAudit.String())
+	_, err := user.Update(t.Context(), s.DB, boil.Whitelist(models.UserColumns.Scopes))
+	require.NoError(t, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-openapi/swag"
 	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/data/dto"
 	"github.com/SafeMPC/mpc-service/internal/types"
 	"github.com/SafeMPC/mpc-service/internal/util"
@@ -29,10 +30,22 @@ func postLoginHandler(s *api.Server) echo.HandlerFunc {
 			Username: dto.NewUsername(body.Username.String()),
 			Password: swag.StringValue(body.Password),
 		})
+		entry := audit.Entry{
+			EventType: audit.EventTypeAuth,
+			Operation: audit.OperationLogin,
+			Result:    audit.ResultSuccess,
+			Details: map[string]interface{}{
+				"method":   "password",
+				"username": body.Username.String(),
+			},
+		}
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to authenticate user")
+			entry.Result = audit.ResultFailure
+			s.Audit.Record(ctx, entry)
 			return err
 		}
+		s.Audit.Record(ctx, entry)
 
 		return util.ValidateAndReturn(c, http.StatusOK, result.ToTypes())
 	}
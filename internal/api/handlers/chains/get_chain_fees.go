@@ -0,0 +1,76 @@
+package chains
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/chains"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// GetChainFeesRoute 注册手续费估算路由
+func GetChainFeesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/chains/:chain/fees", getChainFeesHandler(s))
+}
+
+// getChainFeesHandler 查询链的慢、正常、快三档手续费估算
+func getChainFeesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params chains.GetChainFeesParams
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		chainInfo, err := s.Chains.Lookup(params.Chain)
+		if err != nil {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unsupported chain type: "+params.Chain)
+		}
+
+		estimator, err := chainInfo.FeeEstimator()
+		if err != nil {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Fee estimation is not supported for chain type: "+params.Chain)
+		}
+
+		estimate, err := estimator.EstimateFees(ctx)
+		if err != nil {
+			log.Error().Err(err).Str("chain", chainInfo.Name).Msg("Failed to estimate fees")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to estimate fees")
+		}
+
+		response := &types.ChainFeesResponse{
+			ChainType: swag.String(chainInfo.Name),
+			Unit:      swag.String(estimate.Unit),
+			BaseFee:   bigIntString(estimate.BaseFee),
+			Slow:      feeTierToTypes(estimate.Slow),
+			Normal:    feeTierToTypes(estimate.Normal),
+			Fast:      feeTierToTypes(estimate.Fast),
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+func feeTierToTypes(tier *chain.FeeTier) *types.FeeTier {
+	return &types.FeeTier{
+		FeeRate:      swag.String(bigIntString(tier.FeeRate)),
+		PriorityFee:  bigIntString(tier.PriorityFee),
+		TargetBlocks: int64(tier.TargetBlocks),
+	}
+}
+
+// bigIntString 十进制字符串，nil 时为空
+func bigIntString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+	return value.String()
+}
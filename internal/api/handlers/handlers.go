@@ -3,7 +3,9 @@ package handlers
 
 import (
 	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/handlers/audit"
 	"github.com/SafeMPC/mpc-service/internal/api/handlers/auth"
+	"github.com/SafeMPC/mpc-service/internal/api/handlers/chains"
 	"github.com/SafeMPC/mpc-service/internal/api/handlers/common"
 	"github.com/SafeMPC/mpc-service/internal/api/handlers/infra/sessions"
 	"github.com/SafeMPC/mpc-service/internal/api/handlers/push"
@@ -16,6 +18,7 @@ import (
 func AttachAllRoutes(s *api.Server) {
 	// attach our routes
 	s.Router.Routes = []*echo.Route{
+		audit.GetAuditLogsRoute(s),
 		auth.DeleteUserAccountRoute(s),
 		auth.GetCompleteRegisterRoute(s),
 		auth.GetUserInfoRoute(s),
@@ -31,6 +34,7 @@ func AttachAllRoutes(s *api.Server) {
 		webauthnhandlers.PostWebAuthnRegisterFinishRoute(s),
 		webauthnhandlers.PostWebAuthnLoginBeginRoute(s),
 		webauthnhandlers.PostWebAuthnLoginFinishRoute(s),
+		chains.GetChainFeesRoute(s),
 		common.GetHealthyRoute(s),
 		common.GetReadyRoute(s),
 		common.GetSwaggerRoute(s),
@@ -40,7 +44,35 @@ func AttachAllRoutes(s *api.Server) {
 		walletshandlers.GetWalletsRoute(s),
 		walletshandlers.GetWalletRoute(s),
 		walletshandlers.GetWalletBalanceRoute(s),
+		walletshandlers.GetWalletTransactionsRoute(s),
+		walletshandlers.PostSignChallengeRoute(s),
 		walletshandlers.PostSignTransactionRoute(s),
+		walletshandlers.PostWalletTransferRoute(s),
+		walletshandlers.GetWalletSignRequestsRoute(s),
+		walletshandlers.GetWalletSignRequestRoute(s),
+		walletshandlers.PostApproveSignRequestRoute(s),
+		walletshandlers.PostRejectSignRequestRoute(s),
+		walletshandlers.GetWalletPolicyRoute(s),
+		walletshandlers.PutWalletPolicyRoute(s),
+		walletshandlers.GetWalletMembersRoute(s),
+		walletshandlers.PostWalletMemberRoute(s),
+		walletshandlers.PutWalletMemberRoute(s),
+		walletshandlers.DeleteWalletMemberRoute(s),
+		walletshandlers.GetWalletMemberChangesRoute(s),
+		walletshandlers.PostApproveWalletMemberChangeRoute(s),
+		walletshandlers.PostRejectWalletMemberChangeRoute(s),
+		walletshandlers.GetWalletInvitationsRoute(s),
+		walletshandlers.PostWalletInvitationRoute(s),
+		walletshandlers.DeleteWalletInvitationRoute(s),
+		walletshandlers.PostAcceptWalletInvitationRoute(s),
+		walletshandlers.PostReshareWalletRoute(s),
+		walletshandlers.GetWalletRefreshHistoryRoute(s),
+		walletshandlers.PostEnableWalletRoute(s),
+		walletshandlers.PostDisableWalletRoute(s),
+		walletshandlers.PostScheduleWalletDeletionRoute(s),
+		walletshandlers.PostCancelWalletDeletionRoute(s),
+		walletshandlers.GetWalletShareDeletionsRoute(s),
+		walletshandlers.PostConfirmWalletShareDeletionRoute(s),
 		push.PutUpdatePushTokenRoute(s),
 		wellknown.GetAndroidDigitalAssetLinksRoute(s),
 		wellknown.GetAppleAppSiteAssociationRoute(s),
@@ -58,15 +58,16 @@ func getSessionHandler(s *api.Server) echo.HandlerFunc {
 
 		status := session.Status
 		innerResponse := types.GetSessionResponse{
-			SessionID:   &sessionIDUUID,
-			WalletID:    &walletIDUUID,
-			SessionType: sessionType,
-			Status:      &status,
-			Progress:    progress,
-			Signature:   session.Signature,
-			PublicKey:   "", // 如果有公钥，从其他地方获取
-			CreatedAt:   strfmt.DateTime(session.CreatedAt),
-			DurationMs:  int64(session.DurationMs),
+			SessionID:    &sessionIDUUID,
+			WalletID:     &walletIDUUID,
+			SessionType:  sessionType,
+			Status:       &status,
+			Progress:     progress,
+			Signature:    session.Signature,
+			PublicKey:    "", // 如果有公钥，从其他地方获取
+			CreatedAt:    strfmt.DateTime(session.CreatedAt),
+			DurationMs:   int64(session.DurationMs),
+			ErrorMessage: session.ErrorMessage,
 		}
 
 		if session.CompletedAt != nil {
@@ -0,0 +1,39 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// DeleteWalletInvitationRoute 注册撤销钱包邀请路由
+func DeleteWalletInvitationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.DELETE("/wallets/:walletId/invitations/:invitationId", deleteWalletInvitationHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// deleteWalletInvitationHandler owner 撤销 pending 的邀请
+func deleteWalletInvitationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.DeleteWalletInvitationParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		invitation, err := s.Members.RevokeInvitation(ctx, member.MembershipFromContext(ctx), params.WalletID, params.InvitationID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Str("invitation_id", params.InvitationID).Msg("Failed to revoke wallet invitation")
+			return memberHTTPError(err)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, walletInvitationToTypes(s, invitation))
+	}
+}
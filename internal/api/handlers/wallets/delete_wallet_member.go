@@ -0,0 +1,31 @@
+package wallets
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// DeleteWalletMemberRoute 注册移除钱包成员路由
+func DeleteWalletMemberRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.DELETE("/wallets/:walletId/members/:credentialId", deleteWalletMemberHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// deleteWalletMemberHandler 提出移除成员，owner 批准后生效
+func deleteWalletMemberHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.DeleteWalletMemberParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		return proposeMemberChange(c, s, &storage.WalletMemberChange{
+			WalletID:     params.WalletID,
+			Action:       storage.WalletMemberChangeActionRemove,
+			CredentialID: params.CredentialID,
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/SafeMPC/mpc-service/internal/api"
 	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
 	"github.com/SafeMPC/mpc-service/internal/types"
 	"github.com/SafeMPC/mpc-service/internal/types/wallets"
 	"github.com/SafeMPC/mpc-service/internal/util"
@@ -14,7 +16,7 @@ import (
 )
 
 func GetWalletRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Auth.GET("/wallets/:wallet_id", getWalletHandler(s))
+	return s.Router.APIV1Auth.GET("/wallets/:walletId", getWalletHandler(s), middleware.WalletRole(s, storage.WalletRoleViewer))
 }
 
 func getWalletHandler(s *api.Server) echo.HandlerFunc {
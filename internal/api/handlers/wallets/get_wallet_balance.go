@@ -3,11 +3,15 @@ package wallets
 import (
 	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/go-openapi/swag"
 	"github.com/SafeMPC/mpc-service/internal/api"
 	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
-	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/infra/transaction"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
 	"github.com/SafeMPC/mpc-service/internal/types"
 	"github.com/SafeMPC/mpc-service/internal/types/wallets"
 	"github.com/SafeMPC/mpc-service/internal/util"
@@ -16,7 +20,7 @@ import (
 
 // GetWalletBalanceRoute 注册余额查询路由
 func GetWalletBalanceRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Auth.GET("/wallets/:walletId/balance", getWalletBalanceHandler(s))
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/balance", getWalletBalanceHandler(s), middleware.WalletRole(s, storage.WalletRoleViewer))
 }
 
 // getWalletBalanceHandler 查询钱包余额
@@ -40,6 +44,12 @@ func getWalletBalanceHandler(s *api.Server) echo.HandlerFunc {
 			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "chain_type is required")
 		}
 
+		// 通过链注册表解析链配置和适配器
+		chainInfo, err := s.Chains.Lookup(chainType)
+		if err != nil {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unsupported chain type: "+chainType)
+		}
+
 		// 查询密钥信息（钱包 ID 等于密钥 ID）
 		keyMetadata, err := s.KeyService.GetKey(ctx, walletID)
 		if err != nil {
@@ -58,43 +68,108 @@ func getWalletBalanceHandler(s *api.Server) echo.HandlerFunc {
 			}
 		}
 
-		// 根据链类型选择适配器并查询余额
+		// 解析资产：为空、native 或原生代币符号时查询原生币，否则在链注册表的代币中查找
+		var token *registry.Token
+		if asset := swag.StringValue(params.Asset); asset != "" &&
+			!strings.EqualFold(asset, transaction.NativeAsset) && !strings.EqualFold(asset, chainInfo.Symbol) {
+			token, err = chainInfo.Token(asset)
+			if err != nil {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unknown asset: "+asset)
+			}
+		}
+
 		var balance *big.Int
-		var symbol string
-		var decimals int64
-
-		switch chainType {
-		case "ethereum":
-			// 创建 Ethereum 适配器（使用 Sepolia 测试网 ChainID: 11155111）
-			// TODO: 从配置获取 RPC 端点和 ChainID
-			rpcEndpoint := "https://sepolia.infura.io/v3/YOUR_API_KEY" // TODO: 从配置读取
-			chainID := big.NewInt(11155111)                            // Sepolia
-			ethereumAdapter := chain.NewEthereumAdapter(chainID, rpcEndpoint)
-
-			balance, err = ethereumAdapter.GetBalance(ctx, address)
+		switch chainInfo.Family {
+		case registry.FamilyEVM:
+			adapter, err := chainInfo.EthereumAdapter()
+			if err != nil {
+				return err
+			}
+			if token != nil {
+				balance, err = adapter.GetTokenBalance(ctx, token.Address, address)
+			} else {
+				balance, err = adapter.GetBalance(ctx, address)
+			}
 			if err != nil {
-				log.Error().Err(err).Str("address", address).Msg("Failed to get Ethereum balance")
+				log.Error().Err(err).Str("address", address).Str("chain", chainInfo.Name).Msg("Failed to get EVM balance")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to query balance")
+			}
+		case registry.FamilySolana:
+			adapter, err := chainInfo.SolanaAdapter()
+			if err != nil {
+				return err
+			}
+			if token != nil {
+				balance, err = adapter.GetTokenBalance(ctx, address, token.Address)
+			} else {
+				balance, err = adapter.GetBalance(ctx, address)
+			}
+			if err != nil {
+				log.Error().Err(err).Str("address", address).Str("chain", chainInfo.Name).Msg("Failed to get Solana balance")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to query balance")
+			}
+		case registry.FamilyTron:
+			adapter, err := chainInfo.TronAdapter()
+			if err != nil {
+				return err
+			}
+			if token != nil {
+				balance, err = adapter.GetTokenBalance(ctx, token.Address, address)
+			} else {
+				balance, err = adapter.GetBalance(ctx, address)
+			}
+			if err != nil {
+				log.Error().Err(err).Str("address", address).Str("chain", chainInfo.Name).Msg("Failed to get Tron balance")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to query balance")
+			}
+		case registry.FamilyCosmos:
+			adapter, err := chainInfo.CosmosAdapter()
+			if err != nil {
+				return err
+			}
+			balance, err = adapter.GetBalance(ctx, address)
+			if err != nil {
+				log.Error().Err(err).Str("address", address).Str("chain", chainInfo.Name).Msg("Failed to get Cosmos balance")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to query balance")
+			}
+		case registry.FamilyAptos:
+			adapter, err := chainInfo.AptosAdapter()
+			if err != nil {
+				return err
+			}
+			balance, err = adapter.GetBalance(ctx, address)
+			if err != nil {
+				log.Error().Err(err).Str("address", address).Str("chain", chainInfo.Name).Msg("Failed to get Aptos balance")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to query balance")
+			}
+		case registry.FamilySui:
+			adapter, err := chainInfo.SuiAdapter()
+			if err != nil {
+				return err
+			}
+			balance, err = adapter.GetBalance(ctx, address)
+			if err != nil {
+				log.Error().Err(err).Str("address", address).Str("chain", chainInfo.Name).Msg("Failed to get Sui balance")
 				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to query balance")
 			}
-
-			symbol = "ETH"
-			decimals = 18
 		default:
-			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unsupported chain type: "+chainType)
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Balance query is not supported for chain type: "+chainType)
 		}
 
-		// 转换余额为字符串（Wei -> ETH）
-		// 1 ETH = 10^18 Wei
-		ethDivisor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals), nil))
-		balanceFloat := new(big.Float).SetInt(balance)
-		ethBalance := new(big.Float).Quo(balanceFloat, ethDivisor)
+		// 按资产精度转换余额（如 Wei -> ETH）
+		symbol, decimals, tokenAddress := chainInfo.Symbol, chainInfo.Decimals, ""
+		if token != nil {
+			symbol, decimals, tokenAddress = token.Symbol, token.Decimals, token.Address
+		}
+		divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+		formatted := new(big.Rat).SetFrac(balance, divisor).FloatString(decimals)
 
-		decimalsPtr := swag.Int64(decimals)
 		response := &types.WalletBalanceResponse{
-			Balance:   swag.String(ethBalance.Text('f', 18)),
-			Symbol:    swag.String(symbol),
-			Decimals:  decimalsPtr,
-			ChainType: chainType,
+			Balance:      swag.String(formatted),
+			Symbol:       swag.String(symbol),
+			Decimals:     swag.Int64(int64(decimals)),
+			ChainType:    chainType,
+			TokenAddress: tokenAddress,
 		}
 
 		return util.ValidateAndReturn(c, http.StatusOK, response)
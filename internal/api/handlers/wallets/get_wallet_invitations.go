@@ -0,0 +1,45 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletInvitationsRoute 注册钱包邀请列表路由
+func GetWalletInvitationsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/invitations", getWalletInvitationsHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// getWalletInvitationsHandler 列出钱包的邮件邀请（按创建时间倒序）
+func getWalletInvitationsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.GetWalletInvitationsParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		invitations, err := s.Members.ListInvitations(ctx, params.WalletID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to list wallet invitations")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list wallet invitations")
+		}
+
+		result := make([]*types.WalletInvitation, 0, len(invitations))
+		for _, invitation := range invitations {
+			result = append(result, walletInvitationToTypes(s, invitation))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListWalletInvitationsResponse{Invitations: result})
+	}
+}
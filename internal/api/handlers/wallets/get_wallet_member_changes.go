@@ -0,0 +1,46 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletMemberChangesRoute 注册成员变更列表路由
+func GetWalletMemberChangesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/member-changes", getWalletMemberChangesHandler(s), middleware.WalletRole(s, storage.WalletRoleViewer))
+}
+
+// getWalletMemberChangesHandler 列出钱包的成员变更（按创建时间倒序）
+func getWalletMemberChangesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := wallets.NewGetWalletMemberChangesParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		changes, err := s.Members.ListChanges(ctx, params.WalletID, swag.StringValue(params.Status))
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to list wallet member changes")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list wallet member changes")
+		}
+
+		result := make([]*types.WalletMemberChange, 0, len(changes))
+		for _, change := range changes {
+			result = append(result, walletMemberChangeToTypes(change))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListWalletMemberChangesResponse{Changes: result})
+	}
+}
@@ -0,0 +1,45 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletMembersRoute 注册钱包成员列表路由
+func GetWalletMembersRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/members", getWalletMembersHandler(s), middleware.WalletRole(s, storage.WalletRoleViewer))
+}
+
+// getWalletMembersHandler 列出钱包成员及其角色
+func getWalletMembersHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.GetWalletMembersParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		members, err := s.Members.List(ctx, params.WalletID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to list wallet members")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list wallet members")
+		}
+
+		result := make([]*types.WalletMember, 0, len(members))
+		for _, m := range members {
+			result = append(result, walletMemberToTypes(m))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListWalletMembersResponse{Members: result})
+	}
+}
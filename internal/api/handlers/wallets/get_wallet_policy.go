@@ -0,0 +1,44 @@
+package wallets
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletPolicyRoute 注册查询钱包签名策略路由
+func GetWalletPolicyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/policy", getWalletPolicyHandler(s), middleware.WalletRole(s, storage.WalletRoleViewer))
+}
+
+// getWalletPolicyHandler 返回钱包的签名策略，未配置策略时返回 404
+func getWalletPolicyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.GetWalletPolicyParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		signingPolicy, err := s.SigningService.GetSigningPolicy(ctx, params.WalletID)
+		if err != nil {
+			if errors.Is(err, storage.ErrSigningPolicyNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Signing policy not found")
+			}
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to get signing policy")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get signing policy")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, walletPolicyToTypes(signingPolicy))
+	}
+}
@@ -0,0 +1,70 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletRefreshHistoryRoute 注册分片刷新历史路由
+func GetWalletRefreshHistoryRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/refresh-history", getWalletRefreshHistoryHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// getWalletRefreshHistoryHandler 列出钱包密钥的分片刷新历史（按开始时间倒序）
+func getWalletRefreshHistoryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := wallets.NewGetWalletRefreshHistoryParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		if _, err := s.KeyService.GetKey(ctx, params.WalletID); err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+
+		records, err := s.KeyService.ListKeyRefreshHistory(ctx, params.WalletID, int(swag.Int64Value(params.Limit)))
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to list key refresh history")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list key refresh history")
+		}
+
+		result := make([]*types.KeyRefreshRecord, 0, len(records))
+		for _, record := range records {
+			result = append(result, keyRefreshRecordToTypes(record))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListKeyRefreshHistoryResponse{Records: result})
+	}
+}
+
+// keyRefreshRecordToTypes 转换为 API 类型，失败原因只记录在服务端，不对外返回
+func keyRefreshRecordToTypes(record *storage.KeyRefreshRecord) *types.KeyRefreshRecord {
+	startedAt := strfmt.DateTime(record.StartedAt)
+	result := &types.KeyRefreshRecord{
+		ID:         swag.Int64(record.ID),
+		KeyID:      swag.String(record.KeyID),
+		Trigger:    swag.String(record.Trigger),
+		Attempt:    swag.Int64(int64(record.Attempt)),
+		Status:     swag.String(record.Status),
+		StartedAt:  &startedAt,
+		DurationMs: int64(record.DurationMs),
+	}
+	if record.CompletedAt != nil {
+		result.CompletedAt = strfmt.DateTime(*record.CompletedAt)
+	}
+	return result
+}
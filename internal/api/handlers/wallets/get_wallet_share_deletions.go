@@ -0,0 +1,68 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletShareDeletionsRoute 注册分片删除确认列表路由
+func GetWalletShareDeletionsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/share-deletions", getWalletShareDeletionsHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// getWalletShareDeletionsHandler 列出钱包密钥在各节点的分片删除确认记录
+func getWalletShareDeletionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.GetWalletShareDeletionsParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if _, err := s.KeyService.GetKey(ctx, params.WalletID); err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+
+		deletions, err := s.KeyService.ListKeyShareDeletions(ctx, params.WalletID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to list key share deletions")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list key share deletions")
+		}
+
+		result := make([]*types.KeyShareDeletion, 0, len(deletions))
+		for _, deletion := range deletions {
+			result = append(result, keyShareDeletionToTypes(deletion))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListKeyShareDeletionsResponse{Deletions: result})
+	}
+}
+
+// keyShareDeletionToTypes 转换为 API 类型，节点返回的错误只记录在服务端，不对外返回
+func keyShareDeletionToTypes(deletion *storage.KeyShareDeletion) *types.KeyShareDeletion {
+	requestedAt := strfmt.DateTime(deletion.RequestedAt)
+	result := &types.KeyShareDeletion{
+		NodeID:      swag.String(deletion.NodeID),
+		Status:      swag.String(deletion.Status),
+		Attempts:    swag.Int64(int64(deletion.Attempts)),
+		RequestedAt: &requestedAt,
+		UpdatedAt:   strfmt.DateTime(deletion.UpdatedAt),
+	}
+	if deletion.ConfirmedAt != nil {
+		result.ConfirmedAt = strfmt.DateTime(*deletion.ConfirmedAt)
+	}
+	return result
+}
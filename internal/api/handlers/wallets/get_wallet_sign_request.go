@@ -0,0 +1,38 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletSignRequestRoute 注册签名请求查询路由
+func GetWalletSignRequestRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/sign-requests/:requestId", getWalletSignRequestHandler(s), middleware.WalletRole(s, storage.WalletRoleViewer))
+}
+
+// getWalletSignRequestHandler 查询签名请求及其成员审批记录
+func getWalletSignRequestHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.GetWalletSignRequestParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		signRequest, approvals, err := s.SignRequests.Get(ctx, params.WalletID, params.RequestID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Str("sign_request_id", params.RequestID).Msg("Failed to get sign request")
+			return signRequestHTTPError(err)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, signRequestToTypes(signRequest, approvals))
+	}
+}
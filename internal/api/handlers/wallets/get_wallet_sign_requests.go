@@ -0,0 +1,56 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletSignRequestsRoute 注册签名请求列表路由
+func GetWalletSignRequestsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/sign-requests", getWalletSignRequestsHandler(s), middleware.WalletRole(s, storage.WalletRoleViewer))
+}
+
+// getWalletSignRequestsHandler 列出钱包的签名请求（按创建时间倒序），不包含审批记录
+func getWalletSignRequestsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := wallets.NewGetWalletSignRequestsParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		if _, err := s.KeyService.GetKey(ctx, params.WalletID); err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+
+		requests, err := s.SignRequests.List(ctx, &storage.SignRequestFilter{
+			WalletID: params.WalletID,
+			Status:   swag.StringValue(params.Status),
+			Limit:    int(swag.Int64Value(params.Limit)),
+			Offset:   int(swag.Int64Value(params.Offset)),
+		})
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to list sign requests")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list sign requests")
+		}
+
+		signRequests := make([]*types.SignRequest, 0, len(requests))
+		for _, signRequest := range requests {
+			signRequests = append(signRequests, signRequestToTypes(signRequest, nil))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ListSignRequestsResponse{SignRequests: signRequests})
+	}
+}
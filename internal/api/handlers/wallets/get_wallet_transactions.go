@@ -0,0 +1,120 @@
+package wallets
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/infra/transaction"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// GetWalletTransactionsRoute 注册交易记录查询路由
+func GetWalletTransactionsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/wallets/:walletId/transactions", getWalletTransactionsHandler(s), middleware.WalletRole(s, storage.WalletRoleViewer))
+}
+
+// getWalletTransactionsHandler 查询钱包交易历史：已广播的转出交易及索引器发现的转入
+func getWalletTransactionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := wallets.NewGetWalletTransactionsParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		chainInfo, err := s.Chains.Lookup(params.ChainType)
+		if err != nil {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unsupported chain type: "+params.ChainType)
+		}
+
+		if _, err := s.KeyService.GetKey(ctx, params.WalletID); err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+
+		query := &transaction.HistoryQuery{
+			WalletID:  params.WalletID,
+			ChainType: chainInfo.Name,
+			Direction: swag.StringValue(params.Direction),
+			Asset:     swag.StringValue(params.Asset),
+			Cursor:    swag.StringValue(params.Cursor),
+			Limit:     int(swag.Int64Value(params.Limit)),
+		}
+		if params.From != nil {
+			from := time.Time(*params.From)
+			query.From = &from
+		}
+		if params.To != nil {
+			to := time.Time(*params.To)
+			query.To = &to
+		}
+		if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "from must be before to")
+		}
+
+		entries, nextCursor, err := s.Transactions.History(ctx, query)
+		if err != nil {
+			if errors.Is(err, transaction.ErrInvalidCursor) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid cursor")
+			}
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Str("chain", chainInfo.Name).Msg("Failed to list transactions")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list transactions")
+		}
+
+		transactions := make([]*types.Transaction, 0, len(entries))
+		for _, entry := range entries {
+			transactions = append(transactions, historyEntryToTypes(entry, chainInfo))
+		}
+
+		response := &types.TransactionsResponse{
+			Transactions: transactions,
+			Limit:        int64(query.Limit),
+			NextCursor:   nextCursor,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// historyEntryToTypes 按资产精度转换金额（如 Wei -> ETH），原生币使用链的原生代币符号和精度
+func historyEntryToTypes(entry *storage.WalletHistoryEntry, chainInfo *registry.Chain) *types.Transaction {
+	asset, decimals := entry.Asset, entry.Decimals
+	if asset == "" {
+		asset, decimals = chainInfo.Symbol, chainInfo.Decimals
+	}
+
+	value := entry.Value
+	if amount, ok := new(big.Int).SetString(entry.Value, 10); ok {
+		divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+		value = new(big.Rat).SetFrac(amount, divisor).FloatString(decimals)
+	}
+
+	return &types.Transaction{
+		TxHash:        entry.TxHash,
+		Direction:     entry.Direction,
+		From:          entry.FromAddress,
+		To:            entry.ToAddress,
+		Value:         value,
+		Asset:         asset,
+		Status:        entry.Status,
+		BlockNumber:   entry.BlockNumber,
+		Confirmations: entry.Confirmations,
+		ReplacedBy:    entry.ReplacedBy,
+		Error:         entry.Error,
+		Timestamp:     strfmt.DateTime(entry.Timestamp),
+	}
+}
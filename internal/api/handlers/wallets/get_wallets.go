@@ -23,9 +23,11 @@ func getWalletsHandler(s *api.Server) echo.HandlerFunc {
 		ctx := c.Request().Context()
 		log := util.LogFromContext(ctx)
 
-		// TODO: JWT Token 验证（从请求头获取）
-		// 从 JWT Token 中获取 userID
-		// userID := getUserIDFromToken(c)
+		// 只列出会话用户的 Passkey 凭证是成员的钱包
+		userID := sessionUserID(c, s)
+		if userID == "" {
+			return httperrors.NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeGeneric, "Valid passkey session token is required")
+		}
 
 		// 使用统一的参数绑定方式
 		var params wallets.GetWalletsParams
@@ -53,6 +55,8 @@ func getWalletsHandler(s *api.Server) echo.HandlerFunc {
 			ChainType: chainType,
 			Limit:     int(limit),
 			Offset:    int(offset),
+
+			MemberUserID: userID,
 		}
 
 		// 查询密钥列表
@@ -0,0 +1,53 @@
+package wallets_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWalletsListsOnlyMemberWallets(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		// 列表响应按 uuid 校验钱包 ID
+		saveWallet := func() string {
+			now := time.Now()
+			walletID := uuid.New().String()
+			require.NoError(t, metadataStore(s).SaveKeyMetadata(t.Context(), &storage.KeyMetadata{
+				KeyID:      walletID,
+				PublicKey:  "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc",
+				Algorithm:  "ECDSA",
+				Curve:      "secp256k1",
+				Threshold:  2,
+				TotalNodes: 2,
+				ChainType:  "ethereum",
+				Status:     storage.KeyStatusActive,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}))
+			return walletID
+		}
+		aliceWallet := saveWallet()
+		bobWallet := saveWallet()
+		addTestMember(t, s, aliceWallet, "user-alice", "alice-key", storage.WalletRoleViewer)
+		addTestMember(t, s, bobWallet, "user-bob", "bob-key", storage.WalletRoleOwner)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/wallets", nil, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/wallets", nil, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-alice")))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var list types.ListWalletsResponse
+		test.ParseResponseAndValidate(t, res, &list)
+		require.Len(t, list.Wallets, 1)
+		assert.Equal(t, aliceWallet, list.Wallets[0].WalletID.String())
+	})
+}
@@ -0,0 +1,53 @@
+package wallets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// changeWalletStatus 执行钱包生命周期变更并返回统一的响应，状态迁移校验由 key.Service 负责
+func changeWalletStatus(c echo.Context, s *api.Server, walletID string, change func(ctx context.Context) (*key.KeyMetadata, error)) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	if _, err := s.KeyService.GetKey(ctx, walletID); err != nil {
+		log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to get key")
+		return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+	}
+
+	updated, err := change(ctx)
+	if err != nil {
+		log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to change wallet status")
+		switch {
+		case errors.Is(err, storage.ErrInvalidKeyStatusTransition):
+			return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet status does not allow this operation")
+		case errors.Is(err, key.ErrShareDeletionStarted):
+			return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Key share deletion already started, deletion cannot be cancelled")
+		case errors.Is(err, key.ErrInvalidDeletionWindow):
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, key.ErrInvalidDeletionWindow.Error())
+		default:
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to change wallet status")
+		}
+	}
+
+	response := &types.WalletLifecycleResponse{
+		WalletID: swag.String(updated.KeyID),
+		Status:   swag.String(updated.Status),
+	}
+	if updated.DeletionDate != nil {
+		response.DeletionDate = strfmt.DateTime(*updated.DeletionDate)
+	}
+
+	return util.ValidateAndReturn(c, http.StatusOK, response)
+}
@@ -0,0 +1,164 @@
+package wallets
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// proposeMemberChange 提出成员变更，变更等待 owner 批准，返回 202
+func proposeMemberChange(c echo.Context, s *api.Server, change *storage.WalletMemberChange) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	if _, err := s.KeyService.GetKey(ctx, change.WalletID); err != nil {
+		log.Error().Err(err).Str("wallet_id", change.WalletID).Msg("Failed to get key")
+		return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+	}
+
+	proposed, err := s.Members.Propose(ctx, member.MembershipFromContext(ctx), change)
+	if err != nil {
+		log.Error().Err(err).Str("wallet_id", change.WalletID).Str("action", change.Action).Msg("Failed to propose wallet member change")
+		return memberHTTPError(err)
+	}
+
+	return util.ValidateAndReturn(c, http.StatusAccepted, walletMemberChangeToTypes(proposed))
+}
+
+// reviewMemberChange 校验 owner 的 WebAuthn assertion 并批准或拒绝成员变更，返回更新后的变更
+func reviewMemberChange(c echo.Context, s *api.Server, walletID, changeID string, body *types.PostWalletMemberChangeDecisionPayload, approve bool) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	webauthnAssertion := body.WebauthnAssertion
+	if webauthnAssertion.CredentialID == nil || webauthnAssertion.AuthenticatorData == nil ||
+		webauthnAssertion.ClientDataJSON == nil || webauthnAssertion.Signature == nil {
+		return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "webauthn_assertion is incomplete")
+	}
+	assertion := &member.Assertion{
+		CredentialID:      base64.RawURLEncoding.EncodeToString(*webauthnAssertion.CredentialID),
+		AuthenticatorData: *webauthnAssertion.AuthenticatorData,
+		ClientDataJSON:    *webauthnAssertion.ClientDataJSON,
+		Signature:         *webauthnAssertion.Signature,
+	}
+
+	review := s.Members.Approve
+	if !approve {
+		review = s.Members.Reject
+	}
+	change, err := review(ctx, walletID, changeID, assertion)
+	if err != nil {
+		log.Error().Err(err).Str("wallet_id", walletID).Str("change_id", changeID).Bool("approve", approve).Msg("Failed to review wallet member change")
+		return memberHTTPError(err)
+	}
+
+	return util.ValidateAndReturn(c, http.StatusOK, walletMemberChangeToTypes(change))
+}
+
+// memberHTTPError 把成员管理的错误转换为不包含内部细节的 HTTP 错误，错误详情由调用方记录日志
+func memberHTTPError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrWalletMemberChangeNotFound):
+		return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet member change not found")
+	case errors.Is(err, storage.ErrWalletInvitationNotFound):
+		return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet invitation not found")
+	case errors.Is(err, member.ErrInvalidAssertion):
+		return httperrors.NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeGeneric, "Invalid WebAuthn assertion")
+	case errors.Is(err, member.ErrNotWalletMember):
+		return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Caller is not a member of this wallet")
+	case errors.Is(err, member.ErrInsufficientRole):
+		return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Wallet role does not allow this operation")
+	case errors.Is(err, member.ErrLastOwner):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet must keep at least one owner")
+	case errors.Is(err, member.ErrInvalidMemberChange):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Member change does not match the current wallet members")
+	case errors.Is(err, member.ErrNotPending):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet member change is not pending")
+	case errors.Is(err, member.ErrDuplicateInvitation):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "A pending invitation already exists for this email")
+	case errors.Is(err, member.ErrInvitationNotPending):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet invitation is not pending")
+	case errors.Is(err, member.ErrInvitationExpired):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet invitation has expired")
+	default:
+		return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to process wallet member change")
+	}
+}
+
+// sessionUserID 从 Authorization 头的 Passkey 会话令牌中解析 userID，令牌缺失或无效时返回空字符串
+func sessionUserID(c echo.Context, s *api.Server) string {
+	userID, err := middleware.SessionUserID(c, s)
+	if err != nil {
+		util.LogFromContext(c.Request().Context()).Debug().Err(err).Msg("Failed to validate passkey session token")
+		return ""
+	}
+	return userID
+}
+
+func walletMemberToTypes(m *storage.WalletMember) *types.WalletMember {
+	return &types.WalletMember{
+		CredentialID: swag.String(m.CredentialID),
+		Role:         swag.String(m.Role),
+		CreatedAt:    strfmt.DateTime(m.CreatedAt),
+	}
+}
+
+func walletMemberChangeToTypes(change *storage.WalletMemberChange) *types.WalletMemberChange {
+	changeID := strfmt.UUID(change.ChangeID)
+	result := &types.WalletMemberChange{
+		ChangeID:     &changeID,
+		WalletID:     swag.String(change.WalletID),
+		Action:       swag.String(change.Action),
+		CredentialID: swag.String(change.CredentialID),
+		Role:         change.Role,
+		Status:       swag.String(change.Status),
+		ChangeHash:   swag.String(change.ChangeHash),
+		ProposedBy:   change.ProposedBy,
+		DecidedBy:    change.DecidedBy,
+		CreatedAt:    strfmt.DateTime(change.CreatedAt),
+	}
+	if change.DecidedAt != nil {
+		result.DecidedAt = strfmt.DateTime(*change.DecidedAt)
+	}
+	return result
+}
+
+// walletInvitationToTypes 转换钱包邀请，已过期的 pending 邀请返回 expired 状态，不返回令牌
+func walletInvitationToTypes(s *api.Server, invitation *storage.WalletInvitation) *types.WalletInvitation {
+	invitationID := strfmt.UUID(invitation.InvitationID)
+	email := strfmt.Email(invitation.Email)
+	validUntil := strfmt.DateTime(invitation.ValidUntil)
+	status := invitation.Status
+	if s.Members.InvitationExpired(invitation) {
+		status = types.WalletInvitationStatusExpired
+	}
+	result := &types.WalletInvitation{
+		InvitationID:         &invitationID,
+		WalletID:             swag.String(invitation.WalletID),
+		Email:                &email,
+		Role:                 swag.String(invitation.Role),
+		Status:               swag.String(status),
+		InvitedBy:            invitation.InvitedBy,
+		AcceptedCredentialID: invitation.AcceptedCredentialID,
+		ValidUntil:           &validUntil,
+		CreatedAt:            strfmt.DateTime(invitation.CreatedAt),
+	}
+	if invitation.AcceptedAt != nil {
+		result.AcceptedAt = strfmt.DateTime(*invitation.AcceptedAt)
+	}
+	if invitation.RevokedAt != nil {
+		result.RevokedAt = strfmt.DateTime(*invitation.RevokedAt)
+	}
+	return result
+}
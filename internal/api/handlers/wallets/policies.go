@@ -0,0 +1,72 @@
+package wallets
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+func walletPolicyToTypes(signingPolicy *storage.SigningPolicy) *types.WalletPolicy {
+	defaultAction := signingPolicy.DefaultAction
+	if defaultAction == "" {
+		defaultAction = storage.PolicyActionAllow
+	}
+	rules := make([]*types.WalletPolicyRule, 0, len(signingPolicy.Rules))
+	for i := range signingPolicy.Rules {
+		rules = append(rules, policyRuleToTypes(&signingPolicy.Rules[i]))
+	}
+	return &types.WalletPolicy{
+		WalletID:      swag.String(signingPolicy.WalletID),
+		PolicyType:    swag.String(signingPolicy.PolicyType),
+		MinSignatures: swag.Int64(int64(signingPolicy.MinSignatures)),
+		DefaultAction: swag.String(defaultAction),
+		Rules:         rules,
+		CreatedAt:     strfmt.DateTime(signingPolicy.CreatedAt),
+		UpdatedAt:     strfmt.DateTime(signingPolicy.UpdatedAt),
+	}
+}
+
+func policyRuleToTypes(rule *storage.PolicyRule) *types.WalletPolicyRule {
+	result := &types.WalletPolicyRule{
+		Name:                rule.Name,
+		Action:              swag.String(rule.Action),
+		Chains:              rule.Chains,
+		ExcludeChains:       rule.ExcludeChains,
+		Assets:              rule.Assets,
+		AmountAbove:         rule.AmountAbove,
+		Destinations:        rule.Destinations,
+		ExcludeDestinations: rule.ExcludeDestinations,
+	}
+	if rule.TimeWindow != nil {
+		result.TimeWindow = &types.WalletPolicyTimeWindow{
+			Start:    swag.String(rule.TimeWindow.Start),
+			End:      swag.String(rule.TimeWindow.End),
+			Timezone: rule.TimeWindow.Timezone,
+			Outside:  rule.TimeWindow.Outside,
+		}
+	}
+	return result
+}
+
+func policyRuleFromTypes(rule *types.WalletPolicyRule) storage.PolicyRule {
+	result := storage.PolicyRule{
+		Name:                rule.Name,
+		Action:              swag.StringValue(rule.Action),
+		Chains:              rule.Chains,
+		ExcludeChains:       rule.ExcludeChains,
+		Assets:              rule.Assets,
+		AmountAbove:         rule.AmountAbove,
+		Destinations:        rule.Destinations,
+		ExcludeDestinations: rule.ExcludeDestinations,
+	}
+	if rule.TimeWindow != nil {
+		result.TimeWindow = &storage.PolicyTimeWindow{
+			Start:    swag.StringValue(rule.TimeWindow.Start),
+			End:      swag.StringValue(rule.TimeWindow.End),
+			Timezone: rule.TimeWindow.Timezone,
+			Outside:  rule.TimeWindow.Outside,
+		}
+	}
+	return result
+}
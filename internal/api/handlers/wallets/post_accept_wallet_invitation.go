@@ -0,0 +1,53 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// PostAcceptWalletInvitationRoute 注册接受钱包邀请路由
+func PostAcceptWalletInvitationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallet-invitations/:token/accept", postAcceptWalletInvitationHandler(s))
+}
+
+// postAcceptWalletInvitationHandler 受邀人用 Passkey 会话令牌和自己的凭证接受邀请：viewer 和 approver 直接加入钱包返回 200，
+// owner 和 admin 邀请返回 202 和待 owner 批准的成员变更
+func postAcceptWalletInvitationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.PostAcceptWalletInvitationParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostAcceptWalletInvitationPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		userID := sessionUserID(c, s)
+		if userID == "" {
+			return httperrors.NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeGeneric, "Valid passkey session token is required")
+		}
+
+		invitation, change, err := s.Members.AcceptInvitation(ctx, params.Token, userID, swag.StringValue(body.CredentialID))
+		if err != nil {
+			log.Error().Err(err).Str("user_id", userID).Msg("Failed to accept wallet invitation")
+			return memberHTTPError(err)
+		}
+
+		if change != nil {
+			return util.ValidateAndReturn(c, http.StatusAccepted, walletMemberChangeToTypes(change))
+		}
+		return util.ValidateAndReturn(c, http.StatusOK, walletInvitationToTypes(s, invitation))
+	}
+}
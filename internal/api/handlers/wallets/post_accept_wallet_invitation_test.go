@@ -0,0 +1,91 @@
+package wallets_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAcceptWalletInvitation(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		walletID := createTestWallet(t, s)
+		owner := addTestMember(t, s, walletID, "user-owner", "owner-key", storage.WalletRoleOwner)
+		carol := addTestPasskey(t, s, "user-carol", "carol-key")
+
+		invitation, err := s.Members.Invite(ctx, &member.Membership{WalletID: walletID, CredentialID: owner, Role: storage.WalletRoleOwner},
+			walletID, "carol@example.com", storage.WalletRoleViewer)
+		require.NoError(t, err)
+
+		path := "/api/v1/auth/wallet-invitations/" + invitation.Token + "/accept"
+		headers := test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-carol"))
+
+		res := test.PerformRequest(t, s, "POST", path, test.GenericPayload{"credential_id": carol}, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		// 只能用调用者自己的凭证接受
+		res = test.PerformRequest(t, s, "POST", path, test.GenericPayload{"credential_id": owner}, headers)
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/wallet-invitations/"+uuid.New().String()+"/accept", test.GenericPayload{"credential_id": carol}, headers)
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", path, test.GenericPayload{"credential_id": carol}, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var accepted types.WalletInvitation
+		test.ParseResponseAndValidate(t, res, &accepted)
+		assert.Equal(t, storage.WalletInvitationStatusAccepted, *accepted.Status)
+
+		isMember, role, err := metadataStore(s).IsWalletMember(ctx, walletID, carol)
+		require.NoError(t, err)
+		assert.True(t, isMember)
+		assert.Equal(t, storage.WalletRoleViewer, role)
+
+		// 令牌只能使用一次
+		res = test.PerformRequest(t, s, "POST", path, test.GenericPayload{"credential_id": carol}, headers)
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+	})
+}
+
+func TestPostAcceptWalletInvitationRequiresOwnerApproval(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		walletID := createTestWallet(t, s)
+		owner := addTestMember(t, s, walletID, "user-owner", "owner-key", storage.WalletRoleOwner)
+		carol := addTestPasskey(t, s, "user-carol", "carol-key")
+
+		invitation, err := s.Members.Invite(ctx, &member.Membership{WalletID: walletID, CredentialID: owner, Role: storage.WalletRoleOwner},
+			walletID, "carol@example.com", storage.WalletRoleAdmin)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/wallet-invitations/"+invitation.Token+"/accept",
+			test.GenericPayload{"credential_id": carol}, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-carol")))
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		var change types.WalletMemberChange
+		test.ParseResponseAndValidate(t, res, &change)
+		assert.Equal(t, storage.WalletMemberChangeStatusPending, *change.Status)
+		assert.Equal(t, storage.WalletRoleAdmin, change.Role)
+		assert.Equal(t, carol, *change.CredentialID)
+		assert.Equal(t, owner, change.ProposedBy)
+
+		// 持有邀请令牌不足以成为 admin，等待 owner 批准
+		isMember, _, err := metadataStore(s).IsWalletMember(ctx, walletID, carol)
+		require.NoError(t, err)
+		assert.False(t, isMember)
+
+		changes, err := metadataStore(s).ListWalletMemberChanges(ctx, walletID, storage.WalletMemberChangeStatusPending)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, change.ChangeID.String(), changes[0].ChangeID)
+	})
+}
@@ -0,0 +1,33 @@
+package wallets
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostApproveSignRequestRoute 注册签名请求批准路由
+func PostApproveSignRequestRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/sign-requests/:requestId/approve", postApproveSignRequestHandler(s), middleware.WalletRole(s, storage.WalletRoleApprover))
+}
+
+// postApproveSignRequestHandler 钱包成员使用 Passkey 批准签名请求，批准数达到要求后在后台执行阈值签名
+func postApproveSignRequestHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostApproveSignRequestParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostSignRequestDecisionPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		return reviewSignRequest(c, s, params.WalletID, params.RequestID, &body, true)
+	}
+}
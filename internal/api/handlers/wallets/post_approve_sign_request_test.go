@@ -0,0 +1,155 @@
+package wallets_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/auth"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostApproveSignRequestRoleDenied(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		walletID := createTestWallet(t, s)
+		viewer := addTestMember(t, s, walletID, "user-viewer", "viewer-key", storage.WalletRoleViewer)
+		addTestMember(t, s, walletID, "user-owner", "owner-key", storage.WalletRoleOwner)
+		requestID := createTestSignRequest(t, s, walletID)
+
+		path := "/api/v1/auth/wallets/" + walletID + "/sign-requests/" + requestID + "/approve"
+		body := signRequestDecisionPayload(viewer)
+
+		res := test.PerformRequest(t, s, "POST", path, body, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", path, body, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-viewer")))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", path, body, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-outsider")))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+	})
+}
+
+func TestPostApproveSignRequestForeignCredential(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		walletID := createTestWallet(t, s)
+		addTestMember(t, s, walletID, "user-alice", "alice-key", storage.WalletRoleApprover)
+		bob := addTestMember(t, s, walletID, "user-bob", "bob-key", storage.WalletRoleApprover)
+		requestID := createTestSignRequest(t, s, walletID)
+
+		path := "/api/v1/auth/wallets/" + walletID + "/sign-requests/" + requestID + "/approve"
+
+		// alice 不能用 bob 的凭证审批
+		res := test.PerformRequest(t, s, "POST", path, signRequestDecisionPayload(bob), test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-alice")))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		// bob 自己的凭证，但 assertion 不是对请求哈希的签名
+		res = test.PerformRequest(t, s, "POST", path, signRequestDecisionPayload(bob), test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-bob")))
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		approvals, err := metadataStore(s).ListSignRequestApprovals(ctx, requestID)
+		require.NoError(t, err)
+		assert.Empty(t, approvals)
+	})
+}
+
+func metadataStore(s *api.Server) storage.MetadataStore {
+	return s.WebAuthnService.GetMetadataStore()
+}
+
+// createTestWallet 保存一个 active 的钱包密钥，返回钱包 ID
+func createTestWallet(t *testing.T, s *api.Server) string {
+	t.Helper()
+
+	now := time.Now()
+	walletID := "wallet-" + uuid.New().String()
+	err := metadataStore(s).SaveKeyMetadata(t.Context(), &storage.KeyMetadata{
+		KeyID:      walletID,
+		PublicKey:  "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc",
+		Algorithm:  "ECDSA",
+		Curve:      "secp256k1",
+		Threshold:  2,
+		TotalNodes: 3,
+		ChainType:  "ethereum",
+		Status:     storage.KeyStatusActive,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+	require.NoError(t, err)
+	return walletID
+}
+
+// addTestMember 为 userID 注册名为 name 的 Passkey 凭证并以 role 加入钱包，返回 Base64URL 编码的凭证 ID
+func addTestMember(t *testing.T, s *api.Server, walletID, userID, name, role string) string {
+	t.Helper()
+
+	credentialID := addTestPasskey(t, s, userID, name)
+	require.NoError(t, metadataStore(s).AddWalletMember(t.Context(), walletID, credentialID, role))
+	return credentialID
+}
+
+// addTestPasskey 为 userID 注册名为 name 的 Passkey 凭证，返回 Base64URL 编码的凭证 ID
+func addTestPasskey(t *testing.T, s *api.Server, userID, name string) string {
+	t.Helper()
+
+	ctx := t.Context()
+	credentialID := base64.RawURLEncoding.EncodeToString([]byte(name))
+	require.NoError(t, metadataStore(s).SavePasskey(ctx, &storage.Passkey{
+		CredentialID: credentialID,
+		PublicKey:    "a5010203262001215820",
+		DeviceName:   name,
+		CreatedAt:    time.Now(),
+	}))
+	require.NoError(t, metadataStore(s).SaveUserCredential(ctx, userID, credentialID, name))
+	return credentialID
+}
+
+// passkeySessionToken 签发 Passkey 登录的会话令牌，subject 为 userID
+func passkeySessionToken(t *testing.T, s *api.Server, userID string) string {
+	t.Helper()
+
+	token, err := auth.NewJWTManager(s.Config.MPC.JWTSecret, "", time.Hour).Generate(userID, "", nil)
+	require.NoError(t, err)
+	return token
+}
+
+// createTestSignRequest 保存一个需要一个审批的待审批签名请求，返回请求 ID
+func createTestSignRequest(t *testing.T, s *api.Server, walletID string) string {
+	t.Helper()
+
+	now := time.Now()
+	requestID := uuid.New().String()
+	require.NoError(t, metadataStore(s).SaveSignRequest(t.Context(), &storage.SignRequest{
+		RequestID:         requestID,
+		WalletID:          walletID,
+		ChainType:         "ethereum",
+		MessageHex:        "abcdef",
+		RequestHash:       "3f6c",
+		Status:            storage.SignRequestStatusAwaitingApproval,
+		RequiredApprovals: 1,
+		ExpiresAt:         now.Add(time.Hour),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}))
+	return requestID
+}
+
+// signRequestDecisionPayload 使用 credentialID 的审批请求体，assertion 不是有效的签名
+func signRequestDecisionPayload(credentialID string) test.GenericPayload {
+	rawCredentialID, _ := base64.RawURLEncoding.DecodeString(credentialID)
+	return test.GenericPayload{
+		"webauthn_assertion": map[string]interface{}{
+			"credential_id":      base64.StdEncoding.EncodeToString(rawCredentialID),
+			"authenticator_data": base64.StdEncoding.EncodeToString([]byte("authenticator-data")),
+			"client_data_json":   base64.StdEncoding.EncodeToString([]byte(`{"type":"webauthn.get"}`)),
+			"signature":          base64.StdEncoding.EncodeToString([]byte("signature")),
+		},
+	}
+}
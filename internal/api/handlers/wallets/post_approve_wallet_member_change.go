@@ -0,0 +1,33 @@
+package wallets
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostApproveWalletMemberChangeRoute 注册成员变更批准路由
+func PostApproveWalletMemberChangeRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/member-changes/:changeId/approve", postApproveWalletMemberChangeHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// postApproveWalletMemberChangeHandler owner 使用 Passkey 批准成员变更，变更立即生效
+func postApproveWalletMemberChangeHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostApproveWalletMemberChangeParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostWalletMemberChangeDecisionPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		return reviewMemberChange(c, s, params.WalletID, params.ChangeID, &body, true)
+	}
+}
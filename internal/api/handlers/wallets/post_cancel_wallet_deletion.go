@@ -0,0 +1,32 @@
+package wallets
+
+import (
+	"context"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostCancelWalletDeletionRoute 注册取消删除钱包路由
+func PostCancelWalletDeletionRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/cancel-deletion", postCancelWalletDeletionHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// postCancelWalletDeletionHandler 在删除等待期内取消删除，钱包回到 Disabled 状态
+func postCancelWalletDeletionHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostCancelWalletDeletionParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		return changeWalletStatus(c, s, params.WalletID, func(ctx context.Context) (*key.KeyMetadata, error) {
+			return s.KeyService.CancelKeyDeletion(ctx, params.WalletID)
+		})
+	}
+}
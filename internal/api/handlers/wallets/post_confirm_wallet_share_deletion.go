@@ -0,0 +1,59 @@
+package wallets
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostConfirmWalletShareDeletionRoute 注册设备分片删除确认路由
+func PostConfirmWalletShareDeletionRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/share-deletions/:nodeId/confirm", postConfirmWalletShareDeletionHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// postConfirmWalletShareDeletionHandler 移动端/客户端设备删除本地分片后确认
+func postConfirmWalletShareDeletionHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.PostConfirmWalletShareDeletionParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if _, err := s.KeyService.GetKey(ctx, params.WalletID); err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+
+		deletion, err := s.KeyService.ConfirmDeviceShareDeletion(ctx, params.WalletID, params.NodeID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Str("node_id", params.NodeID).Msg("Failed to confirm device share deletion")
+			switch {
+			case errors.Is(err, key.ErrNotClientNode):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Node is not a client device node")
+			case errors.Is(err, key.ErrShareDeletionNotRequested):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "No pending share deletion for this node")
+			default:
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to confirm share deletion")
+			}
+		}
+
+		log.Info().
+			Str("wallet_id", params.WalletID).
+			Str("node_id", params.NodeID).
+			Msg("Device share deletion confirmed")
+
+		return util.ValidateAndReturn(c, http.StatusOK, keyShareDeletionToTypes(deletion))
+	}
+}
@@ -1,6 +1,7 @@
 package wallets
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net"
 	"net/http"
@@ -10,9 +11,13 @@ import (
 
 	"github.com/SafeMPC/mpc-service/internal/api"
 	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/auth"
 	"github.com/SafeMPC/mpc-service/internal/infra/key"
 	"github.com/SafeMPC/mpc-service/internal/infra/service"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
 	"github.com/SafeMPC/mpc-service/internal/types"
 	"github.com/SafeMPC/mpc-service/internal/util"
 	"github.com/go-openapi/strfmt"
@@ -36,21 +41,15 @@ func postCreateWalletHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
-		// TODO: JWT Token 验证（从请求头获取）
-		// 这里暂时跳过，等待 JWT 中间件实现
-
-		// WebAuthn 二次验证（测试环境暂时允许跳过）
-		// TODO: 生产环境必须要求 WebAuthn Assertion
-		if body.WebauthnAssertion != nil {
-			credentialID := body.WebauthnAssertion.CredentialID
-			if credentialID == nil {
-				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "credential_id is required")
-			}
-			// TODO: 验证 WebAuthn Assertion
-			// 需要从 JWT Token 中获取 userID，然后验证 assertion
-			_ = credentialID // 暂时未使用
-		} else {
-			log.Warn().Msg("WebAuthn assertion not provided - skipping validation for testing")
+		// 创建者由 Passkey 会话令牌确定，创建者的凭证与钱包在同一事务中成为 owner，
+		// 之后签名、成员管理等钱包接口都按成员角色授权
+		userID := sessionUserID(c, s)
+		if userID == "" {
+			return httperrors.NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeGeneric, "Valid passkey session token is required")
+		}
+		ownerCredentialID, err := creatorCredentialID(c, s, userID, body.WebauthnAssertion)
+		if err != nil {
+			return err
 		}
 
 		// 生成钱包 ID（使用 UUID）
@@ -63,6 +62,30 @@ func postCreateWalletHandler(s *api.Server) echo.HandlerFunc {
 		protocol := inferProtocol(algorithm, curve)
 		chainType := swag.StringValue(body.ChainType)
 
+		// chain_type 必须是链注册表中的链
+		chainInfo, err := s.Chains.Lookup(chainType)
+		if err != nil {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unsupported chain_type: "+chainType)
+		}
+
+		// 地址类型只对 Bitcoin 钱包有意义，记录在密钥 Tags 中，生成地址时使用
+		var tags map[string]string
+		if body.AddressType != "" {
+			if chainInfo.Family != registry.FamilyBitcoin {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "address_type is only supported for bitcoin wallets")
+			}
+			// 钱包的签名算法必须能花费该类型的输出（P2TR 需要 Schnorr，其余需要 ECDSA）
+			addressType, err := chain.ParseBitcoinAddressType(body.AddressType)
+			if err != nil {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unsupported address_type: "+body.AddressType)
+			}
+			if !strings.EqualFold(algorithm, addressType.SignatureAlgorithm()) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric,
+					fmt.Sprintf("address_type %s requires the %s algorithm", addressType, addressType.SignatureAlgorithm()))
+			}
+			tags = map[string]string{key.BitcoinAddressTypeTag: body.AddressType}
+		}
+
 		// 2-of-2 模式：需要提供 mobile node ID
 		mobileNodeID := body.MobileNodeID
 		if mobileNodeID == "" {
@@ -78,7 +101,10 @@ func postCreateWalletHandler(s *api.Server) echo.HandlerFunc {
 			Threshold:    2,
 			TotalNodes:   2,
 			ChainType:    chainType,
+			Tags:         tags,
 			MobileNodeID: mobileNodeID,
+
+			OwnerCredentialID: ownerCredentialID,
 		}
 
 		// 创建 pending key（不执行 DKG，只创建占位符）
@@ -102,14 +128,26 @@ func postCreateWalletHandler(s *api.Server) echo.HandlerFunc {
 		dkgSession, err := s.MPCService.CreateDKGSession(ctx, dkgReq)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to create DKG session")
+			s.Audit.Record(ctx, audit.Entry{
+				EventType: audit.EventTypeKey,
+				Operation: audit.OperationCreate,
+				Result:    audit.ResultFailure,
+				KeyID:     keyID,
+				Details: map[string]interface{}{
+					"algorithm": algorithm,
+					"curve":     curve,
+					"error":     err.Error(),
+				},
+			})
 			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create wallet: "+err.Error())
 		}
 
 		// 生成临时 JWT Token
 		// TODO: 在生产环境中，应该使用真实的认证 Token
-		secretKey := s.Config.MPC.JWTSecret
-		if secretKey == "" {
-			secretKey = "default-secret-key-change-in-production"
+		secretKey, err := middleware.JWTSecret(s)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to generate temp JWT token")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create wallet")
 		}
 		issuer := "safempc"
 		if s.Config.MPC.JWTIssuer != "" {
@@ -168,6 +206,24 @@ func postCreateWalletHandler(s *api.Server) echo.HandlerFunc {
 			Strs("signer_endpoints", signerEndpoints).
 			Msg("Created DKG session with mobile node ID")
 
+		s.Audit.Record(ctx, audit.Entry{
+			EventType: audit.EventTypeKey,
+			Operation: audit.OperationCreate,
+			Result:    audit.ResultSuccess,
+			KeyID:     keyID,
+			NodeID:    mobileNodeID,
+			SessionID: dkgSession.SessionID,
+			Details: map[string]interface{}{
+				"algorithm":           algorithm,
+				"curve":               curve,
+				"chain_type":          chainType,
+				"protocol":            protocol,
+				"threshold":           keyReq.Threshold,
+				"total_nodes":         keyReq.TotalNodes,
+				"owner_credential_id": ownerCredentialID,
+			},
+		})
+
 		// 返回响应
 		walletIDUUID := strfmt.UUID(walletID)
 		dkgSessionIDUUID := strfmt.UUID(dkgSession.SessionID)
@@ -184,6 +240,33 @@ func postCreateWalletHandler(s *api.Server) echo.HandlerFunc {
 	}
 }
 
+// creatorCredentialID 返回成为钱包 owner 的创建者凭证（Base64URL）：提供 webauthn_assertion 时使用其凭证，
+// 凭证必须属于会话用户；否则使用用户最近注册的 Passkey
+func creatorCredentialID(c echo.Context, s *api.Server, userID string, webauthnAssertion *types.WebAuthnAssertion) (string, error) {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	passkeys, err := s.WebAuthnService.GetMetadataStore().ListUserPasskeys(ctx, userID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", userID).Msg("Failed to list user passkeys")
+		return "", httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create wallet")
+	}
+	if len(passkeys) == 0 {
+		return "", httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "A registered passkey is required to create a wallet")
+	}
+	if webauthnAssertion == nil || webauthnAssertion.CredentialID == nil {
+		return passkeys[0].CredentialID, nil
+	}
+
+	credentialID := base64.RawURLEncoding.EncodeToString(*webauthnAssertion.CredentialID)
+	for _, passkey := range passkeys {
+		if passkey.CredentialID == credentialID {
+			return credentialID, nil
+		}
+	}
+	return "", httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Credential does not belong to the caller")
+}
+
 // inferProtocol 根据算法和曲线推断协议
 func inferProtocol(algorithm, curve string) string {
 	algo := swag.String(algorithm)
@@ -0,0 +1,40 @@
+package wallets_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostCreateWalletRequiresPasskeyOwner(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		body := test.GenericPayload{
+			"algorithm":  "ECDSA",
+			"curve":      "secp256k1",
+			"chain_type": "ethereum",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/wallets", body, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		// 没有注册 Passkey 的用户不能成为 owner
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/wallets", body, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-alice")))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		// 不能把其他用户的凭证指定为 owner
+		addTestPasskey(t, s, "user-alice", "alice-key")
+		addTestPasskey(t, s, "user-bob", "bob-key")
+		body["webauthn_assertion"] = map[string]interface{}{
+			"credential_id":      base64.StdEncoding.EncodeToString([]byte("bob-key")),
+			"authenticator_data": base64.StdEncoding.EncodeToString([]byte("authenticator-data")),
+			"client_data_json":   base64.StdEncoding.EncodeToString([]byte(`{"type":"webauthn.get"}`)),
+			"signature":          base64.StdEncoding.EncodeToString([]byte("signature")),
+		}
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/wallets", body, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-alice")))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+	})
+}
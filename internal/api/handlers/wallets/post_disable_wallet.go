@@ -0,0 +1,32 @@
+package wallets
+
+import (
+	"context"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostDisableWalletRoute 注册禁用钱包路由
+func PostDisableWalletRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/disable", postDisableWalletHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// postDisableWalletHandler 禁用钱包（Active -> Disabled），禁用后不能签名
+func postDisableWalletHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostDisableWalletParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		return changeWalletStatus(c, s, params.WalletID, func(ctx context.Context) (*key.KeyMetadata, error) {
+			return s.KeyService.DisableKey(ctx, params.WalletID)
+		})
+	}
+}
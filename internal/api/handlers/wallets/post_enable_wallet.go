@@ -0,0 +1,32 @@
+package wallets
+
+import (
+	"context"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostEnableWalletRoute 注册启用钱包路由
+func PostEnableWalletRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/enable", postEnableWalletHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// postEnableWalletHandler 启用钱包（Disabled -> Active）
+func postEnableWalletHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostEnableWalletParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		return changeWalletStatus(c, s, params.WalletID, func(ctx context.Context) (*key.KeyMetadata, error) {
+			return s.KeyService.EnableKey(ctx, params.WalletID)
+		})
+	}
+}
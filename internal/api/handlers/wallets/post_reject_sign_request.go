@@ -0,0 +1,33 @@
+package wallets
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostRejectSignRequestRoute 注册签名请求拒绝路由
+func PostRejectSignRequestRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/sign-requests/:requestId/reject", postRejectSignRequestHandler(s), middleware.WalletRole(s, storage.WalletRoleApprover))
+}
+
+// postRejectSignRequestHandler 钱包成员使用 Passkey 拒绝签名请求，请求立即终止
+func postRejectSignRequestHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostRejectSignRequestParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostSignRequestDecisionPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		return reviewSignRequest(c, s, params.WalletID, params.RequestID, &body, false)
+	}
+}
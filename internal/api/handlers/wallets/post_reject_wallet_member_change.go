@@ -0,0 +1,33 @@
+package wallets
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostRejectWalletMemberChangeRoute 注册成员变更拒绝路由
+func PostRejectWalletMemberChangeRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/member-changes/:changeId/reject", postRejectWalletMemberChangeHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// postRejectWalletMemberChangeHandler owner 使用 Passkey 拒绝成员变更
+func postRejectWalletMemberChangeHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostRejectWalletMemberChangeParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostWalletMemberChangeDecisionPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		return reviewMemberChange(c, s, params.WalletID, params.ChangeID, &body, false)
+	}
+}
@@ -0,0 +1,109 @@
+package wallets
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// PostReshareWalletRoute 注册密钥重分享路由
+func PostReshareWalletRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/reshare", postReshareWalletHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// postReshareWalletHandler 将钱包分片迁移到新的节点集合/门限，公钥和地址保持不变
+func postReshareWalletHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.PostReshareWalletParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostReshareWalletPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		walletID := params.WalletID
+
+		keyMetadata, err := s.KeyService.GetKey(ctx, walletID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+		if keyMetadata.Status != storage.KeyStatusActive {
+			return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet is not active")
+		}
+
+		newThreshold := int(swag.Int64Value(body.NewThreshold))
+		if newThreshold > len(body.NewNodeIds) {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "new_threshold exceeds number of new nodes")
+		}
+
+		// 重分享改变持有分片的节点，需要 owner 对重分享意图的 WebAuthn assertion
+		credentialID, err := verifySignAssertion(c, s, walletID, reshareIntent(&body), body.WebauthnAssertion)
+		if err != nil {
+			return err
+		}
+		_, role, err := s.WebAuthnService.GetMetadataStore().IsWalletMember(ctx, walletID, credentialID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to check wallet member")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to reshare wallet")
+		}
+		if role != storage.WalletRoleOwner {
+			return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Only wallet owners can reshare")
+		}
+
+		updated, err := s.KeyService.RotateKey(ctx, walletID, body.OldNodeIds, body.NewNodeIds, int(body.OldThreshold), newThreshold)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to reshare wallet key")
+			switch {
+			case errors.Is(err, key.ErrDerivedKey):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Derived wallets cannot be reshared, reshare the root key instead")
+			case errors.Is(err, key.ErrKeyNotActive):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet is not active")
+			case errors.Is(err, key.ErrInvalidNewNode):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "new_node_ids must be active signers or the wallet's mobile node")
+			}
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to reshare wallet")
+		}
+
+		log.Info().
+			Str("wallet_id", walletID).
+			Str("credential_id", credentialID).
+			Strs("new_node_ids", body.NewNodeIds).
+			Int("new_threshold", newThreshold).
+			Msg("Wallet reshared")
+
+		response := &types.ReshareWalletResponse{
+			WalletID:           swag.String(updated.KeyID),
+			PublicKey:          swag.String(updated.PublicKey),
+			Address:            updated.Address,
+			Threshold:          swag.Int64(int64(updated.Threshold)),
+			TotalNodes:         swag.Int64(int64(updated.TotalNodes)),
+			ParticipatingNodes: body.NewNodeIds,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// reshareIntent WebAuthn challenge 绑定的重分享意图："reshare:<new_threshold>:<new_node_ids 以逗号连接>"
+func reshareIntent(body *types.PostReshareWalletPayload) []byte {
+	return []byte(fmt.Sprintf("reshare:%d:%s", swag.Int64Value(body.NewThreshold), strings.Join(body.NewNodeIds, ",")))
+}
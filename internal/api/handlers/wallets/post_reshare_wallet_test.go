@@ -0,0 +1,43 @@
+package wallets_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostReshareWalletRequiresOwnerAssertion(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		walletID := createTestWallet(t, s)
+		owner := addTestMember(t, s, walletID, "user-owner", "owner-key", storage.WalletRoleOwner)
+		admin := addTestMember(t, s, walletID, "user-admin", "admin-key", storage.WalletRoleAdmin)
+
+		path := "/api/v1/auth/wallets/" + walletID + "/reshare"
+		body := signRequestDecisionPayload(admin)
+		body["new_node_ids"] = []string{"mobile-attacker", "server-signer-p2"}
+		body["new_threshold"] = 2
+
+		// admin 不能重分享
+		res := test.PerformRequest(t, s, "POST", path, body, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-admin")))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		// owner 必须提供 WebAuthn assertion
+		ownerHeaders := test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-owner"))
+		res = test.PerformRequest(t, s, "POST", path, test.GenericPayload{
+			"new_node_ids":  []string{"mobile-attacker", "server-signer-p2"},
+			"new_threshold": 2,
+		}, ownerHeaders)
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		// assertion 不是对重分享意图 challenge 的签名
+		body = signRequestDecisionPayload(owner)
+		body["new_node_ids"] = []string{"mobile-attacker", "server-signer-p2"}
+		body["new_threshold"] = 2
+		res = test.PerformRequest(t, s, "POST", path, body, ownerHeaders)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,38 @@
+package wallets
+
+import (
+	"context"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// PostScheduleWalletDeletionRoute 注册计划删除钱包路由
+func PostScheduleWalletDeletionRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/schedule-deletion", postScheduleWalletDeletionHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// postScheduleWalletDeletionHandler 钱包进入删除等待期，立即停止签名，等待期结束后销毁密钥分片
+func postScheduleWalletDeletionHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostScheduleWalletDeletionParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostScheduleWalletDeletionPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		return changeWalletStatus(c, s, params.WalletID, func(ctx context.Context) (*key.KeyMetadata, error) {
+			return s.KeyService.ScheduleKeyDeletion(ctx, params.WalletID, int(body.PendingWindowDays))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// PostSignChallengeRoute 注册签名 challenge 路由
+func PostSignChallengeRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/sign/challenge", postSignChallengeHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// postSignChallengeHandler 为待签名消息签发一次性 WebAuthn challenge
+func postSignChallengeHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.PostSignChallengeParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostSignChallengePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		walletID := params.WalletID
+		if _, err := s.KeyService.GetKey(ctx, walletID); err != nil {
+			log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+
+		_, message, err := decodeMessageHex(swag.StringValue(body.MessageHex))
+		if err != nil {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid message_hex format")
+		}
+
+		challenge, err := s.WebAuthnService.BeginSignChallenge(ctx, walletID, message)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to begin sign challenge")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create sign challenge")
+		}
+
+		expiresAt := strfmt.DateTime(challenge.ExpiresAt)
+		return util.ValidateAndReturn(c, http.StatusOK, &types.SignChallengeResponse{
+			Challenge: swag.String(challenge.Challenge),
+			ExpiresAt: &expiresAt,
+		})
+	}
+}
@@ -2,7 +2,9 @@ package wallets
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"net"
 	"net/http"
 	"strconv"
@@ -11,13 +13,21 @@ import (
 
 	"github.com/SafeMPC/mpc-service/internal/api"
 	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/auth"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/policy"
+	"github.com/SafeMPC/mpc-service/internal/infra/signing"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/infra/webauthn"
 	"github.com/SafeMPC/mpc-service/internal/mpc/node"
 	"github.com/SafeMPC/mpc-service/internal/types"
 	"github.com/SafeMPC/mpc-service/internal/types/wallets"
 	"github.com/SafeMPC/mpc-service/internal/util"
 	pb "github.com/SafeMPC/mpc-service/pb/mpc/v1"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -25,7 +35,7 @@ import (
 
 // PostSignTransactionRoute 注册交易签名路由
 func PostSignTransactionRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Auth.POST("/wallets/:walletId/sign", postSignTransactionHandler(s))
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/sign", postSignTransactionHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
 }
 
 // postSignTransactionHandler 签名交易
@@ -50,15 +60,6 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 
 		walletID := params.WalletID
 
-		// WebAuthn 二次验证（测试环境暂时允许跳过）
-		// TODO: 生产环境必须要求 WebAuthn Assertion
-		if body.WebauthnAssertion != nil {
-			// TODO: 验证 WebAuthn Assertion
-			// 需要从 JWT Token 中获取 userID，然后验证 assertion
-		} else {
-			log.Warn().Msg("WebAuthn assertion not provided - skipping validation for testing")
-		}
-
 		// 查询密钥信息
 		keyMetadata, err := s.KeyService.GetKey(ctx, walletID)
 		if err != nil {
@@ -67,36 +68,79 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		// 解码消息
-		messageHex := ""
-		if body.MessageHex != nil {
-			messageHex = *body.MessageHex
-		}
-		if messageHex == "" {
+		if swag.StringValue(body.MessageHex) == "" {
 			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "message_hex is required")
 		}
-
-		// 移除 "0x" 前缀（如果有）
-		if len(messageHex) > 2 && messageHex[:2] == "0x" {
-			messageHex = messageHex[2:]
-		}
-
-		_, err = hex.DecodeString(messageHex)
+		messageHex, message, err := decodeMessageHex(*body.MessageHex)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to decode message_hex")
 			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid message_hex format")
 		}
 
+		// WebAuthn 二次验证：钱包成员对服务端为该钱包和消息签发的 challenge 签名
+		credentialID, err := verifySignAssertion(c, s, walletID, message, body.WebauthnAssertion)
+		if err != nil {
+			return err
+		}
+
+		// 原始消息无法确认目标地址、金额和资产，签名策略中依赖这些字段的规则按最严格的结果评估；
+		// 需要按交易内容评估策略的转账使用 POST /v1/wallets/{walletId}/transfers；
+		// 链类型取钱包密钥记录的链，请求中的 chain_type 由客户端控制，不参与策略评估
+		signReq := &signing.SignRequest{
+			KeyID:          walletID,
+			MessageHex:     messageHex,
+			MessageType:    "transaction",
+			ChainType:      keyMetadata.ChainType,
+			DerivationPath: body.DerivationPath,
+		}
+
 		// 推断协议
 		protocol := inferProtocol(keyMetadata.Algorithm, keyMetadata.Curve)
 
 		// 创建签名会话
-		// 使用 SigningService 创建签名会话
-		signingSession, err := s.SigningService.CreateSigningSession(ctx, walletID, protocol)
+		// 使用 SigningService 创建签名会话，创建前评估签名策略
+		signingSession, err := s.SigningService.CreateSigningSession(ctx, signReq, protocol)
+		if errors.Is(err, policy.ErrApprovalRequired) {
+			// 策略要求审批：保存为待审批的签名请求，收集到足够的成员审批后再签名
+			return submitSignRequest(c, s, signReq)
+		}
 		if err != nil {
+			s.Audit.Record(ctx, audit.Entry{
+				EventType: audit.EventTypeSigning,
+				Operation: audit.OperationSign,
+				Result:    audit.ResultFailure,
+				KeyID:     walletID,
+				Details: map[string]interface{}{
+					"message_hex":   messageHex,
+					"credential_id": credentialID,
+					"error":         err.Error(),
+				},
+			})
+			if errors.Is(err, key.ErrKeyNotActive) {
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet is not active")
+			}
+			if errors.Is(err, policy.ErrDenied) {
+				return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Signing request denied by policy")
+			}
 			log.Error().Err(err).Msg("Failed to create signing session")
 			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create signing session: "+err.Error())
 		}
 
+		// 签名请求已受理；签名完成由会话管理器在 Signer 上报结果时记录
+		s.Audit.Record(ctx, audit.Entry{
+			EventType: audit.EventTypeSigning,
+			Operation: audit.OperationSign,
+			Result:    audit.ResultSuccess,
+			KeyID:     walletID,
+			SessionID: signingSession.SessionID,
+			Details: map[string]interface{}{
+				"message_hex":   messageHex,
+				"credential_id": credentialID,
+				"protocol":      protocol,
+				"stage":         "requested",
+			},
+		})
+
 		nodes, err := s.NodeDiscovery.DiscoverNodes(ctx, node.NodeTypeSigner, node.NodeStatusActive, signingSession.TotalNodes)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to discover signer nodes")
@@ -122,41 +166,11 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 			signerEndpoints = append(signerEndpoints, net.JoinHostPort(host, strconv.Itoa(9091)))
 		}
 
-		sessionToken := ""
-		authHeader := c.Request().Header.Get("Authorization")
-		if authHeader != "" {
-			sessionToken = strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer "))
-		}
-
-		secretKey := s.Config.MPC.JWTSecret
-		if secretKey == "" {
-			secretKey = "default-secret-key-change-in-production"
-		}
-
-		var mobileNodeID string
-		if sessionToken != "" {
-			log.Info().Int("session_token_len", len(sessionToken)).Msg("Parsed session token from Authorization header")
-			jwtManager := auth.NewJWTManager(secretKey, "", 0)
-			claims, err := jwtManager.Validate(sessionToken)
-			if err != nil {
-				log.Warn().Err(err).Msg("Failed to validate session token as JWT; will try parsing without claims validation")
-				claims, err = jwtManager.ParseWithoutClaimsValidation(sessionToken)
-				if err != nil {
-					log.Warn().Err(err).Msg("Failed to parse session token as JWT; cannot derive mobile node id")
-					claims = nil
-				}
-			}
-			if claims != nil {
-				if claims.Subject != "" {
-					mobileNodeID = claims.Subject
-				} else if claims.AppID != "" {
-					mobileNodeID = claims.AppID
-				}
-			}
-		}
+		sessionToken := strings.TrimSpace(strings.TrimPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer "))
+		mobileNodeID := resolveMobileNodeID(ctx, s, walletID)
 
 		if mobileNodeID == "" {
-			log.Warn().Msg("Unable to resolve mobile node id from token; StartSign will be skipped and direct-connect signing may stall")
+			log.Warn().Msg("Unable to resolve mobile node id from DKG session; StartSign will be skipped and direct-connect signing may stall")
 		} else {
 			issuer := "safempc"
 			if s.Config.MPC.JWTIssuer != "" {
@@ -166,8 +180,9 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 			if tokenDuration <= 0 {
 				tokenDuration = 24 * time.Hour
 			}
-			jwtManager := auth.NewJWTManager(secretKey, issuer, tokenDuration)
-			if refreshedToken, err := jwtManager.Generate(mobileNodeID, "default-tenant", nil); err == nil {
+			if secret, err := middleware.JWTSecret(s); err != nil {
+				log.Warn().Err(err).Msg("Failed to refresh session token for signer gRPC")
+			} else if refreshedToken, err := auth.NewJWTManager(secret, issuer, tokenDuration).Generate(mobileNodeID, "default-tenant", nil); err == nil {
 				sessionToken = refreshedToken
 			} else {
 				log.Warn().Err(err).Msg("Failed to refresh session token for signer gRPC")
@@ -181,11 +196,10 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 				}
 			}
 
-			msgBytes, _ := hex.DecodeString(messageHex)
 			startReq := &pb.StartSignRequest{
 				SessionId:       signingSession.SessionID,
 				KeyId:           walletID,
-				Message:         msgBytes,
+				Message:         message,
 				MessageHex:      messageHex,
 				Protocol:        protocol,
 				Threshold:       int32(signingSession.Threshold),
@@ -204,7 +218,9 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 					_, err := s.MPCGRPCClient.SendStartSign(ctx, n.NodeID, startReq)
 					if err != nil {
 						log.Error().Err(err).Str("signer_node_id", n.NodeID).Str("session_id", signingSession.SessionID).Msg("Failed to StartSign on signer")
+						continue
 					}
+					s.SessionManager.RecordDispatch(ctx, signingSession.SessionID, n.NodeID)
 				}
 			} else {
 				log.Warn().Msg("MPCGRPCClient is nil; dialing signer endpoints directly for StartSign")
@@ -225,7 +241,9 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 					_ = conn.Close()
 					if err != nil {
 						log.Error().Err(err).Str("signer_endpoint", n.Endpoint).Str("session_id", signingSession.SessionID).Msg("Failed to StartSign on signer endpoint")
+						continue
 					}
+					s.SessionManager.RecordDispatch(ctx, signingSession.SessionID, n.NodeID)
 				}
 			}
 		}
@@ -244,3 +262,89 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 		return util.ValidateAndReturn(c, http.StatusOK, response)
 	}
 }
+
+// verifySignAssertion 校验签名交易请求的 WebAuthn assertion，返回 assertion 的凭证 ID（Base64URL）；
+// 只有测试代码可以在配置中关闭 RequireSignAssertion，此时未提供的 assertion 被跳过，提供的仍然校验
+func verifySignAssertion(c echo.Context, s *api.Server, walletID string, message []byte, webauthnAssertion *types.WebAuthnAssertion) (string, error) {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	if webauthnAssertion == nil {
+		if s.Config.MPC.RequireSignAssertion {
+			return "", httperrors.NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeGeneric, "webauthn_assertion is required")
+		}
+		log.Warn().Str("wallet_id", walletID).Msg("WebAuthn assertion not provided - skipping validation for testing")
+		return "", nil
+	}
+	if webauthnAssertion.CredentialID == nil || webauthnAssertion.AuthenticatorData == nil ||
+		webauthnAssertion.ClientDataJSON == nil || webauthnAssertion.Signature == nil {
+		return "", httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "webauthn_assertion is incomplete")
+	}
+
+	credentialID := base64.RawURLEncoding.EncodeToString(*webauthnAssertion.CredentialID)
+	err := s.WebAuthnService.VerifySignAssertion(ctx, walletID, message, &webauthn.Assertion{
+		CredentialID:      *webauthnAssertion.CredentialID,
+		AuthenticatorData: *webauthnAssertion.AuthenticatorData,
+		ClientDataJSON:    *webauthnAssertion.ClientDataJSON,
+		Signature:         *webauthnAssertion.Signature,
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("wallet_id", walletID).Str("credential_id", credentialID).Msg("WebAuthn assertion verification failed")
+		switch {
+		case errors.Is(err, webauthn.ErrNotWalletMember):
+			return "", httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Credential is not a wallet member")
+		case errors.Is(err, webauthn.ErrInvalidAssertion), errors.Is(err, webauthn.ErrInvalidSignChallenge),
+			errors.Is(err, storage.ErrPasskeySignCountNotIncreased):
+			return "", httperrors.NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeGeneric, "Invalid WebAuthn assertion")
+		default:
+			return "", httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to verify WebAuthn assertion")
+		}
+	}
+	return credentialID, nil
+}
+
+// decodeMessageHex 去掉可选的 "0x" 前缀并解码待签名消息，返回去掉前缀的 hex 和消息字节
+func decodeMessageHex(messageHex string) (string, []byte, error) {
+	messageHex = strings.TrimPrefix(messageHex, "0x")
+	message, err := hex.DecodeString(messageHex)
+	if err != nil {
+		return "", nil, err
+	}
+	return messageHex, message, nil
+}
+
+// submitSignRequest 创建待审批的签名请求并返回 202，签名在收集到足够的成员审批后由审批服务执行
+func submitSignRequest(c echo.Context, s *api.Server, signReq *signing.SignRequest) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	signReq.MobileNodeID = resolveMobileNodeID(ctx, s, signReq.KeyID)
+
+	signRequest, err := s.SignRequests.Submit(ctx, signReq)
+	if err != nil {
+		log.Error().Err(err).Str("wallet_id", signReq.KeyID).Msg("Failed to submit sign request")
+		if errors.Is(err, policy.ErrDenied) {
+			return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Signing request denied by policy")
+		}
+		return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to submit sign request: "+err.Error())
+	}
+
+	log.Info().
+		Str("wallet_id", signRequest.WalletID).
+		Str("sign_request_id", signRequest.RequestID).
+		Int("required_approvals", signRequest.RequiredApprovals).
+		Msg("Signing request requires approval, sign request created")
+
+	return util.ValidateAndReturn(c, http.StatusAccepted, signRequestToTypes(signRequest, nil))
+}
+
+// resolveMobileNodeID 从钱包密钥 DKG 会话的参与节点中解析手机节点 ID，无法解析时返回空字符串
+// 会话令牌的 subject 是用户 ID，不能作为节点 ID
+func resolveMobileNodeID(ctx context.Context, s *api.Server, walletID string) string {
+	mobileNodeID, err := s.KeyService.GetMobileNodeID(ctx, walletID)
+	if err != nil {
+		util.LogFromContext(ctx).Warn().Err(err).Str("wallet_id", walletID).Msg("Failed to resolve mobile node id from DKG session")
+		return ""
+	}
+	return mobileNodeID
+}
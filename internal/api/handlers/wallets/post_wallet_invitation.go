@@ -0,0 +1,66 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/SafeMPC/mpc-service/internal/util/url"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// PostWalletInvitationRoute 注册邀请钱包成员路由
+func PostWalletInvitationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/invitations", postWalletInvitationHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// postWalletInvitationHandler owner 邀请邮箱加入钱包并发送邀请邮件，邮件发送失败时撤销邀请
+func postWalletInvitationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.PostWalletInvitationParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostWalletInvitationPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		if _, err := s.KeyService.GetKey(ctx, params.WalletID); err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+
+		inviter := member.MembershipFromContext(ctx)
+		invitation, err := s.Members.Invite(ctx, inviter, params.WalletID, body.Email.String(), swag.StringValue(body.Role))
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to create wallet invitation")
+			return memberHTTPError(err)
+		}
+
+		invitationLink, err := url.WalletInvitationDeeplinkURL(s.Config, invitation.Token)
+		if err == nil {
+			err = s.Mailer.SendWalletInvitation(ctx, invitation.Email, invitation.WalletID, invitation.Role, invitationLink.String())
+		}
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Str("invitation_id", invitation.InvitationID).Msg("Failed to send wallet invitation email")
+			if _, revokeErr := s.Members.RevokeInvitation(ctx, inviter, params.WalletID, invitation.InvitationID); revokeErr != nil {
+				log.Error().Err(revokeErr).Str("invitation_id", invitation.InvitationID).Msg("Failed to revoke unsent wallet invitation")
+			}
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to send wallet invitation email")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusCreated, walletInvitationToTypes(s, invitation))
+	}
+}
@@ -0,0 +1,39 @@
+package wallets
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// PostWalletMemberRoute 注册添加钱包成员路由
+func PostWalletMemberRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/members", postWalletMemberHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// postWalletMemberHandler 提出添加成员，owner 批准后生效
+func postWalletMemberHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PostWalletMemberParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostWalletMemberPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		return proposeMemberChange(c, s, &storage.WalletMemberChange{
+			WalletID:     params.WalletID,
+			Action:       storage.WalletMemberChangeActionAdd,
+			CredentialID: swag.StringValue(body.CredentialID),
+			Role:         swag.StringValue(body.Role),
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package wallets_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostWalletMemberRoleDenied(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		walletID := createTestWallet(t, s)
+		addTestMember(t, s, walletID, "user-owner", "owner-key", storage.WalletRoleOwner)
+		addTestMember(t, s, walletID, "user-viewer", "viewer-key", storage.WalletRoleViewer)
+		newcomer := addTestPasskey(t, s, "user-newcomer", "newcomer-key")
+
+		path := "/api/v1/auth/wallets/" + walletID + "/members"
+		body := test.GenericPayload{"credential_id": newcomer, "role": storage.WalletRoleApprover}
+
+		res := test.PerformRequest(t, s, "POST", path, body, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", path, body, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-viewer")))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", path, body, test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-owner")))
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		var change types.WalletMemberChange
+		test.ParseResponseAndValidate(t, res, &change)
+		assert.Equal(t, storage.WalletMemberChangeStatusPending, *change.Status)
+
+		// 变更等待 owner 批准，成员不变
+		isMember, _, err := metadataStore(s).IsWalletMember(t.Context(), walletID, newcomer)
+		require.NoError(t, err)
+		assert.False(t, isMember)
+	})
+}
+
+func TestWalletWithoutMembersDeniesCallers(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		walletID := createTestWallet(t, s)
+		credentialID := addTestPasskey(t, s, "user-alice", "alice-key")
+		headers := test.HeadersWithAuth(t, passkeySessionToken(t, s, "user-alice"))
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/wallets/"+walletID+"/members", nil, headers)
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		// 不能把自己添加为没有成员的钱包的 owner
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/wallets/"+walletID+"/members",
+			test.GenericPayload{"credential_id": credentialID, "role": storage.WalletRoleOwner}, headers)
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		members, err := metadataStore(s).ListWalletMembers(t.Context(), walletID)
+		require.NoError(t, err)
+		assert.Empty(t, members)
+
+		// 运维指定 owner 后按角色放行
+		_, err = s.Members.AssignOwner(t.Context(), walletID, "user-alice", credentialID)
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/wallets/"+walletID+"/members", nil, headers)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
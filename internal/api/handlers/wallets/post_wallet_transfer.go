@@ -0,0 +1,254 @@
+package wallets
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/infra/approval"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/policy"
+	"github.com/SafeMPC/mpc-service/internal/infra/signing"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/infra/transaction"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// PostWalletTransferRoute 注册转账路由
+func PostWalletTransferRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/wallets/:walletId/transfers", postWalletTransferHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// postWalletTransferHandler 由服务端构建转账交易并阈值签名，EVM 和 Solana 交易签名后直接广播
+// 签名策略按服务端构建交易时使用的目标地址、金额和资产评估，与实际签名的交易一致；
+// 策略要求审批时创建转账签名请求并返回 202，请求通过审批后携带 sign_request_id 重新提交转账
+func postWalletTransferHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.PostWalletTransferParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostWalletTransferPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		walletID := params.WalletID
+		chainType := swag.StringValue(body.ChainType)
+
+		chainInfo, err := s.Chains.Lookup(chainType)
+		if err != nil {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unsupported chain type: "+chainType)
+		}
+
+		keyMetadata, err := s.KeyService.GetKey(ctx, walletID)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to get key")
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+		}
+
+		amount, ok := new(big.Int).SetString(swag.StringValue(body.Amount), 10)
+		if !ok || amount.Sign() <= 0 {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid amount, expected a positive integer in the smallest unit")
+		}
+
+		// 解析资产：为空、native 或原生代币符号时转账原生币，否则在链注册表的代币中查找
+		var token *registry.Token
+		if asset := body.Asset; asset != "" &&
+			!strings.EqualFold(asset, transaction.NativeAsset) && !strings.EqualFold(asset, chainInfo.Symbol) {
+			token, err = chainInfo.Token(asset)
+			if err != nil {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unknown asset: "+asset)
+			}
+		}
+
+		// WebAuthn 二次验证：challenge 绑定转账意图（链、收款地址、金额和资产）
+		credentialID, err := verifySignAssertion(c, s, walletID, transferIntent(&body), body.WebauthnAssertion)
+		if err != nil {
+			return err
+		}
+
+		transfer := &walletTransfer{
+			chain:    chainInfo,
+			key:      keyMetadata,
+			to:       swag.StringValue(body.To),
+			amount:   amount,
+			token:    token,
+			feeRate:  uint64(max(body.FeeRate, 0)),
+			utxos:    body.Utxos,
+			replace:  body.ReplaceTxHash,
+			mobileID: resolveMobileNodeID(ctx, s, walletID),
+		}
+		if token != nil {
+			transfer.asset = token.Address
+		}
+
+		// 引用已通过审批的转账请求时先领取该请求，同一请求只能执行一次；
+		// 请求批准的转账参数由签名服务在评估策略时与本次转账比对
+		signCtx := ctx
+		var execution *approval.TransferExecution
+		if body.SignRequestID != "" {
+			execution, err = s.SignRequests.ClaimTransfer(ctx, walletID, body.SignRequestID.String())
+			if err != nil {
+				log.Warn().Err(err).Str("wallet_id", walletID).Str("sign_request_id", body.SignRequestID.String()).Msg("Failed to claim transfer sign request")
+				return claimTransferHTTPError(err)
+			}
+			defer func() {
+				if err := execution.Release(); err != nil {
+					log.Warn().Err(err).Str("sign_request_id", transfer.signRequestID).Msg("Failed to release transfer sign request")
+				}
+			}()
+			signCtx = execution.Context()
+			transfer.signRequestID = execution.Request().RequestID
+		}
+
+		var signed *signedTransfer
+		switch chainInfo.Family {
+		case registry.FamilyBitcoin:
+			signed, err = signBitcoinTransfer(signCtx, s, transfer)
+		case registry.FamilyEVM:
+			signed, err = signEVMTransfer(signCtx, s, transfer)
+		case registry.FamilySolana:
+			signed, err = signSolanaTransfer(signCtx, s, transfer)
+		default:
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Transfers are not supported for chain type: "+chainType)
+		}
+
+		// 与原始消息签名一样，策略要求审批时创建签名请求等待成员审批
+		if errors.Is(err, policy.ErrApprovalRequired) && execution == nil {
+			return submitSignRequest(c, s, transferSignRequest(transfer))
+		}
+
+		var record *storage.TransactionRecord
+		if err == nil {
+			record, err = broadcastTransfer(signCtx, s, walletID, transfer, signed)
+		}
+		if err == nil && execution != nil {
+			// 交易已签名（EVM 和 Solana 已广播），写回失败时请求仍为 approved，只记录日志
+			if completeErr := execution.Complete(context.WithoutCancel(ctx)); completeErr != nil {
+				log.Error().Err(completeErr).Str("sign_request_id", transfer.signRequestID).Msg("Failed to complete transfer sign request")
+			}
+		}
+
+		details := map[string]interface{}{
+			"chain":         chainInfo.Name,
+			"to":            transfer.to,
+			"amount":        amount.String(),
+			"asset":         transfer.asset,
+			"credential_id": credentialID,
+		}
+		if transfer.signRequestID != "" {
+			details["sign_request_id"] = transfer.signRequestID
+		}
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", walletID).Str("chain", chainInfo.Name).Msg("Failed to sign transfer")
+			details["error"] = err.Error()
+			s.Audit.Record(ctx, audit.Entry{
+				EventType: audit.EventTypeSigning,
+				Operation: audit.OperationTransfer,
+				Result:    audit.ResultFailure,
+				KeyID:     walletID,
+				Details:   details,
+			})
+			return transferHTTPError(err)
+		}
+
+		details["from"] = signed.from
+		details["tx_hash"] = signed.tx.Hash
+		if record != nil {
+			details["tx_hash"] = record.TxHash
+		}
+		s.Audit.Record(ctx, audit.Entry{
+			EventType: audit.EventTypeSigning,
+			Operation: audit.OperationTransfer,
+			Result:    audit.ResultSuccess,
+			KeyID:     walletID,
+			Details:   details,
+		})
+
+		response := &types.WalletTransferResponse{
+			ChainType: swag.String(chainInfo.Name),
+			From:      swag.String(signed.from),
+			To:        swag.String(transfer.to),
+			Amount:    swag.String(amount.String()),
+			Asset:     transfer.asset,
+			TxHash:    swag.String(signed.tx.Hash),
+			RawTx:     swag.String(signed.tx.Raw),
+		}
+		if signed.tx.Fee != nil {
+			response.Fee = signed.tx.Fee.String()
+		}
+		if record != nil {
+			response.TxHash = swag.String(record.TxHash)
+			response.Status = record.Status
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// transferHTTPError 将构建或签名转账的错误映射为不包含内部细节的 HTTP 错误
+func transferHTTPError(err error) error {
+	switch {
+	case errors.Is(err, chain.ErrInsufficientFunds):
+		return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Insufficient funds")
+	case errors.Is(err, transaction.ErrReplacementNotFound), errors.Is(err, transaction.ErrNonceAlreadyMined):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Transaction cannot be replaced")
+	case errors.Is(err, errBroadcastRejected):
+		return httperrors.NewHTTPError(http.StatusBadGateway, types.PublicHTTPErrorTypeGeneric, "Transaction was rejected by the node")
+	case errors.Is(err, errInvalidTransfer):
+		return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid transfer request")
+	case errors.Is(err, key.ErrKeyNotActive):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet is not active")
+	case errors.Is(err, policy.ErrDenied):
+		return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Signing request denied by policy")
+	case errors.Is(err, policy.ErrApprovalRequired):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Sign request does not approve this transfer")
+	default:
+		return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to sign transfer")
+	}
+}
+
+// claimTransferHTTPError 将领取转账签名请求的错误映射为 HTTP 错误
+func claimTransferHTTPError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrSignRequestNotFound):
+		return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Sign request not found")
+	case errors.Is(err, approval.ErrNotApprovedTransfer):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Sign request is not an approved transfer")
+	case errors.Is(err, approval.ErrTransferInProgress):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Sign request is already being used by another transfer")
+	default:
+		return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to claim sign request")
+	}
+}
+
+// transferSignRequest 转账签名请求，策略评估字段取自服务端构建交易使用的参数
+func transferSignRequest(transfer *walletTransfer) *signing.SignRequest {
+	return &signing.SignRequest{
+		KeyID:        transfer.key.KeyID,
+		ChainType:    transfer.chain.Name,
+		MobileNodeID: transfer.mobileID,
+		Destination:  transfer.to,
+		Amount:       transfer.amount,
+		Asset:        transfer.asset,
+
+		SignRequestID: transfer.signRequestID,
+	}
+}
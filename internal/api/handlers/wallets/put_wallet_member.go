@@ -0,0 +1,39 @@
+package wallets
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// PutWalletMemberRoute 注册修改钱包成员角色路由
+func PutWalletMemberRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.PUT("/wallets/:walletId/members/:credentialId", putWalletMemberHandler(s), middleware.WalletRole(s, storage.WalletRoleAdmin))
+}
+
+// putWalletMemberHandler 提出修改成员角色，owner 批准后生效
+func putWalletMemberHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var params wallets.PutWalletMemberParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutWalletMemberPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		return proposeMemberChange(c, s, &storage.WalletMemberChange{
+			WalletID:     params.WalletID,
+			Action:       storage.WalletMemberChangeActionUpdateRole,
+			CredentialID: params.CredentialID,
+			Role:         swag.StringValue(body.Role),
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package wallets
+
+import (
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/infra/policy"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/types/wallets"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// PutWalletPolicyRoute 注册设置钱包签名策略路由
+func PutWalletPolicyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.PUT("/wallets/:walletId/policy", putWalletPolicyHandler(s), middleware.WalletRole(s, storage.WalletRoleOwner))
+}
+
+// putWalletPolicyHandler 用请求中的策略替换钱包的签名策略，之后创建的签名会话按新策略评估
+func putWalletPolicyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var params wallets.PutWalletPolicyParams
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutWalletPolicyPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		signingPolicy := &storage.SigningPolicy{
+			WalletID:      params.WalletID,
+			PolicyType:    swag.StringValue(body.PolicyType),
+			MinSignatures: int(body.MinSignatures),
+			DefaultAction: body.DefaultAction,
+			Rules:         make([]storage.PolicyRule, 0, len(body.Rules)),
+		}
+		for _, rule := range body.Rules {
+			if rule == nil {
+				continue
+			}
+			signingPolicy.Rules = append(signingPolicy.Rules, policyRuleFromTypes(rule))
+		}
+		if err := policy.Validate(signingPolicy); err != nil {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid signing policy: "+err.Error())
+		}
+
+		saved, err := s.SigningService.SaveSigningPolicy(ctx, signingPolicy)
+		if err != nil {
+			log.Error().Err(err).Str("wallet_id", params.WalletID).Msg("Failed to save signing policy")
+			s.Audit.Record(ctx, audit.Entry{
+				EventType: audit.EventTypePolicy,
+				Operation: audit.OperationUpdate,
+				Result:    audit.ResultFailure,
+				KeyID:     params.WalletID,
+				Details:   map[string]interface{}{"error": err.Error()},
+			})
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to save signing policy")
+		}
+
+		s.Audit.Record(ctx, audit.Entry{
+			EventType: audit.EventTypePolicy,
+			Operation: audit.OperationUpdate,
+			Result:    audit.ResultSuccess,
+			KeyID:     params.WalletID,
+			Details: map[string]interface{}{
+				"policy_type":    saved.PolicyType,
+				"min_signatures": saved.MinSignatures,
+				"default_action": saved.DefaultAction,
+				"rules":          saved.Rules,
+			},
+		})
+
+		return util.ValidateAndReturn(c, http.StatusOK, walletPolicyToTypes(saved))
+	}
+}
@@ -0,0 +1,106 @@
+package wallets
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/infra/approval"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// reviewSignRequest 校验成员的 WebAuthn assertion 并批准或拒绝签名请求，返回更新后的签名请求；
+// assertion 的凭证必须属于会话用户，批准数按不同用户统计
+func reviewSignRequest(c echo.Context, s *api.Server, walletID, requestID string, body *types.PostSignRequestDecisionPayload, approve bool) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	webauthnAssertion := body.WebauthnAssertion
+	if webauthnAssertion.CredentialID == nil || webauthnAssertion.AuthenticatorData == nil ||
+		webauthnAssertion.ClientDataJSON == nil || webauthnAssertion.Signature == nil {
+		return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "webauthn_assertion is incomplete")
+	}
+	assertion := &approval.Assertion{
+		CredentialID:      base64.RawURLEncoding.EncodeToString(*webauthnAssertion.CredentialID),
+		AuthenticatorData: *webauthnAssertion.AuthenticatorData,
+		ClientDataJSON:    *webauthnAssertion.ClientDataJSON,
+		Signature:         *webauthnAssertion.Signature,
+	}
+
+	review := s.SignRequests.Approve
+	if !approve {
+		review = s.SignRequests.Reject
+	}
+	signRequest, approvals, err := review(ctx, walletID, requestID, sessionUserID(c, s), assertion)
+	if err != nil {
+		log.Error().Err(err).Str("wallet_id", walletID).Str("sign_request_id", requestID).Bool("approve", approve).Msg("Failed to review sign request")
+		return signRequestHTTPError(err)
+	}
+
+	return util.ValidateAndReturn(c, http.StatusOK, signRequestToTypes(signRequest, approvals))
+}
+
+// signRequestHTTPError 把签名请求审批的错误转换为不包含内部细节的 HTTP 错误，详细错误由调用方记录日志
+func signRequestHTTPError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrSignRequestNotFound):
+		return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Sign request not found")
+	case errors.Is(err, approval.ErrInvalidAssertion):
+		return httperrors.NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeGeneric, "Invalid WebAuthn assertion")
+	case errors.Is(err, approval.ErrNotWalletMember):
+		return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Credential is not a wallet member")
+	case errors.Is(err, approval.ErrNotAwaitingApproval):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Sign request is not awaiting approval")
+	case errors.Is(err, storage.ErrDuplicateSignRequestApproval):
+		return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Sign request already reviewed by this user")
+	default:
+		return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to process sign request")
+	}
+}
+
+// signRequestToTypes 转换签名请求，approvals 为空时不返回审批记录
+func signRequestToTypes(signRequest *storage.SignRequest, approvals []*storage.SignRequestApproval) *types.SignRequest {
+	requestID := strfmt.UUID(signRequest.RequestID)
+	expiresAt := strfmt.DateTime(signRequest.ExpiresAt)
+	result := &types.SignRequest{
+		RequestID:         &requestID,
+		WalletID:          swag.String(signRequest.WalletID),
+		ChainType:         signRequest.ChainType,
+		MessageHex:        signRequest.MessageHex,
+		To:                signRequest.Destination,
+		Amount:            signRequest.Amount,
+		Asset:             signRequest.Asset,
+		RequestHash:       swag.String(signRequest.RequestHash),
+		Status:            swag.String(signRequest.Status),
+		RequiredApprovals: swag.Int64(int64(signRequest.RequiredApprovals)),
+		SessionID:         signRequest.SessionID,
+		Signature:         signRequest.Signature,
+		Error:             signRequest.ErrorMessage,
+		ExpiresAt:         &expiresAt,
+		CreatedAt:         strfmt.DateTime(signRequest.CreatedAt),
+	}
+	if signRequest.PolicyDecision != nil {
+		result.PolicyReason = signRequest.PolicyDecision.Reason
+	}
+
+	for _, a := range approvals {
+		decision := types.SignRequestApprovalDecisionApprove
+		if !a.Approved {
+			decision = types.SignRequestApprovalDecisionReject
+		}
+		createdAt := strfmt.DateTime(a.CreatedAt)
+		result.Approvals = append(result.Approvals, &types.SignRequestApproval{
+			CredentialID: swag.String(a.CredentialID),
+			Decision:     swag.String(decision),
+			CreatedAt:    &createdAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,333 @@
+package wallets
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/infra/transaction"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-openapi/swag"
+)
+
+// erc20TransferGasLimit ERC-20 转账的 gas 上限，覆盖常见代币合约的 transfer 开销，未用完的 gas 不计费
+const erc20TransferGasLimit = 100000
+
+var (
+	// errInvalidTransfer 转账参数无法构建交易（地址、金额、UTXO 或资产不合法）
+	errInvalidTransfer = errors.New("invalid transfer")
+	// errBroadcastRejected 节点拒绝了已签名的转账交易
+	errBroadcastRejected = errors.New("transfer broadcast rejected")
+)
+
+// walletTransfer 一次转账的参数，由请求和钱包信息解析而来
+type walletTransfer struct {
+	chain    *registry.Chain
+	key      *key.KeyMetadata
+	to       string
+	amount   *big.Int
+	token    *registry.Token // 为空表示原生币
+	asset    string          // 代币合约或 Mint 地址，原生币为空
+	feeRate  uint64
+	utxos    []*types.BitcoinUtxo
+	replace  string // EVM 被替换交易的哈希
+	mobileID string
+
+	signRequestID string // 批准该转账的签名请求，策略要求审批时必须提供
+}
+
+// signedTransfer 已签名的转账交易
+type signedTransfer struct {
+	from        string
+	tx          *chain.Transaction
+	reservation *storage.NonceReservation // EVM 交易预留的 nonce，广播后标记或释放
+}
+
+// transferIntent WebAuthn challenge 绑定的转账意图："transfer:<chain_type>:<to>:<amount>:<asset>"，字段取请求中的原始值；
+// 替换交易时追加 ":replace:<replace_tx_hash>"
+func transferIntent(body *types.PostWalletTransferPayload) []byte {
+	intent := fmt.Sprintf("transfer:%s:%s:%s:%s",
+		swag.StringValue(body.ChainType), swag.StringValue(body.To), swag.StringValue(body.Amount), body.Asset)
+	if body.ReplaceTxHash != "" {
+		intent += ":replace:" + body.ReplaceTxHash
+	}
+	return []byte(intent)
+}
+
+// signBitcoinTransfer 从钱包地址花费请求中的 UTXO，构建 PSBT 并逐个输入阈值签名
+func signBitcoinTransfer(ctx context.Context, s *api.Server, transfer *walletTransfer) (*signedTransfer, error) {
+	if transfer.token != nil {
+		return nil, fmt.Errorf("%w: bitcoin has no tokens", errInvalidTransfer)
+	}
+
+	// BuildTransaction 不能花费 P2PKH 输出；P2TR 输入需要 Schnorr 签名，其余需要 ECDSA 签名
+	addressType, err := s.KeyService.BitcoinAddressType(transfer.key.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if !addressType.Spendable() {
+		return nil, fmt.Errorf("%w: wallet address type %s cannot be spent", errInvalidTransfer, addressType)
+	}
+	if !strings.EqualFold(transfer.key.Algorithm, addressType.SignatureAlgorithm()) {
+		return nil, fmt.Errorf("%w: %s wallets must use %s keys", errInvalidTransfer, addressType, addressType.SignatureAlgorithm())
+	}
+
+	adapter, err := transfer.chain.BitcoinAdapter(addressType)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := hex.DecodeString(transfer.key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %w", err)
+	}
+	from, err := adapter.GenerateAddress(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive from address: %w", err)
+	}
+
+	if len(transfer.utxos) == 0 {
+		return nil, fmt.Errorf("%w: utxos are required", errInvalidTransfer)
+	}
+	utxos := make([]chain.UTXO, 0, len(transfer.utxos))
+	for _, utxo := range transfer.utxos {
+		vout := swag.Int64Value(utxo.Vout)
+		if vout < 0 || vout > int64(^uint32(0)) {
+			return nil, fmt.Errorf("%w: invalid vout %d", errInvalidTransfer, vout)
+		}
+		utxos = append(utxos, chain.UTXO{
+			TxID:   swag.StringValue(utxo.Txid),
+			Vout:   uint32(vout),
+			Amount: swag.Int64Value(utxo.Amount),
+		})
+	}
+
+	feeRate := transfer.feeRate
+	if feeRate == 0 {
+		estimate, err := adapter.EstimateFees(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate fee rate: %w", err)
+		}
+		feeRate = estimate.Normal.FeeRate.Uint64()
+	}
+
+	unsigned, err := adapter.BuildTransaction(&chain.BuildTxRequest{
+		From:             from,
+		To:               transfer.to,
+		Amount:           transfer.amount,
+		FeeRate:          feeRate,
+		UTXOs:            utxos,
+		BitcoinPublicKey: pubKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTransfer, err)
+	}
+
+	signed, err := s.SigningService.SignBitcoinTransaction(ctx, adapter, transferSignRequest(transfer), unsigned)
+	if err != nil {
+		return nil, err
+	}
+	return &signedTransfer{from: from, tx: signed}, nil
+}
+
+// signEVMTransfer 从钱包地址构建 EVM 转账（ERC-20 代币转账发往代币合约）并阈值签名
+// nonce 由 NonceManager 预留，构建或签名失败时释放；链支持 EIP-1559 时构建动态费用交易，否则按 eth_gasPrice 构建 EIP-2930 交易
+func signEVMTransfer(ctx context.Context, s *api.Server, transfer *walletTransfer) (*signedTransfer, error) {
+	adapter, err := transfer.chain.EthereumAdapter()
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := hex.DecodeString(transfer.key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %w", err)
+	}
+	from, err := adapter.GenerateAddress(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive from address: %w", err)
+	}
+
+	fees, err := adapter.EstimateFees(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate fees: %w", err)
+	}
+
+	var reservation *storage.NonceReservation
+	if transfer.replace != "" {
+		reservation, err = s.Nonces.ReserveReplacement(ctx, transfer.chain.Name, from, transfer.replace)
+	} else {
+		reservation, err = s.Nonces.Reserve(ctx, transfer.chain.Name, from)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := buildAndSignEVMTransfer(ctx, s, adapter, transfer, from, fees, reservation)
+	if err != nil {
+		// 请求可能已被取消（例如等待移动端签名超时），释放不依赖请求上下文
+		if releaseErr := s.Nonces.Release(context.WithoutCancel(ctx), reservation); releaseErr != nil {
+			util.LogFromContext(ctx).Warn().Err(releaseErr).Uint64("nonce", reservation.Nonce).Msg("Failed to release nonce after failed transfer")
+		}
+		return nil, err
+	}
+	return signed, nil
+}
+
+// buildAndSignEVMTransfer 使用预留的 nonce 构建并签名 EVM 转账，替换交易的手续费不低于原交易上浮 10%
+func buildAndSignEVMTransfer(
+	ctx context.Context,
+	s *api.Server,
+	adapter *chain.EthereumAdapter,
+	transfer *walletTransfer,
+	from string,
+	fees *chain.FeeEstimate,
+	reservation *storage.NonceReservation,
+) (*signedTransfer, error) {
+	req := &chain.BuildTxRequest{
+		From:   from,
+		To:     transfer.to,
+		Amount: transfer.amount,
+		Nonce:  reservation.Nonce,
+	}
+	if fees.BaseFee != nil {
+		req.MaxFeePerGas = fees.Normal.FeeRate
+		req.MaxPriorityFeePerGas = fees.Normal.PriorityFee
+	} else {
+		req.EVMTxType = ethtypes.AccessListTxType
+		req.GasPrice = fees.Normal.FeeRate
+	}
+	if transfer.token != nil {
+		req.TokenContract = transfer.token.Address
+		req.GasLimit = erc20TransferGasLimit
+	}
+
+	if reservation.ReplacesTxHash != "" {
+		replaced, err := replacedEVMTransaction(ctx, s, reservation)
+		if err != nil {
+			return nil, err
+		}
+		req.MaxFeePerGas = maxBigInt(req.MaxFeePerGas, transaction.ReplacementFee(replaced.GasFeeCap()))
+		req.MaxPriorityFeePerGas = maxBigInt(req.MaxPriorityFeePerGas, transaction.ReplacementFee(replaced.GasTipCap()))
+		req.GasPrice = maxBigInt(req.GasPrice, transaction.ReplacementFee(replaced.GasPrice()))
+	}
+
+	unsigned, err := adapter.BuildTransaction(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTransfer, err)
+	}
+
+	signed, err := s.SigningService.SignEthereumTransaction(ctx, adapter, transferSignRequest(transfer), unsigned)
+	if err != nil {
+		return nil, err
+	}
+	return &signedTransfer{from: from, tx: signed, reservation: reservation}, nil
+}
+
+// replacedEVMTransaction 解码被替换交易广播时记录的已签名交易，用于计算替换所需的最低手续费
+func replacedEVMTransaction(ctx context.Context, s *api.Server, reservation *storage.NonceReservation) (*ethtypes.Transaction, error) {
+	record, err := s.Transactions.ReplacedRecord(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := hexutil.Decode(record.RawTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode replaced transaction: %w", err)
+	}
+	replaced := new(ethtypes.Transaction)
+	if err := replaced.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode replaced transaction: %w", err)
+	}
+	return replaced, nil
+}
+
+// maxBigInt 返回较大值，current 为空时视为未设置
+func maxBigInt(current, minimum *big.Int) *big.Int {
+	if current == nil {
+		return nil
+	}
+	if current.Cmp(minimum) < 0 {
+		return minimum
+	}
+	return current
+}
+
+// signSolanaTransfer 从钱包地址构建 SOL 或 SPL 代币转账并阈值签名
+// 代币转账在接收方关联代币账户不存在时由钱包创建；交易引用最新区块哈希，约 60 秒内未广播即失效
+func signSolanaTransfer(ctx context.Context, s *api.Server, transfer *walletTransfer) (*signedTransfer, error) {
+	adapter, err := transfer.chain.SolanaAdapter()
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := hex.DecodeString(transfer.key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %w", err)
+	}
+	from, err := adapter.GenerateAddress(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive from address: %w", err)
+	}
+
+	req := &chain.BuildTxRequest{
+		From:   from,
+		To:     transfer.to,
+		Amount: transfer.amount,
+	}
+	if transfer.token != nil {
+		req.TokenMint = transfer.token.Address
+		req.TokenDecimals = uint8(transfer.token.Decimals)
+		req.CreateTokenAccount = true
+	}
+
+	unsigned, err := adapter.BuildTransactionWithLatestBlockhash(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTransfer, err)
+	}
+
+	signed, err := s.SigningService.SignSolanaTransaction(ctx, adapter, transferSignRequest(transfer), unsigned)
+	if err != nil {
+		return nil, err
+	}
+	return &signedTransfer{from: from, tx: signed}, nil
+}
+
+// broadcastTransfer 广播已签名的 EVM 或 Solana 转账并记录为 pending，之后由交易跟踪器更新确认状态
+// Bitcoin 交易不由服务端广播，返回 nil；广播成功但记录保存失败时只记录日志，交易已经发出
+func broadcastTransfer(ctx context.Context, s *api.Server, walletID string, transfer *walletTransfer, signed *signedTransfer) (*storage.TransactionRecord, error) {
+	if transfer.chain.Family == registry.FamilyBitcoin {
+		return nil, nil
+	}
+
+	decimals := transfer.chain.Decimals
+	if transfer.token != nil {
+		decimals = transfer.token.Decimals
+	}
+
+	record, err := s.Transactions.Broadcast(ctx, &transaction.BroadcastRequest{
+		WalletID:  walletID,
+		ChainType: transfer.chain.Name,
+		RawTx:     signed.tx.Raw,
+		From:      signed.from,
+		To:        transfer.to,
+		Value:     transfer.amount,
+		Asset:     transfer.asset,
+		Decimals:  decimals,
+
+		Reservation: signed.reservation,
+	})
+	if err != nil && record == nil {
+		return nil, fmt.Errorf("%w: %w", errBroadcastRejected, err)
+	}
+	if err != nil {
+		util.LogFromContext(ctx).Error().Err(err).Str("wallet_id", walletID).Str("tx_hash", record.TxHash).Msg("Failed to record broadcast transfer")
+	}
+	return record, nil
+}
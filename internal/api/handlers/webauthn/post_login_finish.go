@@ -9,6 +9,8 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/auth"
 	"github.com/SafeMPC/mpc-service/internal/types"
 	"github.com/SafeMPC/mpc-service/internal/util"
@@ -51,10 +53,24 @@ func postWebAuthnLoginFinishHandler(s *api.Server) echo.HandlerFunc {
 			swag.StringValue(body.SessionData),
 			assertionResponse,
 		)
+		entry := audit.Entry{
+			EventType: audit.EventTypeAuth,
+			Operation: audit.OperationLogin,
+			Result:    audit.ResultSuccess,
+			UserID:    swag.StringValue(body.UserID),
+			Details: map[string]interface{}{
+				"method":        "passkey",
+				"credential_id": assertionResponse.ID,
+			},
+		}
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to finish WebAuthn login")
+			entry.Result = audit.ResultFailure
+			entry.Details["error"] = err.Error()
+			s.Audit.Record(ctx, entry)
 			return echo.NewHTTPError(http.StatusUnauthorized, "Authentication failed: "+err.Error())
 		}
+		s.Audit.Record(ctx, entry)
 
 		// 生成 JWT Token
 		// 使用 Auth Service 的 JWT Manager（如果可用）
@@ -80,9 +96,9 @@ func postWebAuthnLoginFinishHandler(s *api.Server) echo.HandlerFunc {
 // generateJWTToken 生成 JWT Token
 func generateJWTToken(s *api.Server, userID string) (string, error) {
 	// 从 config 获取 JWT 配置
-	secretKey := s.Config.MPC.JWTSecret
-	if secretKey == "" {
-		secretKey = "default-secret-key-change-in-production" // 默认值，生产环境必须配置
+	secretKey, err := middleware.JWTSecret(s)
+	if err != nil {
+		return "", err
 	}
 	
 	issuer := "safempc" // 默认 issuer
@@ -8,6 +8,7 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/types"
 	"github.com/SafeMPC/mpc-service/internal/util"
 	"github.com/labstack/echo/v4"
@@ -51,10 +52,23 @@ func postWebAuthnRegisterFinishHandler(s *api.Server) echo.HandlerFunc {
 			swag.StringValue(body.SessionData),
 			credentialResponse,
 		)
+		entry := audit.Entry{
+			EventType: audit.EventTypePasskey,
+			Operation: audit.OperationRegister,
+			Result:    audit.ResultSuccess,
+			UserID:    swag.StringValue(body.UserID),
+			Details: map[string]interface{}{
+				"credential_id": credentialResponse.ID,
+			},
+		}
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to finish WebAuthn registration")
+			entry.Result = audit.ResultFailure
+			entry.Details["error"] = err.Error()
+			s.Audit.Record(ctx, entry)
 			return echo.NewHTTPError(http.StatusBadRequest, "Failed to complete registration: "+err.Error())
 		}
+		s.Audit.Record(ctx, entry)
 
 		// 生成 JWT Token（注册成功后自动登录）
 		userID := swag.StringValue(body.UserID)
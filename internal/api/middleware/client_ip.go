@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+var (
+	DefaultClientIPConfig = ClientIPConfig{
+		Skipper: middleware.DefaultSkipper,
+	}
+)
+
+type ClientIPConfig struct {
+	Skipper middleware.Skipper
+}
+
+// ClientIP stores the client's IP address (as resolved by echo's IP extractor) in the request context,
+// making it available to code without access to the echo context, e.g. audit logging in services.
+func ClientIP() echo.MiddlewareFunc {
+	return ClientIPWithConfig(DefaultClientIPConfig)
+}
+
+func ClientIPWithConfig(config ClientIPConfig) echo.MiddlewareFunc {
+	if config.Skipper == nil {
+		config.Skipper = DefaultClientIPConfig.Skipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+
+			ctx := context.WithValue(c.Request().Context(), util.CTXKeyClientIP, c.RealIP())
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
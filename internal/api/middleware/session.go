@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// ErrJWTSecretNotConfigured 没有配置 MPC_JWT_SECRET，不签发也不接受 Passkey 会话令牌
+var ErrJWTSecretNotConfigured = errors.New("MPC_JWT_SECRET is not configured")
+
+// JWTSecret 返回 Passkey 会话令牌的签名密钥，未配置时返回 ErrJWTSecretNotConfigured，不回退到内置的默认密钥
+func JWTSecret(s *api.Server) (string, error) {
+	if s.Config.MPC.JWTSecret == "" {
+		return "", ErrJWTSecretNotConfigured
+	}
+	return s.Config.MPC.JWTSecret, nil
+}
+
+// SessionUserID 从 Authorization 头的 Passkey 会话令牌中解析 userID（JWT subject），没有令牌时返回空字符串
+func SessionUserID(c echo.Context, s *api.Server) (string, error) {
+	token := strings.TrimSpace(strings.TrimPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer "))
+	if token == "" {
+		return "", nil
+	}
+
+	secret, err := JWTSecret(s)
+	if err != nil {
+		return "", err
+	}
+	claims, err := auth.NewJWTManager(secret, "", 0).Validate(token)
+	if err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/httperrors"
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
+	"github.com/SafeMPC/mpc-service/internal/types"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// WalletRole 要求调用者在路径参数 walletId 对应的钱包中至少拥有 minRole 角色。
+// 调用者由 Authorization 头中 Passkey 登录签发的 JWT（subject 为 userID）确定，
+// 解析出的成员身份通过 member.MembershipFromContext 传给 handler；
+// 没有成员的钱包不放行任何调用者
+func WalletRole(s *api.Server, minRole string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			log := util.LogFromContext(ctx)
+
+			userID, tokenErr := SessionUserID(c, s)
+			if errors.Is(tokenErr, ErrJWTSecretNotConfigured) {
+				log.Error().Err(tokenErr).Msg("Cannot validate passkey session token")
+			}
+			membership, err := s.Members.Resolve(ctx, c.Param("walletId"), userID)
+			switch {
+			case errors.Is(err, member.ErrNotWalletMember):
+				if userID == "" || tokenErr != nil {
+					return httperrors.NewHTTPError(http.StatusUnauthorized, types.PublicHTTPErrorTypeGeneric, "Valid passkey session token is required")
+				}
+				return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Caller is not a member of this wallet")
+			case err != nil:
+				log.Error().Err(err).Str("wallet_id", c.Param("walletId")).Msg("Failed to resolve wallet membership")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to resolve wallet membership")
+			}
+
+			if !member.RoleAtLeast(membership.Role, minRole) {
+				return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Wallet role "+membership.Role+" is not allowed, requires "+minRole)
+			}
+
+			c.SetRequest(c.Request().WithContext(member.WithMembership(ctx, membership)))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/api"
+	"github.com/SafeMPC/mpc-service/internal/api/middleware"
+	"github.com/SafeMPC/mpc-service/internal/auth"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletRoleRequiresJWTSecret(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		store := s.WebAuthnService.GetMetadataStore()
+		walletID := "wallet-role-test"
+		credentialID := "b3duZXIta2V5"
+		require.NoError(t, store.SavePasskey(ctx, &storage.Passkey{CredentialID: credentialID, PublicKey: "a5010203262001215820", CreatedAt: time.Now()}))
+		require.NoError(t, store.SaveUserCredential(ctx, "user-owner", credentialID, "owner-key"))
+		require.NoError(t, store.AddWalletMember(ctx, walletID, credentialID, storage.WalletRoleOwner))
+
+		path := "/testing-wallet-role/:walletId"
+		s.Echo.GET(path, func(c echo.Context) error {
+			return c.NoContent(http.StatusNoContent)
+		}, middleware.WalletRole(s, storage.WalletRoleViewer))
+
+		token, err := auth.NewJWTManager(s.Config.MPC.JWTSecret, "", time.Hour).Generate("user-owner", "", nil)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/testing-wallet-role/"+walletID, nil, test.HeadersWithAuth(t, token))
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		// 未配置密钥时不回退到默认密钥，任何令牌都不接受
+		s.Config.MPC.JWTSecret = ""
+		_, err = middleware.JWTSecret(s)
+		assert.ErrorIs(t, err, middleware.ErrJWTSecretNotConfigured)
+
+		defaultToken, err := auth.NewJWTManager("default-secret-key-change-in-production", "", time.Hour).Generate("user-owner", "", nil)
+		require.NoError(t, err)
+		for _, token := range []string{token, defaultToken} {
+			res = test.PerformRequest(t, s, "GET", "/testing-wallet-role/"+walletID, nil, test.HeadersWithAuth(t, token))
+			assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+		}
+	})
+}
@@ -7,17 +7,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/auth"
 	"github.com/SafeMPC/mpc-service/internal/config"
 	"github.com/SafeMPC/mpc-service/internal/i18n"
+	"github.com/SafeMPC/mpc-service/internal/infra/approval"
 	"github.com/SafeMPC/mpc-service/internal/infra/discovery"
 	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
+	"github.com/SafeMPC/mpc-service/internal/infra/policy"
 	"github.com/SafeMPC/mpc-service/internal/infra/service"
 	"github.com/SafeMPC/mpc-service/internal/infra/session"
 	"github.com/SafeMPC/mpc-service/internal/infra/signing"
 	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/infra/transaction"
 	"github.com/SafeMPC/mpc-service/internal/infra/webauthn"
 	"github.com/SafeMPC/mpc-service/internal/mailer"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
 	mpcgrpc "github.com/SafeMPC/mpc-service/internal/mpc/grpc"
 	"github.com/SafeMPC/mpc-service/internal/mpc/node"
 	"github.com/SafeMPC/mpc-service/internal/persistence"
@@ -95,15 +101,24 @@ func NewMetadataStore(db *sql.DB) storage.MetadataStore {
 	return storage.NewPostgreSQLStore(db)
 }
 
-// NewWebAuthnServiceProvider 创建 WebAuthn 服务
+// NewWebAuthnServiceProvider 创建 WebAuthn 服务，默认的 RP 配置仅用于开发环境
 func NewWebAuthnServiceProvider(cfg config.Server, metadataStore storage.MetadataStore) (*webauthn.Service, error) {
-	// 从环境变量或配置获取 WebAuthn 配置
-	// 如果没有配置，使用默认值（仅用于开发环境）
-	rpID := "localhost" // TODO: 从配置读取
 	rpName := "SafeMPC"
-	rpOrigin := "http://localhost:8080"
 
-	return webauthn.NewService(rpID, rpName, rpOrigin, metadataStore)
+	webauthnService, err := webauthn.NewService(cfg.MPC.WebAuthnRPID, rpName, cfg.MPC.WebAuthnRPOrigin, metadataStore)
+	if err != nil {
+		return nil, err
+	}
+	webauthnService.SetSignChallengeTTL(cfg.MPC.SignChallengeTTL)
+	return webauthnService, nil
+}
+
+// NewMemberServiceProvider 创建钱包成员管理服务，成员变更由 owner 的 Passkey 审批，邮件邀请按配置过期
+func NewMemberServiceProvider(cfg config.Server, metadataStore storage.MetadataStore, webauthnService *webauthn.Service, auditService *audit.Service) *member.Service {
+	memberService := member.NewService(metadataStore, webauthnService)
+	memberService.SetInvitationTTL(cfg.MPC.WalletInvitationTTL)
+	memberService.SetAuditService(auditService)
+	return memberService
 }
 
 func NewRedisClient(cfg config.Server) (*redis.Client, error) {
@@ -172,12 +187,14 @@ func NewNodeDiscovery(manager *node.Manager, discoveryService *discovery.Service
 	return node.NewDiscovery(manager, discoveryService)
 }
 
-func NewSessionManager(metadataStore storage.MetadataStore, sessionStore storage.SessionStore, cfg config.Server) *session.Manager {
+func NewSessionManager(metadataStore storage.MetadataStore, sessionStore storage.SessionStore, auditService *audit.Service, cfg config.Server) *session.Manager {
 	timeout := time.Duration(cfg.MPC.SessionTimeout)
 	if timeout <= 0 {
 		timeout = 300
 	}
-	return session.NewManager(metadataStore, sessionStore, timeout*time.Second)
+	sessionManager := session.NewManager(metadataStore, sessionStore, timeout*time.Second)
+	sessionManager.SetAuditService(auditService)
+	return sessionManager
 }
 
 func NewDKGServiceProvider(
@@ -185,27 +202,135 @@ func NewDKGServiceProvider(
 	keyShareStorage storage.KeyShareStorage,
 	nodeManager *node.Manager,
 	nodeDiscovery *node.Discovery,
-	grpcClient *mpcgrpc.GRPCClient, // 用于 Service 触发 Signer StartDKG
+	sessionManager *session.Manager, // 用于创建重分享会话
+	grpcClient *mpcgrpc.GRPCClient, // 用于 Service 触发 Signer StartDKG / StartReshare
 	cfg config.Server,
 ) *key.DKGService {
 	// Service 节点不执行协议计算，DKGService 只负责协调
-	return key.NewDKGService(metadataStore, keyShareStorage, nodeManager, nodeDiscovery, grpcClient)
+	return key.NewDKGService(metadataStore, keyShareStorage, nodeManager, nodeDiscovery, sessionManager, grpcClient)
+}
+
+// NewChainRegistryProvider 加载链注册表：MPC_CHAINS_FILE 指定的配置文件，未设置时使用内置主网配置
+func NewChainRegistryProvider(cfg config.Server) (*registry.Registry, error) {
+	if cfg.MPC.ChainsFile != "" {
+		chains, err := registry.Load(cfg.MPC.ChainsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load chain registry: %w", err)
+		}
+		return chains, nil
+	}
+
+	chains, err := registry.New(registry.DefaultChains(cfg.MPC.BitcoinNetwork))
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain configuration: %w", err)
+	}
+	return chains, nil
 }
 
 func NewKeyServiceProvider(
 	metadataStore storage.MetadataStore,
 	keyShareStorage storage.KeyShareStorage,
 	dkgService *key.DKGService,
-) *key.Service {
-	return key.NewService(metadataStore, keyShareStorage, dkgService)
+	auditService *audit.Service,
+	chains *registry.Registry,
+	cfg config.Server,
+) (*key.Service, error) {
+	keyService := key.NewService(metadataStore, keyShareStorage, dkgService)
+	keyService.SetDeletionWindowDays(cfg.MPC.KeyDeletionWindowDays)
+	keyService.SetAuditService(auditService)
+	keyService.SetChainRegistry(chains)
+	if err := keyService.SetBitcoinAddressType(cfg.MPC.BitcoinAddressType); err != nil {
+		return nil, fmt.Errorf("invalid bitcoin configuration: %w", err)
+	}
+	return keyService, nil
+}
+
+// NewKeyRefreshSchedulerProvider 创建分片定期刷新调度器（仅在 Service 节点由 Server.Start 启动）
+func NewKeyRefreshSchedulerProvider(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	dkgService *key.DKGService,
+	cfg config.Server,
+) *key.RefreshScheduler {
+	return key.NewRefreshScheduler(
+		metadataStore,
+		sessionStore,
+		dkgService,
+		cfg.MPC.KeyRotationDays,
+		cfg.MPC.KeyRefreshCheckInterval,
+		cfg.MPC.KeyRefreshMaxRetries,
+		cfg.MPC.KeyRefreshRetryBackoff,
+	)
+}
+
+// NewKeyDeletionSchedulerProvider 创建密钥删除调度器（仅在 Service 节点由 Server.Start 启动）
+func NewKeyDeletionSchedulerProvider(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	keyService *key.Service,
+	cfg config.Server,
+) *key.DeletionScheduler {
+	return key.NewDeletionScheduler(metadataStore, sessionStore, keyService, cfg.MPC.KeyDeletionCheckInterval)
 }
 
-func NewSigningServiceProvider(keyService *key.Service, sessionManager *session.Manager, nodeDiscovery *node.Discovery, cfg config.Server, grpcClient *mpcgrpc.GRPCClient, metadataStore storage.MetadataStore) *signing.Service {
+func NewTransactionServiceProvider(metadataStore storage.MetadataStore, chains *registry.Registry, nonces *transaction.NonceManager) *transaction.Service {
+	return transaction.NewService(metadataStore, chains, nonces)
+}
+
+// NewNonceManagerProvider 创建 EVM nonce 管理器（使用 Redis 分布式锁）
+func NewNonceManagerProvider(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	chains *registry.Registry,
+	cfg config.Server,
+) *transaction.NonceManager {
+	return transaction.NewNonceManager(metadataStore, sessionStore, chains, cfg.MPC.NonceLockWait, cfg.MPC.NonceReservationTimeout)
+}
+
+// NewTransactionTrackerProvider 创建交易确认跟踪器（仅在 Service 节点由 Server.Start 启动）
+func NewTransactionTrackerProvider(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	chains *registry.Registry,
+	cfg config.Server,
+) *transaction.Tracker {
+	return transaction.NewTracker(metadataStore, sessionStore, chains, cfg.MPC.TxPollInterval, cfg.MPC.TxDropTimeout)
+}
+
+// NewTransactionIndexerProvider 创建转入交易索引器（仅在 Service 节点由 Server.Start 启动）
+func NewTransactionIndexerProvider(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	chains *registry.Registry,
+	cfg config.Server,
+) *transaction.Indexer {
+	maxBlocks := uint64(0)
+	if cfg.MPC.IndexerMaxBlocks > 0 {
+		maxBlocks = uint64(cfg.MPC.IndexerMaxBlocks)
+	}
+	return transaction.NewIndexer(metadataStore, sessionStore, chains, cfg.MPC.IndexerPollInterval, maxBlocks)
+}
+
+// NewSigningServiceProvider 创建签名服务，MPC_ENABLE_POLICY 开启时创建会话前评估钱包签名策略
+func NewSigningServiceProvider(keyService *key.Service, sessionManager *session.Manager, nodeDiscovery *node.Discovery, cfg config.Server, grpcClient *mpcgrpc.GRPCClient, metadataStore storage.MetadataStore, chains *registry.Registry) *signing.Service {
 	defaultProtocol := cfg.MPC.DefaultProtocol
 	if defaultProtocol == "" {
 		defaultProtocol = "gg20"
 	}
-	return signing.NewService(keyService, sessionManager, nodeDiscovery, defaultProtocol, grpcClient, metadataStore)
+	signingService := signing.NewService(keyService, sessionManager, nodeDiscovery, defaultProtocol, grpcClient, metadataStore)
+	if cfg.MPC.EnablePolicy {
+		signingService.SetPolicyEngine(policy.NewEngine(metadataStore, chains))
+	}
+	return signingService
+}
+
+// NewApprovalServiceProvider 创建签名请求审批服务，策略要求审批的签名收集到足够的成员审批后再执行，
+// 成员的 Passkey assertion 由 webauthn.Service 校验，执行转账请求时在 sessionStore 中加锁
+func NewApprovalServiceProvider(cfg config.Server, metadataStore storage.MetadataStore, sessionStore storage.SessionStore, signingService *signing.Service, webauthnService *webauthn.Service, auditService *audit.Service) *approval.Service {
+	approvalService := approval.NewService(metadataStore, signingService, webauthnService, cfg.MPC.SignRequestTTL)
+	approvalService.SetAuditService(auditService)
+	approvalService.SetSessionStore(sessionStore)
+	return approvalService
 }
 
 func NewMPCServiceProvider(
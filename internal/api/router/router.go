@@ -15,6 +15,7 @@ import (
 	"github.com/SafeMPC/mpc-service/internal/api/handlers/constants"
 	"github.com/SafeMPC/mpc-service/internal/api/middleware"
 	"github.com/SafeMPC/mpc-service/internal/api/router/templates"
+	"github.com/SafeMPC/mpc-service/internal/auth"
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
@@ -192,6 +193,9 @@ func Init(s *api.Server) error {
 	// Add your custom / additional middlewares here.
 	// see https://echo.labstack.com/middleware
 
+	// Client IP is always made available to the request context as it is required for audit logging
+	s.Echo.Use(middleware.ClientIP())
+
 	// ---
 	// Initialize our general groups and set middleware to use above them
 	s.Router = &api.Router{
@@ -231,7 +235,9 @@ func Init(s *api.Server) error {
 					"/api/v1/auth/webauthn/register/begin",
 					"/api/v1/auth/webauthn/register/finish",
 					"/api/v1/auth/webauthn/login/begin",
-					"/api/v1/auth/webauthn/login/finish":
+					"/api/v1/auth/webauthn/login/finish",
+					// 接受钱包邀请由 Passkey 会话令牌认证
+					"/api/v1/auth/wallet-invitations/:token/accept":
 					return true
 				}
 				// 测试环境：暂时跳过钱包路由的认证（仅用于开发测试）
@@ -247,6 +253,13 @@ func Init(s *api.Server) error {
 		// Your other endpoints, typically secured by bearer auth, available at /api/v1/**
 		APIV1Push:  s.Echo.Group("/api/v1/push", middleware.Auth(s)),
 		APIV1Infra: s.Echo.Group("/api/v1/infra", middleware.E2EJWT(s)),
+
+		// Audit log for compliance reviews, requires the audit scope, available at /api/v1/audit/**
+		APIV1Audit: s.Echo.Group("/api/v1/audit", middleware.AuthWithConfig(middleware.AuthConfig{
+			S:      s,
+			Mode:   middleware.AuthModeRequired,
+			Scopes: []string{auth.ScopeAudit.String()},
+		})),
 	}
 
 	// 注册健康检查路由（已移除旧的 internal/grpc 实现）
@@ -9,6 +9,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/config"
 	"github.com/SafeMPC/mpc-service/internal/data/dto"
 	"github.com/SafeMPC/mpc-service/internal/data/local"
@@ -22,12 +23,16 @@ import (
 	"github.com/rs/zerolog/log"
 
 	// MPC imports
+	"github.com/SafeMPC/mpc-service/internal/infra/approval"
 	"github.com/SafeMPC/mpc-service/internal/infra/discovery"
 	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
 	"github.com/SafeMPC/mpc-service/internal/infra/service"
 	"github.com/SafeMPC/mpc-service/internal/infra/session"
 	"github.com/SafeMPC/mpc-service/internal/infra/signing"
+	"github.com/SafeMPC/mpc-service/internal/infra/transaction"
 	"github.com/SafeMPC/mpc-service/internal/infra/webauthn"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
 	mpcgrpc "github.com/SafeMPC/mpc-service/internal/mpc/grpc"
 	"github.com/SafeMPC/mpc-service/internal/mpc/node"
 
@@ -42,6 +47,7 @@ type Router struct {
 	APIV1Auth  *echo.Group
 	APIV1Push  *echo.Group
 	APIV1Infra *echo.Group
+	APIV1Audit *echo.Group
 	WellKnown  *echo.Group
 }
 
@@ -67,12 +73,21 @@ type Server struct {
 	I18n    *i18n.Service
 	Clock   time2.Clock
 	Auth    AuthService
+	Audit   *audit.Service
 	Local   *local.Service
 	Metrics *metrics.Service
 
 	// MPC services
+	Chains           *registry.Registry // 链注册表
 	KeyService       *key.Service
+	KeyRefresher     *key.RefreshScheduler     // 分片定期刷新调度器
+	KeyDeleter       *key.DeletionScheduler    // 删除等待期结束后销毁密钥
+	Transactions     *transaction.Service      // 广播交易并记录
+	Nonces           *transaction.NonceManager // 为并发发送的 EVM 交易分配 nonce
+	TxTracker        *transaction.Tracker      // 跟踪已广播交易的确认状态
+	TxIndexer        *transaction.Indexer      // 索引钱包的转入交易
 	SigningService   *signing.Service
+	SignRequests     *approval.Service // 需要成员审批的签名请求
 	MPCService       *service.Service
 	NodeManager      *node.Manager
 	NodeRegistry     *node.Registry
@@ -80,6 +95,7 @@ type Server struct {
 	SessionManager   *session.Manager
 	DiscoveryService *discovery.Service // ✅ 新的统一服务发现
 	WebAuthnService  *webauthn.Service  // WebAuthn 服务
+	Members          *member.Service    // 钱包成员与角色
 
 	// gRPC services (unified MPC gRPC)
 	// 注意：Service 节点不应该有 gRPC Server，只有 Signer 节点才有
@@ -99,10 +115,19 @@ func newServerWithComponents(
 	i18n *i18n.Service,
 	clock time2.Clock,
 	auth AuthService,
+	auditService *audit.Service,
 	local *local.Service,
 	metrics *metrics.Service,
+	chains *registry.Registry,
 	keyService *key.Service,
+	keyRefresher *key.RefreshScheduler,
+	keyDeleter *key.DeletionScheduler,
+	transactions *transaction.Service,
+	nonces *transaction.NonceManager,
+	txTracker *transaction.Tracker,
+	txIndexer *transaction.Indexer,
 	signingService *signing.Service,
+	signRequests *approval.Service,
 	mpcService *service.Service,
 	nodeManager *node.Manager,
 	nodeRegistry *node.Registry,
@@ -111,6 +136,7 @@ func newServerWithComponents(
 	mpcGRPCClient *mpcgrpc.GRPCClient, // ✅ 统一的 MPC gRPC 客户端
 	discoveryService *discovery.Service, // ✅ 新的统一服务发现
 	webAuthnService *webauthn.Service, // WebAuthn 服务
+	members *member.Service,
 	managementServer *mpcgrpc.ManagementServer, // V3: 管理服务器
 ) *Server {
 	s := &Server{
@@ -121,11 +147,20 @@ func newServerWithComponents(
 		I18n:    i18n,
 		Clock:   clock,
 		Auth:    auth,
+		Audit:   auditService,
 		Local:   local,
 		Metrics: metrics,
 
+		Chains:           chains,
 		KeyService:       keyService,
+		KeyRefresher:     keyRefresher,
+		KeyDeleter:       keyDeleter,
+		Transactions:     transactions,
+		Nonces:           nonces,
+		TxTracker:        txTracker,
+		TxIndexer:        txIndexer,
 		SigningService:   signingService,
+		SignRequests:     signRequests,
 		MPCService:       mpcService,
 		NodeManager:      nodeManager,
 		NodeRegistry:     nodeRegistry,
@@ -133,6 +168,7 @@ func newServerWithComponents(
 		SessionManager:   sessionManager,
 		DiscoveryService: discoveryService, // ✅ 新的统一服务发现
 		WebAuthnService:  webAuthnService,
+		Members:          members,
 		MPCGRPCClient:    mpcGRPCClient, // ✅ 统一的 MPC gRPC 客户端
 		ManagementServer: managementServer,
 	}
@@ -222,6 +258,13 @@ func (s *Server) Start() error {
 		}
 	}
 
+	// 恢复重启前未完成的会话：在接收 Signer 上报前重建会话缓存，无法恢复的会话标记失败
+	if s.Config.MPC.NodeType == "service" && s.SessionManager != nil {
+		if _, err := s.SessionManager.RecoverSessions(ctx); err != nil {
+			log.Error().Err(err).Msg("Failed to recover in-flight sessions, continuing startup")
+		}
+	}
+
 	// 启动 Management gRPC Server (V3)
 	// Service 节点提供 ManagementService 供 Signer 调用
 	if s.Config.MPC.NodeType == "service" && s.ManagementServer != nil {
@@ -246,6 +289,27 @@ func (s *Server) Start() error {
 			Msg("Started Management gRPC server for Signer nodes")
 	}
 
+	// 启动分片定期刷新调度器（MPC_KEY_ROTATION_DAYS > 0 时生效）
+	// 只有 Service 节点负责协调重分享
+	if s.Config.MPC.NodeType == "service" && s.KeyRefresher != nil {
+		s.KeyRefresher.Start(ctx)
+	}
+
+	// 启动密钥删除调度器：销毁删除等待期已结束的密钥
+	if s.Config.MPC.NodeType == "service" && s.KeyDeleter != nil {
+		s.KeyDeleter.Start(ctx)
+	}
+
+	// 启动交易确认跟踪器：轮询已广播交易的回执/签名状态
+	if s.Config.MPC.NodeType == "service" && s.TxTracker != nil {
+		s.TxTracker.Start(ctx)
+	}
+
+	// 启动转入交易索引器：扫描链上转入钱包的原生币和代币
+	if s.Config.MPC.NodeType == "service" && s.TxIndexer != nil {
+		s.TxIndexer.Start(ctx)
+	}
+
 	// 4. 启动 HTTP 服务器
 	if err := s.Echo.Start(s.Config.Echo.ListenAddress); err != nil {
 		return fmt.Errorf("failed to start echo server: %w", err)
@@ -276,6 +340,24 @@ func (s *Server) Shutdown(ctx context.Context) []error {
 	// 注意：Service 节点不应该有 gRPC Server
 	// 只有 Signer 节点才需要停止 gRPC Server
 
+	// 2. 停止分片定期刷新调度器
+	if s.KeyRefresher != nil {
+		log.Debug().Msg("Stopping key share refresh scheduler")
+		s.KeyRefresher.Stop(ctx)
+	}
+	if s.KeyDeleter != nil {
+		log.Debug().Msg("Stopping key deletion scheduler")
+		s.KeyDeleter.Stop(ctx)
+	}
+	if s.TxTracker != nil {
+		log.Debug().Msg("Stopping transaction tracker")
+		s.TxTracker.Stop(ctx)
+	}
+	if s.TxIndexer != nil {
+		log.Debug().Msg("Stopping transaction indexer")
+		s.TxIndexer.Stop(ctx)
+	}
+
 	// 3. 关闭 HTTP 服务器
 	if s.Echo != nil {
 		log.Debug().Msg("Shutting down echo server")
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/wire"
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/auth"
 	"github.com/SafeMPC/mpc-service/internal/config"
 	"github.com/SafeMPC/mpc-service/internal/data/local"
@@ -24,6 +25,7 @@ var serviceSet = wire.NewSet(
 	NewMailer,
 	NewI18N,
 	authServiceSet,
+	audit.NewService,
 	local.NewService,
 	metrics.New,
 	NewClock,
@@ -46,12 +48,22 @@ var mpcServiceSet = wire.NewSet(
 	NewSessionManager,
 	// WebAuthn service
 	NewWebAuthnServiceProvider,
+	NewMemberServiceProvider,
 	// gRPC communication
 	NewMPCGRPCClient,
+	// Chain registry
+	NewChainRegistryProvider,
 	// DKG service (must be before NewKeyServiceProvider)
 	NewDKGServiceProvider,
 	NewKeyServiceProvider,
+	NewKeyRefreshSchedulerProvider,
+	NewKeyDeletionSchedulerProvider,
+	NewNonceManagerProvider,
+	NewTransactionServiceProvider,
+	NewTransactionTrackerProvider,
+	NewTransactionIndexerProvider,
 	NewSigningServiceProvider,
+	NewApprovalServiceProvider,
 	NewMPCServiceProvider,
 	// Service discovery
 	NewMPCDiscoveryService,
@@ -10,6 +10,7 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/auth"
 	"github.com/SafeMPC/mpc-service/internal/config"
 	"github.com/SafeMPC/mpc-service/internal/data/local"
@@ -42,6 +43,10 @@ func InitNewServer(server config.Server) (*Server, error) {
 	v := NoTest()
 	clock := NewClock(v...)
 	authService := NewAuthService(server, db, clock)
+	auditService, err := audit.NewService(server, db)
+	if err != nil {
+		return nil, err
+	}
 	localService := local.NewService(server, db, clock)
 	metricsService, err := metrics.New(server, db)
 	if err != nil {
@@ -62,23 +67,38 @@ func InitNewServer(server config.Server) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	dkgService := NewDKGServiceProvider(metadataStore, keyShareStorage, manager, discovery, grpcClient, server)
-	keyService := NewKeyServiceProvider(metadataStore, keyShareStorage, dkgService)
 	client, err := NewRedisClient(server)
 	if err != nil {
 		return nil, err
 	}
 	sessionStore := NewSessionStore(client)
-	sessionManager := NewSessionManager(metadataStore, sessionStore, server)
-	signingService := NewSigningServiceProvider(keyService, sessionManager, discovery, server, grpcClient, metadataStore)
+	sessionManager := NewSessionManager(metadataStore, sessionStore, auditService, server)
+	dkgService := NewDKGServiceProvider(metadataStore, keyShareStorage, manager, discovery, sessionManager, grpcClient, server)
+	registryRegistry, err := NewChainRegistryProvider(server)
+	if err != nil {
+		return nil, err
+	}
+	keyService, err := NewKeyServiceProvider(metadataStore, keyShareStorage, dkgService, auditService, registryRegistry, server)
+	if err != nil {
+		return nil, err
+	}
+	refreshScheduler := NewKeyRefreshSchedulerProvider(metadataStore, sessionStore, dkgService, server)
+	deletionScheduler := NewKeyDeletionSchedulerProvider(metadataStore, sessionStore, keyService, server)
+	nonceManager := NewNonceManagerProvider(metadataStore, sessionStore, registryRegistry, server)
+	transactionService := NewTransactionServiceProvider(metadataStore, registryRegistry, nonceManager)
+	tracker := NewTransactionTrackerProvider(metadataStore, sessionStore, registryRegistry, server)
+	indexer := NewTransactionIndexerProvider(metadataStore, sessionStore, registryRegistry, server)
+	signingService := NewSigningServiceProvider(keyService, sessionManager, discovery, server, grpcClient, metadataStore, registryRegistry)
 	serviceService := NewMPCServiceProvider(server, keyService, sessionManager, discovery, grpcClient, metadataStore)
 	registry := NewNodeRegistry(manager)
 	webauthnService, err := NewWebAuthnServiceProvider(server, metadataStore)
 	if err != nil {
 		return nil, err
 	}
+	approvalService := NewApprovalServiceProvider(server, metadataStore, sessionStore, signingService, webauthnService, auditService)
+	memberService := NewMemberServiceProvider(server, metadataStore, webauthnService, auditService)
 	managementServer := NewManagementServer(discoveryService, sessionManager)
-	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService, keyService, signingService, serviceService, manager, registry, discovery, sessionManager, grpcClient, discoveryService, webauthnService, managementServer)
+	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, auditService, localService, metricsService, registryRegistry, keyService, refreshScheduler, deletionScheduler, transactionService, nonceManager, tracker, indexer, signingService, approvalService, serviceService, manager, registry, discovery, sessionManager, grpcClient, discoveryService, webauthnService, memberService, managementServer)
 	return apiServer, nil
 }
 
@@ -99,6 +119,10 @@ func InitNewServerWithDB(server config.Server, db *sql.DB, t ...*testing.T) (*Se
 	}
 	clock := NewClock(t...)
 	authService := NewAuthService(server, db, clock)
+	auditService, err := audit.NewService(server, db)
+	if err != nil {
+		return nil, err
+	}
 	localService := local.NewService(server, db, clock)
 	metricsService, err := metrics.New(server, db)
 	if err != nil {
@@ -119,23 +143,38 @@ func InitNewServerWithDB(server config.Server, db *sql.DB, t ...*testing.T) (*Se
 	if err != nil {
 		return nil, err
 	}
-	dkgService := NewDKGServiceProvider(metadataStore, keyShareStorage, manager, discovery, grpcClient, server)
-	keyService := NewKeyServiceProvider(metadataStore, keyShareStorage, dkgService)
 	client, err := NewRedisClient(server)
 	if err != nil {
 		return nil, err
 	}
 	sessionStore := NewSessionStore(client)
-	sessionManager := NewSessionManager(metadataStore, sessionStore, server)
-	signingService := NewSigningServiceProvider(keyService, sessionManager, discovery, server, grpcClient, metadataStore)
+	sessionManager := NewSessionManager(metadataStore, sessionStore, auditService, server)
+	dkgService := NewDKGServiceProvider(metadataStore, keyShareStorage, manager, discovery, sessionManager, grpcClient, server)
+	registryRegistry, err := NewChainRegistryProvider(server)
+	if err != nil {
+		return nil, err
+	}
+	keyService, err := NewKeyServiceProvider(metadataStore, keyShareStorage, dkgService, auditService, registryRegistry, server)
+	if err != nil {
+		return nil, err
+	}
+	refreshScheduler := NewKeyRefreshSchedulerProvider(metadataStore, sessionStore, dkgService, server)
+	deletionScheduler := NewKeyDeletionSchedulerProvider(metadataStore, sessionStore, keyService, server)
+	nonceManager := NewNonceManagerProvider(metadataStore, sessionStore, registryRegistry, server)
+	transactionService := NewTransactionServiceProvider(metadataStore, registryRegistry, nonceManager)
+	tracker := NewTransactionTrackerProvider(metadataStore, sessionStore, registryRegistry, server)
+	indexer := NewTransactionIndexerProvider(metadataStore, sessionStore, registryRegistry, server)
+	signingService := NewSigningServiceProvider(keyService, sessionManager, discovery, server, grpcClient, metadataStore, registryRegistry)
 	serviceService := NewMPCServiceProvider(server, keyService, sessionManager, discovery, grpcClient, metadataStore)
 	registry := NewNodeRegistry(manager)
 	webauthnService, err := NewWebAuthnServiceProvider(server, metadataStore)
 	if err != nil {
 		return nil, err
 	}
+	approvalService := NewApprovalServiceProvider(server, metadataStore, sessionStore, signingService, webauthnService, auditService)
+	memberService := NewMemberServiceProvider(server, metadataStore, webauthnService, auditService)
 	managementServer := NewManagementServer(discoveryService, sessionManager)
-	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, localService, metricsService, keyService, signingService, serviceService, manager, registry, discovery, sessionManager, grpcClient, discoveryService, webauthnService, managementServer)
+	apiServer := newServerWithComponents(server, db, mailer, service, i18nService, clock, authService, auditService, localService, metricsService, registryRegistry, keyService, refreshScheduler, deletionScheduler, transactionService, nonceManager, tracker, indexer, signingService, approvalService, serviceService, manager, registry, discovery, sessionManager, grpcClient, discoveryService, webauthnService, memberService, managementServer)
 	return apiServer, nil
 }
 
@@ -147,7 +186,7 @@ var serviceSet = wire.NewSet(
 	NewPush,
 	NewMailer,
 	NewI18N,
-	authServiceSet, local.NewService, metrics.New, NewClock,
+	authServiceSet, audit.NewService, local.NewService, metrics.New, NewClock,
 	mpcServiceSet,
 )
 
@@ -166,12 +205,22 @@ var mpcServiceSet = wire.NewSet(
 	NewSessionManager,
 
 	NewWebAuthnServiceProvider,
+	NewMemberServiceProvider,
 
 	NewMPCGRPCClient,
 
+	NewChainRegistryProvider,
+
 	NewDKGServiceProvider,
 	NewKeyServiceProvider,
+	NewKeyRefreshSchedulerProvider,
+	NewKeyDeletionSchedulerProvider,
+	NewNonceManagerProvider,
+	NewTransactionServiceProvider,
+	NewTransactionTrackerProvider,
+	NewTransactionIndexerProvider,
 	NewSigningServiceProvider,
+	NewApprovalServiceProvider,
 	NewMPCServiceProvider,
 
 	NewMPCDiscoveryService,
@@ -0,0 +1,169 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/models"
+	"github.com/aarondl/null/v8"
+)
+
+const (
+	SignatureAlgorithmHMACSHA256 = "hmac-sha256"
+	SignatureAlgorithmEd25519    = "ed25519"
+
+	// chainLockID is the transaction scoped advisory lock serializing appends to the hash chain
+	// across all coordinator instances.
+	chainLockID int64 = 0x6d70635f61756474 // "mpc_audt"
+)
+
+var (
+	ErrInvalidSigningKey         = errors.New("invalid audit signing key")
+	ErrUnknownSignatureAlgorithm = errors.New("unknown audit signature algorithm")
+	ErrMissingSigningKey         = errors.New("audit is enabled but MPC_AUDIT_SIGNING_KEY is not set")
+)
+
+// Signer signs the hash of an audit entry.
+type Signer interface {
+	Verifier
+	Sign(entryHash []byte) []byte
+}
+
+// Verifier checks the signature of an audit entry hash.
+type Verifier interface {
+	Verify(entryHash []byte, signature []byte) bool
+}
+
+// NewSigner creates the signer for the given algorithm from a base64 encoded key: the HMAC secret for
+// hmac-sha256 or the 32 byte private key seed for ed25519. An empty key returns a nil Signer.
+func NewSigner(algorithm string, encodedKey string) (Signer, error) {
+	if encodedKey == "" {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSigningKey, err)
+	}
+
+	switch algorithm {
+	case SignatureAlgorithmHMACSHA256:
+		if len(key) < sha256.Size {
+			return nil, fmt.Errorf("%w: hmac key must be at least %d bytes", ErrInvalidSigningKey, sha256.Size)
+		}
+		return hmacSigner{key: key}, nil
+	case SignatureAlgorithmEd25519:
+		if len(key) != ed25519.SeedSize {
+			return nil, fmt.Errorf("%w: ed25519 seed must be %d bytes", ErrInvalidSigningKey, ed25519.SeedSize)
+		}
+		return ed25519Signer{privateKey: ed25519.NewKeyFromSeed(key)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSignatureAlgorithm, algorithm)
+	}
+}
+
+// NewEd25519Verifier creates a verifier from a base64 encoded Ed25519 public key, allowing auditors
+// to check signatures without access to the coordinator's signing key.
+func NewEd25519Verifier(encodedPublicKey string) (Verifier, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSigningKey, err)
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: ed25519 public key must be %d bytes", ErrInvalidSigningKey, ed25519.PublicKeySize)
+	}
+	return ed25519Verifier{publicKey: ed25519.PublicKey(key)}, nil
+}
+
+type hmacSigner struct {
+	key []byte
+}
+
+func (s hmacSigner) Sign(entryHash []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(entryHash)
+	return mac.Sum(nil)
+}
+
+func (s hmacSigner) Verify(entryHash []byte, signature []byte) bool {
+	return hmac.Equal(s.Sign(entryHash), signature)
+}
+
+type ed25519Signer struct {
+	privateKey ed25519.PrivateKey
+}
+
+func (s ed25519Signer) Sign(entryHash []byte) []byte {
+	return ed25519.Sign(s.privateKey, entryHash)
+}
+
+func (s ed25519Signer) Verify(entryHash []byte, signature []byte) bool {
+	return ed25519.Verify(s.publicKey(), entryHash, signature)
+}
+
+func (s ed25519Signer) publicKey() ed25519.PublicKey {
+	return s.privateKey.Public().(ed25519.PublicKey)
+}
+
+type ed25519Verifier struct {
+	publicKey ed25519.PublicKey
+}
+
+func (v ed25519Verifier) Verify(entryHash []byte, signature []byte) bool {
+	return ed25519.Verify(v.publicKey, entryHash, signature)
+}
+
+// chainedEntry is the canonical representation of an audit entry covered by its hash.
+// Fields must never be reordered or removed, otherwise existing chains no longer verify.
+type chainedEntry struct {
+	ID        int64           `json:"id"`
+	PrevHash  null.String     `json:"prev_hash"`
+	Timestamp string          `json:"timestamp"`
+	EventType string          `json:"event_type"`
+	Operation string          `json:"operation"`
+	Result    string          `json:"result"`
+	UserID    null.String     `json:"user_id"`
+	KeyID     null.String     `json:"key_id"`
+	NodeID    null.String     `json:"node_id"`
+	SessionID null.String     `json:"session_id"`
+	IPAddress null.String     `json:"ip_address"`
+	Details   json.RawMessage `json:"details"`
+}
+
+// ComputeEntryHash returns the hex encoded SHA-256 hash over the entry's content and the hash of its
+// predecessor. auditLog must be read back from the database, so timestamp precision and the jsonb
+// representation of details match what a later verification reads.
+func ComputeEntryHash(auditLog *models.AuditLog) (string, error) {
+	entry := chainedEntry{
+		ID:        auditLog.ID,
+		PrevHash:  auditLog.PrevHash,
+		Timestamp: auditLog.Timestamp.UTC().Format(time.RFC3339Nano),
+		EventType: auditLog.EventType,
+		Operation: auditLog.Operation,
+		Result:    auditLog.Result,
+		UserID:    auditLog.UserID,
+		KeyID:     auditLog.KeyID,
+		NodeID:    auditLog.NodeID,
+		SessionID: auditLog.SessionID,
+		IPAddress: auditLog.IPAddress,
+		Details:   json.RawMessage("null"),
+	}
+	if auditLog.Details.Valid {
+		entry.Details = json.RawMessage(auditLog.Details.JSON)
+	}
+
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,119 @@
+package audit_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/config"
+	"github.com/SafeMPC/mpc-service/internal/models"
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSigner(t *testing.T) {
+	signer, err := audit.NewSigner(audit.SignatureAlgorithmHMACSHA256, "")
+	require.NoError(t, err)
+	assert.Nil(t, signer)
+
+	_, err = audit.NewSigner(audit.SignatureAlgorithmHMACSHA256, base64.StdEncoding.EncodeToString([]byte("too-short")))
+	assert.True(t, errors.Is(err, audit.ErrInvalidSigningKey))
+
+	_, err = audit.NewSigner(audit.SignatureAlgorithmEd25519, base64.StdEncoding.EncodeToString(make([]byte, 16)))
+	assert.True(t, errors.Is(err, audit.ErrInvalidSigningKey))
+
+	_, err = audit.NewSigner("rsa", base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	assert.True(t, errors.Is(err, audit.ErrUnknownSignatureAlgorithm))
+
+	_, err = audit.NewSigner(audit.SignatureAlgorithmHMACSHA256, "not base64!")
+	assert.True(t, errors.Is(err, audit.ErrInvalidSigningKey))
+}
+
+func TestNewServiceRequiresSigningKey(t *testing.T) {
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.MPC.AuditSigningKey = ""
+
+	cfg.MPC.EnableAudit = true
+	_, err := audit.NewService(cfg, nil)
+	assert.True(t, errors.Is(err, audit.ErrMissingSigningKey))
+
+	cfg.MPC.EnableAudit = false
+	service, err := audit.NewService(cfg, nil)
+	require.NoError(t, err)
+	assert.False(t, service.Enabled())
+}
+
+func TestSignerRoundTrip(t *testing.T) {
+	hash := []byte("0123456789abcdef0123456789abcdef")
+
+	for _, algorithm := range []string{audit.SignatureAlgorithmHMACSHA256, audit.SignatureAlgorithmEd25519} {
+		t.Run(algorithm, func(t *testing.T) {
+			signer, err := audit.NewSigner(algorithm, base64.StdEncoding.EncodeToString(make([]byte, 32)))
+			require.NoError(t, err)
+			require.NotNil(t, signer)
+
+			signature := signer.Sign(hash)
+			assert.True(t, signer.Verify(hash, signature))
+			assert.False(t, signer.Verify([]byte("fedcba9876543210fedcba9876543210"), signature))
+
+			other, err := audit.NewSigner(algorithm, base64.StdEncoding.EncodeToString(append(make([]byte, 31), 1)))
+			require.NoError(t, err)
+			assert.False(t, other.Verify(hash, signature))
+		})
+	}
+}
+
+func TestEd25519Verifier(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	signer, err := audit.NewSigner(audit.SignatureAlgorithmEd25519, base64.StdEncoding.EncodeToString(seed))
+	require.NoError(t, err)
+
+	publicKey := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+	verifier, err := audit.NewEd25519Verifier(base64.StdEncoding.EncodeToString(publicKey))
+	require.NoError(t, err)
+
+	hash := []byte("0123456789abcdef0123456789abcdef")
+	assert.True(t, verifier.Verify(hash, signer.Sign(hash)))
+
+	_, err = audit.NewEd25519Verifier(base64.StdEncoding.EncodeToString(make([]byte, 16)))
+	assert.True(t, errors.Is(err, audit.ErrInvalidSigningKey))
+}
+
+func TestComputeEntryHash(t *testing.T) {
+	auditLog := &models.AuditLog{
+		ID:        1,
+		Timestamp: time.Date(2026, 10, 16, 12, 0, 0, 123456000, time.UTC),
+		EventType: audit.EventTypeSigning,
+		Operation: audit.OperationSign,
+		Result:    audit.ResultSuccess,
+		KeyID:     null.StringFrom("key-1"),
+		Details:   null.JSONFrom([]byte(`{"nodes": ["server-p1", "mobile-p1"]}`)),
+	}
+
+	hash, err := audit.ComputeEntryHash(auditLog)
+	require.NoError(t, err)
+	assert.Len(t, hash, 64)
+
+	// timezone of the loaded timestamp doesn't matter
+	sameEntry := *auditLog
+	sameEntry.Timestamp = auditLog.Timestamp.In(time.FixedZone("CEST", 2*60*60))
+	sameHash, err := audit.ComputeEntryHash(&sameEntry)
+	require.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	modified := *auditLog
+	modified.Result = audit.ResultFailure
+	modifiedHash, err := audit.ComputeEntryHash(&modified)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, modifiedHash)
+
+	relinked := *auditLog
+	relinked.PrevHash = null.StringFrom(hash)
+	relinkedHash, err := audit.ComputeEntryHash(&relinked)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, relinkedHash)
+}
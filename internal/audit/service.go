@@ -0,0 +1,232 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/SafeMPC/mpc-service/internal/auth"
+	"github.com/SafeMPC/mpc-service/internal/config"
+	"github.com/SafeMPC/mpc-service/internal/models"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	dbutil "github.com/SafeMPC/mpc-service/internal/util/db"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// Service writes and queries the audit_logs table.
+// Every entry is appended to a hash chain (see chain.go) and signed with the configured
+// MPC_AUDIT_SIGNING_KEY, so deleted or modified entries are detected by Verify.
+// A nil *Service is valid and records nothing, so components constructed without
+// an audit service (e.g. signer nodes or unit tests) don't need to special-case it.
+type Service struct {
+	config config.Server
+	db     *sql.DB
+	signer Signer
+}
+
+func NewService(config config.Server, db *sql.DB) (*Service, error) {
+	signer, err := NewSigner(config.MPC.AuditSignatureAlgorithm, config.MPC.AuditSigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit signer: %w", err)
+	}
+
+	// an unsigned chain can be recomputed by anyone with write access to the database,
+	// so refuse to start instead of silently recording entries that can't be trusted
+	if signer == nil && config.MPC.EnableAudit {
+		return nil, ErrMissingSigningKey
+	}
+
+	if signer, ok := signer.(ed25519Signer); ok {
+		// auditors verify signatures with the public key (app audit verify --public-key)
+		log.Info().Str("publicKey", base64.StdEncoding.EncodeToString(signer.publicKey())).Msg("Signing audit log entries with Ed25519")
+	}
+
+	return &Service{
+		config: config,
+		db:     db,
+		signer: signer,
+	}, nil
+}
+
+// Enabled reports whether audit entries are persisted (MPC_ENABLE_AUDIT).
+func (s *Service) Enabled() bool {
+	return s != nil && s.db != nil && s.config.MPC.EnableAudit
+}
+
+// Record persists an audit entry. Failing to write the audit trail must never fail the
+// audited operation itself, so errors are logged instead of returned.
+func (s *Service) Record(ctx context.Context, entry Entry) {
+	if !s.Enabled() {
+		return
+	}
+
+	log := util.LogFromContext(ctx).With().
+		Str("event_type", entry.EventType).
+		Str("operation", entry.Operation).
+		Logger()
+
+	if entry.UserID == "" {
+		if user := auth.UserFromContext(ctx); user != nil {
+			entry.UserID = user.ID
+		}
+	}
+	if entry.IPAddress == "" {
+		entry.IPAddress = util.ClientIPFromContext(ctx)
+	}
+
+	auditLog := &models.AuditLog{
+		EventType: entry.EventType,
+		Operation: entry.Operation,
+		Result:    entry.Result,
+		UserID:    nullString(entry.UserID),
+		KeyID:     nullString(entry.KeyID),
+		NodeID:    nullString(entry.NodeID),
+		SessionID: nullString(entry.SessionID),
+		IPAddress: nullString(entry.IPAddress),
+	}
+
+	if len(entry.Details) > 0 {
+		details, err := json.Marshal(entry.Details)
+		if err != nil {
+			log.Err(err).Msg("Failed to marshal audit details, storing entry without details")
+		} else {
+			auditLog.Details = null.JSONFrom(details)
+		}
+	}
+
+	if err := s.appendEntry(ctx, auditLog); err != nil {
+		log.Err(err).Msg("Failed to write audit log")
+	}
+}
+
+// appendEntry inserts auditLog as the new head of the hash chain. Appends are serialized by an
+// advisory lock, so entry IDs grow in chain order.
+func (s *Service) appendEntry(ctx context.Context, auditLog *models.AuditLog) error {
+	return dbutil.WithTransaction(ctx, s.db, func(exec boil.ContextExecutor) error {
+		if _, err := exec.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", chainLockID); err != nil {
+			return fmt.Errorf("failed to lock audit chain: %w", err)
+		}
+
+		head, err := models.AuditLogs(
+			models.AuditLogWhere.EntryHash.IsNotNull(),
+			qm.OrderBy(models.AuditLogColumns.ID+" DESC"),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to load audit chain head: %w", err)
+		}
+		if head != nil {
+			auditLog.PrevHash = head.EntryHash
+		}
+
+		if err := auditLog.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert audit log: %w", err)
+		}
+
+		// hash what later verifications will read: the database assigned id/timestamp and the jsonb text
+		if err := auditLog.Reload(ctx, exec); err != nil {
+			return fmt.Errorf("failed to reload audit log: %w", err)
+		}
+
+		entryHash, err := ComputeEntryHash(auditLog)
+		if err != nil {
+			return err
+		}
+		auditLog.EntryHash = null.StringFrom(entryHash)
+
+		if s.signer != nil {
+			hash, err := hex.DecodeString(entryHash)
+			if err != nil {
+				return fmt.Errorf("failed to decode audit entry hash: %w", err)
+			}
+			auditLog.Signature = null.StringFrom(hex.EncodeToString(s.signer.Sign(hash)))
+		}
+
+		if _, err := auditLog.Update(ctx, exec, boil.Whitelist(models.AuditLogColumns.EntryHash, models.AuditLogColumns.Signature)); err != nil {
+			return fmt.Errorf("failed to update audit log hash: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// List returns the audit entries matching filter, newest first, together with the total
+// number of matching entries.
+func (s *Service) List(ctx context.Context, filter ListFilter) ([]*models.AuditLog, int64, error) {
+	mods := filterMods(filter)
+
+	total, err := models.AuditLogs(mods...).Count(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	mods = append(mods,
+		qm.OrderBy(models.AuditLogTableColumns.Timestamp+" DESC, "+models.AuditLogTableColumns.ID+" DESC"),
+		qm.Limit(limit),
+		qm.Offset(filter.Offset),
+	)
+
+	auditLogs, err := models.AuditLogs(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+
+	return auditLogs, total, nil
+}
+
+func filterMods(filter ListFilter) []qm.QueryMod {
+	var mods []qm.QueryMod
+
+	if filter.EventType != "" {
+		mods = append(mods, models.AuditLogWhere.EventType.EQ(filter.EventType))
+	}
+	if filter.Operation != "" {
+		mods = append(mods, models.AuditLogWhere.Operation.EQ(filter.Operation))
+	}
+	if filter.Result != "" {
+		mods = append(mods, models.AuditLogWhere.Result.EQ(filter.Result))
+	}
+	if filter.UserID != "" {
+		mods = append(mods, models.AuditLogWhere.UserID.EQ(null.StringFrom(filter.UserID)))
+	}
+	if filter.KeyID != "" {
+		mods = append(mods, models.AuditLogWhere.KeyID.EQ(null.StringFrom(filter.KeyID)))
+	}
+	if filter.SessionID != "" {
+		mods = append(mods, models.AuditLogWhere.SessionID.EQ(null.StringFrom(filter.SessionID)))
+	}
+	if filter.From != nil {
+		mods = append(mods, models.AuditLogWhere.Timestamp.GTE(*filter.From))
+	}
+	if filter.To != nil {
+		mods = append(mods, models.AuditLogWhere.Timestamp.LT(*filter.To))
+	}
+
+	return mods
+}
+
+func nullString(s string) null.String {
+	if s == "" {
+		return null.String{}
+	}
+	return null.StringFrom(s)
+}
@@ -0,0 +1,74 @@
+package audit
+
+import "time"
+
+// Event types group audit entries by the subsystem that produced them.
+const (
+	EventTypeKey     = "key"
+	EventTypeSigning = "signing"
+	EventTypeSession = "session"
+	EventTypePasskey = "passkey"
+	EventTypeAuth    = "auth"
+	EventTypePolicy  = "policy"
+	EventTypeMember  = "member"
+)
+
+// Operations recorded within the event types above.
+const (
+	OperationCreate           = "create"
+	OperationDKGComplete      = "dkg_complete"
+	OperationDerive           = "derive"
+	OperationReshare          = "reshare"
+	OperationEnable           = "enable"
+	OperationDisable          = "disable"
+	OperationScheduleDeletion = "schedule_deletion"
+	OperationCancelDeletion   = "cancel_deletion"
+	OperationDestroy          = "destroy"
+	OperationConfirmDeletion  = "confirm_share_deletion"
+	OperationSign             = "sign"
+	OperationTransfer         = "transfer"
+	OperationFail             = "fail"
+	OperationRegister         = "register"
+	OperationLogin            = "login"
+	OperationUpdate           = "update"
+	OperationEvaluate         = "evaluate"
+	OperationSubmit           = "submit"
+	OperationApprove          = "approve"
+	OperationReject           = "reject"
+	OperationInvite           = "invite"
+	OperationRevoke           = "revoke"
+	OperationAccept           = "accept"
+)
+
+// Results of an audited operation.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Entry is a single audit record. Empty optional fields are stored as NULL.
+type Entry struct {
+	EventType string
+	Operation string
+	Result    string
+	UserID    string // acting user, taken from the request context if empty
+	KeyID     string
+	NodeID    string
+	SessionID string
+	IPAddress string // client IP, taken from the request context if empty
+	Details   map[string]interface{}
+}
+
+// ListFilter restricts the audit entries returned by Service.List. Zero values are ignored.
+type ListFilter struct {
+	EventType string
+	Operation string
+	Result    string
+	UserID    string
+	KeyID     string
+	SessionID string
+	From      *time.Time
+	To        *time.Time
+	Limit     int
+	Offset    int
+}
@@ -0,0 +1,126 @@
+package audit
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/SafeMPC/mpc-service/internal/models"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const verifyBatchSize = 1000
+
+// BrokenLink describes the first audit entry failing verification.
+type BrokenLink struct {
+	ID     int64
+	Reason string
+}
+
+// VerifyResult is the outcome of walking the audit hash chain.
+type VerifyResult struct {
+	// Verified is the number of chained entries checked before the first broken link (or in total).
+	Verified int
+	// Unchained is the number of entries written before the hash chain was introduced.
+	Unchained int
+	// SignaturesChecked is false if no verifier was provided, i.e. only the hash links were checked.
+	SignaturesChecked bool
+	// HeadID and HeadHash identify the last verified entry. Truncating the tail of the chain can't be
+	// detected from the chain alone, so auditors should record the head externally and compare it
+	// with the next verification.
+	HeadID   int64
+	HeadHash string
+	// Broken is nil if the whole chain verified.
+	Broken *BrokenLink
+}
+
+// Verify walks the audit hash chain in insertion order and stops at the first broken link: an entry
+// whose content doesn't match its hash, whose previous hash doesn't match its predecessor (i.e. an
+// entry was deleted or reordered), that is missing from the chain or whose signature is invalid.
+// Signatures are only checked if verifier is not nil.
+func Verify(ctx context.Context, exec boil.ContextExecutor, verifier Verifier) (*VerifyResult, error) {
+	result := &VerifyResult{SignaturesChecked: verifier != nil}
+
+	var (
+		lastID   int64
+		prevHash null.String
+		started  bool
+	)
+
+	for {
+		auditLogs, err := models.AuditLogs(
+			models.AuditLogWhere.ID.GT(lastID),
+			qm.OrderBy(models.AuditLogColumns.ID+" ASC"),
+			qm.Limit(verifyBatchSize),
+		).All(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load audit logs: %w", err)
+		}
+
+		for _, auditLog := range auditLogs {
+			lastID = auditLog.ID
+
+			if !auditLog.EntryHash.Valid {
+				if !started {
+					result.Unchained++
+					continue
+				}
+				result.Broken = &BrokenLink{ID: auditLog.ID, Reason: "entry is missing its hash"}
+				return result, nil
+			}
+
+			if reason := verifyEntry(auditLog, prevHash, verifier); reason != "" {
+				result.Broken = &BrokenLink{ID: auditLog.ID, Reason: reason}
+				return result, nil
+			}
+
+			started = true
+			prevHash = auditLog.EntryHash
+			result.Verified++
+			result.HeadID = auditLog.ID
+			result.HeadHash = auditLog.EntryHash.String
+		}
+
+		if len(auditLogs) < verifyBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// verifyEntry returns the reason auditLog fails verification or an empty string.
+func verifyEntry(auditLog *models.AuditLog, prevHash null.String, verifier Verifier) string {
+	if auditLog.PrevHash != prevHash {
+		if !prevHash.Valid {
+			return "first chained entry references a previous entry, chain start was deleted"
+		}
+		return fmt.Sprintf("previous hash does not match entry before it (expected %s), an entry was deleted or modified", prevHash.String)
+	}
+
+	entryHash, err := ComputeEntryHash(auditLog)
+	if err != nil {
+		return err.Error()
+	}
+	if entryHash != auditLog.EntryHash.String {
+		return "entry hash does not match its content, entry was modified"
+	}
+
+	if verifier == nil {
+		return ""
+	}
+	if !auditLog.Signature.Valid {
+		return "entry is not signed"
+	}
+
+	hash, err := hex.DecodeString(entryHash)
+	if err != nil {
+		return fmt.Sprintf("failed to decode entry hash: %v", err)
+	}
+	signature, err := hex.DecodeString(auditLog.Signature.String)
+	if err != nil || !verifier.Verify(hash, signature) {
+		return "invalid signature"
+	}
+
+	return ""
+}
@@ -0,0 +1,156 @@
+package audit_test
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"testing"
+
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/config"
+	"github.com/SafeMPC/mpc-service/internal/models"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withChainedAuditLogs(t *testing.T, closure func(db *sql.DB, signer audit.Signer, auditLogs models.AuditLogSlice)) {
+	t.Helper()
+
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+
+		cfg := config.DefaultServiceConfigFromEnv()
+		cfg.MPC.EnableAudit = true
+		cfg.MPC.AuditSignatureAlgorithm = audit.SignatureAlgorithmHMACSHA256
+		cfg.MPC.AuditSigningKey = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+		service, err := audit.NewService(cfg, db)
+		require.NoError(t, err)
+
+		for _, sessionID := range []string{"session-1", "session-2", "session-3", "session-4"} {
+			service.Record(ctx, audit.Entry{
+				EventType: audit.EventTypeSigning,
+				Operation: audit.OperationSign,
+				Result:    audit.ResultSuccess,
+				KeyID:     "key-1",
+				SessionID: sessionID,
+				Details:   map[string]interface{}{"duration_ms": 1500, "nodes": []string{"server-p1", "mobile-p1"}},
+			})
+		}
+
+		auditLogs, err := models.AuditLogs(qm.OrderBy(models.AuditLogColumns.ID+" ASC")).All(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, auditLogs, 4)
+
+		signer, err := audit.NewSigner(cfg.MPC.AuditSignatureAlgorithm, cfg.MPC.AuditSigningKey)
+		require.NoError(t, err)
+
+		closure(db, signer, auditLogs)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	withChainedAuditLogs(t, func(db *sql.DB, signer audit.Signer, auditLogs models.AuditLogSlice) {
+		assert.False(t, auditLogs[0].PrevHash.Valid)
+		for i := 1; i < len(auditLogs); i++ {
+			assert.Equal(t, auditLogs[i-1].EntryHash, auditLogs[i].PrevHash)
+			assert.True(t, auditLogs[i].Signature.Valid)
+		}
+
+		result, err := audit.Verify(t.Context(), db, signer)
+		require.NoError(t, err)
+
+		assert.Nil(t, result.Broken)
+		assert.Equal(t, 4, result.Verified)
+		assert.True(t, result.SignaturesChecked)
+		assert.Equal(t, auditLogs[3].ID, result.HeadID)
+		assert.Equal(t, auditLogs[3].EntryHash.String, result.HeadHash)
+	})
+}
+
+func TestVerifyModifiedEntry(t *testing.T) {
+	withChainedAuditLogs(t, func(db *sql.DB, signer audit.Signer, auditLogs models.AuditLogSlice) {
+		ctx := t.Context()
+
+		tampered := auditLogs[1]
+		tampered.Result = audit.ResultFailure
+		_, err := tampered.Update(ctx, db, boil.Whitelist(models.AuditLogColumns.Result))
+		require.NoError(t, err)
+
+		result, err := audit.Verify(ctx, db, signer)
+		require.NoError(t, err)
+
+		require.NotNil(t, result.Broken)
+		assert.Equal(t, tampered.ID, result.Broken.ID)
+		assert.Equal(t, 1, result.Verified)
+		assert.Equal(t, auditLogs[0].ID, result.HeadID)
+	})
+}
+
+func TestVerifyRehashedEntry(t *testing.T) {
+	withChainedAuditLogs(t, func(db *sql.DB, signer audit.Signer, auditLogs models.AuditLogSlice) {
+		ctx := t.Context()
+
+		// recomputing the hash without the signing key still breaks the signature
+		tampered := auditLogs[2]
+		tampered.Details = null.JSONFrom([]byte(`{"duration_ms": 1}`))
+		_, err := tampered.Update(ctx, db, boil.Whitelist(models.AuditLogColumns.Details))
+		require.NoError(t, err)
+		require.NoError(t, tampered.Reload(ctx, db))
+
+		entryHash, err := audit.ComputeEntryHash(tampered)
+		require.NoError(t, err)
+		tampered.EntryHash = null.StringFrom(entryHash)
+		_, err = tampered.Update(ctx, db, boil.Whitelist(models.AuditLogColumns.EntryHash))
+		require.NoError(t, err)
+
+		result, err := audit.Verify(ctx, db, signer)
+		require.NoError(t, err)
+
+		require.NotNil(t, result.Broken)
+		assert.Equal(t, tampered.ID, result.Broken.ID)
+		assert.Equal(t, "invalid signature", result.Broken.Reason)
+
+		// without a verifier only the link to the next entry breaks
+		result, err = audit.Verify(ctx, db, nil)
+		require.NoError(t, err)
+
+		require.NotNil(t, result.Broken)
+		assert.Equal(t, auditLogs[3].ID, result.Broken.ID)
+	})
+}
+
+func TestVerifyDeletedEntry(t *testing.T) {
+	withChainedAuditLogs(t, func(db *sql.DB, signer audit.Signer, auditLogs models.AuditLogSlice) {
+		ctx := t.Context()
+
+		_, err := auditLogs[1].Delete(ctx, db)
+		require.NoError(t, err)
+
+		result, err := audit.Verify(ctx, db, signer)
+		require.NoError(t, err)
+
+		require.NotNil(t, result.Broken)
+		assert.Equal(t, auditLogs[2].ID, result.Broken.ID)
+		assert.Equal(t, 1, result.Verified)
+	})
+}
+
+func TestVerifyDeletedChainStart(t *testing.T) {
+	withChainedAuditLogs(t, func(db *sql.DB, signer audit.Signer, auditLogs models.AuditLogSlice) {
+		ctx := t.Context()
+
+		_, err := auditLogs[0].Delete(ctx, db)
+		require.NoError(t, err)
+
+		result, err := audit.Verify(ctx, db, signer)
+		require.NoError(t, err)
+
+		require.NotNil(t, result.Broken)
+		assert.Equal(t, auditLogs[1].ID, result.Broken.ID)
+		assert.Equal(t, 0, result.Verified)
+	})
+}
@@ -3,7 +3,8 @@ package auth
 type Scope string
 
 const (
-	ScopeApp Scope = "app"
+	ScopeApp   Scope = "app"
+	ScopeAudit Scope = "audit" // read access to the audit log
 )
 
 func (s Scope) String() string {
@@ -80,8 +80,9 @@ type ManagementServer struct {
 }
 
 type FrontendServer struct {
-	BaseURL               string
-	PasswordResetEndpoint string
+	BaseURL                  string
+	PasswordResetEndpoint    string
+	WalletInvitationEndpoint string
 }
 
 type LoggerServer struct {
@@ -145,6 +146,48 @@ type MPC struct {
 	KeyRotationDays int
 	IsGuardianNode  bool // 是否作为 Guardian 节点运行
 
+	// 审计日志哈希链签名配置（EnableAudit 时生效）
+	AuditSignatureAlgorithm string // hmac-sha256 或 ed25519
+	AuditSigningKey         string // base64 编码：HMAC 密钥或 Ed25519 私钥种子（32 字节）
+
+	// 分片定期刷新配置（KeyRotationDays > 0 时生效）
+	KeyRefreshCheckInterval time.Duration // 扫描到期密钥的间隔
+	KeyRefreshMaxRetries    int           // 单次刷新的最大尝试次数
+	KeyRefreshRetryBackoff  time.Duration // 重试初始退避时间（指数增长）
+
+	// 密钥删除等待期配置
+	KeyDeletionWindowDays    int           // 默认删除等待期（7-30 天）
+	KeyDeletionCheckInterval time.Duration // 扫描等待期已结束密钥的间隔
+
+	// 链注册表配置
+	ChainsFile         string // 链注册表 JSON 文件路径，为空时使用内置主网配置
+	BitcoinNetwork     string // 内置配置中的 Bitcoin 网络：mainnet, testnet, testnet4, signet, regtest
+	BitcoinAddressType string // 新建 Bitcoin 钱包未指定地址类型时的默认值：p2pkh, p2wpkh, p2sh-p2wpkh, p2tr
+
+	// 交易确认跟踪配置
+	TxPollInterval time.Duration // 轮询 pending 交易状态的间隔
+	TxDropTimeout  time.Duration // 广播后超过该时间仍未上链的交易标记为 dropped
+
+	// EVM nonce 管理配置
+	NonceLockWait           time.Duration // 等待地址 nonce 锁的超时
+	NonceReservationTimeout time.Duration // 预留后既未广播也未释放的 nonce 超过该时间后可重新分配
+
+	// 转入交易索引配置
+	IndexerPollInterval time.Duration // 扫描新区块和新签名的间隔
+	IndexerMaxBlocks    int           // 单次扫描每条 EVM 链最多处理的区块数
+
+	// 签名请求审批配置（EnablePolicy 时生效）
+	SignRequestTTL time.Duration // 签名请求等待成员审批的时长，超时后标记为 expired
+
+	// 钱包邀请配置
+	WalletInvitationTTL time.Duration // 邮件邀请链接的有效期
+
+	// WebAuthn 配置
+	WebAuthnRPID         string        // Relying Party ID（前端域名）
+	WebAuthnRPOrigin     string        // 前端 Origin，assertion 的 clientDataJSON.origin 必须与其一致
+	RequireSignAssertion bool          // 签名交易必须提供钱包成员对服务端 challenge 的 WebAuthn assertion，只能由测试代码关闭
+	SignChallengeTTL     time.Duration // 签名 challenge 的有效期
+
 	// 性能配置
 	MaxConcurrentSessions int
 	MaxConcurrentSignings int
@@ -278,8 +321,9 @@ func DefaultServiceConfigFromEnv() Server {
 			TLSConfig:  nil,
 		},
 		Frontend: FrontendServer{
-			BaseURL:               util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
-			PasswordResetEndpoint: util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			BaseURL:                  util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
+			PasswordResetEndpoint:    util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			WalletInvitationEndpoint: util.GetEnv("SERVER_FRONTEND_WALLET_INVITATION_ENDPOINT", "/wallet-invitation"),
 		},
 		Logger: LoggerServer{
 			Level:              util.LogLevelFromString(util.GetEnv("SERVER_LOGGER_LEVEL", zerolog.DebugLevel.String())),
@@ -333,6 +377,39 @@ func DefaultServiceConfigFromEnv() Server {
 			MaxConcurrentSessions: util.GetEnvAsInt("MPC_MAX_CONCURRENT_SESSIONS", 100),
 			MaxConcurrentSignings: util.GetEnvAsInt("MPC_MAX_CONCURRENT_SIGNINGS", 50),
 			SessionTimeout:        util.GetEnvAsInt("MPC_SESSION_TIMEOUT", 300),
+
+			AuditSignatureAlgorithm: util.GetEnv("MPC_AUDIT_SIGNATURE_ALGORITHM", "hmac-sha256"),
+			AuditSigningKey:         util.GetEnv("MPC_AUDIT_SIGNING_KEY", ""),
+
+			KeyRefreshCheckInterval: time.Minute * time.Duration(util.GetEnvAsInt("MPC_KEY_REFRESH_CHECK_INTERVAL_MINUTES", 60)),
+			KeyRefreshMaxRetries:    util.GetEnvAsInt("MPC_KEY_REFRESH_MAX_RETRIES", 3),
+			KeyRefreshRetryBackoff:  time.Second * time.Duration(util.GetEnvAsInt("MPC_KEY_REFRESH_RETRY_BACKOFF_SECONDS", 60)),
+
+			KeyDeletionWindowDays:    util.GetEnvAsInt("MPC_KEY_DELETION_WINDOW_DAYS", 30),
+			KeyDeletionCheckInterval: time.Minute * time.Duration(util.GetEnvAsInt("MPC_KEY_DELETION_CHECK_INTERVAL_MINUTES", 60)),
+
+			ChainsFile:         util.GetEnv("MPC_CHAINS_FILE", ""),
+			BitcoinNetwork:     util.GetEnv("MPC_BITCOIN_NETWORK", "mainnet"),
+			BitcoinAddressType: util.GetEnv("MPC_BITCOIN_ADDRESS_TYPE", "p2wpkh"),
+
+			TxPollInterval: time.Second * time.Duration(util.GetEnvAsInt("MPC_TX_POLL_INTERVAL_SECONDS", 15)),
+			TxDropTimeout:  time.Minute * time.Duration(util.GetEnvAsInt("MPC_TX_DROP_TIMEOUT_MINUTES", 30)),
+
+			NonceLockWait:           time.Second * time.Duration(util.GetEnvAsInt("MPC_NONCE_LOCK_WAIT_SECONDS", 5)),
+			NonceReservationTimeout: time.Minute * time.Duration(util.GetEnvAsInt("MPC_NONCE_RESERVATION_TIMEOUT_MINUTES", 15)),
+
+			IndexerPollInterval: time.Second * time.Duration(util.GetEnvAsInt("MPC_INDEXER_POLL_INTERVAL_SECONDS", 30)),
+			IndexerMaxBlocks:    util.GetEnvAsInt("MPC_INDEXER_MAX_BLOCKS", 50),
+
+			SignRequestTTL: time.Minute * time.Duration(util.GetEnvAsInt("MPC_SIGN_REQUEST_TTL_MINUTES", 1440)),
+
+			WalletInvitationTTL: time.Hour * time.Duration(util.GetEnvAsInt("MPC_WALLET_INVITATION_TTL_HOURS", 72)),
+
+			WebAuthnRPID:     util.GetEnv("MPC_WEBAUTHN_RP_ID", "localhost"),
+			WebAuthnRPOrigin: util.GetEnv("MPC_WEBAUTHN_RP_ORIGIN", "http://localhost:8080"),
+			// 没有对应的环境变量，只有测试代码可以在传给测试服务器的配置中关闭
+			RequireSignAssertion: true,
+			SignChallengeTTL:     time.Second * time.Duration(util.GetEnvAsInt("MPC_SIGN_CHALLENGE_TTL_SECONDS", 300)),
 		},
 	}
 }
@@ -0,0 +1,541 @@
+package approval
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
+	"github.com/SafeMPC/mpc-service/internal/infra/policy"
+	"github.com/SafeMPC/mpc-service/internal/infra/signing"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrApprovalNotRequired 签名策略允许直接签名，不需要创建签名请求
+	ErrApprovalNotRequired = errors.New("signing request does not require approval")
+	// ErrNotAwaitingApproval 签名请求已审批完成、被拒绝或已过期
+	ErrNotAwaitingApproval = errors.New("sign request is not awaiting approval")
+	// ErrNotWalletMember 审批凭证不是调用者的钱包成员凭证或角色低于 approver
+	ErrNotWalletMember = errors.New("credential is not a wallet member")
+	// ErrInvalidAssertion WebAuthn assertion 校验失败
+	ErrInvalidAssertion = errors.New("invalid webauthn assertion")
+	// ErrNotApprovedTransfer 签名请求不是已通过审批的转账请求
+	ErrNotApprovedTransfer = errors.New("sign request is not an approved transfer")
+	// ErrTransferInProgress 转账请求正在被另一个转账使用
+	ErrTransferInProgress = errors.New("transfer of sign request is in progress")
+)
+
+// DefaultTTL 签名请求默认的审批有效期
+const DefaultTTL = 24 * time.Hour
+
+// signTimeout 审批通过后阈值签名的最长执行时间，需大于 signing.Service 等待签名完成的时间；
+// 超过该时间仍为 approved 的请求（如签名期间服务重启）标记为 failed
+const signTimeout = 15 * time.Minute
+
+// saveTimeout 写回签名结果的超时时间
+const saveTimeout = 10 * time.Second
+
+// transferLockTTL 执行转账请求时持有的锁的有效期，持有期间自动续期
+const transferLockTTL = time.Minute
+
+// requestHashDomain 请求哈希的域分隔前缀，避免与其他用途的 Passkey 签名混用
+const requestHashDomain = "SafeMPC sign request"
+
+// Store 签名请求和钱包成员的存储
+type Store interface {
+	SaveSignRequest(ctx context.Context, req *storage.SignRequest) error
+	GetSignRequest(ctx context.Context, requestID string) (*storage.SignRequest, error)
+	ListSignRequests(ctx context.Context, filter *storage.SignRequestFilter) ([]*storage.SignRequest, error)
+	UpdateSignRequest(ctx context.Context, req *storage.SignRequest, expectedStatus string) error
+	SaveSignRequestApproval(ctx context.Context, approval *storage.SignRequestApproval) error
+	ListSignRequestApprovals(ctx context.Context, requestID string) ([]*storage.SignRequestApproval, error)
+	IsWalletMember(ctx context.Context, walletID, credentialID string) (bool, string, error)
+	ListUserPasskeys(ctx context.Context, userID string) ([]*storage.Passkey, error)
+}
+
+// Signer 评估签名策略并执行阈值签名，由 signing.Service 实现
+type Signer interface {
+	EvaluatePolicy(ctx context.Context, req *signing.SignRequest) (*storage.PolicyDecision, error)
+	ThresholdSign(ctx context.Context, req *signing.SignRequest) (*signing.SignResponse, error)
+}
+
+// Assertion 钱包成员对请求哈希的 WebAuthn assertion，challenge 为 Base64URL（无填充）编码的请求哈希
+type Assertion struct {
+	CredentialID      string // Base64URL（无填充）编码的凭证 ID
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+	Signature         []byte
+}
+
+// AssertionVerifier 校验 Passkey assertion（RP ID、origin、UV 标志和签名计数器），由 webauthn.Service 实现，
+// credentialID 为原始凭证 ID
+type AssertionVerifier interface {
+	VerifyAssertion(ctx context.Context, credentialID string, challenge []byte, authData []byte, clientDataJSON []byte, signature []byte) error
+}
+
+// Service 签名请求审批服务：策略要求审批的签名先保存为签名请求，收集到 RequiredApprovals 个不同用户的
+// 审批后才执行阈值签名，任一成员拒绝即终止请求
+type Service struct {
+	store        Store
+	signer       Signer
+	verifier     AssertionVerifier
+	ttl          time.Duration
+	auditService *audit.Service
+	sessionStore storage.SessionStore
+
+	now      func() time.Time
+	dispatch func(fn func()) // 执行审批通过后的签名，默认在新的 goroutine 中执行
+}
+
+// NewService 创建签名请求审批服务，ttl 不大于 0 时使用 DefaultTTL
+func NewService(store Store, signer Signer, verifier AssertionVerifier, ttl time.Duration) *Service {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Service{
+		store:    store,
+		signer:   signer,
+		verifier: verifier,
+		ttl:      ttl,
+		now:      time.Now,
+		dispatch: func(fn func()) { go fn() },
+	}
+}
+
+// SetAuditService 设置审计服务，签名请求的创建和成员审批写入审计日志
+func (s *Service) SetAuditService(auditService *audit.Service) {
+	s.auditService = auditService
+}
+
+// SetSessionStore 设置执行转账请求时加锁使用的存储，未设置时不能执行转账请求
+func (s *Service) SetSessionStore(sessionStore storage.SessionStore) {
+	s.sessionStore = sessionStore
+}
+
+// RequestHash 计算签名请求的哈希，成员审批时对其进行 WebAuthn 签名
+func RequestHash(req *storage.SignRequest) []byte {
+	fields := []string{
+		requestHashDomain,
+		req.RequestID,
+		req.WalletID,
+		req.ChainType,
+		req.MessageHex,
+		req.Destination,
+		req.Amount,
+		req.Asset,
+	}
+	hash := sha256.Sum256([]byte(strings.Join(fields, "\n")))
+	return hash[:]
+}
+
+// Submit 为签名策略要求审批的请求创建待审批的签名请求；策略拒绝时返回 policy.ErrDenied，
+// 不需要审批时返回 ErrApprovalNotRequired。没有待签名消息的请求为转账请求，审批通过后由 ClaimTransfer 执行
+func (s *Service) Submit(ctx context.Context, req *signing.SignRequest) (*storage.SignRequest, error) {
+	decision, err := s.signer.EvaluatePolicy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if decision == nil || decision.Action != storage.PolicyActionRequireApproval {
+		if err := policy.DecisionError(decision); err != nil {
+			return nil, err
+		}
+		return nil, ErrApprovalNotRequired
+	}
+
+	messageHex := strings.ToLower(strings.TrimPrefix(req.MessageHex, "0x"))
+	if messageHex == "" {
+		messageHex = hex.EncodeToString(req.Message)
+	}
+
+	now := s.now()
+	signRequest := &storage.SignRequest{
+		RequestID:         uuid.New().String(),
+		WalletID:          req.KeyID,
+		ChainType:         decision.ChainType,
+		MessageHex:        messageHex,
+		DerivationPath:    req.DerivationPath,
+		Destination:       req.Destination,
+		Asset:             req.Asset,
+		MobileNodeID:      req.MobileNodeID,
+		Status:            storage.SignRequestStatusAwaitingApproval,
+		RequiredApprovals: decision.RequiredApprovals,
+		PolicyDecision:    decision,
+		ExpiresAt:         now.Add(s.ttl),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if req.Amount != nil {
+		signRequest.Amount = req.Amount.String()
+	}
+	signRequest.RequestHash = hex.EncodeToString(RequestHash(signRequest))
+
+	if err := s.store.SaveSignRequest(ctx, signRequest); err != nil {
+		return nil, errors.Wrap(err, "failed to save sign request")
+	}
+
+	s.auditService.Record(ctx, audit.Entry{
+		EventType: audit.EventTypeSigning,
+		Operation: audit.OperationSubmit,
+		Result:    audit.ResultSuccess,
+		KeyID:     signRequest.WalletID,
+		Details: map[string]interface{}{
+			"sign_request_id":    signRequest.RequestID,
+			"message_hex":        signRequest.MessageHex,
+			"required_approvals": signRequest.RequiredApprovals,
+			"reason":             decision.Reason,
+		},
+	})
+
+	return signRequest, nil
+}
+
+// Get 获取钱包的签名请求及其审批记录，已过期的请求标记为 expired，签名超时的请求标记为 failed
+func (s *Service) Get(ctx context.Context, walletID, requestID string) (*storage.SignRequest, []*storage.SignRequestApproval, error) {
+	signRequest, err := s.get(ctx, walletID, requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	approvals, err := s.store.ListSignRequestApprovals(ctx, requestID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list sign request approvals")
+	}
+	return signRequest, approvals, nil
+}
+
+// List 列出签名请求（按创建时间倒序），已过期的请求标记为 expired，签名超时的请求标记为 failed
+func (s *Service) List(ctx context.Context, filter *storage.SignRequestFilter) ([]*storage.SignRequest, error) {
+	requests, err := s.store.ListSignRequests(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sign requests")
+	}
+
+	result := make([]*storage.SignRequest, 0, len(requests))
+	for _, signRequest := range requests {
+		if err := s.expire(ctx, signRequest); err != nil {
+			return nil, err
+		}
+		if filter != nil && filter.Status != "" && signRequest.Status != filter.Status {
+			continue
+		}
+		result = append(result, signRequest)
+	}
+	return result, nil
+}
+
+// Approve 钱包成员批准签名请求；assertion 必须来自 userID 自己的 Passkey 凭证，同一用户的多个凭证只计一次批准。
+// 批准的用户数达到 RequiredApprovals 时请求变为 approved，并在后台执行阈值签名；转账请求等待转账接口引用
+func (s *Service) Approve(ctx context.Context, walletID, requestID, userID string, assertion *Assertion) (*storage.SignRequest, []*storage.SignRequestApproval, error) {
+	return s.review(ctx, walletID, requestID, userID, assertion, true)
+}
+
+// Reject 钱包成员拒绝签名请求，请求立即变为 rejected
+func (s *Service) Reject(ctx context.Context, walletID, requestID, userID string, assertion *Assertion) (*storage.SignRequest, []*storage.SignRequestApproval, error) {
+	return s.review(ctx, walletID, requestID, userID, assertion, false)
+}
+
+func (s *Service) review(ctx context.Context, walletID, requestID, userID string, assertion *Assertion, approved bool) (*storage.SignRequest, []*storage.SignRequestApproval, error) {
+	signRequest, err := s.get(ctx, walletID, requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if signRequest.Status != storage.SignRequestStatusAwaitingApproval {
+		return nil, nil, errors.Wrapf(ErrNotAwaitingApproval, "sign request %s is %s", requestID, signRequest.Status)
+	}
+	if err := s.verifyAssertion(ctx, signRequest, userID, assertion); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.store.SaveSignRequestApproval(ctx, &storage.SignRequestApproval{
+		RequestID:    requestID,
+		UserID:       userID,
+		CredentialID: assertion.CredentialID,
+		Approved:     approved,
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	operation := audit.OperationApprove
+	if !approved {
+		operation = audit.OperationReject
+	}
+	s.auditService.Record(ctx, audit.Entry{
+		EventType: audit.EventTypeSigning,
+		Operation: operation,
+		Result:    audit.ResultSuccess,
+		KeyID:     walletID,
+		Details: map[string]interface{}{
+			"sign_request_id": requestID,
+			"user_id":         userID,
+			"credential_id":   assertion.CredentialID,
+		},
+	})
+
+	approvals, err := s.store.ListSignRequestApprovals(ctx, requestID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list sign request approvals")
+	}
+
+	if !approved {
+		signRequest.Status = storage.SignRequestStatusRejected
+	} else if storage.CountApprovedUsers(approvals) >= signRequest.RequiredApprovals {
+		signRequest.Status = storage.SignRequestStatusApproved
+	} else {
+		return signRequest, approvals, nil
+	}
+
+	if err := s.store.UpdateSignRequest(ctx, signRequest, storage.SignRequestStatusAwaitingApproval); err != nil {
+		if !errors.Is(err, storage.ErrSignRequestStatusConflict) {
+			return nil, nil, errors.Wrap(err, "failed to update sign request")
+		}
+		// 并发的审批或拒绝已经完成了状态转换
+		current, err := s.store.GetSignRequest(ctx, requestID)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to get sign request")
+		}
+		return current, approvals, nil
+	}
+
+	if signRequest.Status == storage.SignRequestStatusApproved && signRequest.IsTransfer() {
+		log.Info().
+			Str("wallet_id", walletID).
+			Str("sign_request_id", requestID).
+			Int("approvals", storage.CountApprovedUsers(approvals)).
+			Msg("Transfer sign request approved, waiting for the transfer to be submitted")
+	} else if signRequest.Status == storage.SignRequestStatusApproved {
+		log.Info().
+			Str("wallet_id", walletID).
+			Str("sign_request_id", requestID).
+			Int("approvals", storage.CountApprovedUsers(approvals)).
+			Msg("Sign request approved, starting threshold signing")
+		approvedRequest := *signRequest
+		s.dispatch(func() { s.sign(&approvedRequest) })
+	}
+	return signRequest, approvals, nil
+}
+
+// sign 审批通过后执行阈值签名并写回结果；签名失败或超时时请求变为 failed 并记录失败原因
+func (s *Service) sign(signRequest *storage.SignRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+
+	req := &signing.SignRequest{
+		KeyID:          signRequest.WalletID,
+		MessageHex:     signRequest.MessageHex,
+		MessageType:    "transaction",
+		ChainType:      signRequest.ChainType,
+		DerivationPath: signRequest.DerivationPath,
+		MobileNodeID:   signRequest.MobileNodeID,
+		Destination:    signRequest.Destination,
+		Asset:          signRequest.Asset,
+		SignRequestID:  signRequest.RequestID,
+	}
+	if signRequest.Amount != "" {
+		req.Amount, _ = new(big.Int).SetString(signRequest.Amount, 10)
+	}
+
+	resp, err := s.signer.ThresholdSign(ctx, req)
+	if err != nil {
+		log.Error().Err(err).Str("sign_request_id", signRequest.RequestID).Msg("Threshold signing of approved sign request failed")
+		signRequest.Status = storage.SignRequestStatusFailed
+		signRequest.ErrorMessage = err.Error()
+	} else {
+		signRequest.Status = storage.SignRequestStatusSigned
+		signRequest.SessionID = resp.SessionID
+		signRequest.Signature = resp.Signature
+		signRequest.ErrorMessage = ""
+	}
+
+	// 签名可能用完了 ctx 的时间，写回结果使用单独的超时
+	saveCtx, saveCancel := context.WithTimeout(context.Background(), saveTimeout)
+	defer saveCancel()
+	if err := s.store.UpdateSignRequest(saveCtx, signRequest, storage.SignRequestStatusApproved); err != nil {
+		log.Error().Err(err).Str("sign_request_id", signRequest.RequestID).Msg("Failed to save signing result of sign request")
+	}
+}
+
+// TransferExecution 已领取的转账请求，释放前其他转账不能使用同一请求
+type TransferExecution struct {
+	service *Service
+	request *storage.SignRequest
+	lock    *storage.Lock
+}
+
+// ClaimTransfer 领取钱包已通过审批的转账请求，用于构建并签名该请求批准的转账；
+// 请求不存在返回 storage.ErrSignRequestNotFound，不是已通过审批的转账请求返回 ErrNotApprovedTransfer，
+// 正在被其他转账使用返回 ErrTransferInProgress。调用方完成后必须调用 Release
+func (s *Service) ClaimTransfer(ctx context.Context, walletID, requestID string) (*TransferExecution, error) {
+	if s.sessionStore == nil {
+		return nil, errors.New("session store is not configured")
+	}
+
+	signRequest, err := s.get(ctx, walletID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkApprovedTransfer(signRequest); err != nil {
+		return nil, err
+	}
+
+	lock, err := storage.TryLock(ctx, s.sessionStore, "sign_request_transfer:"+requestID, transferLockTTL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to lock sign request")
+	}
+	if lock == nil {
+		return nil, errors.Wrapf(ErrTransferInProgress, "sign request %s", requestID)
+	}
+
+	// 加锁前其他转账可能已经执行完该请求
+	signRequest, err = s.get(ctx, walletID, requestID)
+	if err == nil {
+		err = checkApprovedTransfer(signRequest)
+	}
+	if err != nil {
+		if releaseErr := lock.Release(); releaseErr != nil {
+			log.Warn().Err(releaseErr).Str("sign_request_id", requestID).Msg("Failed to release sign request lock")
+		}
+		return nil, err
+	}
+
+	return &TransferExecution{service: s, request: signRequest, lock: lock}, nil
+}
+
+// Request 领取的转账请求
+func (e *TransferExecution) Request() *storage.SignRequest {
+	return e.request
+}
+
+// Context 锁丢失或释放后被取消的上下文，签名和广播应使用该上下文
+func (e *TransferExecution) Context() context.Context {
+	return e.lock.Context()
+}
+
+// Complete 转账已签名，请求变为 signed，之后不能再次使用
+func (e *TransferExecution) Complete(ctx context.Context) error {
+	completed := *e.request
+	completed.Status = storage.SignRequestStatusSigned
+	completed.ErrorMessage = ""
+	if err := e.service.store.UpdateSignRequest(ctx, &completed, storage.SignRequestStatusApproved); err != nil {
+		return errors.Wrap(err, "failed to complete sign request")
+	}
+	*e.request = completed
+	return nil
+}
+
+// Release 释放请求的锁；未调用 Complete 时请求仍为 approved，可以重新提交转账
+func (e *TransferExecution) Release() error {
+	return e.lock.Release()
+}
+
+// checkApprovedTransfer 校验签名请求是已通过审批的转账请求
+func checkApprovedTransfer(signRequest *storage.SignRequest) error {
+	if !signRequest.IsTransfer() {
+		return errors.Wrapf(ErrNotApprovedTransfer, "sign request %s is not a transfer", signRequest.RequestID)
+	}
+	if signRequest.Status != storage.SignRequestStatusApproved {
+		return errors.Wrapf(ErrNotApprovedTransfer, "sign request %s is %s", signRequest.RequestID, signRequest.Status)
+	}
+	return nil
+}
+
+// get 读取钱包的签名请求，不属于该钱包的请求视为不存在
+func (s *Service) get(ctx context.Context, walletID, requestID string) (*storage.SignRequest, error) {
+	signRequest, err := s.store.GetSignRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if signRequest.WalletID != walletID {
+		return nil, storage.ErrSignRequestNotFound
+	}
+	if err := s.expire(ctx, signRequest); err != nil {
+		return nil, err
+	}
+	return signRequest, nil
+}
+
+// expire 把超过有效期仍未审批完成的请求标记为 expired，审批通过的转账请求超过有效期仍未执行同样标记为 expired；
+// 审批通过后超过 signTimeout 仍未写回签名结果的请求（签名期间服务重启或写回失败）标记为 failed，避免永远停留在 approved
+func (s *Service) expire(ctx context.Context, signRequest *storage.SignRequest) error {
+	expired := *signRequest
+	switch {
+	case signRequest.Status == storage.SignRequestStatusAwaitingApproval && !s.now().Before(signRequest.ExpiresAt):
+		expired.Status = storage.SignRequestStatusExpired
+	case signRequest.Status == storage.SignRequestStatusApproved && signRequest.IsTransfer():
+		if s.now().Before(signRequest.ExpiresAt) {
+			return nil
+		}
+		expired.Status = storage.SignRequestStatusExpired
+	case signRequest.Status == storage.SignRequestStatusApproved && !s.now().Before(signRequest.UpdatedAt.Add(signTimeout)):
+		expired.Status = storage.SignRequestStatusFailed
+		expired.ErrorMessage = "signing did not complete"
+	default:
+		return nil
+	}
+
+	err := s.store.UpdateSignRequest(ctx, &expired, signRequest.Status)
+	if err == nil {
+		*signRequest = expired
+		return nil
+	}
+	if !errors.Is(err, storage.ErrSignRequestStatusConflict) {
+		return errors.Wrap(err, "failed to expire sign request")
+	}
+
+	current, err := s.store.GetSignRequest(ctx, signRequest.RequestID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get sign request")
+	}
+	*signRequest = *current
+	return nil
+}
+
+// verifyAssertion 校验审批凭证属于调用者、是 approver 以上的钱包成员，并且 assertion 是该凭证对请求哈希的签名
+func (s *Service) verifyAssertion(ctx context.Context, signRequest *storage.SignRequest, userID string, assertion *Assertion) error {
+	if assertion == nil || assertion.CredentialID == "" {
+		return errors.Wrap(ErrInvalidAssertion, "credential id is required")
+	}
+	if userID == "" {
+		return errors.Wrap(ErrNotWalletMember, "user is required")
+	}
+
+	passkeys, err := s.store.ListUserPasskeys(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to list user passkeys")
+	}
+	owned := false
+	for _, passkey := range passkeys {
+		owned = owned || passkey.CredentialID == assertion.CredentialID
+	}
+	if !owned {
+		return errors.Wrap(ErrNotWalletMember, "credential does not belong to the caller")
+	}
+
+	isMember, role, err := s.store.IsWalletMember(ctx, signRequest.WalletID, assertion.CredentialID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check wallet member")
+	}
+	if !isMember {
+		return ErrNotWalletMember
+	}
+	if !member.RoleAtLeast(role, storage.WalletRoleApprover) {
+		return errors.Wrapf(ErrNotWalletMember, "role %s cannot review sign requests", role)
+	}
+
+	credentialID, err := base64.RawURLEncoding.DecodeString(assertion.CredentialID)
+	if err != nil {
+		return errors.Wrap(ErrInvalidAssertion, "invalid credential id")
+	}
+	if err := s.verifier.VerifyAssertion(ctx, string(credentialID), RequestHash(signRequest),
+		assertion.AuthenticatorData, assertion.ClientDataJSON, assertion.Signature); err != nil {
+		return errors.Wrap(ErrInvalidAssertion, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,506 @@
+package approval
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SafeMPC/mpc-service/internal/auth"
+	"github.com/SafeMPC/mpc-service/internal/infra/policy"
+	"github.com/SafeMPC/mpc-service/internal/infra/signing"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+)
+
+const walletID = "wallet-1"
+
+type memoryStore struct {
+	mu        sync.Mutex
+	requests  map[string]*storage.SignRequest
+	approvals map[string][]*storage.SignRequestApproval
+	members   map[string]string // credentialID -> role
+	passkeys  map[string]*storage.Passkey
+	owners    map[string]string // credentialID -> userID
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		requests:  map[string]*storage.SignRequest{},
+		approvals: map[string][]*storage.SignRequestApproval{},
+		members:   map[string]string{},
+		passkeys:  map[string]*storage.Passkey{},
+		owners:    map[string]string{},
+	}
+}
+
+func (m *memoryStore) SaveSignRequest(ctx context.Context, req *storage.SignRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *req
+	m.requests[req.RequestID] = &saved
+	return nil
+}
+
+func (m *memoryStore) GetSignRequest(ctx context.Context, requestID string) (*storage.SignRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	req, ok := m.requests[requestID]
+	if !ok {
+		return nil, storage.ErrSignRequestNotFound
+	}
+	found := *req
+	return &found, nil
+}
+
+func (m *memoryStore) ListSignRequests(ctx context.Context, filter *storage.SignRequestFilter) ([]*storage.SignRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var requests []*storage.SignRequest
+	for _, req := range m.requests {
+		if req.WalletID == filter.WalletID && (filter.Status == "" || req.Status == filter.Status) {
+			found := *req
+			requests = append(requests, &found)
+		}
+	}
+	return requests, nil
+}
+
+func (m *memoryStore) UpdateSignRequest(ctx context.Context, req *storage.SignRequest, expectedStatus string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, ok := m.requests[req.RequestID]
+	if !ok || current.Status != expectedStatus {
+		return storage.ErrSignRequestStatusConflict
+	}
+	current.Status = req.Status
+	current.SessionID = req.SessionID
+	current.Signature = req.Signature
+	current.ErrorMessage = req.ErrorMessage
+	return nil
+}
+
+func (m *memoryStore) SaveSignRequestApproval(ctx context.Context, approval *storage.SignRequestApproval) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.approvals[approval.RequestID] {
+		if existing.CredentialID == approval.CredentialID || (approval.UserID != "" && existing.UserID == approval.UserID) {
+			return storage.ErrDuplicateSignRequestApproval
+		}
+	}
+	saved := *approval
+	m.approvals[approval.RequestID] = append(m.approvals[approval.RequestID], &saved)
+	return nil
+}
+
+func (m *memoryStore) ListSignRequestApprovals(ctx context.Context, requestID string) ([]*storage.SignRequestApproval, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*storage.SignRequestApproval(nil), m.approvals[requestID]...), nil
+}
+
+func (m *memoryStore) IsWalletMember(ctx context.Context, walletID, credentialID string) (bool, string, error) {
+	role, ok := m.members[credentialID]
+	return ok, role, nil
+}
+
+func (m *memoryStore) ListUserPasskeys(ctx context.Context, userID string) ([]*storage.Passkey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var passkeys []*storage.Passkey
+	for credentialID, owner := range m.owners {
+		if owner == userID {
+			passkeys = append(passkeys, m.passkeys[credentialID])
+		}
+	}
+	return passkeys, nil
+}
+
+// passkeyVerifier 按 webauthn.Service 的约定（原始凭证 ID）查找公钥并校验 assertion 签名
+type passkeyVerifier struct {
+	store *memoryStore
+}
+
+func (v passkeyVerifier) VerifyAssertion(ctx context.Context, credentialID string, challenge []byte, authData []byte, clientDataJSON []byte, signature []byte) error {
+	passkey, ok := v.store.passkeys[base64.RawURLEncoding.EncodeToString([]byte(credentialID))]
+	if !ok {
+		return storage.ErrSignRequestNotFound
+	}
+	return auth.VerifyPasskeySignature(passkey.PublicKey, signature, authData, clientDataJSON, base64.RawURLEncoding.EncodeToString(challenge))
+}
+
+type fakeSigner struct {
+	decision *storage.PolicyDecision
+	signed   []*signing.SignRequest
+	err      error
+}
+
+func (f *fakeSigner) EvaluatePolicy(ctx context.Context, req *signing.SignRequest) (*storage.PolicyDecision, error) {
+	if f.decision == nil {
+		return nil, nil
+	}
+	decision := *f.decision
+	return &decision, nil
+}
+
+func (f *fakeSigner) ThresholdSign(ctx context.Context, req *signing.SignRequest) (*signing.SignResponse, error) {
+	f.signed = append(f.signed, req)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &signing.SignResponse{SessionID: "session-1", Signature: "deadbeef"}, nil
+}
+
+// lockStore 内存版分布式锁，只实现 storage.Lock 用到的方法
+type lockStore struct {
+	storage.SessionStore
+
+	mu     sync.Mutex
+	seq    int
+	owners map[string]string
+}
+
+func (l *lockStore) AcquireLock(_ context.Context, key string, _ time.Duration) (string, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, held := l.owners[key]; held {
+		return "", false, nil
+	}
+	l.seq++
+	token := strconv.Itoa(l.seq)
+	l.owners[key] = token
+	return token, true, nil
+}
+
+func (l *lockStore) ExtendLock(_ context.Context, key, token string, _ time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.owners[key] == token, nil
+}
+
+func (l *lockStore) ReleaseLock(_ context.Context, key, token string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.owners[key] == token {
+		delete(l.owners, key)
+	}
+	return nil
+}
+
+// testPasskey 模拟 ES256 Passkey 认证器
+type testPasskey struct {
+	userID       string
+	credentialID string
+	key          *ecdsa.PrivateKey
+}
+
+// newTestPasskey 为用户 user-<name> 注册一个 approver 凭证
+func newTestPasskey(t *testing.T, store *memoryStore, name string) *testPasskey {
+	return newUserPasskey(t, store, "user-"+name, name)
+}
+
+func newUserPasskey(t *testing.T, store *memoryStore, userID, name string) *testPasskey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	cose, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{KeyType: int64(webauthncose.EllipticKey), Algorithm: int64(webauthncose.AlgES256)},
+		Curve:         int64(webauthncose.P256),
+		XCoord:        key.X.FillBytes(make([]byte, 32)),
+		YCoord:        key.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	passkey := &testPasskey{userID: userID, credentialID: base64.RawURLEncoding.EncodeToString([]byte(name)), key: key}
+	store.members[passkey.credentialID] = "approver"
+	store.owners[passkey.credentialID] = userID
+	store.passkeys[passkey.credentialID] = &storage.Passkey{CredentialID: passkey.credentialID, PublicKey: hex.EncodeToString(cose)}
+	return passkey
+}
+
+func (p *testPasskey) assert(t *testing.T, requestHashHex string) *Assertion {
+	requestHash, err := hex.DecodeString(requestHashHex)
+	require.NoError(t, err)
+
+	clientDataJSON, err := json.Marshal(map[string]string{
+		"type":      "webauthn.get",
+		"challenge": base64.RawURLEncoding.EncodeToString(requestHash),
+		"origin":    "https://wallet.example.com",
+	})
+	require.NoError(t, err)
+	rpIDHash := sha256.Sum256([]byte("wallet.example.com"))
+	authData := append(rpIDHash[:], 0x05, 0, 0, 0, 1) // UP | UV，signCount = 1
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, p.key, digest[:])
+	require.NoError(t, err)
+
+	return &Assertion{CredentialID: p.credentialID, AuthenticatorData: authData, ClientDataJSON: clientDataJSON, Signature: signature}
+}
+
+func newTestService(store *memoryStore, signer *fakeSigner) *Service {
+	service := NewService(store, signer, passkeyVerifier{store: store}, time.Hour)
+	service.dispatch = func(fn func()) { fn() }
+	return service
+}
+
+func requireApproval(required int) *storage.PolicyDecision {
+	return &storage.PolicyDecision{Action: storage.PolicyActionRequireApproval, RuleIndex: -1, ChainType: "ethereum", RequiredApprovals: required}
+}
+
+func submitTestRequest(t *testing.T, service *Service) *storage.SignRequest {
+	signRequest, err := service.Submit(context.Background(), &signing.SignRequest{
+		KeyID: walletID, MessageHex: "0xABCDEF", Destination: "0x1111111111111111111111111111111111111111",
+		Amount: big.NewInt(5000), MobileNodeID: "mobile-1",
+	})
+	require.NoError(t, err)
+	return signRequest
+}
+
+func TestSubmitRequiresApprovalDecision(t *testing.T) {
+	store := newMemoryStore()
+	signer := &fakeSigner{}
+	service := newTestService(store, signer)
+	req := &signing.SignRequest{KeyID: walletID, MessageHex: "abcd"}
+
+	_, err := service.Submit(context.Background(), req)
+	assert.ErrorIs(t, err, ErrApprovalNotRequired)
+
+	signer.decision = &storage.PolicyDecision{Action: storage.PolicyActionDeny, Reason: "blocked"}
+	_, err = service.Submit(context.Background(), req)
+	assert.ErrorIs(t, err, policy.ErrDenied)
+
+	signer.decision = requireApproval(2)
+	signRequest, err := service.Submit(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusAwaitingApproval, signRequest.Status)
+	assert.Equal(t, 2, signRequest.RequiredApprovals)
+	assert.Equal(t, hex.EncodeToString(RequestHash(signRequest)), signRequest.RequestHash)
+	assert.Empty(t, store.approvals)
+}
+
+func TestApproveCollectsDistinctMemberApprovals(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	signer := &fakeSigner{decision: requireApproval(2)}
+	service := newTestService(store, signer)
+	alice := newTestPasskey(t, store, "alice")
+	bob := newTestPasskey(t, store, "bob")
+
+	signRequest := submitTestRequest(t, service)
+	assert.Equal(t, "abcdef", signRequest.MessageHex)
+	assert.Equal(t, "5000", signRequest.Amount)
+
+	updated, approvals, err := service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, alice.assert(t, signRequest.RequestHash))
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusAwaitingApproval, updated.Status)
+	assert.Len(t, approvals, 1)
+	assert.Empty(t, signer.signed)
+
+	// 同一成员不能重复审批，用同一用户的另一个凭证也不能
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, alice.assert(t, signRequest.RequestHash))
+	assert.ErrorIs(t, err, storage.ErrDuplicateSignRequestApproval)
+
+	aliceLaptop := newUserPasskey(t, store, alice.userID, "alice-laptop")
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, aliceLaptop.assert(t, signRequest.RequestHash))
+	assert.ErrorIs(t, err, storage.ErrDuplicateSignRequestApproval)
+
+	// 不能使用其他用户的凭证审批
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, bob.assert(t, signRequest.RequestHash))
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+
+	// 非成员、签名不是针对该请求或凭证与签名不匹配时拒绝
+	mallory := newTestPasskey(t, store, "mallory")
+	delete(store.members, mallory.credentialID)
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, mallory.userID, mallory.assert(t, signRequest.RequestHash))
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, bob.userID, bob.assert(t, hex.EncodeToString(make([]byte, 32))))
+	assert.ErrorIs(t, err, ErrInvalidAssertion)
+
+	forged := alice.assert(t, signRequest.RequestHash)
+	forged.CredentialID = bob.credentialID
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, bob.userID, forged)
+	assert.ErrorIs(t, err, ErrInvalidAssertion)
+
+	_, _, err = service.Approve(ctx, "wallet-2", signRequest.RequestID, bob.userID, bob.assert(t, signRequest.RequestHash))
+	assert.ErrorIs(t, err, storage.ErrSignRequestNotFound)
+
+	updated, approvals, err = service.Approve(ctx, walletID, signRequest.RequestID, bob.userID, bob.assert(t, signRequest.RequestHash))
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusApproved, updated.Status)
+	assert.Len(t, approvals, 2)
+
+	require.Len(t, signer.signed, 1)
+	assert.Equal(t, signRequest.RequestID, signer.signed[0].SignRequestID)
+	assert.Equal(t, "abcdef", signer.signed[0].MessageHex)
+	assert.Equal(t, "mobile-1", signer.signed[0].MobileNodeID)
+	assert.Equal(t, "5000", signer.signed[0].Amount.String())
+
+	stored, _, err := service.Get(ctx, walletID, signRequest.RequestID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusSigned, stored.Status)
+	assert.Equal(t, "session-1", stored.SessionID)
+	assert.Equal(t, "deadbeef", stored.Signature)
+
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, mallory.userID, mallory.assert(t, signRequest.RequestHash))
+	assert.ErrorIs(t, err, ErrNotAwaitingApproval)
+}
+
+func TestRejectVetoesSignRequest(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	signer := &fakeSigner{decision: requireApproval(1)}
+	service := newTestService(store, signer)
+	alice := newTestPasskey(t, store, "alice")
+	bob := newTestPasskey(t, store, "bob")
+
+	signRequest := submitTestRequest(t, service)
+	updated, _, err := service.Reject(ctx, walletID, signRequest.RequestID, alice.userID, alice.assert(t, signRequest.RequestHash))
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusRejected, updated.Status)
+
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, bob.userID, bob.assert(t, signRequest.RequestHash))
+	assert.ErrorIs(t, err, ErrNotAwaitingApproval)
+	assert.Empty(t, signer.signed)
+}
+
+func TestSigningFailureMarksRequestFailed(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	signer := &fakeSigner{decision: requireApproval(1), err: assert.AnError}
+	service := newTestService(store, signer)
+	alice := newTestPasskey(t, store, "alice")
+
+	signRequest := submitTestRequest(t, service)
+	_, _, err := service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, alice.assert(t, signRequest.RequestHash))
+	require.NoError(t, err)
+
+	stored, _, err := service.Get(ctx, walletID, signRequest.RequestID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusFailed, stored.Status)
+	assert.Equal(t, assert.AnError.Error(), stored.ErrorMessage)
+}
+
+func TestStuckSigningMarksRequestFailed(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	service := newTestService(store, &fakeSigner{decision: requireApproval(1)})
+	// 模拟签名期间服务重启：签名没有执行，请求停留在 approved
+	service.dispatch = func(fn func()) {}
+	alice := newTestPasskey(t, store, "alice")
+
+	signRequest := submitTestRequest(t, service)
+	approved, _, err := service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, alice.assert(t, signRequest.RequestHash))
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusApproved, approved.Status)
+
+	stored, _, err := service.Get(ctx, walletID, signRequest.RequestID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusApproved, stored.Status)
+
+	service.now = func() time.Time { return signRequest.UpdatedAt.Add(signTimeout) }
+	stored, _, err = service.Get(ctx, walletID, signRequest.RequestID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusFailed, stored.Status)
+	assert.NotEmpty(t, stored.ErrorMessage)
+}
+
+func TestExpiredSignRequest(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	service := newTestService(store, &fakeSigner{decision: requireApproval(1)})
+	alice := newTestPasskey(t, store, "alice")
+
+	signRequest := submitTestRequest(t, service)
+	service.now = func() time.Time { return signRequest.ExpiresAt }
+
+	_, _, err := service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, alice.assert(t, signRequest.RequestHash))
+	assert.ErrorIs(t, err, ErrNotAwaitingApproval)
+
+	pending, err := service.List(ctx, &storage.SignRequestFilter{WalletID: walletID, Status: storage.SignRequestStatusAwaitingApproval})
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	all, err := service.List(ctx, &storage.SignRequestFilter{WalletID: walletID})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, storage.SignRequestStatusExpired, all[0].Status)
+}
+
+func TestApprovedTransferIsClaimedOnce(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	signer := &fakeSigner{decision: requireApproval(1)}
+	service := newTestService(store, signer)
+	service.SetSessionStore(&lockStore{owners: map[string]string{}})
+	alice := newTestPasskey(t, store, "alice")
+
+	// 转账请求没有待签名消息，审批的是目标地址、金额和资产
+	signRequest, err := service.Submit(ctx, &signing.SignRequest{
+		KeyID: walletID, ChainType: "ethereum", Destination: "0x1111111111111111111111111111111111111111", Amount: big.NewInt(5000),
+	})
+	require.NoError(t, err)
+	require.True(t, signRequest.IsTransfer())
+
+	_, err = service.ClaimTransfer(ctx, walletID, signRequest.RequestID)
+	assert.ErrorIs(t, err, ErrNotApprovedTransfer)
+
+	approved, _, err := service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, alice.assert(t, signRequest.RequestHash))
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusApproved, approved.Status)
+	assert.Empty(t, signer.signed)
+
+	// 审批通过的转账请求在有效期内保持 approved，不按签名超时标记为 failed
+	service.now = func() time.Time { return signRequest.UpdatedAt.Add(signTimeout) }
+	execution, err := service.ClaimTransfer(ctx, walletID, signRequest.RequestID)
+	require.NoError(t, err)
+
+	_, err = service.ClaimTransfer(ctx, walletID, signRequest.RequestID)
+	assert.ErrorIs(t, err, ErrTransferInProgress)
+	_, err = service.ClaimTransfer(ctx, "wallet-2", signRequest.RequestID)
+	assert.ErrorIs(t, err, storage.ErrSignRequestNotFound)
+
+	require.NoError(t, execution.Complete(ctx))
+	require.NoError(t, execution.Release())
+
+	_, err = service.ClaimTransfer(ctx, walletID, signRequest.RequestID)
+	assert.ErrorIs(t, err, ErrNotApprovedTransfer)
+	stored, _, err := service.Get(ctx, walletID, signRequest.RequestID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusSigned, stored.Status)
+}
+
+func TestUnusedApprovedTransferExpires(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	service := newTestService(store, &fakeSigner{decision: requireApproval(1)})
+	service.SetSessionStore(&lockStore{owners: map[string]string{}})
+	alice := newTestPasskey(t, store, "alice")
+
+	signRequest, err := service.Submit(ctx, &signing.SignRequest{KeyID: walletID, Destination: "0x1111111111111111111111111111111111111111", Amount: big.NewInt(5000)})
+	require.NoError(t, err)
+	_, _, err = service.Approve(ctx, walletID, signRequest.RequestID, alice.userID, alice.assert(t, signRequest.RequestHash))
+	require.NoError(t, err)
+
+	service.now = func() time.Time { return signRequest.ExpiresAt }
+	_, err = service.ClaimTransfer(ctx, walletID, signRequest.RequestID)
+	assert.ErrorIs(t, err, ErrNotApprovedTransfer)
+	stored, _, err := service.Get(ctx, walletID, signRequest.RequestID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.SignRequestStatusExpired, stored.Status)
+}
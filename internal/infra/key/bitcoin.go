@@ -0,0 +1,55 @@
+package key
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+)
+
+// BitcoinAddressTypeTag 钱包 Tags 中记录 Bitcoin 地址类型的键（p2pkh, p2wpkh, p2sh-p2wpkh, p2tr）
+const BitcoinAddressTypeTag = "address_type"
+
+// SetBitcoinAddressType 设置新建 Bitcoin 钱包未指定地址类型时的默认值（MPC_BITCOIN_ADDRESS_TYPE）
+func (s *Service) SetBitcoinAddressType(defaultAddressType string) error {
+	addressType, err := chain.ParseBitcoinAddressType(defaultAddressType)
+	if err != nil {
+		return err
+	}
+
+	s.bitcoinAddressType = addressType
+	return nil
+}
+
+// BitcoinAddressType 钱包的 Bitcoin 地址类型：Tags 中记录的类型
+// 没有记录的钱包创建于支持地址类型之前，地址为 P2PKH，不受默认值影响，避免已有钱包的地址发生变化
+func (s *Service) BitcoinAddressType(tags map[string]string) (chain.BitcoinAddressType, error) {
+	if addressType, ok := tags[BitcoinAddressTypeTag]; ok && addressType != "" {
+		return chain.ParseBitcoinAddressType(addressType)
+	}
+	return chain.BitcoinAddressP2PKH, nil
+}
+
+// newWalletTags 新建钱包的 Tags：Bitcoin 钱包未指定地址类型时记录默认值，不修改调用方的 map
+func (s *Service) newWalletTags(chainType string, tags map[string]string) map[string]string {
+	if _, ok := tags[BitcoinAddressTypeTag]; ok || s.chains == nil {
+		return tags
+	}
+	c, err := s.chains.Lookup(chainType)
+	if err != nil || c.Family != registry.FamilyBitcoin {
+		return tags
+	}
+
+	withType := make(map[string]string, len(tags)+1)
+	for k, v := range tags {
+		withType[k] = v
+	}
+	return setBitcoinAddressType(withType, s.bitcoinAddressType)
+}
+
+// setBitcoinAddressType 在 Tags 中记录钱包的地址类型，保证之后重新生成的地址一致
+func setBitcoinAddressType(tags map[string]string, addressType chain.BitcoinAddressType) map[string]string {
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+	tags[BitcoinAddressTypeTag] = string(addressType)
+	return tags
+}
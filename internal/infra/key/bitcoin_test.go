@@ -0,0 +1,43 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitcoinAddressTypeDefaults(t *testing.T) {
+	chains, err := registry.New([]registry.Chain{
+		{Name: "bitcoin", Family: registry.FamilyBitcoin, Symbol: "BTC", Decimals: 8},
+		{Name: "ethereum", Family: registry.FamilyEVM, ChainID: 1, Symbol: "ETH", Decimals: 18},
+	})
+	require.NoError(t, err)
+
+	s := NewService(newFakeMetadataStore(), nil, nil)
+	s.SetChainRegistry(chains)
+	require.NoError(t, s.SetBitcoinAddressType("p2wpkh"))
+	assert.Error(t, s.SetBitcoinAddressType("p2wsh"))
+
+	// 没有记录地址类型的已有钱包保持 P2PKH，不受默认值影响
+	addressType, err := s.BitcoinAddressType(nil)
+	require.NoError(t, err)
+	assert.Equal(t, chain.BitcoinAddressP2PKH, addressType)
+
+	// 新建的 Bitcoin 钱包记录默认值，调用方指定的类型和其他链不变
+	tags := map[string]string{"team": "ops"}
+	created := s.newWalletTags("bitcoin", tags)
+	assert.Equal(t, "p2wpkh", created[BitcoinAddressTypeTag])
+	assert.Equal(t, "ops", created["team"])
+	assert.NotContains(t, tags, BitcoinAddressTypeTag)
+
+	explicit := map[string]string{BitcoinAddressTypeTag: "p2pkh"}
+	assert.Equal(t, explicit, s.newWalletTags("bitcoin", explicit))
+	assert.Nil(t, s.newWalletTags("ethereum", nil))
+
+	addressType, err = s.BitcoinAddressType(created)
+	require.NoError(t, err)
+	assert.Equal(t, chain.BitcoinAddressP2WPKH, addressType)
+}
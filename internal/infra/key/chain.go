@@ -0,0 +1,72 @@
+package key
+
+import (
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/pkg/errors"
+)
+
+// SetChainRegistry 设置链注册表，钱包的 chain_type 通过注册表解析为链配置和适配器
+func (s *Service) SetChainRegistry(chains *registry.Registry) {
+	s.chains = chains
+}
+
+// addressAdapter 解析 chainType 对应的地址生成适配器
+// Bitcoin 链按 Tags 中记录的地址类型（见 BitcoinAddressType）生成地址，并返回实际使用的地址类型，其他链返回空
+func (s *Service) addressAdapter(chainType string, tags map[string]string) (chain.Adapter, chain.BitcoinAddressType, error) {
+	c, err := s.chains.Lookup(chainType)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch c.Family {
+	case registry.FamilyBitcoin:
+		addressType, err := s.BitcoinAddressType(tags)
+		if err != nil {
+			return nil, "", err
+		}
+		adapter, err := c.BitcoinAdapter(addressType)
+		if err != nil {
+			return nil, "", err
+		}
+		return adapter, addressType, nil
+	case registry.FamilyEVM:
+		adapter, err := c.EthereumAdapter()
+		if err != nil {
+			return nil, "", err
+		}
+		return adapter, "", nil
+	case registry.FamilySolana:
+		adapter, err := c.SolanaAdapter()
+		if err != nil {
+			return nil, "", err
+		}
+		return adapter, "", nil
+	case registry.FamilyTron:
+		adapter, err := c.TronAdapter()
+		if err != nil {
+			return nil, "", err
+		}
+		return adapter, "", nil
+	case registry.FamilyCosmos:
+		adapter, err := c.CosmosAdapter()
+		if err != nil {
+			return nil, "", err
+		}
+		return adapter, "", nil
+	case registry.FamilyAptos:
+		adapter, err := c.AptosAdapter()
+		if err != nil {
+			return nil, "", err
+		}
+		return adapter, "", nil
+	case registry.FamilySui:
+		adapter, err := c.SuiAdapter()
+		if err != nil {
+			return nil, "", err
+		}
+		return adapter, "", nil
+	default:
+		return nil, "", errors.Errorf("unsupported chain family: %s", c.Family)
+	}
+}
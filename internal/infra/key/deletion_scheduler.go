@@ -0,0 +1,215 @@
+package key
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// deletionLockTTL 单个密钥销毁的分布式锁有效期，避免多个 Service 实例同时销毁同一密钥
+	deletionLockTTL = 10 * time.Minute
+)
+
+var (
+	deletionMetricsOnce sync.Once
+	deletionDestroyed   *prometheus.CounterVec
+	deletionPendingKeys prometheus.Gauge
+)
+
+// DeletionScheduler 密钥删除调度器
+// 扫描 PendingDeletion 密钥，等待期结束后通知所有节点销毁分片并标记为 Deleted；
+// 有节点未确认时密钥保持 PendingDeletion，下次扫描只重试未确认的节点
+type DeletionScheduler struct {
+	metadataStore storage.MetadataStore
+	sessionStore  storage.SessionStore
+	keyService    *Service
+
+	checkInterval time.Duration
+
+	started  atomic.Bool
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// NewDeletionScheduler 创建密钥删除调度器
+func NewDeletionScheduler(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	keyService *Service,
+	checkInterval time.Duration,
+) *DeletionScheduler {
+	ensureDeletionMetrics()
+
+	if checkInterval <= 0 {
+		checkInterval = time.Hour
+	}
+
+	return &DeletionScheduler{
+		metadataStore: metadataStore,
+		sessionStore:  sessionStore,
+		keyService:    keyService,
+		checkInterval: checkInterval,
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+}
+
+// Start 启动后台调度（立即执行一次扫描，之后按 checkInterval 周期执行）
+func (s *DeletionScheduler) Start(ctx context.Context) {
+	if !s.started.CompareAndSwap(false, true) {
+		return
+	}
+
+	log.Info().
+		Dur("check_interval", s.checkInterval).
+		Msg("Starting key deletion scheduler")
+
+	go func() {
+		defer close(s.doneCh)
+
+		ticker := time.NewTicker(s.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			s.RunOnce(ctx)
+
+			select {
+			case <-ticker.C:
+			case <-s.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止后台调度，等待正在进行的扫描退出
+func (s *DeletionScheduler) Stop(ctx context.Context) {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	if !s.started.Load() {
+		return
+	}
+
+	select {
+	case <-s.doneCh:
+	case <-ctx.Done():
+		log.Warn().Msg("Timed out waiting for key deletion scheduler to stop")
+	}
+}
+
+// RunOnce 扫描一次等待期已结束的密钥并逐个销毁
+func (s *DeletionScheduler) RunOnce(ctx context.Context) {
+	dueKeys, pending, err := s.findDueKeys(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find keys due for deletion")
+		return
+	}
+
+	deletionPendingKeys.Set(float64(pending))
+
+	if len(dueKeys) == 0 {
+		log.Debug().Int("pending_keys", pending).Msg("No keys due for deletion")
+		return
+	}
+
+	log.Info().Int("due_keys", len(dueKeys)).Msg("Found keys due for deletion")
+
+	for _, keyMeta := range dueKeys {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+		s.destroyKey(ctx, keyMeta)
+	}
+}
+
+// findDueKeys 列出删除等待期已结束的 PendingDeletion 密钥，同时返回 PendingDeletion 密钥总数
+func (s *DeletionScheduler) findDueKeys(ctx context.Context) ([]*storage.KeyMetadata, int, error) {
+	now := time.Now()
+	var dueKeys []*storage.KeyMetadata
+	pending := 0
+
+	for offset := 0; ; offset += refreshListPageSize {
+		keys, err := s.metadataStore.ListKeys(ctx, &storage.KeyFilter{
+			Status: storage.KeyStatusPendingDeletion,
+			Limit:  refreshListPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "failed to list pending deletion keys")
+		}
+
+		pending += len(keys)
+		for _, keyMeta := range keys {
+			if keyMeta.DeletionDate == nil || !now.Before(*keyMeta.DeletionDate) {
+				dueKeys = append(dueKeys, keyMeta)
+			}
+		}
+
+		if len(keys) < refreshListPageSize {
+			break
+		}
+	}
+
+	return dueKeys, pending, nil
+}
+
+// destroyKey 在分布式锁保护下销毁单个密钥
+func (s *DeletionScheduler) destroyKey(ctx context.Context, keyMeta *storage.KeyMetadata) {
+	lockKey := "key_deletion:" + keyMeta.KeyID
+	lock, err := storage.TryLock(ctx, s.sessionStore, lockKey, deletionLockTTL)
+	if err != nil {
+		log.Error().Err(err).Str("key_id", keyMeta.KeyID).Msg("Failed to acquire key deletion lock")
+		return
+	}
+	if lock == nil {
+		log.Info().Str("key_id", keyMeta.KeyID).Msg("Key deletion already in progress on another instance, skipping")
+		return
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			log.Warn().Err(err).Str("key_id", keyMeta.KeyID).Msg("Failed to release key deletion lock")
+		}
+	}()
+	ctx = lock.Context()
+
+	if err := s.keyService.destroyKey(ctx, keyMeta); err != nil {
+		deletionDestroyed.WithLabelValues(RefreshStatusFailed).Inc()
+		log.Warn().Err(err).Str("key_id", keyMeta.KeyID).Msg("Key deletion not completed, will retry on next scan")
+		return
+	}
+
+	deletionDestroyed.WithLabelValues(RefreshStatusSuccess).Inc()
+}
+
+func ensureDeletionMetrics() {
+	deletionMetricsOnce.Do(func() {
+		deletionDestroyed = promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpc",
+			Subsystem: "key_deletion",
+			Name:      "attempts_total",
+			Help:      "Total number of attempts to destroy keys whose deletion window has elapsed, by result",
+		}, []string{"result"})
+		deletionPendingKeys = promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: "mpc",
+			Subsystem: "key_deletion",
+			Name:      "pending_keys",
+			Help:      "Number of keys in PendingDeletion state",
+		})
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/SafeMPC/mpc-service/internal/infra/session"
 	"github.com/SafeMPC/mpc-service/internal/infra/storage"
 	"github.com/SafeMPC/mpc-service/internal/mpc/node"
 	pb "github.com/SafeMPC/mpc-service/pb/mpc/v1"
@@ -43,7 +44,8 @@ type DKGService struct {
 	metadataStore   storage.MetadataStore
 	keyShareStorage storage.KeyShareStorage
 	nodeManager     *node.Manager
-	nodeDiscovery   *node.Discovery
+	nodeDiscovery   dkgNodeDiscovery
+	sessionManager  *session.Manager
 	grpcClient      dkgGRPCClient
 	// 同步模式配置：最大等待时间、轮询间隔
 	MaxWaitTime  time.Duration
@@ -53,6 +55,13 @@ type DKGService struct {
 // dkgGRPCClient 最小化的 gRPC 客户端接口
 type dkgGRPCClient interface {
 	SendStartDKG(ctx context.Context, nodeID string, req *pb.StartDKGRequest) (*pb.StartDKGResponse, error)
+	SendStartResharing(ctx context.Context, nodeID string, req *pb.StartReshareRequest) (*pb.StartReshareResponse, error)
+	SendDeleteShare(ctx context.Context, nodeID string, req *pb.DeleteShareRequest) (*pb.DeleteShareResponse, error)
+}
+
+// dkgNodeDiscovery 最小化的节点发现接口，由 node.Discovery 实现
+type dkgNodeDiscovery interface {
+	DiscoverNodes(ctx context.Context, nodeType node.NodeType, status node.NodeStatus, limit int) ([]*node.Node, error)
 }
 
 // NewDKGService 创建DKG服务
@@ -61,7 +70,8 @@ func NewDKGService(
 	metadataStore storage.MetadataStore,
 	keyShareStorage storage.KeyShareStorage,
 	nodeManager *node.Manager,
-	nodeDiscovery *node.Discovery,
+	nodeDiscovery dkgNodeDiscovery,
+	sessionManager *session.Manager,
 	grpcClient dkgGRPCClient,
 ) *DKGService {
 	return &DKGService{
@@ -69,6 +79,7 @@ func NewDKGService(
 		keyShareStorage: keyShareStorage,
 		nodeManager:     nodeManager,
 		nodeDiscovery:   nodeDiscovery,
+		sessionManager:  sessionManager,
 		grpcClient:      grpcClient,
 		// 缩短同步等待时间，加快失败检测
 		MaxWaitTime:  2 * time.Minute,
@@ -170,7 +181,9 @@ func (s *DKGService) ExecuteDKG(ctx context.Context, keyID string, req *CreateKe
 			NodeIds:    nodeIDs,
 		}
 		startCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
-		_, _ = s.grpcClient.SendStartDKG(startCtx, leaderNodeID, startReq)
+		if _, err := s.grpcClient.SendStartDKG(startCtx, leaderNodeID, startReq); err == nil && s.sessionManager != nil {
+			s.sessionManager.RecordDispatch(ctx, keyID, leaderNodeID)
+		}
 		cancel()
 
 		deadline := time.Now().Add(s.MaxWaitTime)
@@ -230,9 +243,9 @@ func (s *DKGService) ValidateKeyShares(ctx context.Context, keyID string, public
 	return nil
 }
 
-// ExecuteResharing 执行密钥轮换（Resharing）
-// 注意：在 V2 架构中，Service 节点不支持密钥轮换功能
-// 密钥轮换应该在 Signer 节点完成
+// ExecuteResharing 执行密钥重分享（Resharing）
+// 注意：Service 节点不执行协议计算，只负责创建重分享会话、通知新旧节点集合并等待结果
+// 重分享完成后公钥保持不变，旧分片失效，DKG 会话中的参与节点更新为新节点集合
 func (s *DKGService) ExecuteResharing(
 	ctx context.Context,
 	keyID string,
@@ -241,16 +254,215 @@ func (s *DKGService) ExecuteResharing(
 	oldThreshold int,
 	newThreshold int,
 ) (interface{}, error) {
-	return nil, errors.New("key rotation (Resharing) is not supported in Service node. This feature should be implemented in Signer nodes")
+	if s.grpcClient == nil || s.sessionManager == nil {
+		return nil, errors.New("Service node cannot execute resharing protocol locally. Resharing must be executed on Signer nodes via gRPC")
+	}
+	if oldThreshold <= 0 || len(oldNodeIDs) < oldThreshold {
+		return nil, errors.Errorf("insufficient old nodes: need at least %d, have %d", oldThreshold, len(oldNodeIDs))
+	}
+	if newThreshold <= 0 || len(newNodeIDs) < newThreshold {
+		return nil, errors.Errorf("insufficient new nodes: need at least %d, have %d", newThreshold, len(newNodeIDs))
+	}
+
+	keyMeta, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key metadata")
+	}
+	if keyMeta.PublicKey == "" {
+		return nil, errors.Errorf("key %s has no public key, DKG not completed", keyID)
+	}
+
+	// 新节点必须是服务发现中的活跃 Signer 或密钥当前的手机节点，不按节点 ID 前缀放行
+	mobileNodeID, err := s.validateNewNodes(ctx, keyID, newNodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("key_id", keyID).
+		Strs("old_node_ids", oldNodeIDs).
+		Int("old_threshold", oldThreshold).
+		Strs("new_node_ids", newNodeIDs).
+		Int("new_threshold", newThreshold).
+		Msg("ExecuteResharing: Starting synchronous resharing execution")
+
+	reshareSession, err := s.sessionManager.CreateReshareSession(ctx, keyID, oldNodeIDs, newNodeIDs, newThreshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create reshare session")
+	}
+	sessionID := reshareSession.SessionID
+
+	startReq := &pb.StartReshareRequest{
+		SessionId:    sessionID,
+		KeyId:        keyID,
+		Algorithm:    keyMeta.Algorithm,
+		Curve:        keyMeta.Curve,
+		PublicKey:    keyMeta.PublicKey,
+		OldThreshold: int32(oldThreshold),
+		OldNodeIds:   oldNodeIDs,
+		NewThreshold: int32(newThreshold),
+		NewNodeIds:   newNodeIDs,
+	}
+
+	// 旧节点集合负责交出分片，新节点集合负责接收分片，两侧都需要启动协议
+	startCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	for _, nodeID := range reshareSession.ParticipatingNodes {
+		// 手机节点通过消息路由自动启动
+		if mobileNodeID != "" && nodeID == mobileNodeID {
+			log.Info().
+				Str("session_id", sessionID).
+				Str("node_id", nodeID).
+				Msg("Skipping StartReshare RPC for mobile/client node (will start via message routing)")
+			continue
+		}
+
+		nodeID := nodeID
+		err := s.retryProtocol(startCtx, "start_reshare", func() error {
+			_, err := s.grpcClient.SendStartResharing(startCtx, nodeID, startReq)
+			return err
+		})
+		if err != nil {
+			cancel()
+			_ = s.sessionManager.FailSession(ctx, sessionID)
+			return nil, errors.Wrapf(err, "failed to start resharing on node %s", nodeID)
+		}
+		s.sessionManager.RecordDispatch(ctx, sessionID, nodeID)
+	}
+	cancel()
+
+	deadline := time.Now().Add(s.MaxWaitTime)
+	for time.Now().Before(deadline) {
+		sess, gErr := s.metadataStore.GetSigningSession(ctx, sessionID)
+		if gErr == nil {
+			if strings.EqualFold(sess.Status, "completed") || strings.EqualFold(sess.Status, "success") {
+				if err := s.updateDKGParticipants(ctx, keyID, newNodeIDs, newThreshold); err != nil {
+					return nil, err
+				}
+				return map[string]interface{}{
+					"public_key": sess.Signature,
+					"key_id":     keyID,
+					"session_id": sessionID,
+				}, nil
+			}
+			if strings.EqualFold(sess.Status, "failed") {
+				return nil, errors.Errorf("reshare session %s failed", sessionID)
+			}
+		}
+		time.Sleep(s.PollInterval)
+	}
+
+	_ = s.sessionManager.FailSession(ctx, sessionID)
+	return nil, errors.Errorf("reshare session %s timeout (waited %s)", sessionID, s.MaxWaitTime)
 }
 
-// RotateKey 密钥轮换
-// 注意：在 V2 架构中，Service 节点不支持密钥轮换功能
+// updateDKGParticipants 重分享完成后，将 DKG 会话（sessionID 等于 keyID）的参与节点更新为新节点集合
+// 后续签名和删除分片都以 DKG 会话中的参与节点为准
+func (s *DKGService) updateDKGParticipants(ctx context.Context, keyID string, nodeIDs []string, threshold int) error {
+	dkgSession, err := s.metadataStore.GetSigningSession(ctx, keyID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get DKG session")
+	}
+
+	dkgSession.ParticipatingNodes = nodeIDs
+	dkgSession.Threshold = threshold
+	dkgSession.TotalNodes = len(nodeIDs)
+
+	if err := s.metadataStore.UpdateSigningSession(ctx, dkgSession); err != nil {
+		return errors.Wrap(err, "failed to update DKG session participants")
+	}
+
+	return nil
+}
+
+// GetParticipatingNodes 获取密钥当前持有分片的节点列表（来自 DKG 会话）
+func (s *DKGService) GetParticipatingNodes(ctx context.Context, keyID string) ([]string, error) {
+	dkgSession, err := s.metadataStore.GetSigningSession(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get DKG session")
+	}
+	if len(dkgSession.ParticipatingNodes) == 0 {
+		return nil, errors.Errorf("no participating nodes recorded for key %s", keyID)
+	}
+	return dkgSession.ParticipatingNodes, nil
+}
+
+// ErrNoMobileNode 密钥的参与节点中没有用户设备上的手机节点
+var ErrNoMobileNode = errors.New("key has no mobile node")
+
+// GetMobileNodeID 获取密钥在用户设备上持有分片的手机节点（来自 DKG 会话的参与节点）
+// 没有移动端/客户端节点时，2-of-2 会话的第一个参与节点为手机节点（创建 DKG 会话时手机节点排在首位）
+func (s *DKGService) GetMobileNodeID(ctx context.Context, keyID string) (string, error) {
+	nodeIDs, err := s.GetParticipatingNodes(ctx, keyID)
+	if err != nil {
+		return "", err
+	}
+	for _, nodeID := range nodeIDs {
+		if isClientNode(nodeID) {
+			return nodeID, nil
+		}
+	}
+	if len(nodeIDs) == 2 {
+		return nodeIDs[0], nil
+	}
+	return "", errors.Wrapf(ErrNoMobileNode, "key %s", keyID)
+}
+
+// ErrInvalidNewNode 重分享的新节点既不是活跃的 Signer，也不是密钥当前的手机节点
+var ErrInvalidNewNode = errors.New("new node is not an active signer or the key's mobile node")
+
+// validateNewNodes 校验重分享的新节点集合，返回密钥当前的手机节点（没有时为空字符串）
+func (s *DKGService) validateNewNodes(ctx context.Context, keyID string, newNodeIDs []string) (string, error) {
+	if s.nodeDiscovery == nil {
+		return "", errors.New("node discovery is required to validate new nodes")
+	}
+
+	mobileNodeID, err := s.GetMobileNodeID(ctx, keyID)
+	if err != nil && !errors.Is(err, ErrNoMobileNode) {
+		return "", errors.Wrap(err, "failed to resolve mobile node")
+	}
+
+	signers, err := s.nodeDiscovery.DiscoverNodes(ctx, node.NodeTypeSigner, node.NodeStatusActive, 0)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to discover signer nodes")
+	}
+	activeSigners := make(map[string]bool, len(signers))
+	for _, n := range signers {
+		activeSigners[n.NodeID] = true
+	}
+
+	seen := make(map[string]bool, len(newNodeIDs))
+	for _, nodeID := range newNodeIDs {
+		if seen[nodeID] {
+			return "", errors.Wrapf(ErrInvalidNewNode, "duplicate node %s", nodeID)
+		}
+		seen[nodeID] = true
+
+		if nodeID == "" || (nodeID != mobileNodeID && !activeSigners[nodeID]) {
+			return "", errors.Wrapf(ErrInvalidNewNode, "node %s", nodeID)
+		}
+	}
+
+	return mobileNodeID, nil
+}
+
+// RotateKey 密钥轮换（分片刷新）
+// 使用相同的节点集合和门限执行重分享，公钥不变，旧分片失效
 func (s *DKGService) RotateKey(ctx context.Context, keyID string) error {
-	return errors.New("key rotation is not supported in Service node. This feature should be implemented in Signer nodes")
-	// 4. 更新密钥元数据
+	keyMeta, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get key metadata")
+	}
+
+	nodeIDs, err := s.GetParticipatingNodes(ctx, keyID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.ExecuteResharing(ctx, keyID, nodeIDs, nodeIDs, keyMeta.Threshold, keyMeta.Threshold); err != nil {
+		return errors.Wrap(err, "failed to refresh key shares")
+	}
 
-	return errors.New("key rotation not yet implemented")
+	return nil
 }
 
 // retryProtocol 重试协议执行
@@ -0,0 +1,230 @@
+package key
+
+import (
+	"context"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// MinDeletionWindowDays / MaxDeletionWindowDays 删除等待期范围（天）
+	MinDeletionWindowDays = 7
+	MaxDeletionWindowDays = 30
+	// DefaultDeletionWindowDays 未配置时的默认删除等待期
+	DefaultDeletionWindowDays = 30
+)
+
+var (
+	// ErrKeyNotActive 密钥（或其根密钥）不是 Active 状态，不能用于签名
+	ErrKeyNotActive = errors.New("key is not active")
+	// ErrInvalidDeletionWindow 删除等待期超出允许范围
+	ErrInvalidDeletionWindow = errors.Errorf("deletion window must be between %d and %d days", MinDeletionWindowDays, MaxDeletionWindowDays)
+	// ErrShareDeletionStarted 已有节点确认销毁分片，删除不可取消
+	ErrShareDeletionStarted = errors.New("key share deletion already confirmed by some nodes")
+	// ErrDerivedKey 派生钱包没有独立分片，需要对根密钥执行该操作
+	ErrDerivedKey = errors.New("key is derived from a root key")
+)
+
+// SetDeletionWindowDays 设置默认删除等待期（MPC_KEY_DELETION_WINDOW_DAYS），超出范围时取最近的边界值
+func (s *Service) SetDeletionWindowDays(days int) {
+	if days < MinDeletionWindowDays {
+		days = MinDeletionWindowDays
+	}
+	if days > MaxDeletionWindowDays {
+		days = MaxDeletionWindowDays
+	}
+	s.deletionWindowDays = days
+}
+
+// EnableKey 重新启用已禁用的密钥（Disabled -> Active）
+func (s *Service) EnableKey(ctx context.Context, keyID string) (*KeyMetadata, error) {
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key")
+	}
+
+	if err := s.transitionKeyStatus(ctx, storageKey, storage.KeyStatusActive, nil); err != nil {
+		return nil, err
+	}
+
+	return keyMetadataFromStorage(storageKey), nil
+}
+
+// DisableKey 禁用密钥（Active -> Disabled），禁用后不能签名
+func (s *Service) DisableKey(ctx context.Context, keyID string) (*KeyMetadata, error) {
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key")
+	}
+
+	if err := s.transitionKeyStatus(ctx, storageKey, storage.KeyStatusDisabled, nil); err != nil {
+		return nil, err
+	}
+
+	return keyMetadataFromStorage(storageKey), nil
+}
+
+// ScheduleKeyDeletion 计划删除密钥：进入 PendingDeletion，等待期结束后由 DeletionScheduler 销毁分片
+// windowDays 为 0 时使用默认等待期
+func (s *Service) ScheduleKeyDeletion(ctx context.Context, keyID string, windowDays int) (*KeyMetadata, error) {
+	if windowDays == 0 {
+		windowDays = s.deletionWindowDays
+	}
+	if windowDays < MinDeletionWindowDays || windowDays > MaxDeletionWindowDays {
+		return nil, ErrInvalidDeletionWindow
+	}
+
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key")
+	}
+
+	deletionDate := time.Now().AddDate(0, 0, windowDays)
+	if err := s.transitionKeyStatus(ctx, storageKey, storage.KeyStatusPendingDeletion, &deletionDate); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("key_id", keyID).
+		Int("window_days", windowDays).
+		Time("deletion_date", deletionDate).
+		Msg("Key scheduled for deletion")
+
+	return keyMetadataFromStorage(storageKey), nil
+}
+
+// CancelKeyDeletion 在等待期内取消删除（PendingDeletion -> Disabled），需要显式 EnableKey 才能恢复签名
+// 一旦有节点确认销毁分片，删除不可取消
+func (s *Service) CancelKeyDeletion(ctx context.Context, keyID string) (*KeyMetadata, error) {
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key")
+	}
+
+	if storageKey.Status == storage.KeyStatusPendingDeletion && s.dkgService != nil {
+		deletions, err := s.dkgService.ListKeyShareDeletions(ctx, keyID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list key share deletions")
+		}
+		for _, deletion := range deletions {
+			if deletion.Status == ShareDeletionConfirmed {
+				return nil, ErrShareDeletionStarted
+			}
+		}
+	}
+
+	if err := s.transitionKeyStatus(ctx, storageKey, storage.KeyStatusDisabled, nil); err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("key_id", keyID).Msg("Key deletion cancelled")
+
+	return keyMetadataFromStorage(storageKey), nil
+}
+
+// EnsureKeyActive 校验密钥可用于签名：密钥本身必须是 Active，派生钱包的根密钥也必须是 Active
+func (s *Service) EnsureKeyActive(ctx context.Context, keyID string) error {
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get key")
+	}
+	if storageKey.Status != storage.KeyStatusActive {
+		return errors.Wrapf(ErrKeyNotActive, "key %s is %s", keyID, storageKey.Status)
+	}
+
+	if parentKeyID, ok := storageKey.Tags["parent_key_id"]; ok && parentKeyID != "" {
+		rootKey, err := s.metadataStore.GetKeyMetadata(ctx, parentKeyID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get root key")
+		}
+		if rootKey.Status != storage.KeyStatusActive {
+			return errors.Wrapf(ErrKeyNotActive, "root key %s is %s", parentKeyID, rootKey.Status)
+		}
+	}
+
+	return nil
+}
+
+// transitionKeyStatus 校验并执行状态迁移，所有生命周期变更统一经过这里
+// deletionDate 仅在进入 PendingDeletion/Deleted 时设置，其他迁移会清空
+func (s *Service) transitionKeyStatus(ctx context.Context, storageKey *storage.KeyMetadata, to string, deletionDate *time.Time) error {
+	from, prevDeletionDate := storageKey.Status, storageKey.DeletionDate
+	if err := storage.ValidateKeyStatusTransition(from, to); err != nil {
+		return errors.Wrapf(err, "key %s", storageKey.KeyID)
+	}
+
+	storageKey.Status = to
+	storageKey.DeletionDate = deletionDate
+	storageKey.UpdatedAt = time.Now()
+
+	if err := s.metadataStore.UpdateKeyMetadata(ctx, storageKey); err != nil {
+		storageKey.Status, storageKey.DeletionDate = from, prevDeletionDate
+		return errors.Wrap(err, "failed to update key status")
+	}
+
+	log.Info().
+		Str("key_id", storageKey.KeyID).
+		Str("old_status", from).
+		Str("new_status", to).
+		Msg("Key status changed")
+
+	details := map[string]interface{}{
+		"old_status": from,
+		"new_status": to,
+	}
+	if deletionDate != nil {
+		details["deletion_date"] = deletionDate
+	}
+	s.auditService.Record(ctx, audit.Entry{
+		EventType: audit.EventTypeKey,
+		Operation: lifecycleOperation(from, to),
+		Result:    audit.ResultSuccess,
+		KeyID:     storageKey.KeyID,
+		Details:   details,
+	})
+
+	return nil
+}
+
+// lifecycleOperation 状态迁移对应的审计操作
+func lifecycleOperation(from, to string) string {
+	switch to {
+	case storage.KeyStatusActive:
+		return audit.OperationEnable
+	case storage.KeyStatusDisabled:
+		if from == storage.KeyStatusPendingDeletion {
+			return audit.OperationCancelDeletion
+		}
+		return audit.OperationDisable
+	case storage.KeyStatusPendingDeletion:
+		return audit.OperationScheduleDeletion
+	case storage.KeyStatusDeleted:
+		return audit.OperationDestroy
+	default:
+		return audit.OperationUpdate
+	}
+}
+
+func keyMetadataFromStorage(storageKey *storage.KeyMetadata) *KeyMetadata {
+	return &KeyMetadata{
+		KeyID:        storageKey.KeyID,
+		PublicKey:    storageKey.PublicKey,
+		Algorithm:    storageKey.Algorithm,
+		Curve:        storageKey.Curve,
+		ChainCode:    storageKey.ChainCode,
+		Threshold:    storageKey.Threshold,
+		TotalNodes:   storageKey.TotalNodes,
+		ChainType:    storageKey.ChainType,
+		Address:      storageKey.Address,
+		Status:       storageKey.Status,
+		Description:  storageKey.Description,
+		Tags:         storageKey.Tags,
+		CreatedAt:    storageKey.CreatedAt,
+		UpdatedAt:    storageKey.UpdatedAt,
+		DeletionDate: storageKey.DeletionDate,
+	}
+}
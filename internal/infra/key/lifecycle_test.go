@@ -0,0 +1,127 @@
+package key
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDeletionWindowDaysClamps(t *testing.T) {
+	tests := []struct {
+		days     int
+		expected int
+	}{
+		{days: -1, expected: MinDeletionWindowDays},
+		{days: 0, expected: MinDeletionWindowDays},
+		{days: MinDeletionWindowDays - 1, expected: MinDeletionWindowDays},
+		{days: MinDeletionWindowDays, expected: MinDeletionWindowDays},
+		{days: 14, expected: 14},
+		{days: MaxDeletionWindowDays, expected: MaxDeletionWindowDays},
+		{days: MaxDeletionWindowDays + 1, expected: MaxDeletionWindowDays},
+		{days: 365, expected: MaxDeletionWindowDays},
+	}
+
+	for _, tt := range tests {
+		s := NewService(newFakeMetadataStore(), nil, nil)
+		s.SetDeletionWindowDays(tt.days)
+		assert.Equal(t, tt.expected, s.deletionWindowDays, "days=%d", tt.days)
+	}
+}
+
+func TestScheduleKeyDeletionWindow(t *testing.T) {
+	for _, days := range []int{-1, MinDeletionWindowDays - 1, MaxDeletionWindowDays + 1} {
+		store := newFakeMetadataStore(&storage.KeyMetadata{KeyID: "root-1", Status: storage.KeyStatusActive})
+		s := NewService(store, nil, nil)
+
+		_, err := s.ScheduleKeyDeletion(context.Background(), "root-1", days)
+		assert.True(t, errors.Is(err, ErrInvalidDeletionWindow), "days=%d", days)
+	}
+
+	store := newFakeMetadataStore(&storage.KeyMetadata{KeyID: "root-1", Status: storage.KeyStatusActive})
+	s := NewService(store, nil, nil)
+	s.SetDeletionWindowDays(10)
+
+	before := time.Now()
+	updated, err := s.ScheduleKeyDeletion(context.Background(), "root-1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusPendingDeletion, updated.Status)
+	require.NotNil(t, updated.DeletionDate)
+	assert.WithinDuration(t, before.AddDate(0, 0, 10), *updated.DeletionDate, time.Minute)
+}
+
+func TestKeyLifecycleTransitions(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeMetadataStore(&storage.KeyMetadata{KeyID: "root-1", Status: storage.KeyStatusActive})
+	s := NewService(store, nil, nil)
+
+	// Active 不能直接重新启用
+	_, err := s.EnableKey(ctx, "root-1")
+	assert.True(t, errors.Is(err, storage.ErrInvalidKeyStatusTransition))
+
+	updated, err := s.DisableKey(ctx, "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusDisabled, updated.Status)
+
+	updated, err = s.ScheduleKeyDeletion(ctx, "root-1", MinDeletionWindowDays)
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusPendingDeletion, updated.Status)
+
+	// PendingDeletion 必须先取消删除（回到 Disabled）才能启用
+	_, err = s.EnableKey(ctx, "root-1")
+	assert.True(t, errors.Is(err, storage.ErrInvalidKeyStatusTransition))
+
+	updated, err = s.CancelKeyDeletion(ctx, "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusDisabled, updated.Status)
+	assert.Nil(t, updated.DeletionDate)
+
+	updated, err = s.EnableKey(ctx, "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusActive, updated.Status)
+}
+
+func TestTransitionKeyStatusRejectsStaleStatus(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeMetadataStore(&storage.KeyMetadata{KeyID: "root-1", Status: storage.KeyStatusActive})
+	s := NewService(store, nil, nil)
+
+	stale, err := store.GetKeyMetadata(ctx, "root-1")
+	require.NoError(t, err)
+
+	// 另一个请求先把密钥计划删除并销毁
+	current, err := store.GetKeyMetadata(ctx, "root-1")
+	require.NoError(t, err)
+	current.Status = storage.KeyStatusPendingDeletion
+	require.NoError(t, store.UpdateKeyMetadata(ctx, current))
+	current.Status = storage.KeyStatusDeleted
+	require.NoError(t, store.UpdateKeyMetadata(ctx, current))
+
+	// 用过期的 Active 快照禁用，存储层按当前状态（Deleted）拒绝
+	err = s.transitionKeyStatus(ctx, stale, storage.KeyStatusDisabled, nil)
+	assert.True(t, errors.Is(err, storage.ErrInvalidKeyStatusTransition))
+	assert.Equal(t, storage.KeyStatusActive, stale.Status)
+
+	k, err := store.GetKeyMetadata(ctx, "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusDeleted, k.Status)
+}
+
+func TestCancelKeyDeletionAfterShareDeletionStarted(t *testing.T) {
+	ctx := context.Background()
+	store, _, s := newShareDeletionFixture("mobile-p1", "server-signer-p2")
+
+	_, err := s.dkgService.DeleteKeyShares(ctx, "root-1", "key deleted")
+	require.NoError(t, err)
+
+	_, err = s.CancelKeyDeletion(ctx, "root-1")
+	assert.True(t, errors.Is(err, ErrShareDeletionStarted))
+
+	k, err := store.GetKeyMetadata(ctx, "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusPendingDeletion, k.Status)
+}
@@ -0,0 +1,352 @@
+package key
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// RefreshTriggerScheduled 由定时调度触发的分片刷新
+	RefreshTriggerScheduled = "scheduled"
+
+	RefreshStatusSuccess = "success"
+	RefreshStatusFailed  = "failed"
+
+	// refreshListPageSize 扫描 Active 密钥时的分页大小
+	refreshListPageSize = 100
+	// refreshLockTTL 单个密钥刷新的分布式锁有效期，避免多个 Service 实例同时刷新同一密钥
+	refreshLockTTL = 30 * time.Minute
+)
+
+var (
+	refreshMetricsOnce     sync.Once
+	refreshAttemptsTotal   *prometheus.CounterVec
+	refreshDurationHist    *prometheus.HistogramVec
+	refreshOverdueKeys     prometheus.Gauge
+	refreshLastScanSeconds prometheus.Gauge
+)
+
+// RefreshScheduler 分片定期刷新调度器（Proactive Refresh）
+// 按 MPC.KeyRotationDays 扫描 Active 密钥，分片年龄（最近一次成功刷新时间，没有则为创建时间）超过阈值时，
+// 使用相同参与方和门限执行重分享：公钥不变，旧分片失效。每次尝试都会写入 key_refresh_history
+type RefreshScheduler struct {
+	metadataStore storage.MetadataStore
+	sessionStore  storage.SessionStore
+	dkgService    *DKGService
+
+	maxAge        time.Duration
+	checkInterval time.Duration
+	maxRetries    int
+	retryBackoff  time.Duration
+
+	started  atomic.Bool
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// NewRefreshScheduler 创建分片定期刷新调度器，rotationDays <= 0 时调度器不启用
+func NewRefreshScheduler(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	dkgService *DKGService,
+	rotationDays int,
+	checkInterval time.Duration,
+	maxRetries int,
+	retryBackoff time.Duration,
+) *RefreshScheduler {
+	ensureRefreshMetrics()
+
+	if checkInterval <= 0 {
+		checkInterval = time.Hour
+	}
+	if maxRetries <= 0 {
+		maxRetries = 1
+	}
+	if retryBackoff <= 0 {
+		retryBackoff = time.Minute
+	}
+
+	return &RefreshScheduler{
+		metadataStore: metadataStore,
+		sessionStore:  sessionStore,
+		dkgService:    dkgService,
+		maxAge:        time.Duration(rotationDays) * 24 * time.Hour,
+		checkInterval: checkInterval,
+		maxRetries:    maxRetries,
+		retryBackoff:  retryBackoff,
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+}
+
+// Enabled 是否配置了刷新周期
+func (s *RefreshScheduler) Enabled() bool {
+	return s.maxAge > 0
+}
+
+// Start 启动后台调度（立即执行一次扫描，之后按 checkInterval 周期执行）
+func (s *RefreshScheduler) Start(ctx context.Context) {
+	if !s.Enabled() {
+		log.Info().Msg("Key share refresh scheduler disabled (MPC_KEY_ROTATION_DAYS=0)")
+		return
+	}
+	if !s.started.CompareAndSwap(false, true) {
+		return
+	}
+
+	log.Info().
+		Dur("max_share_age", s.maxAge).
+		Dur("check_interval", s.checkInterval).
+		Int("max_retries", s.maxRetries).
+		Msg("Starting key share refresh scheduler")
+
+	go func() {
+		defer close(s.doneCh)
+
+		ticker := time.NewTicker(s.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			s.RunOnce(ctx)
+
+			select {
+			case <-ticker.C:
+			case <-s.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止后台调度，等待正在进行的扫描退出
+func (s *RefreshScheduler) Stop(ctx context.Context) {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	if !s.started.Load() {
+		return
+	}
+
+	select {
+	case <-s.doneCh:
+	case <-ctx.Done():
+		log.Warn().Msg("Timed out waiting for key share refresh scheduler to stop")
+	}
+}
+
+// RunOnce 扫描一次到期密钥并逐个刷新
+func (s *RefreshScheduler) RunOnce(ctx context.Context) {
+	dueKeys, err := s.findDueKeys(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find keys due for share refresh")
+		return
+	}
+
+	refreshLastScanSeconds.SetToCurrentTime()
+	refreshOverdueKeys.Set(float64(len(dueKeys)))
+
+	if len(dueKeys) == 0 {
+		log.Debug().Msg("No keys due for share refresh")
+		return
+	}
+
+	log.Info().Int("due_keys", len(dueKeys)).Msg("Found keys due for share refresh")
+
+	overdue := len(dueKeys)
+	for _, keyMeta := range dueKeys {
+		if s.stopped(ctx) {
+			return
+		}
+		if s.refreshKey(ctx, keyMeta.KeyID) {
+			overdue--
+			refreshOverdueKeys.Set(float64(overdue))
+		}
+	}
+}
+
+// findDueKeys 列出分片年龄超过 maxAge 的 Active 根密钥
+func (s *RefreshScheduler) findDueKeys(ctx context.Context) ([]*storage.KeyMetadata, error) {
+	now := time.Now()
+	var dueKeys []*storage.KeyMetadata
+
+	for offset := 0; ; offset += refreshListPageSize {
+		keys, err := s.metadataStore.ListKeys(ctx, &storage.KeyFilter{
+			Status: storage.KeyStatusActive,
+			Limit:  refreshListPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list active keys")
+		}
+
+		// 派生钱包没有独立分片，刷新根密钥即可
+		rootKeys := make([]*storage.KeyMetadata, 0, len(keys))
+		keyIDs := make([]string, 0, len(keys))
+		for _, keyMeta := range keys {
+			if parentKeyID, ok := keyMeta.Tags["parent_key_id"]; ok && parentKeyID != "" {
+				continue
+			}
+			rootKeys = append(rootKeys, keyMeta)
+			keyIDs = append(keyIDs, keyMeta.KeyID)
+		}
+
+		// 每页只查询一次刷新历史
+		lastRefreshes, err := s.metadataStore.GetLastSuccessfulKeyRefreshes(ctx, keyIDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get last key refreshes")
+		}
+
+		for _, keyMeta := range rootKeys {
+			lastRefreshedAt := keyMeta.CreatedAt
+			if last, ok := lastRefreshes[keyMeta.KeyID]; ok {
+				lastRefreshedAt = last
+			}
+
+			if now.Sub(lastRefreshedAt) >= s.maxAge {
+				dueKeys = append(dueKeys, keyMeta)
+			}
+		}
+
+		if len(keys) < refreshListPageSize {
+			break
+		}
+	}
+
+	return dueKeys, nil
+}
+
+// refreshKey 刷新单个密钥的分片，失败时按指数退避重试，返回是否最终成功
+func (s *RefreshScheduler) refreshKey(ctx context.Context, keyID string) bool {
+	lockKey := "key_refresh:" + keyID
+	lock, err := storage.TryLock(ctx, s.sessionStore, lockKey, refreshLockTTL)
+	if err != nil {
+		log.Error().Err(err).Str("key_id", keyID).Msg("Failed to acquire key refresh lock")
+		return false
+	}
+	if lock == nil {
+		log.Info().Str("key_id", keyID).Msg("Key share refresh already in progress on another instance, skipping")
+		return false
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			log.Warn().Err(err).Str("key_id", keyID).Msg("Failed to release key refresh lock")
+		}
+	}()
+	// 锁丢失时停止刷新，避免与其他实例并发重分享
+	ctx = lock.Context()
+
+	backoff := s.retryBackoff
+	for attempt := 1; attempt <= s.maxRetries; attempt++ {
+		startedAt := time.Now()
+		refreshErr := s.dkgService.RotateKey(ctx, keyID)
+		completedAt := time.Now()
+		duration := completedAt.Sub(startedAt)
+
+		record := &storage.KeyRefreshRecord{
+			KeyID:       keyID,
+			Trigger:     RefreshTriggerScheduled,
+			Attempt:     attempt,
+			Status:      RefreshStatusSuccess,
+			StartedAt:   startedAt,
+			CompletedAt: &completedAt,
+			DurationMs:  int(duration.Milliseconds()),
+		}
+		if refreshErr != nil {
+			record.Status = RefreshStatusFailed
+			record.Error = refreshErr.Error()
+		}
+		if err := s.metadataStore.SaveKeyRefreshRecord(ctx, record); err != nil {
+			log.Error().Err(err).Str("key_id", keyID).Int("attempt", attempt).Msg("Failed to save key refresh record")
+		}
+
+		refreshAttemptsTotal.WithLabelValues(record.Status).Inc()
+		refreshDurationHist.WithLabelValues(record.Status).Observe(duration.Seconds())
+
+		if refreshErr == nil {
+			log.Info().
+				Str("key_id", keyID).
+				Int("attempt", attempt).
+				Dur("duration", duration).
+				Msg("Key shares refreshed")
+			return true
+		}
+
+		log.Warn().
+			Err(refreshErr).
+			Str("key_id", keyID).
+			Int("attempt", attempt).
+			Int("max_retries", s.maxRetries).
+			Msg("Key share refresh attempt failed")
+
+		if attempt == s.maxRetries {
+			break
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-s.stopCh:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+		backoff *= 2
+	}
+
+	log.Error().
+		Str("key_id", keyID).
+		Int("attempts", s.maxRetries).
+		Msg("Key share refresh failed after all retries, will retry on next scan")
+	return false
+}
+
+func (s *RefreshScheduler) stopped(ctx context.Context) bool {
+	select {
+	case <-s.stopCh:
+		return true
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func ensureRefreshMetrics() {
+	refreshMetricsOnce.Do(func() {
+		refreshAttemptsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpc",
+			Subsystem: "key_refresh",
+			Name:      "attempts_total",
+			Help:      "Total number of key share refresh attempts by result",
+		}, []string{"result"})
+		refreshDurationHist = promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "mpc",
+			Subsystem: "key_refresh",
+			Name:      "duration_seconds",
+			Help:      "Duration of key share refresh attempts",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}, []string{"result"})
+		refreshOverdueKeys = promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: "mpc",
+			Subsystem: "key_refresh",
+			Name:      "overdue_keys",
+			Help:      "Number of active keys whose shares are older than MPC_KEY_ROTATION_DAYS and not yet refreshed",
+		})
+		refreshLastScanSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: "mpc",
+			Subsystem: "key_refresh",
+			Name:      "last_scan_timestamp_seconds",
+			Help:      "Unix timestamp of the last completed scan for keys due for share refresh",
+		})
+	})
+}
@@ -0,0 +1,79 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindDueKeys(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-40 * 24 * time.Hour)
+	recent := now.Add(-time.Hour)
+
+	store := newFakeMetadataStore(
+		&storage.KeyMetadata{KeyID: "never-refreshed", Status: storage.KeyStatusActive, CreatedAt: old},
+		&storage.KeyMetadata{KeyID: "refreshed-recently", Status: storage.KeyStatusActive, CreatedAt: old},
+		&storage.KeyMetadata{KeyID: "refresh-failed", Status: storage.KeyStatusActive, CreatedAt: old},
+		&storage.KeyMetadata{KeyID: "new-key", Status: storage.KeyStatusActive, CreatedAt: recent},
+		&storage.KeyMetadata{KeyID: "derived", Status: storage.KeyStatusActive, CreatedAt: old, Tags: map[string]string{"parent_key_id": "never-refreshed"}},
+		&storage.KeyMetadata{KeyID: "disabled", Status: storage.KeyStatusDisabled, CreatedAt: old},
+	)
+	require.NoError(t, store.SaveKeyRefreshRecord(context.Background(), &storage.KeyRefreshRecord{
+		KeyID: "refreshed-recently", Status: RefreshStatusSuccess, CompletedAt: &recent,
+	}))
+	require.NoError(t, store.SaveKeyRefreshRecord(context.Background(), &storage.KeyRefreshRecord{
+		KeyID: "refresh-failed", Status: RefreshStatusFailed, CompletedAt: &recent,
+	}))
+
+	s := NewRefreshScheduler(store, newFakeLockStore(), nil, 30, time.Hour, 1, time.Second)
+
+	due, err := s.findDueKeys(context.Background())
+	require.NoError(t, err)
+
+	var ids []string
+	for _, k := range due {
+		ids = append(ids, k.KeyID)
+	}
+	assert.ElementsMatch(t, []string{"never-refreshed", "refresh-failed"}, ids)
+}
+
+func TestFindDueKeysQueriesRefreshHistoryOncePerPage(t *testing.T) {
+	old := time.Now().Add(-40 * 24 * time.Hour)
+
+	var keys []*storage.KeyMetadata
+	for i := 0; i < refreshListPageSize+10; i++ {
+		keys = append(keys, &storage.KeyMetadata{
+			KeyID:     fmt.Sprintf("key-%03d", i),
+			Status:    storage.KeyStatusActive,
+			CreatedAt: old,
+		})
+	}
+	store := newFakeMetadataStore(keys...)
+
+	s := NewRefreshScheduler(store, newFakeLockStore(), nil, 30, time.Hour, 1, time.Second)
+
+	due, err := s.findDueKeys(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, due, refreshListPageSize+10)
+	assert.Equal(t, 2, store.refreshLookups)
+}
+
+func TestRefreshKeySkipsWhenLockHeld(t *testing.T) {
+	store := newFakeMetadataStore(&storage.KeyMetadata{KeyID: "root-1", Status: storage.KeyStatusActive})
+	locks := newFakeLockStore()
+	_, acquired, err := locks.AcquireLock(context.Background(), "key_refresh:root-1", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// dkgService 为 nil：若未被锁拦住会直接 panic
+	s := NewRefreshScheduler(store, locks, nil, 30, time.Hour, 1, time.Second)
+
+	assert.False(t, s.refreshKey(context.Background(), "root-1"))
+	assert.Empty(t, store.refreshes)
+}
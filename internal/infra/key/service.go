@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
+	"strings"
 	"time"
 
-	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/google/uuid"
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/infra/storage"
 	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 )
@@ -20,6 +22,15 @@ type Service struct {
 	keyShareStorage   storage.KeyShareStorage
 	dkgService        *DKGService
 	derivationService *DerivationService
+	auditService      *audit.Service
+
+	// deletionWindowDays 默认删除等待期（天），见 SetDeletionWindowDays
+	deletionWindowDays int
+
+	// chains 链注册表，见 SetChainRegistry
+	chains *registry.Registry
+	// bitcoinAddressType Bitcoin 钱包默认地址类型，见 SetBitcoinAddressType
+	bitcoinAddressType chain.BitcoinAddressType
 }
 
 // NewService 创建密钥服务
@@ -29,15 +40,23 @@ func NewService(
 	dkgService *DKGService,
 ) *Service {
 	return &Service{
-		metadataStore:     metadataStore,
-		keyShareStorage:   keyShareStorage,
-		dkgService:        dkgService,
-		derivationService: NewDerivationService(),
+		metadataStore:      metadataStore,
+		keyShareStorage:    keyShareStorage,
+		dkgService:         dkgService,
+		derivationService:  NewDerivationService(),
+		deletionWindowDays: DefaultDeletionWindowDays,
+		chains:             registry.Default(),
+		bitcoinAddressType: chain.BitcoinAddressP2WPKH,
 	}
 }
 
+// SetAuditService 设置审计服务，密钥创建、派生和生命周期变更写入审计日志
+func (s *Service) SetAuditService(auditService *audit.Service) {
+	s.auditService = auditService
+}
+
 // CreateKeyPlaceholder 创建密钥占位符（不执行 DKG）
-// 用于在创建 DKG 会话前先创建 key 记录，满足外键约束
+// 用于在创建 DKG 会话前先创建 key 记录，满足外键约束；OwnerCredentialID 不为空时同一事务中把该凭证添加为 owner
 func (s *Service) CreateKeyPlaceholder(ctx context.Context, req *CreateKeyRequest) error {
 	now := time.Now()
 	pendingKey := &storage.KeyMetadata{
@@ -50,13 +69,20 @@ func (s *Service) CreateKeyPlaceholder(ctx context.Context, req *CreateKeyReques
 		TotalNodes:  req.TotalNodes,
 		ChainType:   req.ChainType,
 		Address:     "",
-		Status:      "Pending",
+		Status:      storage.KeyStatusPending,
 		Description: req.Description,
-		Tags:        req.Tags,
+		Tags:        s.newWalletTags(req.ChainType, req.Tags),
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
 
+	if req.OwnerCredentialID != "" {
+		if err := s.metadataStore.SaveKeyMetadataWithOwner(ctx, pendingKey, req.OwnerCredentialID); err != nil {
+			return errors.Wrap(err, "failed to save pending key metadata")
+		}
+		return nil
+	}
+
 	if err := s.metadataStore.SaveKeyMetadata(ctx, pendingKey); err != nil {
 		return errors.Wrap(err, "failed to save pending key metadata")
 	}
@@ -93,51 +119,10 @@ func (s *Service) GetKey(ctx context.Context, keyID string) (*KeyMetadata, error
 }
 
 // DeleteKey 删除密钥
+// 密钥先进入 PendingDeletion（默认等待期），等待期结束后由 DeletionScheduler 销毁分片
 func (s *Service) DeleteKey(ctx context.Context, keyID string) error {
-	// 获取密钥信息
-	key, err := s.GetKey(ctx, keyID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get key")
-	}
-
-	// 删除所有节点的密钥分片
-	// TODO: 从节点管理器获取所有节点ID
-	nodeIDs := []string{} // 需要实现
-	for _, nodeID := range nodeIDs {
-		if err := s.keyShareStorage.DeleteKeyShare(ctx, keyID, nodeID); err != nil {
-			// 记录错误但继续删除其他分片
-			// log error
-		}
-	}
-
-	// 更新密钥状态为删除
-	now := time.Now()
-	key.Status = "Deleted"
-	key.DeletionDate = &now
-	key.UpdatedAt = now
-
-	storageKey := &storage.KeyMetadata{
-		KeyID:        key.KeyID,
-		PublicKey:    key.PublicKey,
-		Algorithm:    key.Algorithm,
-		Curve:        key.Curve,
-		Threshold:    key.Threshold,
-		TotalNodes:   key.TotalNodes,
-		ChainType:    key.ChainType,
-		Address:      key.Address,
-		Status:       key.Status,
-		Description:  key.Description,
-		Tags:         key.Tags,
-		CreatedAt:    key.CreatedAt,
-		UpdatedAt:    key.UpdatedAt,
-		DeletionDate: key.DeletionDate,
-	}
-
-	if err := s.metadataStore.UpdateKeyMetadata(ctx, storageKey); err != nil {
-		return errors.Wrap(err, "failed to update key status")
-	}
-
-	return nil
+	_, err := s.ScheduleKeyDeletion(ctx, keyID, 0)
+	return err
 }
 
 // ListKeys 列出密钥
@@ -149,6 +134,8 @@ func (s *Service) ListKeys(ctx context.Context, filter *KeyFilter) ([]*KeyMetada
 		TagValue:  filter.TagValue,
 		Limit:     filter.Limit,
 		Offset:    filter.Offset,
+
+		MemberUserID: filter.MemberUserID,
 	}
 
 	storageKeys, err := s.metadataStore.ListKeys(ctx, storageFilter)
@@ -198,17 +185,13 @@ func (s *Service) GenerateAddress(ctx context.Context, keyID string, chainType s
 		return "", errors.Wrap(err, "failed to decode public key")
 	}
 
-	// 根据链类型选择适配器
-	var adapter chain.Adapter
-	switch chainType {
-	case "bitcoin", "btc":
-		adapter = chain.NewBitcoinAdapter(&chaincfg.MainNetParams)
-	case "ethereum", "eth", "evm":
-		adapter = chain.NewEthereumAdapter(big.NewInt(1), "") // mainnet, rpcEndpoint 暂时为空
-	case "solana", "sol":
-		adapter = chain.NewSolanaAdapter()
-	default:
-		return "", errors.Errorf("unsupported chain type: %s", chainType)
+	// 通过链注册表选择适配器
+	adapter, bitcoinAddressType, err := s.addressAdapter(chainType, keyMetadata.Tags)
+	if err != nil {
+		return "", err
+	}
+	if bitcoinAddressType != "" {
+		keyMetadata.Tags = setBitcoinAddressType(keyMetadata.Tags, bitcoinAddressType)
 	}
 
 	// 生成地址
@@ -288,7 +271,7 @@ func (s *Service) CreateRootKey(ctx context.Context, req *CreateRootKeyRequest)
 		TotalNodes:  totalNodes,
 		ChainType:   "",
 		Address:     "",
-		Status:      "Pending",
+		Status:      storage.KeyStatusPending,
 		Description: req.Description,
 		Tags:        req.Tags,
 		CreatedAt:   now,
@@ -307,9 +290,9 @@ func (s *Service) CreateRootKey(ctx context.Context, req *CreateRootKeyRequest)
 		dkgResp, err = s.dkgService.ExecuteDKG(ctx, keyID, dkgReq)
 		if err != nil {
 			// DKG 失败，更新状态为 Failed
-			pendingKey.Status = "Failed"
-			pendingKey.UpdatedAt = time.Now()
-			_ = s.metadataStore.UpdateKeyMetadata(ctx, pendingKey)
+			if statusErr := s.transitionKeyStatus(ctx, pendingKey, storage.KeyStatusFailed, nil); statusErr != nil {
+				log.Error().Err(statusErr).Str("key_id", keyID).Msg("Failed to mark key failed after DKG error")
+			}
 			return nil, errors.Wrap(err, "failed to execute DKG")
 		}
 	} else {
@@ -348,7 +331,7 @@ func (s *Service) CreateRootKey(ctx context.Context, req *CreateRootKeyRequest)
 		Threshold:   threshold,
 		TotalNodes:  totalNodes,
 		Protocol:    req.Protocol,
-		Status:      "Active",
+		Status:      storage.KeyStatusActive,
 		Description: req.Description,
 		Tags:        req.Tags,
 		CreatedAt:   now,
@@ -378,6 +361,19 @@ func (s *Service) CreateRootKey(ctx context.Context, req *CreateRootKeyRequest)
 		return nil, errors.Wrap(err, "failed to update root key metadata")
 	}
 
+	s.auditService.Record(ctx, audit.Entry{
+		EventType: audit.EventTypeKey,
+		Operation: audit.OperationCreate,
+		Result:    audit.ResultSuccess,
+		KeyID:     keyID,
+		Details: map[string]interface{}{
+			"algorithm":   req.Algorithm,
+			"curve":       req.Curve,
+			"threshold":   threshold,
+			"total_nodes": totalNodes,
+			"public_key":  publicKeyHex,
+		},
+	})
 
 	return rootKeyMetadata, nil
 }
@@ -409,73 +405,124 @@ func (s *Service) GetRootKey(ctx context.Context, keyID string) (*RootKeyMetadat
 	return rootKeyMetadata, nil
 }
 
-// DeleteRootKey 删除根密钥
+// DeleteRootKey 删除根密钥（进入删除等待期）
 func (s *Service) DeleteRootKey(ctx context.Context, keyID string) error {
-	// 获取根密钥信息
-	rootKey, err := s.GetRootKey(ctx, keyID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get root key")
+	return s.DeleteKey(ctx, keyID)
+}
+
+// DeleteWalletKey 删除钱包密钥
+func (s *Service) DeleteWalletKey(ctx context.Context, walletID string) error {
+	return s.DeleteKey(ctx, walletID)
+}
+
+// destroyKey 销毁删除等待期已结束的密钥：通知所有参与节点删除分片，全部确认后标记为 Deleted
+// 有节点未确认时密钥保持 PendingDeletion 并返回错误，重复调用只会重试未确认的节点
+func (s *Service) destroyKey(ctx context.Context, storageKey *storage.KeyMetadata) error {
+	if storageKey.Status == storage.KeyStatusDeleted {
+		return nil
+	}
+	if err := storage.ValidateKeyStatusTransition(storageKey.Status, storage.KeyStatusDeleted); err != nil {
+		return errors.Wrapf(err, "key %s", storageKey.KeyID)
+	}
+	if storageKey.DeletionDate != nil && time.Now().Before(*storageKey.DeletionDate) {
+		return errors.Errorf("key %s deletion window has not elapsed (until %s)",
+			storageKey.KeyID, storageKey.DeletionDate.Format(time.RFC3339))
 	}
 
-	// 删除所有节点的密钥分片
-	// TODO: 从节点管理器获取所有节点ID
-	nodeIDs := []string{"server-proxy-1", "server-proxy-2"}
-	for _, nodeID := range nodeIDs {
-		if err := s.keyShareStorage.DeleteKeyShare(ctx, keyID, nodeID); err != nil {
-			// 记录错误但继续删除其他分片
-			log.Warn().Err(err).Str("key_id", keyID).Str("node_id", nodeID).Msg("Failed to delete key share")
+	// 派生钱包没有独立分片（分片属于根密钥），直接标记删除；根密钥需要所有节点确认销毁分片
+	if parentKeyID, ok := storageKey.Tags["parent_key_id"]; !ok || parentKeyID == "" {
+		if s.dkgService == nil {
+			return errors.New("DKG service is required to delete key shares")
+		}
+
+		unconfirmed, err := s.dkgService.DeleteKeyShares(ctx, storageKey.KeyID, "key deleted")
+		if err != nil {
+			return errors.Wrap(err, "failed to delete key shares")
+		}
+		if len(unconfirmed) > 0 {
+			return errors.Errorf("key %s is pending deletion, share deletion not confirmed by nodes: %s",
+				storageKey.KeyID, strings.Join(unconfirmed, ","))
 		}
 	}
 
-	// 更新密钥状态为删除
 	now := time.Now()
-	rootKey.Status = "Deleted"
-	rootKey.DeletionDate = &now
-	rootKey.UpdatedAt = now
+	if err := s.transitionKeyStatus(ctx, storageKey, storage.KeyStatusDeleted, &now); err != nil {
+		return err
+	}
 
-	storageKey := &storage.KeyMetadata{
-		KeyID:        rootKey.KeyID,
-		PublicKey:    rootKey.PublicKey,
-		Algorithm:    rootKey.Algorithm,
-		Curve:        rootKey.Curve,
-		Threshold:    rootKey.Threshold,
-		TotalNodes:   rootKey.TotalNodes,
-		ChainType:    "",
-		Address:      "",
-		Status:       rootKey.Status,
-		Description:  rootKey.Description,
-		Tags:         rootKey.Tags,
-		CreatedAt:    rootKey.CreatedAt,
-		UpdatedAt:    rootKey.UpdatedAt,
-		DeletionDate: rootKey.DeletionDate,
+	log.Info().Str("key_id", storageKey.KeyID).Msg("Key deleted, all key shares destroyed")
+
+	return nil
+}
+
+// ListKeyShareDeletions 获取密钥在各节点的分片删除确认记录（用于证明密钥已销毁）
+func (s *Service) ListKeyShareDeletions(ctx context.Context, keyID string) ([]*storage.KeyShareDeletion, error) {
+	if s.dkgService == nil {
+		return nil, errors.New("DKG service is required to list key share deletions")
 	}
+	return s.dkgService.ListKeyShareDeletions(ctx, keyID)
+}
 
-	if err := s.metadataStore.UpdateKeyMetadata(ctx, storageKey); err != nil {
-		return errors.Wrap(err, "failed to update root key status")
+// ConfirmDeviceShareDeletion 设备删除本地分片后确认，所有节点确认后删除调度器会将密钥标记为 Deleted
+func (s *Service) ConfirmDeviceShareDeletion(ctx context.Context, keyID, nodeID string) (*storage.KeyShareDeletion, error) {
+	if s.dkgService == nil {
+		return nil, errors.New("DKG service is required to confirm key share deletions")
 	}
 
-	return nil
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key")
+	}
+	if storageKey.Status != storage.KeyStatusPendingDeletion {
+		return nil, errors.Wrapf(ErrShareDeletionNotRequested, "key %s is %s", keyID, storageKey.Status)
+	}
+
+	deletion, err := s.dkgService.ConfirmDeviceShareDeletion(ctx, keyID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, audit.Entry{
+		EventType: audit.EventTypeKey,
+		Operation: audit.OperationConfirmDeletion,
+		Result:    audit.ResultSuccess,
+		KeyID:     keyID,
+		NodeID:    nodeID,
+	})
+
+	return deletion, nil
 }
 
-// DeleteWalletKey 删除钱包密钥
-func (s *Service) DeleteWalletKey(ctx context.Context, walletID string) error {
-	// 获取密钥元数据
-	key, err := s.metadataStore.GetKeyMetadata(ctx, walletID)
+// GetMobileNodeID 获取钱包签名时参与的手机节点 ID，派生钱包使用其根密钥的参与节点
+func (s *Service) GetMobileNodeID(ctx context.Context, keyID string) (string, error) {
+	if s.dkgService == nil {
+		return "", errors.New("DKG service is required to resolve the mobile node")
+	}
+
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
 	if err != nil {
-		return errors.Wrap(err, "failed to get key metadata")
+		return "", errors.Wrap(err, "failed to get key metadata")
+	}
+	if parentKeyID, ok := storageKey.Tags["parent_key_id"]; ok && parentKeyID != "" {
+		keyID = parentKeyID
 	}
 
-	// 更新状态
-	now := time.Now()
-	key.Status = "Deleted"
-	key.DeletionDate = &now
-	key.UpdatedAt = now
+	return s.dkgService.GetMobileNodeID(ctx, keyID)
+}
 
-	if err := s.metadataStore.UpdateKeyMetadata(ctx, key); err != nil {
-		return errors.Wrap(err, "failed to update key status")
+// ListKeyRefreshHistory 获取密钥的分片刷新历史（按开始时间倒序），派生钱包返回其根密钥的记录
+func (s *Service) ListKeyRefreshHistory(ctx context.Context, keyID string, limit int) ([]*storage.KeyRefreshRecord, error) {
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key metadata")
 	}
 
-	return nil
+	// 派生钱包没有独立分片，刷新发生在根密钥上
+	if parentKeyID, ok := storageKey.Tags["parent_key_id"]; ok && parentKeyID != "" {
+		keyID = parentKeyID
+	}
+
+	return s.metadataStore.ListKeyRefreshHistory(ctx, keyID, limit)
 }
 
 // DeriveWalletKey 派生钱包密钥（Non-Hardened Derivation based on BIP-32）
@@ -544,16 +591,10 @@ func (s *Service) DeriveWalletKey(ctx context.Context, req *DeriveWalletKeyReque
 	walletPubKey := result.PublicKey
 
 	// 生成地址
-	var adapter chain.Adapter
-	switch req.ChainType {
-	case "bitcoin", "btc":
-		adapter = chain.NewBitcoinAdapter(&chaincfg.MainNetParams)
-	case "ethereum", "eth", "evm":
-		adapter = chain.NewEthereumAdapter(big.NewInt(1), "") // mainnet, rpcEndpoint 暂时为空
-	case "solana", "sol":
-		adapter = chain.NewSolanaAdapter()
-	default:
-		return nil, errors.Errorf("unsupported chain type: %s", req.ChainType)
+	req.Tags = s.newWalletTags(req.ChainType, req.Tags)
+	adapter, bitcoinAddressType, err := s.addressAdapter(req.ChainType, req.Tags)
+	if err != nil {
+		return nil, err
 	}
 
 	address, err := adapter.GenerateAddress(walletPubKey)
@@ -573,7 +614,7 @@ func (s *Service) DeriveWalletKey(ctx context.Context, req *DeriveWalletKeyReque
 		PublicKey:   hex.EncodeToString(walletPubKey),
 		ChainCode:   hex.EncodeToString(result.ChainCode),
 		Address:     address,
-		Status:      "Active",
+		Status:      storage.KeyStatusActive,
 		Description: req.Description,
 		Tags:        req.Tags,
 		CreatedAt:   now,
@@ -587,6 +628,9 @@ func (s *Service) DeriveWalletKey(ctx context.Context, req *DeriveWalletKeyReque
 	// Record derivation info
 	walletMetadata.Tags["parent_key_id"] = req.RootKeyID
 	walletMetadata.Tags["derivation_index"] = big.NewInt(int64(req.Index)).String()
+	if bitcoinAddressType != "" {
+		walletMetadata.Tags = setBitcoinAddressType(walletMetadata.Tags, bitcoinAddressType)
+	}
 
 	storageKey := &storage.KeyMetadata{
 		KeyID:       walletMetadata.WalletID,
@@ -609,9 +653,28 @@ func (s *Service) DeriveWalletKey(ctx context.Context, req *DeriveWalletKeyReque
 		return nil, errors.Wrap(err, "failed to save wallet key metadata")
 	}
 
+	s.recordDerivation(ctx, walletMetadata)
+
 	return walletMetadata, nil
 }
 
+// recordDerivation 记录钱包派生审计日志
+func (s *Service) recordDerivation(ctx context.Context, wallet *WalletKeyMetadata) {
+	s.auditService.Record(ctx, audit.Entry{
+		EventType: audit.EventTypeKey,
+		Operation: audit.OperationDerive,
+		Result:    audit.ResultSuccess,
+		KeyID:     wallet.WalletID,
+		Details: map[string]interface{}{
+			"root_key_id":      wallet.RootKeyID,
+			"chain_type":       wallet.ChainType,
+			"address":          wallet.Address,
+			"derivation_index": wallet.Tags["derivation_index"],
+			"derivation_path":  wallet.Tags["derivation_path"],
+		},
+	})
+}
+
 // AddPasskey 添加用户 Passkey
 func (s *Service) AddPasskey(ctx context.Context, credentialID, publicKey, deviceName string) error {
 	passkey := &storage.Passkey{
@@ -634,10 +697,68 @@ func (s *Service) RestoreKeyShare(ctx context.Context, keyID, nodeID string, sha
 }
 
 // RotateKey 密钥轮换（Resharing）
-// 注意：在 V2 架构中，Service 节点不支持密钥轮换功能
-// 密钥轮换应该在 Signer 节点完成
+// 将密钥分片从旧节点集合/门限迁移到新节点集合/门限，公钥和地址保持不变
+// oldNodeIDs 为空时使用 DKG 会话中记录的参与节点，oldThreshold 为 0 时使用密钥当前门限
 func (s *Service) RotateKey(ctx context.Context, keyID string, oldNodeIDs []string, newNodeIDs []string, oldThreshold int, newThreshold int) (*KeyMetadata, error) {
-	return nil, errors.New("key rotation (Resharing) is not supported in Service node. This feature should be implemented in Signer nodes")
+	if s.dkgService == nil {
+		return nil, errors.New("DKG service is required for key resharing")
+	}
+
+	storageKey, err := s.metadataStore.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key metadata")
+	}
+
+	// 派生钱包没有独立分片，分片属于根密钥
+	if parentKeyID, ok := storageKey.Tags["parent_key_id"]; ok && parentKeyID != "" {
+		return nil, errors.Wrapf(ErrDerivedKey, "key %s is derived from %s, reshare the root key instead", keyID, parentKeyID)
+	}
+	if storageKey.Status != storage.KeyStatusActive {
+		return nil, errors.Wrapf(ErrKeyNotActive, "key %s (status=%s)", keyID, storageKey.Status)
+	}
+
+	if len(oldNodeIDs) == 0 {
+		oldNodeIDs, err = s.dkgService.GetParticipatingNodes(ctx, keyID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve current participating nodes")
+		}
+	}
+	if oldThreshold == 0 {
+		oldThreshold = storageKey.Threshold
+	}
+	if len(newNodeIDs) == 0 {
+		return nil, errors.New("new node IDs are required")
+	}
+
+	resp, err := s.dkgService.ExecuteResharing(ctx, keyID, oldNodeIDs, newNodeIDs, oldThreshold, newThreshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute resharing")
+	}
+
+	// 校验公钥未发生变化
+	if respMap, ok := resp.(map[string]interface{}); ok {
+		if pk, ok := respMap["public_key"].(string); ok && pk != "" && !strings.EqualFold(pk, storageKey.PublicKey) {
+			return nil, errors.Errorf("resharing changed public key of %s", keyID)
+		}
+	}
+
+	storageKey.Threshold = newThreshold
+	storageKey.TotalNodes = len(newNodeIDs)
+	storageKey.UpdatedAt = time.Now()
+
+	if err := s.metadataStore.UpdateKeyMetadata(ctx, storageKey); err != nil {
+		return nil, errors.Wrap(err, "failed to update key metadata after resharing")
+	}
+
+	log.Info().
+		Str("key_id", keyID).
+		Strs("old_node_ids", oldNodeIDs).
+		Strs("new_node_ids", newNodeIDs).
+		Int("old_threshold", oldThreshold).
+		Int("new_threshold", newThreshold).
+		Msg("Key reshared successfully")
+
+	return s.GetKey(ctx, keyID)
 }
 
 // DeriveWalletKeyByPath 派生钱包密钥（支持路径）
@@ -697,15 +818,13 @@ func (s *Service) DeriveWalletKeyByPath(ctx context.Context, req *DeriveWalletKe
 	walletPubKey := result.PublicKey
 
 	// 生成地址
-	var adapter chain.Adapter
-	switch req.ChainType {
-	case "bitcoin", "btc":
-		adapter = chain.NewBitcoinAdapter(&chaincfg.MainNetParams)
-	case "ethereum", "eth", "evm":
-		adapter = chain.NewEthereumAdapter(big.NewInt(1), "") // mainnet, rpcEndpoint 暂时为空
-	default:
+	req.Tags = s.newWalletTags(req.ChainType, req.Tags)
+	adapter, bitcoinAddressType, err := s.addressAdapter(req.ChainType, req.Tags)
+	if errors.Is(err, registry.ErrUnknownChain) {
 		// 如果不支持，暂时不生成地址
 		log.Warn().Str("chain_type", req.ChainType).Msg("Unsupported chain type for address generation")
+	} else if err != nil {
+		return nil, err
 	}
 
 	var address string
@@ -728,7 +847,7 @@ func (s *Service) DeriveWalletKeyByPath(ctx context.Context, req *DeriveWalletKe
 		PublicKey:   hex.EncodeToString(walletPubKey),
 		ChainCode:   hex.EncodeToString(result.ChainCode),
 		Address:     address,
-		Status:      "Active",
+		Status:      storage.KeyStatusActive,
 		Description: req.Description,
 		Tags:        req.Tags,
 		CreatedAt:   now,
@@ -742,6 +861,9 @@ func (s *Service) DeriveWalletKeyByPath(ctx context.Context, req *DeriveWalletKe
 	// Record derivation info
 	walletMetadata.Tags["parent_key_id"] = req.RootKeyID
 	walletMetadata.Tags["derivation_path"] = req.Path
+	if bitcoinAddressType != "" {
+		walletMetadata.Tags = setBitcoinAddressType(walletMetadata.Tags, bitcoinAddressType)
+	}
 
 	storageKey := &storage.KeyMetadata{
 		KeyID:       walletMetadata.WalletID,
@@ -764,5 +886,7 @@ func (s *Service) DeriveWalletKeyByPath(ctx context.Context, req *DeriveWalletKe
 		return nil, errors.Wrap(err, "failed to save wallet key metadata")
 	}
 
+	s.recordDerivation(ctx, walletMetadata)
+
 	return walletMetadata, nil
 }
@@ -0,0 +1,116 @@
+package key
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/node"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKeyRejectsDerivedKey(t *testing.T) {
+	store := newFakeMetadataStore(&storage.KeyMetadata{
+		KeyID:     "wallet-1",
+		Status:    storage.KeyStatusActive,
+		Threshold: 2,
+		Tags:      map[string]string{"parent_key_id": "root-1"},
+	})
+	s := NewService(store, nil, &DKGService{})
+
+	_, err := s.RotateKey(context.Background(), "wallet-1", []string{"a", "b"}, []string{"c", "d"}, 2, 2)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrDerivedKey))
+}
+
+func TestRotateKeyRejectsInactiveKey(t *testing.T) {
+	for _, status := range []string{
+		storage.KeyStatusPending,
+		storage.KeyStatusDisabled,
+		storage.KeyStatusPendingDeletion,
+		storage.KeyStatusDeleted,
+		storage.KeyStatusFailed,
+	} {
+		t.Run(status, func(t *testing.T) {
+			store := newFakeMetadataStore(&storage.KeyMetadata{
+				KeyID:     "root-1",
+				Status:    status,
+				Threshold: 2,
+			})
+			s := NewService(store, nil, &DKGService{})
+
+			_, err := s.RotateKey(context.Background(), "root-1", []string{"a", "b"}, []string{"c", "d"}, 2, 2)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrKeyNotActive))
+			assert.False(t, errors.Is(err, ErrDerivedKey))
+		})
+	}
+}
+
+func TestRotateKeyRequiresNewNodes(t *testing.T) {
+	store := newFakeMetadataStore(&storage.KeyMetadata{
+		KeyID:     "root-1",
+		Status:    storage.KeyStatusActive,
+		Threshold: 2,
+	})
+	s := NewService(store, nil, &DKGService{})
+
+	_, err := s.RotateKey(context.Background(), "root-1", []string{"a", "b"}, nil, 0, 2)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrDerivedKey))
+	assert.False(t, errors.Is(err, ErrKeyNotActive))
+
+	// 失败时不得修改密钥元数据
+	k, err := store.GetKeyMetadata(context.Background(), "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, k.Threshold)
+}
+
+func TestRotateKeyRequiresDKGService(t *testing.T) {
+	s := NewService(newFakeMetadataStore(), nil, nil)
+
+	_, err := s.RotateKey(context.Background(), "root-1", nil, []string{"c", "d"}, 0, 2)
+	require.Error(t, err)
+}
+
+// fakeNodeDiscovery 返回固定的活跃 Signer 列表
+type fakeNodeDiscovery struct {
+	signers []string
+}
+
+func (f *fakeNodeDiscovery) DiscoverNodes(_ context.Context, nodeType node.NodeType, status node.NodeStatus, _ int) ([]*node.Node, error) {
+	var nodes []*node.Node
+	if nodeType == node.NodeTypeSigner && status == node.NodeStatusActive {
+		for _, nodeID := range f.signers {
+			nodes = append(nodes, &node.Node{NodeID: nodeID})
+		}
+	}
+	return nodes, nil
+}
+
+func TestValidateNewNodesAgainstDiscovery(t *testing.T) {
+	store := newFakeMetadataStore(&storage.KeyMetadata{KeyID: "root-1", Status: storage.KeyStatusActive, Threshold: 2})
+	store.sessions["root-1"] = &storage.SigningSession{SessionID: "root-1", KeyID: "root-1", ParticipatingNodes: []string{"mobile-p1", "server-signer-p2"}}
+	dkg := &DKGService{metadataStore: store, nodeDiscovery: &fakeNodeDiscovery{signers: []string{"server-signer-p2", "server-signer-p3"}}}
+
+	mobileNodeID, err := dkg.validateNewNodes(context.Background(), "root-1", []string{"mobile-p1", "server-signer-p3"})
+	require.NoError(t, err)
+	assert.Equal(t, "mobile-p1", mobileNodeID)
+
+	// 移动端前缀不能绕过服务发现，只有密钥当前的手机节点可以不是 Signer
+	for _, newNodeIDs := range [][]string{
+		{"mobile-attacker", "server-signer-p3"},
+		{"client-1", "server-signer-p2"},
+		{"server-signer-p4", "server-signer-p2"},
+		{"server-signer-p2", "server-signer-p2"},
+		{"", "server-signer-p2"},
+	} {
+		_, err := dkg.validateNewNodes(context.Background(), "root-1", newNodeIDs)
+		assert.True(t, errors.Is(err, ErrInvalidNewNode), "%v", newNodeIDs)
+	}
+
+	_, err = (&DKGService{metadataStore: store}).validateNewNodes(context.Background(), "root-1", []string{"server-signer-p2"})
+	assert.Error(t, err)
+}
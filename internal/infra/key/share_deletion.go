@@ -0,0 +1,204 @@
+package key
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	pb "github.com/SafeMPC/mpc-service/pb/mpc/v1"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 节点分片删除确认状态
+const (
+	ShareDeletionPending   = "pending"
+	ShareDeletionConfirmed = "confirmed"
+	ShareDeletionFailed    = "failed"
+	// ShareDeletionAwaitingDevice 移动端/客户端节点的分片保存在用户设备上，Service 无法通过 gRPC 触达，
+	// 需要设备删除分片后通过 ConfirmDeviceShareDeletion 确认
+	ShareDeletionAwaitingDevice = "awaiting_device"
+)
+
+var (
+	// ErrNotClientNode 只有移动端/客户端节点需要由设备确认分片删除
+	ErrNotClientNode = errors.New("node is not a client device node")
+	// ErrShareDeletionNotRequested 该节点没有等待设备确认的分片删除请求
+	ErrShareDeletionNotRequested = errors.New("share deletion has not been requested for this node")
+)
+
+// deleteShareTimeout 单个节点 DeleteShare RPC 的超时时间
+const deleteShareTimeout = 30 * time.Second
+
+// DeleteKeyShares 将分片删除请求扇出到 DKG 会话中记录的所有参与节点，并为每个节点保存确认记录
+// 已确认的节点不会重复请求，因此可以重复调用直到所有节点确认
+// 返回尚未确认删除的节点列表，为空表示所有分片均已销毁；移动端/客户端节点在设备确认前始终在列表中
+func (s *DKGService) DeleteKeyShares(ctx context.Context, keyID string, reason string) ([]string, error) {
+	if s.grpcClient == nil {
+		return nil, errors.New("gRPC client is required to delete key shares on signer nodes")
+	}
+
+	nodeIDs, err := s.GetParticipatingNodes(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.metadataStore.ListKeyShareDeletions(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list key share deletions")
+	}
+	records := make(map[string]*storage.KeyShareDeletion, len(existing))
+	for _, record := range existing {
+		records[record.NodeID] = record
+	}
+
+	var unconfirmed []string
+	for _, nodeID := range nodeIDs {
+		now := time.Now()
+		record, ok := records[nodeID]
+		if !ok {
+			record = &storage.KeyShareDeletion{
+				KeyID:       keyID,
+				NodeID:      nodeID,
+				Status:      ShareDeletionPending,
+				RequestedAt: now,
+			}
+		}
+		if record.Status == ShareDeletionConfirmed {
+			continue
+		}
+
+		// 设备节点只能由设备自己确认，确认前一直计为未确认
+		if isClientNode(nodeID) {
+			if record.Status != ShareDeletionAwaitingDevice {
+				record.Status = ShareDeletionAwaitingDevice
+				record.LastError = ""
+				record.UpdatedAt = now
+				if err := s.metadataStore.SaveKeyShareDeletion(ctx, record); err != nil {
+					return nil, errors.Wrapf(err, "failed to save share deletion record for node %s", nodeID)
+				}
+				log.Info().
+					Str("key_id", keyID).
+					Str("node_id", nodeID).
+					Msg("Key share held on client device, awaiting device confirmation")
+			}
+			unconfirmed = append(unconfirmed, nodeID)
+			continue
+		}
+
+		record.UpdatedAt = now
+		record.Attempts++
+		deleted, message, rpcErr := s.sendDeleteShare(ctx, keyID, nodeID, reason)
+		switch {
+		case rpcErr != nil:
+			record.Status = ShareDeletionFailed
+			record.LastError = rpcErr.Error()
+		case !deleted:
+			record.Status = ShareDeletionFailed
+			record.LastError = message
+		default:
+			confirmedAt := time.Now()
+			record.Status = ShareDeletionConfirmed
+			record.LastError = ""
+			record.ConfirmedAt = &confirmedAt
+		}
+
+		if err := s.metadataStore.SaveKeyShareDeletion(ctx, record); err != nil {
+			return nil, errors.Wrapf(err, "failed to save share deletion record for node %s", nodeID)
+		}
+
+		if record.Status == ShareDeletionFailed {
+			log.Warn().
+				Str("key_id", keyID).
+				Str("node_id", nodeID).
+				Int("attempts", record.Attempts).
+				Str("error", record.LastError).
+				Msg("Key share deletion not confirmed by node")
+			unconfirmed = append(unconfirmed, nodeID)
+		}
+	}
+
+	log.Info().
+		Str("key_id", keyID).
+		Strs("node_ids", nodeIDs).
+		Strs("unconfirmed_node_ids", unconfirmed).
+		Msg("Key share deletion fan-out finished")
+
+	return unconfirmed, nil
+}
+
+// ListKeyShareDeletions 列出密钥在各节点的分片删除确认记录
+func (s *DKGService) ListKeyShareDeletions(ctx context.Context, keyID string) ([]*storage.KeyShareDeletion, error) {
+	return s.metadataStore.ListKeyShareDeletions(ctx, keyID)
+}
+
+// ConfirmDeviceShareDeletion 记录移动端/客户端设备已删除本地分片，重复确认直接返回已有记录
+func (s *DKGService) ConfirmDeviceShareDeletion(ctx context.Context, keyID, nodeID string) (*storage.KeyShareDeletion, error) {
+	if !isClientNode(nodeID) {
+		return nil, errors.Wrapf(ErrNotClientNode, "node %s", nodeID)
+	}
+
+	records, err := s.metadataStore.ListKeyShareDeletions(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list key share deletions")
+	}
+
+	var record *storage.KeyShareDeletion
+	for _, r := range records {
+		if r.NodeID == nodeID {
+			record = r
+			break
+		}
+	}
+	if record == nil {
+		return nil, errors.Wrapf(ErrShareDeletionNotRequested, "key %s node %s", keyID, nodeID)
+	}
+	if record.Status == ShareDeletionConfirmed {
+		return record, nil
+	}
+	if record.Status != ShareDeletionAwaitingDevice {
+		return nil, errors.Wrapf(ErrShareDeletionNotRequested, "key %s node %s is %s", keyID, nodeID, record.Status)
+	}
+
+	now := time.Now()
+	record.Status = ShareDeletionConfirmed
+	record.LastError = ""
+	record.UpdatedAt = now
+	record.ConfirmedAt = &now
+	if err := s.metadataStore.SaveKeyShareDeletion(ctx, record); err != nil {
+		return nil, errors.Wrapf(err, "failed to save share deletion record for node %s", nodeID)
+	}
+
+	log.Info().
+		Str("key_id", keyID).
+		Str("node_id", nodeID).
+		Msg("Client device confirmed key share deletion")
+
+	return record, nil
+}
+
+// isClientNode 分片保存在用户设备上的节点（移动端/客户端）
+func isClientNode(nodeID string) bool {
+	return strings.HasPrefix(nodeID, "mobile-") || strings.HasPrefix(nodeID, "client-")
+}
+
+func (s *DKGService) sendDeleteShare(ctx context.Context, keyID, nodeID, reason string) (bool, string, error) {
+	rpcCtx, cancel := context.WithTimeout(ctx, deleteShareTimeout)
+	defer cancel()
+
+	var resp *pb.DeleteShareResponse
+	err := s.retryProtocol(rpcCtx, "delete_share", func() error {
+		var err error
+		resp, err = s.grpcClient.SendDeleteShare(rpcCtx, nodeID, &pb.DeleteShareRequest{
+			KeyId:  keyID,
+			NodeId: nodeID,
+			Reason: reason,
+		})
+		return err
+	})
+	if err != nil {
+		return false, "", err
+	}
+	return resp.Deleted, resp.Message, nil
+}
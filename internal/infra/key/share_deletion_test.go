@@ -0,0 +1,139 @@
+package key
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	pb "github.com/SafeMPC/mpc-service/pb/mpc/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDeleteShareClient 记录 DeleteShare 调用，其余 RPC 不会在这些测试中用到
+type fakeDeleteShareClient struct {
+	dkgGRPCClient
+
+	calls []string
+}
+
+func (f *fakeDeleteShareClient) SendDeleteShare(_ context.Context, nodeID string, _ *pb.DeleteShareRequest) (*pb.DeleteShareResponse, error) {
+	f.calls = append(f.calls, nodeID)
+	return &pb.DeleteShareResponse{Deleted: true}, nil
+}
+
+func newShareDeletionFixture(nodeIDs ...string) (*fakeMetadataStore, *fakeDeleteShareClient, *Service) {
+	past := time.Now().Add(-time.Hour)
+	store := newFakeMetadataStore(&storage.KeyMetadata{
+		KeyID:        "root-1",
+		Status:       storage.KeyStatusPendingDeletion,
+		DeletionDate: &past,
+	})
+	store.sessions["root-1"] = &storage.SigningSession{SessionID: "root-1", KeyID: "root-1", ParticipatingNodes: nodeIDs}
+
+	client := &fakeDeleteShareClient{}
+	dkg := &DKGService{metadataStore: store, grpcClient: client}
+	return store, client, NewService(store, nil, dkg)
+}
+
+func TestDeleteKeySharesKeepsClientNodesUnconfirmed(t *testing.T) {
+	store, client, s := newShareDeletionFixture("mobile-p1", "server-signer-p2")
+
+	unconfirmed, err := s.dkgService.DeleteKeyShares(context.Background(), "root-1", "key deleted")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mobile-p1"}, unconfirmed)
+	assert.Equal(t, []string{"server-signer-p2"}, client.calls)
+
+	deletions, err := store.ListKeyShareDeletions(context.Background(), "root-1")
+	require.NoError(t, err)
+	statuses := map[string]string{}
+	for _, d := range deletions {
+		statuses[d.NodeID] = d.Status
+	}
+	assert.Equal(t, ShareDeletionAwaitingDevice, statuses["mobile-p1"])
+	assert.Equal(t, ShareDeletionConfirmed, statuses["server-signer-p2"])
+
+	// 重复调用不再请求已确认的节点，设备节点仍未确认
+	unconfirmed, err = s.dkgService.DeleteKeyShares(context.Background(), "root-1", "key deleted")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mobile-p1"}, unconfirmed)
+	assert.Len(t, client.calls, 1)
+}
+
+func TestDestroyKeyWaitsForDeviceConfirmation(t *testing.T) {
+	store, _, s := newShareDeletionFixture("mobile-p1", "server-signer-p2")
+	ctx := context.Background()
+
+	storageKey, err := store.GetKeyMetadata(ctx, "root-1")
+	require.NoError(t, err)
+	require.Error(t, s.destroyKey(ctx, storageKey))
+
+	storageKey, err = store.GetKeyMetadata(ctx, "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusPendingDeletion, storageKey.Status)
+
+	deletion, err := s.ConfirmDeviceShareDeletion(ctx, "root-1", "mobile-p1")
+	require.NoError(t, err)
+	assert.Equal(t, ShareDeletionConfirmed, deletion.Status)
+	assert.NotNil(t, deletion.ConfirmedAt)
+
+	require.NoError(t, s.destroyKey(ctx, storageKey))
+	storageKey, err = store.GetKeyMetadata(ctx, "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, storage.KeyStatusDeleted, storageKey.Status)
+}
+
+func TestConfirmDeviceShareDeletionRejections(t *testing.T) {
+	store, _, s := newShareDeletionFixture("mobile-p1", "client-user-1", "server-signer-p2")
+	ctx := context.Background()
+
+	// 尚未发起删除扇出
+	_, err := s.ConfirmDeviceShareDeletion(ctx, "root-1", "mobile-p1")
+	assert.True(t, errors.Is(err, ErrShareDeletionNotRequested))
+
+	_, err = s.dkgService.DeleteKeyShares(ctx, "root-1", "key deleted")
+	require.NoError(t, err)
+
+	// Signer 节点只能通过 DeleteShare RPC 确认
+	_, err = s.ConfirmDeviceShareDeletion(ctx, "root-1", "server-signer-p2")
+	assert.True(t, errors.Is(err, ErrNotClientNode))
+
+	// 重复确认是幂等的
+	_, err = s.ConfirmDeviceShareDeletion(ctx, "root-1", "client-user-1")
+	require.NoError(t, err)
+	_, err = s.ConfirmDeviceShareDeletion(ctx, "root-1", "client-user-1")
+	require.NoError(t, err)
+
+	unconfirmed, err := s.dkgService.DeleteKeyShares(ctx, "root-1", "key deleted")
+	require.NoError(t, err)
+	sort.Strings(unconfirmed)
+	assert.Equal(t, []string{"mobile-p1"}, unconfirmed)
+
+	// 密钥不在删除流程中
+	storageKey, err := store.GetKeyMetadata(ctx, "root-1")
+	require.NoError(t, err)
+	storageKey.Status = storage.KeyStatusDisabled
+	require.NoError(t, store.UpdateKeyMetadata(ctx, storageKey))
+	_, err = s.ConfirmDeviceShareDeletion(ctx, "root-1", "mobile-p1")
+	assert.True(t, errors.Is(err, ErrShareDeletionNotRequested))
+}
+
+func TestGetMobileNodeIDFromDKGSession(t *testing.T) {
+	_, _, s := newShareDeletionFixture("server-signer-p2", "mobile-alice")
+	mobileNodeID, err := s.GetMobileNodeID(context.Background(), "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, "mobile-alice", mobileNodeID)
+
+	// 2-of-2 会话中手机节点排在首位，节点 ID 不一定带移动端前缀
+	_, _, s = newShareDeletionFixture("device-1", "server-signer-p2")
+	mobileNodeID, err = s.GetMobileNodeID(context.Background(), "root-1")
+	require.NoError(t, err)
+	assert.Equal(t, "device-1", mobileNodeID)
+
+	_, _, s = newShareDeletionFixture("signer-1", "signer-2", "signer-3")
+	_, err = s.GetMobileNodeID(context.Background(), "root-1")
+	assert.True(t, errors.Is(err, ErrNoMobileNode))
+}
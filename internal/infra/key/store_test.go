@@ -0,0 +1,212 @@
+package key
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/pkg/errors"
+)
+
+// fakeMetadataStore 内存版 MetadataStore，只实现密钥服务测试用到的方法
+type fakeMetadataStore struct {
+	storage.MetadataStore
+
+	mu        sync.Mutex
+	order     []string
+	keys      map[string]*storage.KeyMetadata
+	refreshes []*storage.KeyRefreshRecord
+	sessions  map[string]*storage.SigningSession
+	deletions map[string]*storage.KeyShareDeletion
+
+	refreshLookups int
+}
+
+func newFakeMetadataStore(keys ...*storage.KeyMetadata) *fakeMetadataStore {
+	f := &fakeMetadataStore{
+		keys:      make(map[string]*storage.KeyMetadata),
+		sessions:  make(map[string]*storage.SigningSession),
+		deletions: make(map[string]*storage.KeyShareDeletion),
+	}
+	for _, k := range keys {
+		f.order = append(f.order, k.KeyID)
+		f.keys[k.KeyID] = k
+	}
+	return f
+}
+
+func (f *fakeMetadataStore) GetKeyMetadata(_ context.Context, keyID string) (*storage.KeyMetadata, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	k, ok := f.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("key %s not found", keyID)
+	}
+	cp := *k
+	return &cp, nil
+}
+
+func (f *fakeMetadataStore) UpdateKeyMetadata(_ context.Context, key *storage.KeyMetadata) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	current, ok := f.keys[key.KeyID]
+	if !ok {
+		return errors.Errorf("key %s not found", key.KeyID)
+	}
+	// 与 PostgreSQLStore 一致：按存储中的当前状态校验迁移
+	if current.Status != key.Status {
+		if err := storage.ValidateKeyStatusTransition(current.Status, key.Status); err != nil {
+			return err
+		}
+	}
+	cp := *key
+	f.keys[key.KeyID] = &cp
+	return nil
+}
+
+func (f *fakeMetadataStore) ListKeys(_ context.Context, filter *storage.KeyFilter) ([]*storage.KeyMetadata, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var matched []*storage.KeyMetadata
+	for _, keyID := range f.order {
+		k := f.keys[keyID]
+		if filter.Status != "" && k.Status != filter.Status {
+			continue
+		}
+		cp := *k
+		matched = append(matched, &cp)
+	}
+
+	if filter.Offset >= len(matched) {
+		return nil, nil
+	}
+	matched = matched[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(matched) {
+		matched = matched[:filter.Limit]
+	}
+	return matched, nil
+}
+
+func (f *fakeMetadataStore) SaveKeyRefreshRecord(_ context.Context, record *storage.KeyRefreshRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	record.ID = int64(len(f.refreshes) + 1)
+	cp := *record
+	f.refreshes = append(f.refreshes, &cp)
+	return nil
+}
+
+func (f *fakeMetadataStore) GetLastSuccessfulKeyRefreshes(_ context.Context, keyIDs []string) (map[string]time.Time, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.refreshLookups++
+	wanted := make(map[string]bool, len(keyIDs))
+	for _, keyID := range keyIDs {
+		wanted[keyID] = true
+	}
+
+	result := make(map[string]time.Time)
+	for _, record := range f.refreshes {
+		if !wanted[record.KeyID] || record.Status != RefreshStatusSuccess || record.CompletedAt == nil {
+			continue
+		}
+		if last, ok := result[record.KeyID]; !ok || record.CompletedAt.After(last) {
+			result[record.KeyID] = *record.CompletedAt
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeMetadataStore) ListKeyRefreshHistory(_ context.Context, keyID string, limit int) ([]*storage.KeyRefreshRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var records []*storage.KeyRefreshRecord
+	for i := len(f.refreshes) - 1; i >= 0 && len(records) < limit; i-- {
+		if f.refreshes[i].KeyID == keyID {
+			records = append(records, f.refreshes[i])
+		}
+	}
+	return records, nil
+}
+
+func (f *fakeMetadataStore) GetSigningSession(_ context.Context, sessionID string) (*storage.SigningSession, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sess, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, errors.Errorf("session %s not found", sessionID)
+	}
+	cp := *sess
+	return &cp, nil
+}
+
+func (f *fakeMetadataStore) SaveKeyShareDeletion(_ context.Context, deletion *storage.KeyShareDeletion) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cp := *deletion
+	f.deletions[deletion.KeyID+"/"+deletion.NodeID] = &cp
+	return nil
+}
+
+func (f *fakeMetadataStore) ListKeyShareDeletions(_ context.Context, keyID string) ([]*storage.KeyShareDeletion, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result []*storage.KeyShareDeletion
+	for _, deletion := range f.deletions {
+		if deletion.KeyID == keyID {
+			cp := *deletion
+			result = append(result, &cp)
+		}
+	}
+	return result, nil
+}
+
+// fakeLockStore 内存版分布式锁
+type fakeLockStore struct {
+	storage.SessionStore
+
+	mu     sync.Mutex
+	owners map[string]string
+}
+
+func newFakeLockStore() *fakeLockStore {
+	return &fakeLockStore{owners: make(map[string]string)}
+}
+
+func (f *fakeLockStore) AcquireLock(_ context.Context, key string, _ time.Duration) (string, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, held := f.owners[key]; held {
+		return "", false, nil
+	}
+	f.owners[key] = "token-" + key
+	return f.owners[key], true, nil
+}
+
+func (f *fakeLockStore) ExtendLock(_ context.Context, key, token string, _ time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.owners[key] == token, nil
+}
+
+func (f *fakeLockStore) ReleaseLock(_ context.Context, key, token string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.owners[key] == token {
+		delete(f.owners, key)
+	}
+	return nil
+}
@@ -41,6 +41,8 @@ type CreateKeyRequest struct {
 	Tags        map[string]string
 	// 2-of-2 模式：手机节点ID（P1）
 	MobileNodeID string
+	// 钱包创建者的 Passkey 凭证 ID（Base64URL），与密钥在同一事务中添加为 owner
+	OwnerCredentialID string
 }
 
 // KeyFilter 密钥过滤条件
@@ -51,4 +53,6 @@ type KeyFilter struct {
 	TagValue  string
 	Limit     int
 	Offset    int
+
+	MemberUserID string // 不为空时只返回该用户的 Passkey 凭证是成员的钱包
 }
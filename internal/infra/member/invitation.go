@@ -0,0 +1,210 @@
+package member
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// DefaultInvitationTTL 邮件邀请的默认有效期
+const DefaultInvitationTTL = 72 * time.Hour
+
+var (
+	// ErrDuplicateInvitation 该邮箱已有未过期的 pending 邀请
+	ErrDuplicateInvitation = errors.New("email already has a pending wallet invitation")
+	// ErrInvitationNotPending 邀请已被接受或撤销
+	ErrInvitationNotPending = errors.New("wallet invitation is not pending")
+	// ErrInvitationExpired 邀请已过期
+	ErrInvitationExpired = errors.New("wallet invitation has expired")
+)
+
+// SetInvitationTTL 设置邮件邀请的有效期，非正数时保持默认值
+func (s *Service) SetInvitationTTL(ttl time.Duration) {
+	if ttl > 0 {
+		s.invitationTTL = ttl
+	}
+}
+
+// InvitationExpired 判断 pending 邀请是否已过期
+func (s *Service) InvitationExpired(invitation *storage.WalletInvitation) bool {
+	return invitation.Status == storage.WalletInvitationStatusPending && !s.now().Before(invitation.ValidUntil)
+}
+
+// Invite owner 邀请邮箱以 role 加入钱包，返回带一次性令牌的邀请，由调用方发送邮件
+func (s *Service) Invite(ctx context.Context, inviter *Membership, walletID, email, role string) (*storage.WalletInvitation, error) {
+	if inviter == nil || inviter.WalletID != walletID {
+		return nil, ErrNotWalletMember
+	}
+	if inviter.Role != storage.WalletRoleOwner {
+		return nil, errors.Wrapf(ErrInsufficientRole, "role %s cannot invite members", inviter.Role)
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return nil, errors.Wrap(ErrInvalidMemberChange, "email is required")
+	}
+	if !ValidRole(role) {
+		return nil, errors.Wrapf(ErrInvalidMemberChange, "invalid role %q", role)
+	}
+
+	invitations, err := s.store.ListWalletInvitations(ctx, walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet invitations")
+	}
+	for _, invitation := range invitations {
+		if invitation.Email == email && invitation.Status == storage.WalletInvitationStatusPending && !s.InvitationExpired(invitation) {
+			return nil, errors.Wrapf(ErrDuplicateInvitation, "invitation %s", invitation.InvitationID)
+		}
+	}
+
+	invitation := &storage.WalletInvitation{
+		InvitationID: uuid.New().String(),
+		WalletID:     walletID,
+		Email:        email,
+		Role:         role,
+		Token:        uuid.New().String(),
+		InvitedBy:    inviter.CredentialID,
+		Status:       storage.WalletInvitationStatusPending,
+		ValidUntil:   s.now().Add(s.invitationTTL),
+	}
+	if err := s.store.SaveWalletInvitation(ctx, invitation); err != nil {
+		return nil, errors.Wrap(err, "failed to save wallet invitation")
+	}
+
+	s.recordInvitation(ctx, audit.OperationInvite, invitation, inviter.CredentialID)
+	return invitation, nil
+}
+
+// ListInvitations 列出钱包的邮件邀请（按创建时间倒序）
+func (s *Service) ListInvitations(ctx context.Context, walletID string) ([]*storage.WalletInvitation, error) {
+	invitations, err := s.store.ListWalletInvitations(ctx, walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet invitations")
+	}
+	return invitations, nil
+}
+
+// RevokeInvitation owner 撤销 pending 的邀请，撤销后邮件中的链接失效
+func (s *Service) RevokeInvitation(ctx context.Context, revoker *Membership, walletID, invitationID string) (*storage.WalletInvitation, error) {
+	if revoker == nil || revoker.WalletID != walletID {
+		return nil, ErrNotWalletMember
+	}
+	if revoker.Role != storage.WalletRoleOwner {
+		return nil, errors.Wrapf(ErrInsufficientRole, "role %s cannot revoke invitations", revoker.Role)
+	}
+
+	invitation, err := s.store.GetWalletInvitation(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.WalletID != walletID {
+		return nil, storage.ErrWalletInvitationNotFound
+	}
+	if invitation.Status != storage.WalletInvitationStatusPending {
+		return nil, errors.Wrapf(ErrInvitationNotPending, "wallet invitation %s is %s", invitationID, invitation.Status)
+	}
+
+	if err := s.store.RevokeWalletInvitation(ctx, invitation); err != nil {
+		if errors.Is(err, storage.ErrWalletInvitationStatusConflict) {
+			return nil, errors.Wrapf(ErrInvitationNotPending, "wallet invitation %s was decided concurrently", invitationID)
+		}
+		return nil, errors.Wrap(err, "failed to revoke wallet invitation")
+	}
+
+	s.recordInvitation(ctx, audit.OperationRevoke, invitation, revoker.CredentialID)
+	return invitation, nil
+}
+
+// AcceptInvitation 受邀人用自己已注册的 Passkey 凭证接受邀请。Passkey 用户没有经过验证的邮箱，
+// 令牌只证明持有邮件链接：viewer 和 approver 邀请直接以邀请的角色加入钱包，返回的变更为 nil；
+// owner 和 admin 邀请改为提出添加成员的变更（由发出邀请的 owner 提出），owner 用 Passkey 批准后才生效
+func (s *Service) AcceptInvitation(ctx context.Context, token, userID, credentialID string) (*storage.WalletInvitation, *storage.WalletMemberChange, error) {
+	if userID == "" {
+		return nil, nil, ErrNotWalletMember
+	}
+
+	invitation, err := s.store.GetWalletInvitationByToken(ctx, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if invitation.Status != storage.WalletInvitationStatusPending {
+		return nil, nil, errors.Wrapf(ErrInvitationNotPending, "wallet invitation %s is %s", invitation.InvitationID, invitation.Status)
+	}
+	if s.InvitationExpired(invitation) {
+		return nil, nil, ErrInvitationExpired
+	}
+
+	passkeys, err := s.store.ListUserPasskeys(ctx, userID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list user passkeys")
+	}
+	owned := false
+	for _, passkey := range passkeys {
+		owned = owned || passkey.CredentialID == credentialID
+	}
+	if !owned {
+		return nil, nil, errors.Wrap(ErrInvalidMemberChange, "the invitation must be accepted with a credential of the caller")
+	}
+
+	isMember, _, err := s.store.IsWalletMember(ctx, invitation.WalletID, credentialID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to check wallet member")
+	}
+	if isMember {
+		return nil, nil, errors.Wrapf(ErrInvalidMemberChange, "credential %s is already a member", credentialID)
+	}
+
+	var change *storage.WalletMemberChange
+	if InvitationRequiresApproval(invitation.Role) {
+		change = &storage.WalletMemberChange{
+			ChangeID:     uuid.New().String(),
+			WalletID:     invitation.WalletID,
+			Action:       storage.WalletMemberChangeActionAdd,
+			CredentialID: credentialID,
+			Role:         invitation.Role,
+			ProposedBy:   invitation.InvitedBy,
+			Status:       storage.WalletMemberChangeStatusPending,
+		}
+		change.ChangeHash = hex.EncodeToString(ChangeHash(change))
+	}
+
+	invitation.AcceptedCredentialID = credentialID
+	if err := s.store.AcceptWalletInvitation(ctx, invitation, change); err != nil {
+		if errors.Is(err, storage.ErrWalletInvitationStatusConflict) {
+			return nil, nil, errors.Wrapf(ErrInvitationNotPending, "wallet invitation %s was revoked, accepted or expired concurrently", invitation.InvitationID)
+		}
+		return nil, nil, errors.Wrap(err, "failed to accept wallet invitation")
+	}
+
+	s.recordInvitation(ctx, audit.OperationAccept, invitation, credentialID)
+	if change != nil {
+		s.record(ctx, audit.OperationSubmit, change, invitation.InvitedBy)
+	}
+	return invitation, change, nil
+}
+
+// InvitationRequiresApproval 判断接受该角色的邀请后是否还需要 owner 批准
+func InvitationRequiresApproval(role string) bool {
+	return RoleAtLeast(role, storage.WalletRoleAdmin)
+}
+
+func (s *Service) recordInvitation(ctx context.Context, operation string, invitation *storage.WalletInvitation, credentialID string) {
+	s.auditService.Record(ctx, audit.Entry{
+		EventType: audit.EventTypeMember,
+		Operation: operation,
+		Result:    audit.ResultSuccess,
+		KeyID:     invitation.WalletID,
+		Details: map[string]interface{}{
+			"invitation_id": invitation.InvitationID,
+			"email":         invitation.Email,
+			"role":          invitation.Role,
+			"status":        invitation.Status,
+			"credential_id": credentialID,
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package member
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+)
+
+func TestInviteAndAccept(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(t)
+	store.members[credential("alice")] = storage.WalletRoleOwner
+	store.members[credential("bob")] = storage.WalletRoleAdmin
+	alice := &Membership{WalletID: walletID, CredentialID: credential("alice"), Role: storage.WalletRoleOwner}
+	bob := &Membership{WalletID: walletID, CredentialID: credential("bob"), Role: storage.WalletRoleAdmin}
+
+	// 只有 owner 能发出邀请
+	_, err := service.Invite(ctx, bob, walletID, "carol@example.com", storage.WalletRoleApprover)
+	assert.ErrorIs(t, err, ErrInsufficientRole)
+	_, err = service.Invite(ctx, nil, walletID, "carol@example.com", storage.WalletRoleApprover)
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+	_, err = service.Invite(ctx, alice, walletID, "carol@example.com", "superuser")
+	assert.ErrorIs(t, err, ErrInvalidMemberChange)
+
+	invitation, err := service.Invite(ctx, alice, walletID, " Carol@Example.com ", storage.WalletRoleApprover)
+	require.NoError(t, err)
+	assert.Equal(t, "carol@example.com", invitation.Email)
+	assert.Equal(t, storage.WalletInvitationStatusPending, invitation.Status)
+	assert.NotEmpty(t, invitation.Token)
+	assert.WithinDuration(t, time.Now().Add(DefaultInvitationTTL), invitation.ValidUntil, time.Minute)
+
+	// 同一邮箱不能重复邀请
+	_, err = service.Invite(ctx, alice, walletID, "carol@example.com", storage.WalletRoleViewer)
+	assert.ErrorIs(t, err, ErrDuplicateInvitation)
+
+	// 必须用调用者自己的凭证接受
+	_, _, err = service.AcceptInvitation(ctx, invitation.Token, "user-carol", credential("bob"))
+	assert.ErrorIs(t, err, ErrInvalidMemberChange)
+	_, _, err = service.AcceptInvitation(ctx, "unknown-token", "user-carol", credential("carol"))
+	assert.ErrorIs(t, err, storage.ErrWalletInvitationNotFound)
+
+	accepted, change, err := service.AcceptInvitation(ctx, invitation.Token, "user-carol", credential("carol"))
+	require.NoError(t, err)
+	assert.Nil(t, change)
+	assert.Equal(t, storage.WalletInvitationStatusAccepted, accepted.Status)
+	assert.Equal(t, storage.WalletRoleApprover, store.members[credential("carol")])
+
+	// 令牌只能使用一次
+	_, _, err = service.AcceptInvitation(ctx, invitation.Token, "user-carol", credential("carol"))
+	assert.ErrorIs(t, err, ErrInvitationNotPending)
+}
+
+func TestAcceptAdminInvitationRequiresOwnerApproval(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(t)
+	store.members[credential("alice")] = storage.WalletRoleOwner
+	alice := &Membership{WalletID: walletID, CredentialID: credential("alice"), Role: storage.WalletRoleOwner}
+
+	invitation, err := service.Invite(ctx, alice, walletID, "carol@example.com", storage.WalletRoleAdmin)
+	require.NoError(t, err)
+
+	// 持有令牌不足以成为 admin，接受后等待 owner 批准
+	accepted, change, err := service.AcceptInvitation(ctx, invitation.Token, "user-carol", credential("carol"))
+	require.NoError(t, err)
+	assert.Equal(t, storage.WalletInvitationStatusAccepted, accepted.Status)
+	require.NotNil(t, change)
+	assert.Equal(t, storage.WalletMemberChangeStatusPending, change.Status)
+	assert.Equal(t, storage.WalletMemberChangeActionAdd, change.Action)
+	assert.Equal(t, storage.WalletRoleAdmin, change.Role)
+	assert.Equal(t, credential("alice"), change.ProposedBy)
+	assert.NotContains(t, store.members, credential("carol"))
+
+	approved, err := service.Approve(ctx, walletID, change.ChangeID, sign("alice", change))
+	require.NoError(t, err)
+	assert.Equal(t, storage.WalletMemberChangeStatusApplied, approved.Status)
+	assert.Equal(t, storage.WalletRoleAdmin, store.members[credential("carol")])
+}
+
+func TestInvitationExpiresAndCanBeRevoked(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(t)
+	store.members[credential("alice")] = storage.WalletRoleOwner
+	alice := &Membership{WalletID: walletID, CredentialID: credential("alice"), Role: storage.WalletRoleOwner}
+	service.SetInvitationTTL(time.Hour)
+
+	expired, err := service.Invite(ctx, alice, walletID, "carol@example.com", storage.WalletRoleViewer)
+	require.NoError(t, err)
+	service.now = func() time.Time { return time.Now().Add(time.Hour) }
+	assert.True(t, service.InvitationExpired(expired))
+	_, _, err = service.AcceptInvitation(ctx, expired.Token, "user-carol", credential("carol"))
+	assert.ErrorIs(t, err, ErrInvitationExpired)
+
+	// 过期的邀请不妨碍重新邀请
+	invitation, err := service.Invite(ctx, alice, walletID, "carol@example.com", storage.WalletRoleViewer)
+	require.NoError(t, err)
+
+	_, err = service.RevokeInvitation(ctx, alice, "wallet-2", invitation.InvitationID)
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+	revoked, err := service.RevokeInvitation(ctx, alice, walletID, invitation.InvitationID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.WalletInvitationStatusRevoked, revoked.Status)
+
+	_, err = service.RevokeInvitation(ctx, alice, walletID, invitation.InvitationID)
+	assert.ErrorIs(t, err, ErrInvitationNotPending)
+	_, _, err = service.AcceptInvitation(ctx, invitation.Token, "user-carol", credential("carol"))
+	assert.ErrorIs(t, err, ErrInvitationNotPending)
+	assert.NotContains(t, store.members, credential("carol"))
+
+	invitations, err := service.ListInvitations(ctx, walletID)
+	require.NoError(t, err)
+	assert.Len(t, invitations, 2)
+}
@@ -0,0 +1,434 @@
+package member
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/audit"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/util"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotWalletMember 调用者或凭证不是钱包成员
+	ErrNotWalletMember = errors.New("not a wallet member")
+	// ErrInsufficientRole 成员角色不允许该操作
+	ErrInsufficientRole = errors.New("wallet member role does not allow this operation")
+	// ErrInvalidMemberChange 成员变更与当前成员不符（如重复添加、移除非成员）
+	ErrInvalidMemberChange = errors.New("invalid wallet member change")
+	// ErrLastOwner 变更会移除钱包的最后一个 owner
+	ErrLastOwner = errors.New("wallet must keep at least one owner")
+	// ErrNotPending 成员变更已被批准或拒绝
+	ErrNotPending = errors.New("wallet member change is not pending")
+	// ErrWalletHasMembers 钱包已有成员，不能再指定初始 owner
+	ErrWalletHasMembers = errors.New("wallet already has members")
+	// ErrInvalidAssertion WebAuthn assertion 校验失败
+	ErrInvalidAssertion = errors.New("invalid webauthn assertion")
+)
+
+// changeHashDomain 成员变更哈希的域分隔前缀，避免与其他用途的 Passkey 签名混用
+const changeHashDomain = "SafeMPC wallet member change"
+
+// roleRanks 角色从低到高的权限等级
+var roleRanks = map[string]int{
+	storage.WalletRoleViewer:   1,
+	storage.WalletRoleApprover: 2,
+	storage.WalletRoleAdmin:    3,
+	storage.WalletRoleOwner:    4,
+}
+
+// ValidRole 判断是否为已定义的成员角色
+func ValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// RoleAtLeast 判断角色是否不低于 minRole，未定义的角色不满足任何要求
+func RoleAtLeast(role, minRole string) bool {
+	rank, ok := roleRanks[role]
+	return ok && rank >= roleRanks[minRole]
+}
+
+// Store 钱包成员和成员变更的存储
+type Store interface {
+	IsWalletMember(ctx context.Context, walletID, credentialID string) (bool, string, error)
+	ListWalletMembers(ctx context.Context, walletID string) ([]*storage.WalletMember, error)
+	ListUserPasskeys(ctx context.Context, userID string) ([]*storage.Passkey, error)
+	GetPasskey(ctx context.Context, credentialID string) (*storage.Passkey, error)
+	SaveWalletMemberChange(ctx context.Context, change *storage.WalletMemberChange) error
+	GetWalletMemberChange(ctx context.Context, changeID string) (*storage.WalletMemberChange, error)
+	ListWalletMemberChanges(ctx context.Context, walletID, status string) ([]*storage.WalletMemberChange, error)
+	DecideWalletMemberChange(ctx context.Context, change *storage.WalletMemberChange) error
+	SaveWalletInvitation(ctx context.Context, invitation *storage.WalletInvitation) error
+	GetWalletInvitation(ctx context.Context, invitationID string) (*storage.WalletInvitation, error)
+	GetWalletInvitationByToken(ctx context.Context, token string) (*storage.WalletInvitation, error)
+	ListWalletInvitations(ctx context.Context, walletID string) ([]*storage.WalletInvitation, error)
+	RevokeWalletInvitation(ctx context.Context, invitation *storage.WalletInvitation) error
+	AcceptWalletInvitation(ctx context.Context, invitation *storage.WalletInvitation, change *storage.WalletMemberChange) error
+}
+
+// AssertionVerifier 校验 Passkey assertion，由 webauthn.Service 实现，credentialID 为原始凭证 ID
+type AssertionVerifier interface {
+	VerifyAssertion(ctx context.Context, credentialID string, challenge []byte, authData []byte, clientDataJSON []byte, signature []byte) error
+}
+
+// Membership 调用者在钱包中的成员身份
+type Membership struct {
+	WalletID     string
+	CredentialID string
+	Role         string
+}
+
+// Assertion owner 对成员变更哈希的 WebAuthn assertion，challenge 为 Base64URL（无填充）编码的变更哈希
+type Assertion struct {
+	CredentialID      string // Base64URL（无填充）编码的凭证 ID
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+	Signature         []byte
+}
+
+// Service 钱包成员管理：admin 以上的成员提出成员变更，owner 用 Passkey 批准后才生效；
+// 钱包创建时创建者即成为 owner，没有成员的存量钱包只能由运维通过 AssignOwner 指定 owner；
+// owner 也可以通过邮件邀请新成员，受邀人接受后直接加入
+type Service struct {
+	store         Store
+	verifier      AssertionVerifier
+	auditService  *audit.Service
+	invitationTTL time.Duration
+	now           func() time.Time
+}
+
+// NewService 创建钱包成员管理服务
+func NewService(store Store, verifier AssertionVerifier) *Service {
+	return &Service{
+		store:         store,
+		verifier:      verifier,
+		invitationTTL: DefaultInvitationTTL,
+		now:           time.Now,
+	}
+}
+
+// SetAuditService 设置审计服务，成员变更的提出和审批写入审计日志
+func (s *Service) SetAuditService(auditService *audit.Service) {
+	s.auditService = auditService
+}
+
+// WithMembership 把调用者的成员身份保存到 context
+func WithMembership(ctx context.Context, membership *Membership) context.Context {
+	return context.WithValue(ctx, util.CTXKeyWalletMember, membership)
+}
+
+// MembershipFromContext 返回 WalletRole 中间件解析的成员身份，没有经过 WalletRole 时为 nil
+func MembershipFromContext(ctx context.Context) *Membership {
+	membership, _ := ctx.Value(util.CTXKeyWalletMember).(*Membership)
+	return membership
+}
+
+// ChangeHash 计算成员变更的哈希，owner 审批时对其进行 WebAuthn 签名
+func ChangeHash(change *storage.WalletMemberChange) []byte {
+	fields := []string{
+		changeHashDomain,
+		change.ChangeID,
+		change.WalletID,
+		change.Action,
+		change.CredentialID,
+		change.Role,
+	}
+	hash := sha256.Sum256([]byte(strings.Join(fields, "\n")))
+	return hash[:]
+}
+
+// Resolve 按用户的 Passkey 凭证解析其在钱包中的成员身份，多个凭证是成员时取最高角色；
+// 用户不是成员（包括钱包没有成员或 userID 为空）时返回 ErrNotWalletMember
+func (s *Service) Resolve(ctx context.Context, walletID, userID string) (*Membership, error) {
+	if userID == "" {
+		return nil, ErrNotWalletMember
+	}
+	members, err := s.store.ListWalletMembers(ctx, walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet members")
+	}
+
+	passkeys, err := s.store.ListUserPasskeys(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user passkeys")
+	}
+	credentials := make(map[string]bool, len(passkeys))
+	for _, passkey := range passkeys {
+		credentials[passkey.CredentialID] = true
+	}
+
+	var membership *Membership
+	for _, member := range members {
+		if !credentials[member.CredentialID] {
+			continue
+		}
+		if membership == nil || roleRanks[member.Role] > roleRanks[membership.Role] {
+			membership = &Membership{WalletID: walletID, CredentialID: member.CredentialID, Role: member.Role}
+		}
+	}
+	if membership == nil {
+		return nil, ErrNotWalletMember
+	}
+	return membership, nil
+}
+
+// List 列出钱包成员
+func (s *Service) List(ctx context.Context, walletID string) ([]*storage.WalletMember, error) {
+	members, err := s.store.ListWalletMembers(ctx, walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet members")
+	}
+	return members, nil
+}
+
+// ListChanges 列出钱包的成员变更请求（按创建时间倒序），status 为空时不过滤
+func (s *Service) ListChanges(ctx context.Context, walletID, status string) ([]*storage.WalletMemberChange, error) {
+	changes, err := s.store.ListWalletMemberChanges(ctx, walletID, status)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet member changes")
+	}
+	return changes, nil
+}
+
+// Propose 提出成员变更，变更在 owner 批准后生效；proposer 为 WalletRole 解析的调用者成员身份
+func (s *Service) Propose(ctx context.Context, proposer *Membership, change *storage.WalletMemberChange) (*storage.WalletMemberChange, error) {
+	if err := validateChange(change); err != nil {
+		return nil, err
+	}
+	if proposer == nil || proposer.WalletID != change.WalletID {
+		return nil, ErrNotWalletMember
+	}
+	if !RoleAtLeast(proposer.Role, storage.WalletRoleAdmin) {
+		return nil, errors.Wrapf(ErrInsufficientRole, "role %s cannot change members", proposer.Role)
+	}
+
+	members, err := s.store.ListWalletMembers(ctx, change.WalletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet members")
+	}
+	if err := checkChange(members, change); err != nil {
+		return nil, err
+	}
+	if change.Action == storage.WalletMemberChangeActionAdd {
+		if _, err := s.store.GetPasskey(ctx, change.CredentialID); err != nil {
+			return nil, errors.Wrapf(ErrInvalidMemberChange, "unknown credential %s", change.CredentialID)
+		}
+	}
+
+	change.ChangeID = uuid.New().String()
+	change.ProposedBy = proposer.CredentialID
+	change.Status = storage.WalletMemberChangeStatusPending
+	change.ChangeHash = hex.EncodeToString(ChangeHash(change))
+	if err := s.store.SaveWalletMemberChange(ctx, change); err != nil {
+		return nil, errors.Wrap(err, "failed to save wallet member change")
+	}
+
+	s.record(ctx, audit.OperationSubmit, change, proposer.CredentialID)
+	return change, nil
+}
+
+// Approve owner 批准成员变更，变更立即生效
+func (s *Service) Approve(ctx context.Context, walletID, changeID string, assertion *Assertion) (*storage.WalletMemberChange, error) {
+	return s.decide(ctx, walletID, changeID, assertion, true)
+}
+
+// Reject owner 拒绝成员变更
+func (s *Service) Reject(ctx context.Context, walletID, changeID string, assertion *Assertion) (*storage.WalletMemberChange, error) {
+	return s.decide(ctx, walletID, changeID, assertion, false)
+}
+
+func (s *Service) decide(ctx context.Context, walletID, changeID string, assertion *Assertion, approve bool) (*storage.WalletMemberChange, error) {
+	change, err := s.store.GetWalletMemberChange(ctx, changeID)
+	if err != nil {
+		return nil, err
+	}
+	if change.WalletID != walletID {
+		return nil, storage.ErrWalletMemberChangeNotFound
+	}
+	if change.Status != storage.WalletMemberChangeStatusPending {
+		return nil, errors.Wrapf(ErrNotPending, "wallet member change %s is %s", changeID, change.Status)
+	}
+	if err := s.verifyOwnerAssertion(ctx, change, assertion); err != nil {
+		return nil, err
+	}
+
+	operation := audit.OperationReject
+	change.Status = storage.WalletMemberChangeStatusRejected
+	if approve {
+		// 提出变更后成员可能已被修改，生效前重新检查
+		members, err := s.store.ListWalletMembers(ctx, walletID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list wallet members")
+		}
+		if err := checkChange(members, change); err != nil {
+			return nil, err
+		}
+		operation = audit.OperationApprove
+		change.Status = storage.WalletMemberChangeStatusApplied
+	}
+	change.DecidedBy = assertion.CredentialID
+
+	if err := s.store.DecideWalletMemberChange(ctx, change); err != nil {
+		if errors.Is(err, storage.ErrWalletMemberChangeStatusConflict) {
+			return nil, errors.Wrapf(ErrNotPending, "wallet member change %s was decided concurrently", changeID)
+		}
+		return nil, errors.Wrap(err, "failed to decide wallet member change")
+	}
+
+	s.record(ctx, operation, change, assertion.CredentialID)
+	return change, nil
+}
+
+// AssignOwner 把 userID 的 Passkey 凭证 credentialID 指定为没有成员的钱包的 owner，变更立即生效。
+// 只用于创建者没有成为 owner 的存量钱包，由运维通过 wallet assign-owner 命令执行，不对外暴露 API；
+// 钱包已有成员时返回 ErrWalletHasMembers，成员变更必须走 Propose 和 owner 审批
+func (s *Service) AssignOwner(ctx context.Context, walletID, userID, credentialID string) (*storage.WalletMemberChange, error) {
+	members, err := s.store.ListWalletMembers(ctx, walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet members")
+	}
+	if len(members) > 0 {
+		return nil, ErrWalletHasMembers
+	}
+
+	passkeys, err := s.store.ListUserPasskeys(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user passkeys")
+	}
+	owned := false
+	for _, passkey := range passkeys {
+		owned = owned || passkey.CredentialID == credentialID
+	}
+	if !owned {
+		return nil, errors.Wrapf(ErrInvalidMemberChange, "credential %s does not belong to user %s", credentialID, userID)
+	}
+
+	change := &storage.WalletMemberChange{
+		ChangeID:     uuid.New().String(),
+		WalletID:     walletID,
+		Action:       storage.WalletMemberChangeActionAdd,
+		CredentialID: credentialID,
+		Role:         storage.WalletRoleOwner,
+		ProposedBy:   credentialID,
+		Status:       storage.WalletMemberChangeStatusPending,
+	}
+	change.ChangeHash = hex.EncodeToString(ChangeHash(change))
+	if err := s.store.SaveWalletMemberChange(ctx, change); err != nil {
+		return nil, errors.Wrap(err, "failed to save wallet member change")
+	}
+	change.Status = storage.WalletMemberChangeStatusApplied
+	change.DecidedBy = credentialID
+	if err := s.store.DecideWalletMemberChange(ctx, change); err != nil {
+		return nil, errors.Wrap(err, "failed to apply wallet member change")
+	}
+
+	s.record(ctx, audit.OperationCreate, change, credentialID)
+	return change, nil
+}
+
+// verifyOwnerAssertion 校验审批凭证是钱包的 owner，并且 assertion 是该凭证对变更哈希的签名
+func (s *Service) verifyOwnerAssertion(ctx context.Context, change *storage.WalletMemberChange, assertion *Assertion) error {
+	if assertion == nil || assertion.CredentialID == "" {
+		return errors.Wrap(ErrInvalidAssertion, "credential id is required")
+	}
+
+	isMember, role, err := s.store.IsWalletMember(ctx, change.WalletID, assertion.CredentialID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check wallet member")
+	}
+	if !isMember {
+		return ErrNotWalletMember
+	}
+	if role != storage.WalletRoleOwner {
+		return errors.Wrapf(ErrInsufficientRole, "only owners can decide member changes, credential is %s", role)
+	}
+
+	credentialID, err := base64.RawURLEncoding.DecodeString(assertion.CredentialID)
+	if err != nil {
+		return errors.Wrap(ErrInvalidAssertion, "malformed credential id")
+	}
+	if err := s.verifier.VerifyAssertion(ctx, string(credentialID), ChangeHash(change),
+		assertion.AuthenticatorData, assertion.ClientDataJSON, assertion.Signature); err != nil {
+		return errors.Wrap(ErrInvalidAssertion, err.Error())
+	}
+	return nil
+}
+
+func (s *Service) record(ctx context.Context, operation string, change *storage.WalletMemberChange, credentialID string) {
+	s.auditService.Record(ctx, audit.Entry{
+		EventType: audit.EventTypeMember,
+		Operation: operation,
+		Result:    audit.ResultSuccess,
+		KeyID:     change.WalletID,
+		Details: map[string]interface{}{
+			"change_id":     change.ChangeID,
+			"action":        change.Action,
+			"member":        change.CredentialID,
+			"role":          change.Role,
+			"status":        change.Status,
+			"credential_id": credentialID,
+		},
+	})
+}
+
+func validateChange(change *storage.WalletMemberChange) error {
+	if change.CredentialID == "" {
+		return errors.Wrap(ErrInvalidMemberChange, "credential id is required")
+	}
+	switch change.Action {
+	case storage.WalletMemberChangeActionAdd, storage.WalletMemberChangeActionUpdateRole:
+		if !ValidRole(change.Role) {
+			return errors.Wrapf(ErrInvalidMemberChange, "invalid role %q", change.Role)
+		}
+	case storage.WalletMemberChangeActionRemove:
+		change.Role = ""
+	default:
+		return errors.Wrapf(ErrInvalidMemberChange, "invalid action %q", change.Action)
+	}
+	return nil
+}
+
+// checkChange 检查变更与当前成员一致，并且变更后至少保留一个 owner
+func checkChange(members []*storage.WalletMember, change *storage.WalletMemberChange) error {
+	var current *storage.WalletMember
+	owners := 0
+	for _, member := range members {
+		if member.CredentialID == change.CredentialID {
+			current = member
+		}
+		if member.Role == storage.WalletRoleOwner {
+			owners++
+		}
+	}
+
+	switch change.Action {
+	case storage.WalletMemberChangeActionAdd:
+		if current != nil {
+			return errors.Wrapf(ErrInvalidMemberChange, "credential %s is already a member", change.CredentialID)
+		}
+		return nil
+	case storage.WalletMemberChangeActionUpdateRole:
+		if current == nil {
+			return errors.Wrapf(ErrInvalidMemberChange, "credential %s is not a member", change.CredentialID)
+		}
+		if current.Role == change.Role {
+			return errors.Wrapf(ErrInvalidMemberChange, "credential %s already has role %s", change.CredentialID, change.Role)
+		}
+	case storage.WalletMemberChangeActionRemove:
+		if current == nil {
+			return errors.Wrapf(ErrInvalidMemberChange, "credential %s is not a member", change.CredentialID)
+		}
+	}
+
+	if current.Role == storage.WalletRoleOwner && owners <= 1 {
+		return ErrLastOwner
+	}
+	return nil
+}
@@ -0,0 +1,371 @@
+package member
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+)
+
+const walletID = "wallet-1"
+
+type memoryStore struct {
+	mu       sync.Mutex
+	members  map[string]string // credentialID -> role
+	passkeys map[string]string // credentialID -> userID
+	changes  map[string]*storage.WalletMemberChange
+
+	invitations map[string]*storage.WalletInvitation
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		members:  map[string]string{},
+		passkeys: map[string]string{},
+		changes:  map[string]*storage.WalletMemberChange{},
+
+		invitations: map[string]*storage.WalletInvitation{},
+	}
+}
+
+func (m *memoryStore) IsWalletMember(ctx context.Context, walletID, credentialID string) (bool, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	role, ok := m.members[credentialID]
+	return ok, role, nil
+}
+
+func (m *memoryStore) ListWalletMembers(ctx context.Context, walletID string) ([]*storage.WalletMember, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var members []*storage.WalletMember
+	for credentialID, role := range m.members {
+		members = append(members, &storage.WalletMember{WalletID: walletID, CredentialID: credentialID, Role: role})
+	}
+	return members, nil
+}
+
+func (m *memoryStore) ListUserPasskeys(ctx context.Context, userID string) ([]*storage.Passkey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var passkeys []*storage.Passkey
+	for credentialID, owner := range m.passkeys {
+		if owner == userID {
+			passkeys = append(passkeys, &storage.Passkey{CredentialID: credentialID})
+		}
+	}
+	return passkeys, nil
+}
+
+func (m *memoryStore) GetPasskey(ctx context.Context, credentialID string) (*storage.Passkey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.passkeys[credentialID]; !ok {
+		return nil, errors.New("passkey not found")
+	}
+	return &storage.Passkey{CredentialID: credentialID}, nil
+}
+
+func (m *memoryStore) SaveWalletMemberChange(ctx context.Context, change *storage.WalletMemberChange) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *change
+	m.changes[change.ChangeID] = &saved
+	return nil
+}
+
+func (m *memoryStore) GetWalletMemberChange(ctx context.Context, changeID string) (*storage.WalletMemberChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	change, ok := m.changes[changeID]
+	if !ok {
+		return nil, storage.ErrWalletMemberChangeNotFound
+	}
+	found := *change
+	return &found, nil
+}
+
+func (m *memoryStore) ListWalletMemberChanges(ctx context.Context, walletID, status string) ([]*storage.WalletMemberChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var changes []*storage.WalletMemberChange
+	for _, change := range m.changes {
+		if change.WalletID == walletID && (status == "" || change.Status == status) {
+			found := *change
+			changes = append(changes, &found)
+		}
+	}
+	return changes, nil
+}
+
+func (m *memoryStore) DecideWalletMemberChange(ctx context.Context, change *storage.WalletMemberChange) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, ok := m.changes[change.ChangeID]
+	if !ok || current.Status != storage.WalletMemberChangeStatusPending {
+		return storage.ErrWalletMemberChangeStatusConflict
+	}
+	current.Status = change.Status
+	current.DecidedBy = change.DecidedBy
+	if change.Status != storage.WalletMemberChangeStatusApplied {
+		return nil
+	}
+	if change.Action == storage.WalletMemberChangeActionRemove {
+		delete(m.members, change.CredentialID)
+	} else {
+		m.members[change.CredentialID] = change.Role
+	}
+	return nil
+}
+
+func (m *memoryStore) SaveWalletInvitation(ctx context.Context, invitation *storage.WalletInvitation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *invitation
+	m.invitations[invitation.InvitationID] = &saved
+	return nil
+}
+
+func (m *memoryStore) GetWalletInvitation(ctx context.Context, invitationID string) (*storage.WalletInvitation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	invitation, ok := m.invitations[invitationID]
+	if !ok {
+		return nil, storage.ErrWalletInvitationNotFound
+	}
+	found := *invitation
+	return &found, nil
+}
+
+func (m *memoryStore) GetWalletInvitationByToken(ctx context.Context, token string) (*storage.WalletInvitation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, invitation := range m.invitations {
+		if invitation.Token == token {
+			found := *invitation
+			return &found, nil
+		}
+	}
+	return nil, storage.ErrWalletInvitationNotFound
+}
+
+func (m *memoryStore) ListWalletInvitations(ctx context.Context, walletID string) ([]*storage.WalletInvitation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var invitations []*storage.WalletInvitation
+	for _, invitation := range m.invitations {
+		if invitation.WalletID == walletID {
+			found := *invitation
+			invitations = append(invitations, &found)
+		}
+	}
+	return invitations, nil
+}
+
+func (m *memoryStore) RevokeWalletInvitation(ctx context.Context, invitation *storage.WalletInvitation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, ok := m.invitations[invitation.InvitationID]
+	if !ok || current.Status != storage.WalletInvitationStatusPending {
+		return storage.ErrWalletInvitationStatusConflict
+	}
+	current.Status = storage.WalletInvitationStatusRevoked
+	invitation.Status = current.Status
+	return nil
+}
+
+func (m *memoryStore) AcceptWalletInvitation(ctx context.Context, invitation *storage.WalletInvitation, change *storage.WalletMemberChange) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, ok := m.invitations[invitation.InvitationID]
+	if !ok || current.Status != storage.WalletInvitationStatusPending {
+		return storage.ErrWalletInvitationStatusConflict
+	}
+	current.Status = storage.WalletInvitationStatusAccepted
+	current.AcceptedCredentialID = invitation.AcceptedCredentialID
+	invitation.Status = current.Status
+	if change != nil {
+		saved := *change
+		m.changes[change.ChangeID] = &saved
+		return nil
+	}
+	m.members[invitation.AcceptedCredentialID] = invitation.Role
+	return nil
+}
+
+// fakeVerifier 只接受对期望 challenge 的 assertion，签名内容用 challenge 本身代替
+type fakeVerifier struct{}
+
+func (fakeVerifier) VerifyAssertion(ctx context.Context, credentialID string, challenge []byte, authData []byte, clientDataJSON []byte, signature []byte) error {
+	if string(signature) != string(challenge) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func credential(name string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(name))
+}
+
+func sign(name string, change *storage.WalletMemberChange) *Assertion {
+	return &Assertion{CredentialID: credential(name), Signature: ChangeHash(change)}
+}
+
+func newTestService(t *testing.T) (*Service, *memoryStore) {
+	store := newMemoryStore()
+	store.passkeys[credential("alice")] = "user-alice"
+	store.passkeys[credential("bob")] = "user-bob"
+	store.passkeys[credential("carol")] = "user-carol"
+	return NewService(store, fakeVerifier{}), store
+}
+
+func TestResolveWithoutMembers(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(t)
+
+	// 没有成员的钱包不放行任何调用者
+	_, err := service.Resolve(ctx, walletID, "user-alice")
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+	_, err = service.Resolve(ctx, walletID, "")
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+
+	_, err = service.Propose(ctx, nil, &storage.WalletMemberChange{
+		WalletID: walletID, Action: storage.WalletMemberChangeActionAdd, CredentialID: credential("alice"), Role: storage.WalletRoleOwner,
+	})
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+}
+
+func TestAssignOwner(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(t)
+
+	// 只能指定用户自己的凭证
+	_, err := service.AssignOwner(ctx, walletID, "user-alice", credential("bob"))
+	assert.ErrorIs(t, err, ErrInvalidMemberChange)
+
+	change, err := service.AssignOwner(ctx, walletID, "user-alice", credential("alice"))
+	require.NoError(t, err)
+	assert.Equal(t, storage.WalletMemberChangeStatusApplied, change.Status)
+	assert.Equal(t, storage.WalletRoleOwner, store.members[credential("alice")])
+
+	membership, err := service.Resolve(ctx, walletID, "user-alice")
+	require.NoError(t, err)
+	assert.Equal(t, storage.WalletRoleOwner, membership.Role)
+	_, err = service.Resolve(ctx, walletID, "user-bob")
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+
+	// 已有成员的钱包不能再指定 owner
+	_, err = service.AssignOwner(ctx, walletID, "user-bob", credential("bob"))
+	assert.ErrorIs(t, err, ErrWalletHasMembers)
+	assert.NotContains(t, store.members, credential("bob"))
+}
+
+func TestProposeRequiresOwnerApproval(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(t)
+	store.members[credential("alice")] = storage.WalletRoleOwner
+	store.members[credential("bob")] = storage.WalletRoleAdmin
+	bob := &Membership{WalletID: walletID, CredentialID: credential("bob"), Role: storage.WalletRoleAdmin}
+
+	change, err := service.Propose(ctx, bob, &storage.WalletMemberChange{
+		WalletID: walletID, Action: storage.WalletMemberChangeActionAdd, CredentialID: credential("carol"), Role: storage.WalletRoleApprover,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, storage.WalletMemberChangeStatusPending, change.Status)
+	assert.NotContains(t, store.members, credential("carol"))
+
+	// 只有 owner 能审批，且 assertion 必须是对变更哈希的签名
+	_, err = service.Approve(ctx, walletID, change.ChangeID, sign("bob", change))
+	assert.ErrorIs(t, err, ErrInsufficientRole)
+	_, err = service.Approve(ctx, walletID, change.ChangeID, &Assertion{CredentialID: credential("alice"), Signature: []byte("forged")})
+	assert.ErrorIs(t, err, ErrInvalidAssertion)
+	_, err = service.Approve(ctx, "wallet-2", change.ChangeID, sign("alice", change))
+	assert.ErrorIs(t, err, storage.ErrWalletMemberChangeNotFound)
+
+	approved, err := service.Approve(ctx, walletID, change.ChangeID, sign("alice", change))
+	require.NoError(t, err)
+	assert.Equal(t, storage.WalletMemberChangeStatusApplied, approved.Status)
+	assert.Equal(t, storage.WalletRoleApprover, store.members[credential("carol")])
+
+	_, err = service.Reject(ctx, walletID, change.ChangeID, sign("alice", change))
+	assert.ErrorIs(t, err, ErrNotPending)
+
+	// approver 不能提出成员变更
+	carol := &Membership{WalletID: walletID, CredentialID: credential("carol"), Role: storage.WalletRoleApprover}
+	_, err = service.Propose(ctx, carol, &storage.WalletMemberChange{
+		WalletID: walletID, Action: storage.WalletMemberChangeActionRemove, CredentialID: credential("bob"),
+	})
+	assert.ErrorIs(t, err, ErrInsufficientRole)
+}
+
+func TestRejectLeavesMembersUnchanged(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(t)
+	store.members[credential("alice")] = storage.WalletRoleOwner
+	store.members[credential("bob")] = storage.WalletRoleViewer
+	alice := &Membership{WalletID: walletID, CredentialID: credential("alice"), Role: storage.WalletRoleOwner}
+
+	change, err := service.Propose(ctx, alice, &storage.WalletMemberChange{
+		WalletID: walletID, Action: storage.WalletMemberChangeActionUpdateRole, CredentialID: credential("bob"), Role: storage.WalletRoleAdmin,
+	})
+	require.NoError(t, err)
+
+	rejected, err := service.Reject(ctx, walletID, change.ChangeID, sign("alice", change))
+	require.NoError(t, err)
+	assert.Equal(t, storage.WalletMemberChangeStatusRejected, rejected.Status)
+	assert.Equal(t, storage.WalletRoleViewer, store.members[credential("bob")])
+
+	pending, err := service.ListChanges(ctx, walletID, storage.WalletMemberChangeStatusPending)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestProposeKeepsLastOwner(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(t)
+	store.members[credential("alice")] = storage.WalletRoleOwner
+	store.members[credential("bob")] = storage.WalletRoleAdmin
+	alice := &Membership{WalletID: walletID, CredentialID: credential("alice"), Role: storage.WalletRoleOwner}
+
+	_, err := service.Propose(ctx, alice, &storage.WalletMemberChange{
+		WalletID: walletID, Action: storage.WalletMemberChangeActionRemove, CredentialID: credential("alice"),
+	})
+	assert.ErrorIs(t, err, ErrLastOwner)
+	_, err = service.Propose(ctx, alice, &storage.WalletMemberChange{
+		WalletID: walletID, Action: storage.WalletMemberChangeActionUpdateRole, CredentialID: credential("alice"), Role: storage.WalletRoleAdmin,
+	})
+	assert.ErrorIs(t, err, ErrLastOwner)
+
+	// 重复添加和未知凭证
+	_, err = service.Propose(ctx, alice, &storage.WalletMemberChange{
+		WalletID: walletID, Action: storage.WalletMemberChangeActionAdd, CredentialID: credential("bob"), Role: storage.WalletRoleViewer,
+	})
+	assert.ErrorIs(t, err, ErrInvalidMemberChange)
+	_, err = service.Propose(ctx, alice, &storage.WalletMemberChange{
+		WalletID: walletID, Action: storage.WalletMemberChangeActionAdd, CredentialID: credential("mallory"), Role: storage.WalletRoleViewer,
+	})
+	assert.ErrorIs(t, err, ErrInvalidMemberChange)
+}
+
+func TestResolvePicksHighestRole(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(t)
+	store.passkeys[credential("alice-laptop")] = "user-alice"
+	store.members[credential("alice")] = storage.WalletRoleViewer
+	store.members[credential("alice-laptop")] = storage.WalletRoleAdmin
+
+	membership, err := service.Resolve(ctx, walletID, "user-alice")
+	require.NoError(t, err)
+	assert.Equal(t, credential("alice-laptop"), membership.CredentialID)
+	assert.Equal(t, storage.WalletRoleAdmin, membership.Role)
+
+	assert.True(t, RoleAtLeast(storage.WalletRoleOwner, storage.WalletRoleApprover))
+	assert.False(t, RoleAtLeast(storage.WalletRoleViewer, storage.WalletRoleApprover))
+	assert.False(t, RoleAtLeast("superuser", storage.WalletRoleViewer))
+}
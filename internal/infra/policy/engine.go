@@ -0,0 +1,344 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrDenied 签名策略拒绝了该请求
+	ErrDenied = errors.New("signing denied by policy")
+	// ErrApprovalRequired 签名策略要求该请求经过审批
+	ErrApprovalRequired = errors.New("signing requires approval")
+)
+
+// nativeAsset 规则和请求中表示原生代币的资产名称
+const nativeAsset = "native"
+
+// Store 读取钱包签名策略
+type Store interface {
+	GetSigningPolicy(ctx context.Context, walletID string) (*storage.SigningPolicy, error)
+}
+
+// Request 策略评估的输入，交易字段均为可选
+type Request struct {
+	WalletID    string
+	ChainType   string
+	Destination string   // 目标地址
+	Amount      *big.Int // 金额（链上最小单位）
+	Asset       string   // 为空或 native 表示原生代币，代币为符号或合约地址
+}
+
+// Engine 签名策略引擎：按顺序评估钱包策略的规则，第一条匹配的规则决定结果，
+// 没有规则匹配时使用策略的默认结果，钱包未配置策略时允许签名
+type Engine struct {
+	store  Store
+	chains *registry.Registry
+	now    func() time.Time
+}
+
+// NewEngine 创建策略引擎，chains 用于把链别名和代币符号解析为统一的名称和地址，可以为空
+func NewEngine(store Store, chains *registry.Registry) *Engine {
+	return &Engine{
+		store:  store,
+		chains: chains,
+		now:    time.Now,
+	}
+}
+
+// Evaluate 评估签名请求；策略配置无效时返回错误，调用方不应继续签名
+func (e *Engine) Evaluate(ctx context.Context, req *Request) (*storage.PolicyDecision, error) {
+	if req == nil {
+		return nil, errors.New("policy request is nil")
+	}
+
+	policy, err := e.store.GetSigningPolicy(ctx, req.WalletID)
+	if err != nil && !errors.Is(err, storage.ErrSigningPolicyNotFound) {
+		return nil, errors.Wrapf(err, "failed to get signing policy for wallet %s", req.WalletID)
+	}
+
+	now := e.now()
+	decision := &storage.PolicyDecision{
+		RuleIndex:   -1,
+		ChainType:   req.ChainType,
+		Destination: req.Destination,
+		Asset:       req.Asset,
+		EvaluatedAt: now,
+	}
+	if req.Amount != nil {
+		decision.Amount = req.Amount.String()
+	}
+
+	if policy == nil {
+		decision.Action = storage.PolicyActionAllow
+		decision.Reason = "no signing policy configured"
+		return decision, nil
+	}
+	if err := Validate(policy); err != nil {
+		return nil, errors.Wrapf(err, "invalid signing policy for wallet %s", req.WalletID)
+	}
+
+	chainCfg := e.lookupChain(req.ChainType)
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		if !e.ruleMatches(rule, req, chainCfg, now) {
+			continue
+		}
+		decision.Action = rule.Action
+		decision.Rule = rule.Name
+		decision.RuleIndex = i
+		if rule.Name != "" {
+			decision.Reason = fmt.Sprintf("matched rule %q", rule.Name)
+		} else {
+			decision.Reason = fmt.Sprintf("matched rule #%d", i)
+		}
+		requireApprovals(policy, decision)
+		return decision, nil
+	}
+
+	decision.Action = policy.DefaultAction
+	if decision.Action == "" {
+		decision.Action = storage.PolicyActionAllow
+	}
+	decision.Reason = "no rule matched, default action " + decision.Action
+	requireApprovals(policy, decision)
+	return decision, nil
+}
+
+// requireApprovals 设置需要的成员审批数：team 钱包允许的签名也需要 MinSignatures 个审批，
+// require_approval 至少需要一个审批
+func requireApprovals(policy *storage.SigningPolicy, decision *storage.PolicyDecision) {
+	if decision.Action == storage.PolicyActionAllow && policy.PolicyType == storage.PolicyTypeTeam && policy.MinSignatures > 0 {
+		decision.Action = storage.PolicyActionRequireApproval
+		decision.Reason += fmt.Sprintf(", team wallet requires %d approvals", policy.MinSignatures)
+	}
+	if decision.Action == storage.PolicyActionRequireApproval {
+		decision.RequiredApprovals = max(policy.MinSignatures, 1)
+	}
+}
+
+// DecisionError 把不允许签名的评估结果转换为 ErrDenied 或 ErrApprovalRequired
+func DecisionError(decision *storage.PolicyDecision) error {
+	if decision == nil || decision.Allowed() {
+		return nil
+	}
+	switch decision.Action {
+	case storage.PolicyActionDeny:
+		return errors.Wrap(ErrDenied, decision.Reason)
+	case storage.PolicyActionRequireApproval:
+		return errors.Wrap(ErrApprovalRequired, decision.Reason)
+	default:
+		return nil
+	}
+}
+
+// Validate 校验策略的规则和默认结果
+func Validate(policy *storage.SigningPolicy) error {
+	if policy.DefaultAction != "" && !validAction(policy.DefaultAction) {
+		return errors.Errorf("invalid default action %q", policy.DefaultAction)
+	}
+	if policy.MinSignatures < 0 {
+		return errors.Errorf("invalid min_signatures %d", policy.MinSignatures)
+	}
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		if !validAction(rule.Action) {
+			return errors.Errorf("rule #%d: invalid action %q", i, rule.Action)
+		}
+		if rule.AmountAbove != "" {
+			if limit, ok := new(big.Int).SetString(rule.AmountAbove, 10); !ok || limit.Sign() < 0 {
+				return errors.Errorf("rule #%d: invalid amount_above %q", i, rule.AmountAbove)
+			}
+		}
+		if rule.TimeWindow != nil {
+			if _, _, _, err := parseTimeWindow(rule.TimeWindow); err != nil {
+				return errors.Wrapf(err, "rule #%d", i)
+			}
+		}
+	}
+	return nil
+}
+
+func validAction(action string) bool {
+	switch action {
+	case storage.PolicyActionAllow, storage.PolicyActionDeny, storage.PolicyActionRequireApproval:
+		return true
+	default:
+		return false
+	}
+}
+
+// ruleMatches 判断规则是否匹配；条件依赖请求未提供的字段时，只有 deny 和 require_approval 规则匹配，
+// 缺少交易信息永远不会放宽限制
+func (e *Engine) ruleMatches(rule *storage.PolicyRule, req *Request, chainCfg *registry.Chain, now time.Time) bool {
+	unknown := false
+	check := func(matched, known bool) bool {
+		if !known {
+			unknown = true
+			return true
+		}
+		return matched
+	}
+
+	if len(rule.Chains) > 0 || len(rule.ExcludeChains) > 0 {
+		known := req.ChainType != ""
+		chainName := e.chainName(req.ChainType)
+		if len(rule.Chains) > 0 && !check(e.containsChain(rule.Chains, chainName), known) {
+			return false
+		}
+		if len(rule.ExcludeChains) > 0 && !check(!e.containsChain(rule.ExcludeChains, chainName), known) {
+			return false
+		}
+	}
+
+	if len(rule.Assets) > 0 {
+		known := req.Asset != "" || req.Amount != nil
+		asset := normalizeAsset(req.Asset, chainCfg)
+		matched := false
+		for _, candidate := range rule.Assets {
+			if normalizeAsset(candidate, chainCfg) == asset {
+				matched = true
+				break
+			}
+		}
+		if !check(matched, known) {
+			return false
+		}
+	}
+
+	if rule.AmountAbove != "" {
+		limit, _ := new(big.Int).SetString(rule.AmountAbove, 10)
+		if !check(req.Amount != nil && req.Amount.Cmp(limit) > 0, req.Amount != nil) {
+			return false
+		}
+	}
+
+	if len(rule.Destinations) > 0 || len(rule.ExcludeDestinations) > 0 {
+		known := req.Destination != ""
+		if len(rule.Destinations) > 0 && !check(containsFold(rule.Destinations, req.Destination), known) {
+			return false
+		}
+		if len(rule.ExcludeDestinations) > 0 && !check(!containsFold(rule.ExcludeDestinations, req.Destination), known) {
+			return false
+		}
+	}
+
+	if rule.TimeWindow != nil && !inTimeWindow(rule.TimeWindow, now) {
+		return false
+	}
+
+	if unknown {
+		return rule.Action != storage.PolicyActionAllow
+	}
+	return true
+}
+
+func (e *Engine) lookupChain(name string) *registry.Chain {
+	if e.chains == nil || name == "" {
+		return nil
+	}
+	chainCfg, err := e.chains.Lookup(name)
+	if err != nil {
+		return nil
+	}
+	return chainCfg
+}
+
+// chainName 返回链在注册表中的名称，未注册时返回小写的原始名称
+func (e *Engine) chainName(name string) string {
+	if chainCfg := e.lookupChain(name); chainCfg != nil {
+		return chainCfg.Name
+	}
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func (e *Engine) containsChain(names []string, chainName string) bool {
+	for _, name := range names {
+		if e.chainName(name) == chainName {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeAsset 原生代币统一为 native，已配置的代币统一为合约地址，比较时不区分大小写
+func normalizeAsset(asset string, chainCfg *registry.Chain) string {
+	asset = strings.TrimSpace(asset)
+	if asset == "" || strings.EqualFold(asset, nativeAsset) {
+		return nativeAsset
+	}
+	if chainCfg != nil {
+		if strings.EqualFold(asset, chainCfg.Symbol) {
+			return nativeAsset
+		}
+		if token, err := chainCfg.Token(asset); err == nil {
+			asset = token.Address
+		}
+	}
+	return strings.ToLower(asset)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(strings.TrimSpace(candidate), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimeWindow 解析时间窗口，返回起止时刻（当天分钟数）和时区
+func parseTimeWindow(window *storage.PolicyTimeWindow) (int, int, *time.Location, error) {
+	start, err := parseClock(window.Start)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	end, err := parseClock(window.End)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	location := time.UTC
+	if window.Timezone != "" {
+		location, err = time.LoadLocation(window.Timezone)
+		if err != nil {
+			return 0, 0, nil, errors.Wrapf(err, "invalid timezone %q", window.Timezone)
+		}
+	}
+	return start, end, location, nil
+}
+
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, errors.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// inTimeWindow 判断当前时间是否满足时间窗口条件；Start 与 End 相同时窗口为全天
+func inTimeWindow(window *storage.PolicyTimeWindow, now time.Time) bool {
+	start, end, location, err := parseTimeWindow(window)
+	if err != nil {
+		return false
+	}
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+
+	var inside bool
+	switch {
+	case start == end:
+		inside = true
+	case start < end:
+		inside = minute >= start && minute < end
+	default:
+		inside = minute >= start || minute < end
+	}
+	return inside != window.Outside
+}
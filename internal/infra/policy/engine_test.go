@@ -0,0 +1,209 @@
+package policy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+)
+
+const (
+	walletID     = "wallet-1"
+	treasury     = "0x1111111111111111111111111111111111111111"
+	blocked      = "0x2222222222222222222222222222222222222222"
+	stranger     = "0x3333333333333333333333333333333333333333"
+	ethereumUSDC = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+)
+
+type memoryStore map[string]*storage.SigningPolicy
+
+func (s memoryStore) GetSigningPolicy(ctx context.Context, walletID string) (*storage.SigningPolicy, error) {
+	policy, ok := s[walletID]
+	if !ok {
+		return nil, storage.ErrSigningPolicyNotFound
+	}
+	return policy, nil
+}
+
+func newTestEngine(policy *storage.SigningPolicy, now time.Time) *Engine {
+	store := memoryStore{}
+	if policy != nil {
+		policy.WalletID = walletID
+		store[walletID] = policy
+	}
+	engine := NewEngine(store, registry.Default())
+	engine.now = func() time.Time { return now }
+	return engine
+}
+
+func TestEvaluateWithoutPolicy(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	decision, err := newTestEngine(nil, now).Evaluate(context.Background(), &Request{WalletID: walletID, ChainType: "ethereum", Amount: big.NewInt(5)})
+	require.NoError(t, err)
+	assert.Equal(t, storage.PolicyActionAllow, decision.Action)
+	assert.Equal(t, -1, decision.RuleIndex)
+	assert.Equal(t, "5", decision.Amount)
+	assert.Equal(t, now, decision.EvaluatedAt)
+	assert.NoError(t, DecisionError(decision))
+}
+
+func TestEvaluateOrderedRules(t *testing.T) {
+	policy := &storage.SigningPolicy{
+		Rules: []storage.PolicyRule{
+			{Name: "blocklist", Action: storage.PolicyActionDeny, Destinations: []string{blocked}},
+			{Name: "only-evm-and-solana", Action: storage.PolicyActionDeny, ExcludeChains: []string{"eth", "sol"}},
+			{Name: "large-usdc", Action: storage.PolicyActionRequireApproval, Assets: []string{"USDC"}, AmountAbove: "10000000000"},
+			{Name: "large-eth", Action: storage.PolicyActionRequireApproval, Assets: []string{"native"}, AmountAbove: "1000000000000000000"},
+			{Name: "treasury", Action: storage.PolicyActionAllow, Destinations: []string{treasury}},
+		},
+		DefaultAction: storage.PolicyActionDeny,
+	}
+	engine := newTestEngine(policy, time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		req    Request
+		action string
+		rule   string
+	}{
+		{"denylisted destination is case insensitive", Request{ChainType: "ethereum", Destination: "0x2222222222222222222222222222222222222222", Amount: big.NewInt(1)},
+			storage.PolicyActionDeny, "blocklist"},
+		{"chain restriction resolves aliases", Request{ChainType: "tron", Destination: treasury, Amount: big.NewInt(1)},
+			storage.PolicyActionDeny, "only-evm-and-solana"},
+		{"usdc above limit by contract address", Request{ChainType: "evm", Destination: treasury, Asset: ethereumUSDC, Amount: big.NewInt(10000000001)},
+			storage.PolicyActionRequireApproval, "large-usdc"},
+		{"usdc at limit", Request{ChainType: "ethereum", Destination: treasury, Asset: "usdc", Amount: big.NewInt(10000000000)},
+			storage.PolicyActionAllow, "treasury"},
+		{"limit for native asset does not apply to tokens", Request{ChainType: "ethereum", Destination: treasury, Asset: "USDT", Amount: big.NewInt(2000000000000000000)},
+			storage.PolicyActionAllow, "treasury"},
+		{"native above limit by symbol", Request{ChainType: "ethereum", Destination: treasury, Asset: "ETH", Amount: big.NewInt(2000000000000000000)},
+			storage.PolicyActionRequireApproval, "large-eth"},
+		{"usdc limit applies per chain", Request{ChainType: "solana", Destination: treasury, Asset: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", Amount: big.NewInt(20000000000)},
+			storage.PolicyActionRequireApproval, "large-usdc"},
+		{"unknown destination falls through to default", Request{ChainType: "ethereum", Destination: stranger, Amount: big.NewInt(1)},
+			storage.PolicyActionDeny, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			req.WalletID = walletID
+			decision, err := engine.Evaluate(context.Background(), &req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.action, decision.Action)
+			assert.Equal(t, tt.rule, decision.Rule)
+		})
+	}
+}
+
+func TestEvaluateMissingTransactionFields(t *testing.T) {
+	policy := &storage.SigningPolicy{
+		Rules: []storage.PolicyRule{
+			{Name: "allowlist", Action: storage.PolicyActionAllow, Destinations: []string{treasury}},
+			{Name: "large", Action: storage.PolicyActionRequireApproval, AmountAbove: "100"},
+		},
+	}
+	engine := newTestEngine(policy, time.Now())
+
+	// 没有目标地址时 allow 规则不匹配，没有金额时 require_approval 规则匹配
+	decision, err := engine.Evaluate(context.Background(), &Request{WalletID: walletID, ChainType: "ethereum"})
+	require.NoError(t, err)
+	assert.Equal(t, storage.PolicyActionRequireApproval, decision.Action)
+	assert.Equal(t, 1, decision.RuleIndex)
+	assert.ErrorIs(t, DecisionError(decision), ErrApprovalRequired)
+
+	decision, err = engine.Evaluate(context.Background(), &Request{WalletID: walletID, ChainType: "ethereum", Amount: big.NewInt(100)})
+	require.NoError(t, err)
+	assert.Equal(t, storage.PolicyActionAllow, decision.Action)
+	assert.Equal(t, "no rule matched, default action allow", decision.Reason)
+}
+
+func TestEvaluateTimeWindow(t *testing.T) {
+	policy := &storage.SigningPolicy{
+		Rules: []storage.PolicyRule{
+			{Name: "maintenance", Action: storage.PolicyActionDeny, TimeWindow: &storage.PolicyTimeWindow{Start: "23:30", End: "00:30"}},
+			{Name: "after-hours", Action: storage.PolicyActionRequireApproval,
+				TimeWindow: &storage.PolicyTimeWindow{Start: "09:00", End: "18:00", Timezone: "Asia/Shanghai", Outside: true}},
+		},
+	}
+
+	tests := []struct {
+		now    time.Time
+		action string
+	}{
+		{time.Date(2026, 10, 16, 23, 45, 0, 0, time.UTC), storage.PolicyActionDeny},
+		{time.Date(2026, 10, 17, 0, 15, 0, 0, time.UTC), storage.PolicyActionDeny},
+		{time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC), storage.PolicyActionAllow},            // 上海 10:00
+		{time.Date(2026, 10, 16, 9, 59, 0, 0, time.UTC), storage.PolicyActionAllow},           // 上海 17:59
+		{time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC), storage.PolicyActionRequireApproval}, // 上海 18:00
+		{time.Date(2026, 10, 16, 0, 59, 0, 0, time.UTC), storage.PolicyActionRequireApproval}, // 上海 08:59
+	}
+	for _, tt := range tests {
+		decision, err := newTestEngine(policy, tt.now).Evaluate(context.Background(), &Request{WalletID: walletID})
+		require.NoError(t, err)
+		assert.Equal(t, tt.action, decision.Action, tt.now.String())
+	}
+}
+
+func TestEvaluateTeamWalletRequiresApprovals(t *testing.T) {
+	policy := &storage.SigningPolicy{
+		PolicyType:    storage.PolicyTypeTeam,
+		MinSignatures: 2,
+		Rules: []storage.PolicyRule{
+			{Name: "blocklist", Action: storage.PolicyActionDeny, Destinations: []string{blocked}},
+		},
+	}
+	engine := newTestEngine(policy, time.Now())
+
+	decision, err := engine.Evaluate(context.Background(), &Request{WalletID: walletID, ChainType: "ethereum", Destination: treasury})
+	require.NoError(t, err)
+	assert.Equal(t, storage.PolicyActionRequireApproval, decision.Action)
+	assert.Equal(t, 2, decision.RequiredApprovals)
+	assert.Equal(t, "no rule matched, default action allow, team wallet requires 2 approvals", decision.Reason)
+	assert.ErrorIs(t, DecisionError(decision), ErrApprovalRequired)
+
+	// 审批通过的签名请求允许签名
+	decision.SignRequestID = "request-1"
+	assert.NoError(t, DecisionError(decision))
+
+	decision, err = engine.Evaluate(context.Background(), &Request{WalletID: walletID, ChainType: "ethereum", Destination: blocked})
+	require.NoError(t, err)
+	assert.Equal(t, storage.PolicyActionDeny, decision.Action)
+	assert.Zero(t, decision.RequiredApprovals)
+
+	// 非 team 钱包的 require_approval 规则至少需要一个审批
+	decision, err = newTestEngine(&storage.SigningPolicy{
+		PolicyType:    storage.PolicyTypeSingle,
+		DefaultAction: storage.PolicyActionRequireApproval,
+	}, time.Now()).Evaluate(context.Background(), &Request{WalletID: walletID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, decision.RequiredApprovals)
+}
+
+func TestEvaluateInvalidPolicy(t *testing.T) {
+	invalid := []*storage.SigningPolicy{
+		{DefaultAction: "maybe"},
+		{MinSignatures: -1},
+		{Rules: []storage.PolicyRule{{Action: "block"}}},
+		{Rules: []storage.PolicyRule{{Action: storage.PolicyActionDeny, AmountAbove: "1e18"}}},
+		{Rules: []storage.PolicyRule{{Action: storage.PolicyActionDeny, AmountAbove: "-1"}}},
+		{Rules: []storage.PolicyRule{{Action: storage.PolicyActionDeny, TimeWindow: &storage.PolicyTimeWindow{Start: "9", End: "17:00"}}}},
+		{Rules: []storage.PolicyRule{{Action: storage.PolicyActionDeny, TimeWindow: &storage.PolicyTimeWindow{Start: "09:00", End: "17:00", Timezone: "Mars/Olympus"}}}},
+	}
+	for _, policy := range invalid {
+		_, err := newTestEngine(policy, time.Now()).Evaluate(context.Background(), &Request{WalletID: walletID})
+		assert.Error(t, err)
+	}
+}
+
+func TestDecisionError(t *testing.T) {
+	err := DecisionError(&storage.PolicyDecision{Action: storage.PolicyActionDeny, Reason: `matched rule "blocklist"`})
+	assert.ErrorIs(t, err, ErrDenied)
+	assert.Contains(t, err.Error(), "blocklist")
+	assert.NoError(t, DecisionError(nil))
+}
@@ -2,9 +2,11 @@ package session
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/SafeMPC/mpc-service/internal/audit"
 	"github.com/SafeMPC/mpc-service/internal/infra/storage"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
@@ -16,6 +18,7 @@ type Manager struct {
 	sessionStore  storage.SessionStore
 	timeout       time.Duration
 	stateStore    *StateStore
+	auditService  *audit.Service
 }
 
 // NewManager 创建会话管理器
@@ -24,12 +27,23 @@ func NewManager(metadataStore storage.MetadataStore, sessionStore storage.Sessio
 		metadataStore: metadataStore,
 		sessionStore:  sessionStore,
 		timeout:       timeout,
-		stateStore:    NewStateStore(metadataStore, sessionStore),
+		stateStore:    NewStateStore(metadataStore, sessionStore, timeout),
 	}
 }
 
+// SetAuditService 设置审计服务，会话失败和 DKG 完成时写入审计日志
+func (m *Manager) SetAuditService(auditService *audit.Service) {
+	m.auditService = auditService
+}
+
 // CreateSession 创建签名会话
 func (m *Manager) CreateSession(ctx context.Context, keyID string, protocol string, threshold int, totalNodes int) (*Session, error) {
+	return m.CreateSessionWithPolicy(ctx, keyID, protocol, threshold, totalNodes, nil)
+}
+
+// CreateSessionWithPolicy 创建签名会话并保存策略评估结果；策略未允许签名（require_approval 时签名请求未通过审批）时
+// 会话直接以 rejected 状态结束，评估结果写入审计日志
+func (m *Manager) CreateSessionWithPolicy(ctx context.Context, keyID string, protocol string, threshold int, totalNodes int, decision *storage.PolicyDecision) (*Session, error) {
 	// 使用纯 UUID 格式，符合 API 定义要求
 	sessionID := uuid.New().String()
 	now := time.Now()
@@ -47,6 +61,12 @@ func (m *Manager) CreateSession(ctx context.Context, keyID string, protocol stri
 		TotalRounds:        4, // GG18/GG20需要4轮
 		CreatedAt:          now,
 		ExpiresAt:          expiresAt,
+		PolicyDecision:     decision,
+	}
+	if decision != nil && !decision.Allowed() {
+		session.Status = string(SessionStatusRejected)
+		session.CompletedAt = &now
+		session.ErrorMessage = decision.Reason
 	}
 
 	// 保存到PostgreSQL
@@ -64,12 +84,44 @@ func (m *Manager) CreateSession(ctx context.Context, keyID string, protocol stri
 		CreatedAt:          session.CreatedAt,
 		CompletedAt:        session.CompletedAt,
 		DurationMs:         session.DurationMs,
+		ErrorMessage:       session.ErrorMessage,
+		PolicyDecision:     session.PolicyDecision,
 	}
 
 	if err := m.metadataStore.SaveSigningSession(ctx, storageSession); err != nil {
 		return nil, errors.Wrap(err, "failed to save session to database")
 	}
 
+	if decision != nil {
+		result := audit.ResultSuccess
+		if !decision.Allowed() {
+			result = audit.ResultFailure
+		}
+		m.auditService.Record(ctx, audit.Entry{
+			EventType: audit.EventTypePolicy,
+			Operation: audit.OperationEvaluate,
+			Result:    result,
+			KeyID:     keyID,
+			SessionID: sessionID,
+			Details: map[string]interface{}{
+				"action":          decision.Action,
+				"rule":            decision.Rule,
+				"rule_index":      decision.RuleIndex,
+				"reason":          decision.Reason,
+				"chain_type":      decision.ChainType,
+				"destination":     decision.Destination,
+				"amount":          decision.Amount,
+				"asset":           decision.Asset,
+				"sign_request_id": decision.SignRequestID,
+			},
+		})
+	}
+
+	// 被拒绝的会话不会被节点读取，无需缓存
+	if session.Status == string(SessionStatusRejected) {
+		return session, nil
+	}
+
 	// 保存到Redis缓存
 	if err := m.sessionStore.SaveSession(ctx, storageSession, m.timeout); err != nil {
 		return nil, errors.Wrap(err, "failed to save session to cache")
@@ -194,6 +246,76 @@ func (m *Manager) CreateKeyGenSession(ctx context.Context, keyID string, protoco
 	return session, nil
 }
 
+// CreateReshareSession 创建重分享会话（Resharing）
+// 参与节点为新节点集合与旧节点集合的并集，新节点排在前面，Threshold/TotalNodes 记录新的门限配置
+func (m *Manager) CreateReshareSession(ctx context.Context, keyID string, oldNodeIDs []string, newNodeIDs []string, newThreshold int) (*Session, error) {
+	sessionID := uuid.New().String()
+	now := time.Now()
+	expiresAt := now.Add(m.timeout)
+
+	participants := make([]string, 0, len(oldNodeIDs)+len(newNodeIDs))
+	seen := make(map[string]bool, len(oldNodeIDs)+len(newNodeIDs))
+	for _, nodeID := range append(append([]string{}, newNodeIDs...), oldNodeIDs...) {
+		if seen[nodeID] {
+			continue
+		}
+		seen[nodeID] = true
+		participants = append(participants, nodeID)
+	}
+
+	session := &Session{
+		SessionID:          sessionID,
+		KeyID:              keyID,
+		Protocol:           ReshareProtocol,
+		Status:             string(SessionStatusPending),
+		Threshold:          newThreshold,
+		TotalNodes:         len(newNodeIDs),
+		ParticipatingNodes: participants,
+		CurrentRound:       0,
+		TotalRounds:        4,
+		CreatedAt:          now,
+		ExpiresAt:          expiresAt,
+	}
+
+	storageSession := &storage.SigningSession{
+		SessionID:          session.SessionID,
+		KeyID:              session.KeyID,
+		Protocol:           session.Protocol,
+		Status:             session.Status,
+		Threshold:          session.Threshold,
+		TotalNodes:         session.TotalNodes,
+		ParticipatingNodes: session.ParticipatingNodes,
+		CurrentRound:       session.CurrentRound,
+		TotalRounds:        session.TotalRounds,
+		Signature:          session.Signature, // 重分享会话中，Signature 字段存储重分享后上报的公钥
+		CreatedAt:          session.CreatedAt,
+		CompletedAt:        session.CompletedAt,
+		DurationMs:         session.DurationMs,
+	}
+
+	if err := m.metadataStore.SaveSigningSession(ctx, storageSession); err != nil {
+		return nil, errors.Wrap(err, "failed to save reshare session to database")
+	}
+
+	if err := m.sessionStore.SaveSession(ctx, storageSession, m.timeout); err != nil {
+		log.Warn().
+			Err(err).
+			Str("session_id", session.SessionID).
+			Str("key_id", session.KeyID).
+			Msg("Failed to save reshare session to cache (non-critical)")
+	}
+
+	log.Info().
+		Str("session_id", session.SessionID).
+		Str("key_id", keyID).
+		Strs("old_node_ids", oldNodeIDs).
+		Strs("new_node_ids", newNodeIDs).
+		Int("new_threshold", newThreshold).
+		Msg("Reshare session created")
+
+	return session, nil
+}
+
 // GetSession 获取会话
 func (m *Manager) GetSession(ctx context.Context, sessionID string) (*Session, error) {
 	// 先从Redis获取
@@ -246,6 +368,8 @@ func (m *Manager) UpdateSession(ctx context.Context, session *Session) error {
 		CreatedAt:          session.CreatedAt,
 		CompletedAt:        session.CompletedAt,
 		DurationMs:         session.DurationMs,
+		ErrorMessage:       session.ErrorMessage,
+		PolicyDecision:     session.PolicyDecision,
 	}
 
 	// 更新PostgreSQL
@@ -253,6 +377,29 @@ func (m *Manager) UpdateSession(ctx context.Context, session *Session) error {
 		return errors.Wrap(err, "failed to update session in database")
 	}
 
+	// 会话进入终态后 WAL 不再需要用于恢复
+	if isTerminalStatus(session.Status) {
+		if err := m.stateStore.TruncateWAL(ctx, session.SessionID); err != nil {
+			log.Warn().Err(err).Str("session_id", session.SessionID).Msg("Failed to truncate session wal")
+		}
+	}
+
+	if session.Status == string(SessionStatusFailed) || session.Status == string(SessionStatusTimeout) {
+		m.auditService.Record(ctx, audit.Entry{
+			EventType: audit.EventTypeSession,
+			Operation: audit.OperationFail,
+			Result:    audit.ResultFailure,
+			KeyID:     session.KeyID,
+			SessionID: session.SessionID,
+			Details: map[string]interface{}{
+				"protocol":      session.Protocol,
+				"status":        session.Status,
+				"error_message": session.ErrorMessage,
+				"nodes":         session.ParticipatingNodes,
+			},
+		})
+	}
+
 	// 更新Redis缓存
 	remainingTTL := time.Until(session.ExpiresAt)
 	if remainingTTL > 0 {
@@ -301,6 +448,8 @@ func (m *Manager) CompleteSession(ctx context.Context, sessionID string, signatu
 		return errors.Wrap(err, "failed to get session")
 	}
 
+	alreadyCompleted := session.Status == string(SessionStatusCompleted)
+
 	now := time.Now()
 	session.Status = string(SessionStatusCompleted)
 	session.Signature = signature
@@ -311,6 +460,35 @@ func (m *Manager) CompleteSession(ctx context.Context, sessionID string, signatu
 		return errors.Wrap(err, "failed to update session")
 	}
 
+	// 多个 Signer 会重复上报同一结果，只在首次完成时记录
+	if !alreadyCompleted {
+		entry := audit.Entry{
+			EventType: audit.EventTypeSigning,
+			Operation: audit.OperationSign,
+			Result:    audit.ResultSuccess,
+			KeyID:     session.KeyID,
+			SessionID: sessionID,
+			Details: map[string]interface{}{
+				"protocol":    session.Protocol,
+				"signature":   signature,
+				"duration_ms": session.DurationMs,
+				"nodes":       session.ParticipatingNodes,
+				"stage":       "completed",
+			},
+		}
+		if session.Protocol == ReshareProtocol {
+			entry.EventType = audit.EventTypeKey
+			entry.Operation = audit.OperationReshare
+			entry.Details = map[string]interface{}{
+				"public_key":  signature,
+				"threshold":   session.Threshold,
+				"duration_ms": session.DurationMs,
+				"nodes":       session.ParticipatingNodes,
+			}
+		}
+		m.auditService.Record(ctx, entry)
+	}
+
 	return nil
 }
 
@@ -386,8 +564,14 @@ func (m *Manager) CompleteKeygenSession(ctx context.Context, keyID string, publi
 	}
 
 	oldStatus := keyMeta.Status
+	// 多个 Signer 会重复上报同一结果，已是 Active 时不再校验迁移
+	if oldStatus != storage.KeyStatusActive {
+		if err := storage.ValidateKeyStatusTransition(oldStatus, storage.KeyStatusActive); err != nil {
+			return errors.Wrapf(err, "key %s cannot be activated", keyID)
+		}
+	}
 	keyMeta.PublicKey = publicKey
-	keyMeta.Status = "Active"
+	keyMeta.Status = storage.KeyStatusActive
 	keyMeta.UpdatedAt = now
 
 	if err := m.metadataStore.UpdateKeyMetadata(ctx, keyMeta); err != nil {
@@ -395,7 +579,7 @@ func (m *Manager) CompleteKeygenSession(ctx context.Context, keyID string, publi
 			Err(err).
 			Str("key_id", keyID).
 			Str("old_status", oldStatus).
-			Str("new_status", "Active").
+			Str("new_status", storage.KeyStatusActive).
 			Msg("Failed to update key metadata in CompleteKeygenSession")
 		return errors.Wrap(err, "failed to update key metadata")
 	}
@@ -403,13 +587,120 @@ func (m *Manager) CompleteKeygenSession(ctx context.Context, keyID string, publi
 	log.Info().
 		Str("key_id", keyID).
 		Str("old_status", oldStatus).
-		Str("new_status", "Active").
+		Str("new_status", storage.KeyStatusActive).
 		Str("public_key", publicKey).
 		Msg("Key metadata updated successfully - DKG completed")
 
+	// 多个 Signer 会重复上报同一结果，只在首次激活时记录
+	if oldStatus != storage.KeyStatusActive {
+		m.auditService.Record(ctx, audit.Entry{
+			EventType: audit.EventTypeKey,
+			Operation: audit.OperationDKGComplete,
+			Result:    audit.ResultSuccess,
+			KeyID:     keyID,
+			SessionID: session.SessionID,
+			Details: map[string]interface{}{
+				"public_key":  publicKey,
+				"algorithm":   keyMeta.Algorithm,
+				"curve":       keyMeta.Curve,
+				"threshold":   keyMeta.Threshold,
+				"total_nodes": keyMeta.TotalNodes,
+			},
+		})
+	}
+
 	return nil
 }
 
+// CompleteReshareSession 记录新节点上报的重分享结果，所有新节点都上报后完成会话
+// 上报的公钥必须与密钥当前公钥一致，否则会话标记为失败；新节点集合之外的节点上报被拒绝
+func (m *Manager) CompleteReshareSession(ctx context.Context, sessionID string, nodeID string, publicKey string) error {
+	session, err := m.GetSession(ctx, sessionID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reshare session")
+	}
+
+	if session.Protocol != ReshareProtocol {
+		return errors.Errorf("session %s is not a reshare session (protocol=%s)", sessionID, session.Protocol)
+	}
+
+	if session.Status != string(SessionStatusPending) && session.Status != string(SessionStatusActive) {
+		return errors.Errorf("cannot complete session in status %s", session.Status)
+	}
+
+	keyMeta, err := m.metadataStore.GetKeyMetadata(ctx, session.KeyID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get key metadata")
+	}
+
+	if !strings.EqualFold(strings.TrimPrefix(keyMeta.PublicKey, "0x"), strings.TrimPrefix(publicKey, "0x")) {
+		log.Error().
+			Str("session_id", sessionID).
+			Str("key_id", session.KeyID).
+			Str("expected_public_key", keyMeta.PublicKey).
+			Str("reported_public_key", publicKey).
+			Msg("Reshare produced a different public key, failing session")
+		if err := m.FailSession(ctx, sessionID); err != nil {
+			return errors.Wrap(err, "failed to mark reshare session as failed")
+		}
+		return errors.Errorf("reshare session %s reported mismatched public key", sessionID)
+	}
+
+	newNodeIDs := reshareNewNodes(session)
+	if !containsNode(newNodeIDs, nodeID) {
+		return errors.Errorf("node %s is not a new participant of reshare session %s", nodeID, sessionID)
+	}
+
+	if err := m.stateStore.AppendWAL(ctx, &WALRecord{
+		SessionID: sessionID,
+		Type:      WALEventReshareConfirmed,
+		Payload:   []byte(nodeID),
+	}); err != nil {
+		return errors.Wrap(err, "failed to record reshare confirmation")
+	}
+
+	records, err := m.stateStore.ReplayWAL(ctx, sessionID)
+	if err != nil {
+		return errors.Wrap(err, "failed to load reshare confirmations")
+	}
+	confirmed := make(map[string]bool, len(newNodeIDs))
+	for _, record := range records {
+		if record.Type == WALEventReshareConfirmed {
+			confirmed[string(record.Payload)] = true
+		}
+	}
+	for _, id := range newNodeIDs {
+		if !confirmed[id] {
+			log.Info().
+				Str("session_id", sessionID).
+				Str("node_id", nodeID).
+				Int("confirmed", len(confirmed)).
+				Int("new_nodes", len(newNodeIDs)).
+				Msg("Reshare result recorded, waiting for other new nodes")
+			return nil
+		}
+	}
+
+	return m.CompleteSession(ctx, sessionID, publicKey)
+}
+
+// reshareNewNodes 重分享会话的新节点集合（参与节点中的前 TotalNodes 个）
+func reshareNewNodes(session *Session) []string {
+	if session.TotalNodes <= 0 || session.TotalNodes > len(session.ParticipatingNodes) {
+		return session.ParticipatingNodes
+	}
+	return session.ParticipatingNodes[:session.TotalNodes]
+}
+
+func containsNode(nodeIDs []string, nodeID string) bool {
+	for _, id := range nodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *Manager) FailKeygenSession(ctx context.Context, keyID string) error {
 	session, err := m.GetSession(ctx, keyID)
 	if err != nil {
@@ -482,6 +773,23 @@ func (m *Manager) ReplayWAL(ctx context.Context, sessionID string) ([]*WALRecord
 	return m.stateStore.ReplayWAL(ctx, sessionID)
 }
 
+// RecordDispatch 记录 Coordinator 已在节点上启动协议，重启后据此判断会话能否恢复
+// 写入失败只记录日志，不影响协议执行
+func (m *Manager) RecordDispatch(ctx context.Context, sessionID string, nodeID string) {
+	record := &WALRecord{
+		SessionID: sessionID,
+		Type:      WALEventDispatched,
+		Payload:   []byte(nodeID),
+	}
+	if err := m.stateStore.AppendWAL(ctx, record); err != nil {
+		log.Warn().
+			Err(err).
+			Str("session_id", sessionID).
+			Str("node_id", nodeID).
+			Msg("Failed to record session dispatch in wal")
+	}
+}
+
 // ObserveRoundMetric 记录轮次耗时指标
 func (m *Manager) ObserveRoundMetric(protocol string, round int, duration time.Duration) {
 	m.stateStore.ObserveRoundMetric(protocol, round, duration)
@@ -504,5 +812,7 @@ func convertStorageSession(storageSession *storage.SigningSession) *Session {
 		CompletedAt:        storageSession.CompletedAt,
 		DurationMs:         storageSession.DurationMs,
 		ExpiresAt:          storageSession.CreatedAt.Add(5 * time.Minute), // 默认5分钟超时
+		ErrorMessage:       storageSession.ErrorMessage,
+		PolicyDecision:     storageSession.PolicyDecision,
 	}
 }
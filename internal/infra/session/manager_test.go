@@ -0,0 +1,150 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMetadataStore 只实现会话、WAL 和密钥元数据的读写，其余方法不会在这些测试中用到
+type fakeMetadataStore struct {
+	storage.MetadataStore
+
+	keys     map[string]*storage.KeyMetadata
+	sessions map[string]*storage.SigningSession
+	wal      map[string][]*storage.SessionWALRecord
+}
+
+func newFakeMetadataStore(keys ...*storage.KeyMetadata) *fakeMetadataStore {
+	f := &fakeMetadataStore{
+		keys:     map[string]*storage.KeyMetadata{},
+		sessions: map[string]*storage.SigningSession{},
+		wal:      map[string][]*storage.SessionWALRecord{},
+	}
+	for _, k := range keys {
+		f.keys[k.KeyID] = k
+	}
+	return f
+}
+
+func (f *fakeMetadataStore) GetKeyMetadata(_ context.Context, keyID string) (*storage.KeyMetadata, error) {
+	k, ok := f.keys[keyID]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return k, nil
+}
+
+func (f *fakeMetadataStore) SaveSigningSession(_ context.Context, session *storage.SigningSession) error {
+	copied := *session
+	f.sessions[session.SessionID] = &copied
+	return nil
+}
+
+func (f *fakeMetadataStore) UpdateSigningSession(ctx context.Context, session *storage.SigningSession) error {
+	return f.SaveSigningSession(ctx, session)
+}
+
+func (f *fakeMetadataStore) GetSigningSession(_ context.Context, sessionID string) (*storage.SigningSession, error) {
+	session, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, errors.New("session not found")
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (f *fakeMetadataStore) AppendSessionWAL(_ context.Context, record *storage.SessionWALRecord) error {
+	record.Sequence = int64(len(f.wal[record.SessionID]) + 1)
+	f.wal[record.SessionID] = append(f.wal[record.SessionID], record)
+	return nil
+}
+
+func (f *fakeMetadataStore) ListSessionWAL(_ context.Context, sessionID string) ([]*storage.SessionWALRecord, error) {
+	return f.wal[sessionID], nil
+}
+
+func (f *fakeMetadataStore) DeleteSessionWAL(_ context.Context, sessionID string) error {
+	delete(f.wal, sessionID)
+	return nil
+}
+
+// fakeSessionStore 不缓存任何内容，读取全部回退到 fakeMetadataStore
+type fakeSessionStore struct {
+	storage.SessionStore
+}
+
+func (fakeSessionStore) SaveSession(context.Context, *storage.SigningSession, time.Duration) error {
+	return nil
+}
+
+func (fakeSessionStore) GetSession(context.Context, string) (*storage.SigningSession, error) {
+	return nil, errors.New("not cached")
+}
+
+func (fakeSessionStore) UpdateSession(context.Context, *storage.SigningSession, time.Duration) error {
+	return nil
+}
+
+func (fakeSessionStore) AppendWAL(context.Context, *storage.SessionWALRecord, time.Duration) error {
+	return nil
+}
+
+func (fakeSessionStore) SaveWAL(context.Context, string, []*storage.SessionWALRecord, time.Duration) error {
+	return nil
+}
+
+func (fakeSessionStore) GetWAL(context.Context, string) ([]*storage.SessionWALRecord, error) {
+	return nil, storage.ErrWALNotCached
+}
+
+func (fakeSessionStore) DeleteWAL(context.Context, string) error {
+	return nil
+}
+
+const testPublicKey = "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc"
+
+func newReshareFixture(t *testing.T) (*fakeMetadataStore, *Manager, string) {
+	t.Helper()
+
+	store := newFakeMetadataStore(&storage.KeyMetadata{KeyID: "root-1", PublicKey: testPublicKey})
+	m := NewManager(store, fakeSessionStore{}, time.Minute)
+	session, err := m.CreateReshareSession(context.Background(), "root-1",
+		[]string{"mobile-p1", "server-signer-p2"}, []string{"mobile-p1", "server-signer-p3"}, 2)
+	require.NoError(t, err)
+	return store, m, session.SessionID
+}
+
+func TestCompleteReshareSessionWaitsForAllNewNodes(t *testing.T) {
+	store, m, sessionID := newReshareFixture(t)
+	ctx := context.Background()
+
+	require.NoError(t, m.CompleteReshareSession(ctx, sessionID, "server-signer-p3", testPublicKey))
+	assert.Equal(t, string(SessionStatusPending), store.sessions[sessionID].Status)
+
+	// 重复上报不算作其他节点的确认
+	require.NoError(t, m.CompleteReshareSession(ctx, sessionID, "server-signer-p3", testPublicKey))
+	assert.Equal(t, string(SessionStatusPending), store.sessions[sessionID].Status)
+
+	// 只持有旧分片的节点不是新参与者
+	require.Error(t, m.CompleteReshareSession(ctx, sessionID, "server-signer-p2", testPublicKey))
+	assert.Equal(t, string(SessionStatusPending), store.sessions[sessionID].Status)
+
+	require.NoError(t, m.CompleteReshareSession(ctx, sessionID, "mobile-p1", "0x"+testPublicKey))
+	assert.Equal(t, string(SessionStatusCompleted), store.sessions[sessionID].Status)
+	assert.Equal(t, "0x"+testPublicKey, store.sessions[sessionID].Signature)
+}
+
+func TestCompleteReshareSessionFailsOnMismatchedPublicKey(t *testing.T) {
+	store, m, sessionID := newReshareFixture(t)
+	ctx := context.Background()
+
+	require.NoError(t, m.CompleteReshareSession(ctx, sessionID, "server-signer-p3", testPublicKey))
+	require.Error(t, m.CompleteReshareSession(ctx, sessionID, "mobile-p1", "03ffff"))
+	assert.Equal(t, string(SessionStatusFailed), store.sessions[sessionID].Status)
+}
@@ -0,0 +1,197 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// RecoveryOutcomeResumed 会话继续等待 Signer 上报结果
+	RecoveryOutcomeResumed = "resumed"
+	// RecoveryOutcomeFailed 会话无法恢复，已标记失败并记录原因
+	RecoveryOutcomeFailed = "failed"
+
+	// recoveryDispatchGrace 尚未下发到任何节点的会话在此时间内视为仍由其他 Coordinator 实例处理（滚动发布时新旧实例并存）
+	recoveryDispatchGrace = 2 * time.Minute
+)
+
+var (
+	recoveryMetricsOnce   sync.Once
+	recoveredSessionTotal *prometheus.CounterVec
+)
+
+// RecoveryResult 启动恢复的结果
+type RecoveryResult struct {
+	Resumed []string
+	Failed  []string
+}
+
+// RecoverSessions Coordinator 启动时恢复进行中的会话
+// 根据 WAL 判断每个非终态会话：已下发且未超时的会话重建 Redis 缓存，继续等待 Signer 通过 ReportResult 上报，
+// 超时仍未完成的再标记失败；已超时或从未下发的会话立即标记失败并写入原因，避免调用方无限等待
+func (m *Manager) RecoverSessions(ctx context.Context) (*RecoveryResult, error) {
+	ensureRecoveryMetrics()
+
+	sessions, err := m.metadataStore.ListSigningSessionsByStatus(ctx, []string{
+		string(SessionStatusPending),
+		string(SessionStatusActive),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list in-flight sessions")
+	}
+
+	result := &RecoveryResult{}
+	for _, stored := range sessions {
+		outcome, reason, err := m.recoverSession(ctx, stored)
+		if err != nil {
+			log.Error().Err(err).Str("session_id", stored.SessionID).Msg("Failed to recover session")
+			continue
+		}
+
+		recoveredSessionTotal.WithLabelValues(outcome).Inc()
+		if outcome == RecoveryOutcomeResumed {
+			result.Resumed = append(result.Resumed, stored.SessionID)
+			continue
+		}
+		result.Failed = append(result.Failed, stored.SessionID)
+		log.Warn().
+			Str("session_id", stored.SessionID).
+			Str("key_id", stored.KeyID).
+			Str("protocol", stored.Protocol).
+			Str("reason", reason).
+			Msg("In-flight session failed during recovery")
+	}
+
+	log.Info().
+		Int("in_flight", len(sessions)).
+		Int("resumed", len(result.Resumed)).
+		Int("failed", len(result.Failed)).
+		Msg("Session recovery completed")
+
+	return result, nil
+}
+
+// recoverSession 恢复单个会话，返回结果和失败原因
+func (m *Manager) recoverSession(ctx context.Context, stored *storage.SigningSession) (string, string, error) {
+	records, err := m.stateStore.ReplayWAL(ctx, stored.SessionID)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to replay wal")
+	}
+
+	dispatched := false
+	for _, record := range records {
+		if record.Type == WALEventDispatched {
+			dispatched = true
+			break
+		}
+	}
+
+	now := time.Now()
+	expiresAt := stored.CreatedAt.Add(m.timeout)
+
+	var reason string
+	switch {
+	case now.After(expiresAt):
+		reason = "session expired while coordinator was restarting"
+	case !dispatched && now.Sub(stored.CreatedAt) > recoveryDispatchGrace:
+		reason = "coordinator restarted before the session was dispatched to signer nodes"
+	}
+
+	if reason != "" {
+		if err := m.failRecoveredSession(ctx, stored, reason); err != nil {
+			return "", "", err
+		}
+		return RecoveryOutcomeFailed, reason, nil
+	}
+
+	// 协议结果由 Signer 通过 ReportResult 上报，重建缓存后即可继续完成
+	if err := m.sessionStore.SaveSession(ctx, stored, time.Until(expiresAt)); err != nil {
+		return "", "", errors.Wrap(err, "failed to restore session cache")
+	}
+
+	// 到期后仍未完成的会话不会再有等待方处理，届时标记失败
+	sessionID := stored.SessionID
+	time.AfterFunc(time.Until(expiresAt), func() {
+		m.failIfStillInFlight(context.Background(), sessionID)
+	})
+
+	return RecoveryOutcomeResumed, "", nil
+}
+
+// failIfStillInFlight 恢复的会话到期后仍未进入终态时标记失败
+func (m *Manager) failIfStillInFlight(ctx context.Context, sessionID string) {
+	stored, err := m.metadataStore.GetSigningSession(ctx, sessionID)
+	if err != nil {
+		log.Error().Err(err).Str("session_id", sessionID).Msg("Failed to check recovered session")
+		return
+	}
+	if isTerminalStatus(stored.Status) {
+		return
+	}
+
+	reason := "session resumed after coordinator restart but no result was reported before timeout"
+	if err := m.failRecoveredSession(ctx, stored, reason); err != nil {
+		log.Error().Err(err).Str("session_id", sessionID).Msg("Failed to fail expired recovered session")
+		return
+	}
+	log.Warn().Str("session_id", sessionID).Str("reason", reason).Msg("Recovered session expired")
+}
+
+// failRecoveredSession 将无法恢复的会话标记为失败；DKG 会话（sessionID == keyID）同时将 Pending 密钥标记为 Failed
+func (m *Manager) failRecoveredSession(ctx context.Context, stored *storage.SigningSession, reason string) error {
+	session := convertStorageSession(stored)
+	now := time.Now()
+	session.Status = string(SessionStatusFailed)
+	session.ErrorMessage = reason
+	session.CompletedAt = &now
+	session.DurationMs = int(now.Sub(session.CreatedAt).Milliseconds())
+
+	if err := m.UpdateSession(ctx, session); err != nil {
+		return errors.Wrap(err, "failed to mark session failed")
+	}
+
+	if stored.SessionID != stored.KeyID {
+		return nil
+	}
+
+	keyMeta, err := m.metadataStore.GetKeyMetadata(ctx, stored.KeyID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get key metadata")
+	}
+	if storage.ValidateKeyStatusTransition(keyMeta.Status, storage.KeyStatusFailed) != nil {
+		return nil
+	}
+	keyMeta.Status = storage.KeyStatusFailed
+	keyMeta.UpdatedAt = now
+	if err := m.metadataStore.UpdateKeyMetadata(ctx, keyMeta); err != nil {
+		return errors.Wrap(err, "failed to mark key failed")
+	}
+	return nil
+}
+
+func isTerminalStatus(status string) bool {
+	switch SessionStatus(status) {
+	case SessionStatusCompleted, SessionStatusFailed, SessionStatusCancelled, SessionStatusTimeout, SessionStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+func ensureRecoveryMetrics() {
+	recoveryMetricsOnce.Do(func() {
+		recoveredSessionTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpc",
+			Subsystem: "session",
+			Name:      "recovered_total",
+			Help:      "In-flight sessions handled by coordinator startup recovery, by outcome",
+		}, []string{"outcome"})
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
 )
 
 // StateStore 负责在 Redis + PostgreSQL 之间同步会话/轮次状态，并提供 WAL/指标能力
@@ -17,9 +18,8 @@ type StateStore struct {
 	metadata storage.MetadataStore
 	cache    storage.SessionStore
 
-	walMu        sync.Mutex
-	wal          map[string][]*WALRecord
-	walSequences map[string]int64
+	walMu  sync.Mutex
+	walTTL time.Duration
 }
 
 var (
@@ -27,14 +27,16 @@ var (
 	roundDurationHist *prometheus.HistogramVec
 )
 
-// NewStateStore 创建状态存储器
-func NewStateStore(metadata storage.MetadataStore, cache storage.SessionStore) *StateStore {
+// NewStateStore 创建状态存储器，walTTL 为 WAL 在 Redis 中的缓存时间
+func NewStateStore(metadata storage.MetadataStore, cache storage.SessionStore, walTTL time.Duration) *StateStore {
 	ensureRoundMetrics()
+	if walTTL <= 0 {
+		walTTL = 5 * time.Minute
+	}
 	return &StateStore{
-		metadata:     metadata,
-		cache:        cache,
-		wal:          make(map[string][]*WALRecord),
-		walSequences: make(map[string]int64),
+		metadata: metadata,
+		cache:    cache,
+		walTTL:   walTTL,
 	}
 }
 
@@ -104,40 +106,75 @@ func (s *StateStore) LoadRoundProgress(ctx context.Context, sessionID string) (*
 	return convertRoundProgress(stored), nil
 }
 
-// AppendWAL 追加 WAL 记录
-func (s *StateStore) AppendWAL(_ context.Context, record *WALRecord) error {
+// AppendWAL 追加 WAL 记录：先写 PostgreSQL（分配 Sequence），再写 Redis 缓存
+// 缓存写入失败不影响持久化结果，只会让后续读取回退到 PostgreSQL
+func (s *StateStore) AppendWAL(ctx context.Context, record *WALRecord) error {
 	if record == nil {
 		return errors.New("wal record is nil")
 	}
 	if record.SessionID == "" {
 		return errors.New("wal record missing session id")
 	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now()
+	}
 
+	// 进程内串行追加，减少 Sequence 冲突和缓存乱序；跨实例的并发由存储层冲突重试和读取时的 Sequence 校验兜底
 	s.walMu.Lock()
 	defer s.walMu.Unlock()
 
-	record.Sequence = s.walSequences[record.SessionID] + 1
-	s.walSequences[record.SessionID] = record.Sequence
-	if record.CreatedAt.IsZero() {
-		record.CreatedAt = time.Now()
+	stored := toStorageWALRecord(record)
+	if err := s.metadata.AppendSessionWAL(ctx, stored); err != nil {
+		return errors.Wrap(err, "persist wal record")
+	}
+	record.Sequence = stored.Sequence
+
+	if err := s.cache.AppendWAL(ctx, stored, s.walTTL); err != nil {
+		log.Warn().Err(err).Str("session_id", record.SessionID).Msg("Failed to write wal record to cache, dropping cached wal")
+		_ = s.cache.DeleteWAL(ctx, record.SessionID)
 	}
-	s.wal[record.SessionID] = append(s.wal[record.SessionID], record)
 	return nil
 }
 
-// ReplayWAL 读取并清理 WAL
-func (s *StateStore) ReplayWAL(_ context.Context, sessionID string) ([]*WALRecord, error) {
+// ReplayWAL 按 Sequence 顺序读取 WAL（优先 Redis，缓存缺失时从 PostgreSQL 读取并重建缓存）
+func (s *StateStore) ReplayWAL(ctx context.Context, sessionID string) ([]*WALRecord, error) {
 	if sessionID == "" {
 		return nil, errors.New("session id is empty")
 	}
 
-	s.walMu.Lock()
-	defer s.walMu.Unlock()
+	cached, err := s.cache.GetWAL(ctx, sessionID)
+	if err == nil {
+		return fromStorageWALRecords(cached), nil
+	}
+	if !errors.Is(err, storage.ErrWALNotCached) {
+		log.Warn().Err(err).Str("session_id", sessionID).Msg("Failed to read wal from cache, falling back to PostgreSQL")
+	}
+
+	stored, err := s.metadata.ListSessionWAL(ctx, sessionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list wal records")
+	}
+	if len(stored) > 0 {
+		if err := s.cache.SaveWAL(ctx, sessionID, stored, s.walTTL); err != nil {
+			log.Warn().Err(err).Str("session_id", sessionID).Msg("Failed to rebuild wal cache")
+		}
+	}
+	return fromStorageWALRecords(stored), nil
+}
+
+// TruncateWAL 清理会话的 WAL（会话进入终态后不再需要恢复）
+func (s *StateStore) TruncateWAL(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return errors.New("session id is empty")
+	}
 
-	records := append([]*WALRecord(nil), s.wal[sessionID]...)
-	delete(s.wal, sessionID)
-	delete(s.walSequences, sessionID)
-	return records, nil
+	if err := s.metadata.DeleteSessionWAL(ctx, sessionID); err != nil {
+		return errors.Wrap(err, "delete wal records")
+	}
+	if err := s.cache.DeleteWAL(ctx, sessionID); err != nil {
+		return errors.Wrap(err, "delete cached wal")
+	}
+	return nil
 }
 
 // ObserveRoundMetric 暴露轮次耗时指标
@@ -154,6 +191,32 @@ func (s *StateStore) observeRoundMetric(protocol string, round int, duration tim
 	roundDurationHist.WithLabelValues(protocol, labelRound).Observe(duration.Seconds())
 }
 
+func toStorageWALRecord(record *WALRecord) *storage.SessionWALRecord {
+	return &storage.SessionWALRecord{
+		SessionID: record.SessionID,
+		Sequence:  record.Sequence,
+		Round:     record.Round,
+		Type:      record.Type,
+		Payload:   record.Payload,
+		CreatedAt: record.CreatedAt,
+	}
+}
+
+func fromStorageWALRecords(stored []*storage.SessionWALRecord) []*WALRecord {
+	records := make([]*WALRecord, 0, len(stored))
+	for _, record := range stored {
+		records = append(records, &WALRecord{
+			Sequence:  record.Sequence,
+			SessionID: record.SessionID,
+			Round:     record.Round,
+			Type:      record.Type,
+			Payload:   record.Payload,
+			CreatedAt: record.CreatedAt,
+		})
+	}
+	return records
+}
+
 func convertRoundProgress(session *storage.SigningSession) *RoundProgress {
 	return &RoundProgress{
 		SessionID:   session.SessionID,
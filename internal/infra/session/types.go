@@ -1,6 +1,10 @@
 package session
 
-import "time"
+import (
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+)
 
 // Session 签名会话
 type Session struct {
@@ -18,6 +22,8 @@ type Session struct {
 	CompletedAt        *time.Time
 	DurationMs         int
 	ExpiresAt          time.Time
+	ErrorMessage       string                  // 失败原因
+	PolicyDecision     *storage.PolicyDecision // 策略评估结果
 }
 
 // SessionStatus 会话状态
@@ -30,8 +36,13 @@ const (
 	SessionStatusFailed    SessionStatus = "failed"
 	SessionStatusCancelled SessionStatus = "cancelled"
 	SessionStatusTimeout   SessionStatus = "timeout"
+	// SessionStatusRejected 签名策略拒绝或要求审批，会话不会启动协议
+	SessionStatusRejected SessionStatus = "rejected"
 )
 
+// ReshareProtocol 重分享会话的协议标识（复用 signing_sessions 表，通过 Protocol 字段区分）
+const ReshareProtocol = "reshare"
+
 // RoundProgress 描述协议轮次的最新状态
 type RoundProgress struct {
 	SessionID   string
@@ -49,11 +60,20 @@ type RoundProgress struct {
 	ExpiresAt   time.Time
 }
 
+// WAL 事件类型
+const (
+	// WALEventDispatched Coordinator 已在某个节点上启动协议（Payload 为节点 ID）
+	WALEventDispatched = "dispatched"
+	// WALEventReshareConfirmed 新节点上报了与密钥公钥一致的重分享结果（Payload 为节点 ID）
+	WALEventReshareConfirmed = "reshare_confirmed"
+)
+
 // WALRecord 记录尚未提交的协议事件（用于恢复/重放）
 type WALRecord struct {
 	Sequence  int64
 	SessionID string
 	Round     int
+	Type      string
 	Payload   []byte
 	CreatedAt time.Time
 }
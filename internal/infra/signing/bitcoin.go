@@ -0,0 +1,55 @@
+package signing
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/pkg/errors"
+)
+
+// SignBitcoinTransaction 对 BitcoinAdapter.BuildTransaction 生成的 PSBT 逐个输入执行阈值签名，
+// 并用返回的签名完成 PSBT，返回可广播的已签名交易
+// req 提供密钥和鉴权信息，其中的消息字段会被每个输入的 sighash 覆盖，ChainType 为空时按 bitcoin 评估签名策略
+// P2TR 输入由 FROST 签名，签名节点需要用 BIP-86 调整后的密钥签名，否则 FinalizeTransaction 校验失败，交易不会返回
+func (s *Service) SignBitcoinTransaction(ctx context.Context, adapter *chain.BitcoinAdapter, req *SignRequest, unsigned *chain.Transaction) (*chain.Transaction, error) {
+	if unsigned == nil || len(unsigned.SigningHashes) == 0 {
+		return nil, errors.New("transaction has no inputs to sign")
+	}
+
+	var publicKey string
+	signatures := make([][]byte, len(unsigned.SigningHashes))
+	for i, sigHash := range unsigned.SigningHashes {
+		inputReq := *req
+		inputReq.Message = nil
+		inputReq.MessageHex = hex.EncodeToString(sigHash)
+		inputReq.MessageType = "transaction"
+		if inputReq.ChainType == "" {
+			inputReq.ChainType = "bitcoin"
+		}
+
+		resp, err := s.ThresholdSign(ctx, &inputReq)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to sign input %d", i)
+		}
+
+		signatures[i], err = hex.DecodeString(resp.Signature)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode signature for input %d", i)
+		}
+		publicKey = resp.PublicKey
+	}
+
+	pubKey, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode public key")
+	}
+
+	signed, err := adapter.FinalizeTransaction(unsigned.Raw, pubKey, signatures)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to finalize transaction")
+	}
+	signed.Fee = unsigned.Fee
+
+	return signed, nil
+}
@@ -0,0 +1,47 @@
+package signing
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/pkg/errors"
+)
+
+// SignEthereumTransaction 对 EthereumAdapter.BuildTransaction 生成的交易签名哈希执行阈值签名，
+// 并组装为可广播的已签名交易
+// req 提供密钥和鉴权信息，其中的消息字段会被交易签名哈希覆盖，ChainType 为空时按 ethereum 评估签名策略
+func (s *Service) SignEthereumTransaction(ctx context.Context, adapter *chain.EthereumAdapter, req *SignRequest, unsigned *chain.Transaction) (*chain.Transaction, error) {
+	if unsigned == nil || len(unsigned.SigningHashes) != 1 {
+		return nil, errors.New("transaction must have exactly one signing hash")
+	}
+
+	txReq := *req
+	txReq.Message = nil
+	txReq.MessageHex = hex.EncodeToString(unsigned.SigningHashes[0])
+	txReq.MessageType = "transaction"
+	if txReq.ChainType == "" {
+		txReq.ChainType = "ethereum"
+	}
+
+	resp, err := s.ThresholdSign(ctx, &txReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+
+	signature, err := hex.DecodeString(resp.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode signature")
+	}
+	pubKey, err := hex.DecodeString(resp.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode public key")
+	}
+
+	signed, err := adapter.AssembleSignedTx(unsigned.Raw, signature, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to assemble signed transaction")
+	}
+
+	return signed, nil
+}
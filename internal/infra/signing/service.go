@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/SafeMPC/mpc-service/internal/infra/key"
+	"github.com/SafeMPC/mpc-service/internal/infra/policy"
 	"github.com/SafeMPC/mpc-service/internal/infra/session"
 	"github.com/SafeMPC/mpc-service/internal/infra/storage"
 	"github.com/SafeMPC/mpc-service/internal/mpc/node"
@@ -33,9 +34,10 @@ type Service struct {
 	keyService      *key.Service
 	sessionManager  *session.Manager
 	nodeDiscovery   *node.Discovery
-	defaultProtocol string            // 默认协议（从配置中获取）
-	grpcClient      GRPCClient        // gRPC客户端，用于调用participant节点
+	defaultProtocol string                // 默认协议（从配置中获取）
+	grpcClient      GRPCClient            // gRPC客户端，用于调用participant节点
 	metadataStore   storage.MetadataStore // 用于查询 Passkey 公钥
+	policyEngine    *policy.Engine        // 签名策略引擎，见 SetPolicyEngine
 }
 
 // NewService 创建签名服务
@@ -57,6 +59,140 @@ func NewService(
 	}
 }
 
+// SetPolicyEngine 设置签名策略引擎，创建签名会话前评估钱包的签名策略；未设置时不做策略检查
+func (s *Service) SetPolicyEngine(engine *policy.Engine) {
+	s.policyEngine = engine
+}
+
+// EvaluatePolicy 评估签名请求的钱包策略，未设置策略引擎时返回空结果
+func (s *Service) EvaluatePolicy(ctx context.Context, req *SignRequest) (*storage.PolicyDecision, error) {
+	keyMetadata, err := s.keyService.GetKey(ctx, req.KeyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key")
+	}
+	return s.evaluatePolicy(ctx, req, keyMetadata)
+}
+
+// GetSigningPolicy 查询钱包的签名策略，未配置时返回 storage.ErrSigningPolicyNotFound
+func (s *Service) GetSigningPolicy(ctx context.Context, walletID string) (*storage.SigningPolicy, error) {
+	return s.metadataStore.GetSigningPolicy(ctx, walletID)
+}
+
+// SaveSigningPolicy 校验并保存钱包的签名策略，替换已有的策略，返回保存后的策略
+func (s *Service) SaveSigningPolicy(ctx context.Context, signingPolicy *storage.SigningPolicy) (*storage.SigningPolicy, error) {
+	if err := policy.Validate(signingPolicy); err != nil {
+		return nil, errors.Wrap(err, "invalid signing policy")
+	}
+
+	now := time.Now()
+	signingPolicy.CreatedAt = now
+	signingPolicy.UpdatedAt = now
+	if err := s.metadataStore.SaveSigningPolicy(ctx, signingPolicy); err != nil {
+		return nil, errors.Wrap(err, "failed to save signing policy")
+	}
+	return s.metadataStore.GetSigningPolicy(ctx, signingPolicy.WalletID)
+}
+
+// evaluatePolicy 评估签名请求的钱包策略，未设置策略引擎时返回空结果
+func (s *Service) evaluatePolicy(ctx context.Context, req *SignRequest, keyMetadata *key.KeyMetadata) (*storage.PolicyDecision, error) {
+	if s.policyEngine == nil {
+		return nil, nil
+	}
+	chainType := req.ChainType
+	if chainType == "" {
+		chainType = keyMetadata.ChainType
+	}
+	decision, err := s.policyEngine.Evaluate(ctx, &policy.Request{
+		WalletID:    req.KeyID,
+		ChainType:   chainType,
+		Destination: req.Destination,
+		Amount:      req.Amount,
+		Asset:       req.Asset,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to evaluate signing policy")
+	}
+	return decision, nil
+}
+
+// createSession 评估签名策略后创建签名会话；策略拒绝时仍保存被拒绝的会话用于审计，并返回 policy.ErrDenied。
+// 策略要求审批时，请求必须引用已通过审批的签名请求，否则直接返回 policy.ErrApprovalRequired，
+// 由调用方创建签名请求等待成员审批
+func (s *Service) createSession(ctx context.Context, req *SignRequest, keyMetadata *key.KeyMetadata, signingKeyID, protocol string) (*session.Session, error) {
+	decision, err := s.evaluatePolicy(ctx, req, keyMetadata)
+	if err != nil {
+		return nil, err
+	}
+	if decision != nil && decision.Action == storage.PolicyActionRequireApproval {
+		if req.SignRequestID == "" {
+			return nil, policy.DecisionError(decision)
+		}
+		if err := s.checkApprovedSignRequest(ctx, req, decision); err != nil {
+			return nil, err
+		}
+		decision.SignRequestID = req.SignRequestID
+	}
+
+	signingSession, err := s.sessionManager.CreateSessionWithPolicy(ctx, signingKeyID, protocol, keyMetadata.Threshold, keyMetadata.TotalNodes, decision)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create signing session")
+	}
+	if err := policy.DecisionError(decision); err != nil {
+		log.Warn().
+			Str("key_id", req.KeyID).
+			Str("session_id", signingSession.SessionID).
+			Str("action", decision.Action).
+			Str("reason", decision.Reason).
+			Msg("Signing request rejected by policy")
+		return nil, err
+	}
+
+	return signingSession, nil
+}
+
+// checkApprovedSignRequest 校验签名请求已通过审批、与本次签名的钱包和消息（转账请求为转账参数）一致，并且有足够的成员审批
+func (s *Service) checkApprovedSignRequest(ctx context.Context, req *SignRequest, decision *storage.PolicyDecision) error {
+	signRequest, err := s.metadataStore.GetSignRequest(ctx, req.SignRequestID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get sign request %s", req.SignRequestID)
+	}
+	if signRequest.Status != storage.SignRequestStatusApproved {
+		return errors.Wrapf(policy.ErrApprovalRequired, "sign request %s is %s", signRequest.RequestID, signRequest.Status)
+	}
+	if signRequest.WalletID != req.KeyID || !approvedRequestMatches(signRequest, req, decision.ChainType) {
+		return errors.Wrapf(policy.ErrApprovalRequired, "sign request %s does not match the signing request", signRequest.RequestID)
+	}
+
+	approvals, err := s.metadataStore.ListSignRequestApprovals(ctx, signRequest.RequestID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list approvals of sign request %s", signRequest.RequestID)
+	}
+	// 同一用户的多个凭证只计一次，与审批服务判断请求通过的规则一致
+	approved := storage.CountApprovedUsers(approvals)
+	// 审批期间策略可能被修改，按当前策略和创建请求时两者中较高的要求校验
+	required := max(decision.RequiredApprovals, signRequest.RequiredApprovals)
+	if approved < required {
+		return errors.Wrapf(policy.ErrApprovalRequired, "sign request %s has %d of %d approvals", signRequest.RequestID, approved, required)
+	}
+	return nil
+}
+
+// approvedRequestMatches 签名请求是否批准了本次签名：转账请求审批的是链、目标地址、金额和资产，
+// 交易在审批通过后才构建，按这些字段匹配；其余请求按待签名消息匹配
+func approvedRequestMatches(signRequest *storage.SignRequest, req *SignRequest, chainType string) bool {
+	if !signRequest.IsTransfer() {
+		return strings.EqualFold(signRequest.MessageHex, strings.TrimPrefix(req.MessageHex, "0x"))
+	}
+	amount := ""
+	if req.Amount != nil {
+		amount = req.Amount.String()
+	}
+	return strings.EqualFold(signRequest.ChainType, chainType) &&
+		signRequest.Destination == req.Destination &&
+		signRequest.Amount == amount &&
+		strings.EqualFold(signRequest.Asset, req.Asset)
+}
+
 // inferProtocol 根据密钥的 Algorithm 和 Curve 推断协议类型
 // 返回协议名称（gg18, gg20, frost）
 func inferProtocol(algorithm, curve, defaultProtocol string) string {
@@ -89,13 +225,16 @@ func inferProtocol(algorithm, curve, defaultProtocol string) string {
 	return "gg20"
 }
 
-// CreateSigningSession 创建签名会话
-func (s *Service) CreateSigningSession(ctx context.Context, keyID string, protocol string) (*session.Session, error) {
+// CreateSigningSession 创建签名会话，创建前评估签名策略
+func (s *Service) CreateSigningSession(ctx context.Context, req *SignRequest, protocol string) (*session.Session, error) {
 	// 获取密钥信息
-	keyMetadata, err := s.keyService.GetKey(ctx, keyID)
+	keyMetadata, err := s.keyService.GetKey(ctx, req.KeyID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get key")
 	}
+	if err := s.keyService.EnsureKeyActive(ctx, req.KeyID); err != nil {
+		return nil, err
+	}
 
 	// 如果未指定协议，使用默认协议或根据密钥信息推断
 	if protocol == "" {
@@ -103,12 +242,7 @@ func (s *Service) CreateSigningSession(ctx context.Context, keyID string, protoc
 	}
 
 	// 创建会话
-	signingSession, err := s.sessionManager.CreateSession(ctx, keyID, protocol, keyMetadata.Threshold, keyMetadata.TotalNodes)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create signing session")
-	}
-
-	return signingSession, nil
+	return s.createSession(ctx, req, keyMetadata, req.KeyID, protocol)
 }
 
 // GetSigningSession 获取签名会话
@@ -127,6 +261,10 @@ func (s *Service) ThresholdSign(ctx context.Context, req *SignRequest) (*SignRes
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get key")
 	}
+	// 只有 Active 密钥（派生钱包还要求根密钥 Active）可以签名
+	if err := s.keyService.EnsureKeyActive(ctx, req.KeyID); err != nil {
+		return nil, err
+	}
 
 	// 解析派生信息（如果存在）
 	signingKeyID := req.KeyID
@@ -175,11 +313,11 @@ func (s *Service) ThresholdSign(ctx context.Context, req *SignRequest) (*SignRes
 	// 2. 推断协议类型
 	protocolName := inferProtocol(keyMetadata.Algorithm, keyMetadata.Curve, s.defaultProtocol)
 
-	// 3. 创建签名会话
+	// 3. 评估签名策略并创建签名会话
 	// 注意：使用 signingKeyID (可能是 Root Key ID)，以便节点能够加载正确的密钥分片
-	signingSession, err := s.sessionManager.CreateSession(ctx, signingKeyID, protocolName, keyMetadata.Threshold, keyMetadata.TotalNodes)
+	signingSession, err := s.createSession(ctx, req, keyMetadata, signingKeyID, protocolName)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create signing session")
+		return nil, err
 	}
 
 	// 4. 选择参与节点
@@ -363,6 +501,7 @@ func (s *Service) ThresholdSign(ctx context.Context, req *SignRequest) (*SignRes
 				errCh <- errors.Errorf("start signing rejected by node %s: %v", nid, resp)
 				return
 			}
+			s.sessionManager.RecordDispatch(ctx, signingSession.SessionID, nid)
 		}(nodeID)
 	}
 	wgStart.Wait()
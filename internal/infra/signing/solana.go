@@ -0,0 +1,47 @@
+package signing
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/pkg/errors"
+)
+
+// SignSolanaTransaction 对 SolanaAdapter.BuildTransaction 生成的消息字节执行 Ed25519 阈值签名，
+// 并组装为可广播的已签名交易
+// req 提供密钥和鉴权信息，其中的消息字段会被交易消息覆盖，ChainType 为空时按 solana 评估签名策略
+func (s *Service) SignSolanaTransaction(ctx context.Context, adapter *chain.SolanaAdapter, req *SignRequest, unsigned *chain.Transaction) (*chain.Transaction, error) {
+	if unsigned == nil || len(unsigned.SigningHashes) != 1 {
+		return nil, errors.New("transaction must have exactly one message to sign")
+	}
+
+	txReq := *req
+	txReq.Message = nil
+	txReq.MessageHex = hex.EncodeToString(unsigned.SigningHashes[0])
+	txReq.MessageType = "transaction"
+	if txReq.ChainType == "" {
+		txReq.ChainType = "solana"
+	}
+
+	resp, err := s.ThresholdSign(ctx, &txReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+
+	signature, err := hex.DecodeString(resp.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode signature")
+	}
+	pubKey, err := hex.DecodeString(resp.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode public key")
+	}
+
+	signed, err := adapter.AssembleSignedTx(unsigned.Raw, signature, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to assemble signed transaction")
+	}
+
+	return signed, nil
+}
@@ -1,5 +1,7 @@
 package signing
 
+import "math/big"
+
 // AuthToken 鉴权令牌
 type AuthToken struct {
 	PasskeySignature  []byte
@@ -19,6 +21,14 @@ type SignRequest struct {
 	AuthTokens     []AuthToken
 	// 2-of-2 模式：手机节点ID（P1），必需
 	MobileNodeID string
+
+	// 签名策略评估使用的交易字段（可选），未提供时依赖这些字段的策略规则按最严格的结果处理
+	Destination string   // 目标地址
+	Amount      *big.Int // 金额（链上最小单位）
+	Asset       string   // 为空表示原生代币，代币为符号或合约地址
+
+	// 已通过成员审批的签名请求 ID，策略要求审批时必需
+	SignRequestID string
 }
 
 // SignResponse 签名响应
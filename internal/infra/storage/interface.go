@@ -53,31 +53,334 @@ type SigningSession struct {
 	CreatedAt          time.Time
 	CompletedAt        *time.Time
 	DurationMs         int
+	ErrorMessage       string          // 失败原因（可选）
+	PolicyDecision     *PolicyDecision // 创建会话前的策略评估结果（未启用策略引擎时为空）
+}
+
+// SessionWALRecord 协议会话 WAL 记录（按会话内 Sequence 递增）
+type SessionWALRecord struct {
+	SessionID string
+	Sequence  int64
+	Round     int
+	Type      string
+	Payload   []byte
+	CreatedAt time.Time
 }
 
 // SigningPolicy 签名策略
 type SigningPolicy struct {
 	WalletID      string
-	PolicyType    string // single, team
-	MinSignatures int
+	PolicyType    string       // single, team
+	MinSignatures int          // team 钱包签名前需要的成员审批数
+	Rules         []PolicyRule // 按顺序评估，第一条匹配的规则决定结果
+	DefaultAction string       // 没有规则匹配时的结果，为空时为 allow
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
 
+// 签名策略类型
+const (
+	PolicyTypeSingle = "single"
+	PolicyTypeTeam   = "team"
+)
+
+// 策略规则的评估结果
+const (
+	PolicyActionAllow           = "allow"
+	PolicyActionDeny            = "deny"
+	PolicyActionRequireApproval = "require_approval"
+)
+
+// PolicyRule 签名策略规则，所有已设置的条件同时满足时匹配；条件引用的交易字段未随请求提供时，
+// deny 和 require_approval 规则视为匹配，allow 规则视为不匹配
+type PolicyRule struct {
+	Name   string `json:"name"`
+	Action string `json:"action"` // allow, deny, require_approval
+
+	Chains        []string `json:"chains,omitempty"`         // 链名称或别名在列表中时匹配
+	ExcludeChains []string `json:"exclude_chains,omitempty"` // 链名称或别名不在列表中时匹配
+
+	Assets      []string `json:"assets,omitempty"`       // 资产在列表中时匹配，native 表示原生代币，代币为符号或合约地址
+	AmountAbove string   `json:"amount_above,omitempty"` // 金额（链上最小单位，十进制）大于该值时匹配
+
+	Destinations        []string `json:"destinations,omitempty"`         // 目标地址在列表中时匹配（黑名单）
+	ExcludeDestinations []string `json:"exclude_destinations,omitempty"` // 目标地址不在列表中时匹配（白名单）
+
+	TimeWindow *PolicyTimeWindow `json:"time_window,omitempty"`
+}
+
+// PolicyTimeWindow 每日时间窗口，End 早于 Start 时跨越午夜
+type PolicyTimeWindow struct {
+	Start    string `json:"start"`              // HH:MM
+	End      string `json:"end"`                // HH:MM（不含）
+	Timezone string `json:"timezone,omitempty"` // IANA 时区，为空时为 UTC
+	Outside  bool   `json:"outside,omitempty"`  // 为 true 时在窗口外匹配
+}
+
+// PolicyDecision 签名策略的评估结果，随签名会话保存用于审计
+type PolicyDecision struct {
+	Action      string    `json:"action"`
+	Rule        string    `json:"rule,omitempty"` // 匹配的规则名称，为空表示使用默认结果
+	RuleIndex   int       `json:"rule_index"`     // 匹配的规则序号，-1 表示没有规则匹配
+	Reason      string    `json:"reason"`
+	Destination string    `json:"destination,omitempty"`
+	Amount      string    `json:"amount,omitempty"`
+	Asset       string    `json:"asset,omitempty"`
+	ChainType   string    `json:"chain_type,omitempty"`
+	EvaluatedAt time.Time `json:"evaluated_at"`
+
+	RequiredApprovals int    `json:"required_approvals,omitempty"` // require_approval 时需要的成员审批数
+	SignRequestID     string `json:"sign_request_id,omitempty"`    // 已通过审批的签名请求，设置后 require_approval 允许签名
+}
+
+// Allowed 是否允许创建签名会话：allow，或 require_approval 且签名请求已通过审批
+func (d *PolicyDecision) Allowed() bool {
+	switch d.Action {
+	case PolicyActionAllow:
+		return true
+	case PolicyActionRequireApproval:
+		return d.SignRequestID != ""
+	default:
+		return false
+	}
+}
+
+// 签名请求状态
+const (
+	SignRequestStatusAwaitingApproval = "awaiting_approval"
+	SignRequestStatusApproved         = "approved"
+	SignRequestStatusRejected         = "rejected"
+	SignRequestStatusExpired          = "expired"
+	SignRequestStatusSigned           = "signed"
+	SignRequestStatusFailed           = "failed"
+)
+
+// SignRequest 策略要求审批的签名请求，收集到足够的成员审批后才执行阈值签名
+type SignRequest struct {
+	RequestID         string
+	WalletID          string
+	ChainType         string
+	MessageHex        string // 为空表示转账请求，交易在审批通过后由转账接口引用该请求时构建
+	DerivationPath    string
+	Destination       string
+	Amount            string // 链上最小单位的十进制字符串
+	Asset             string
+	MobileNodeID      string // 发起请求的手机节点，审批通过后参与签名
+	RequestHash       string // hex，成员审批时 WebAuthn assertion 的 challenge
+	Status            string // awaiting_approval, approved, rejected, expired, signed, failed
+	RequiredApprovals int
+	PolicyDecision    *PolicyDecision
+	SessionID         string // 审批通过后创建的签名会话
+	Signature         string
+	ErrorMessage      string // 签名失败原因，状态为 failed
+	ExpiresAt         time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// IsTransfer 是否为转账请求：审批的是目标地址、金额和资产，审批通过后不自动签名
+func (r *SignRequest) IsTransfer() bool {
+	return r.MessageHex == ""
+}
+
+// SignRequestApproval 钱包成员对签名请求的审批，每个用户对同一请求只能审批一次
+type SignRequestApproval struct {
+	RequestID    string
+	UserID       string // 审批用户，批准数按不同用户统计
+	CredentialID string
+	Approved     bool // false 表示拒绝
+	CreatedAt    time.Time
+}
+
 // Passkey 用户 Passkey 公钥
 type Passkey struct {
 	CredentialID string
 	PublicKey    string // COSE Key Format (Hex or Base64)
 	DeviceName   string
+	SignCount    uint32 // 认证器签名计数器，用于发现被克隆的认证器
+	CreatedAt    time.Time
+}
+
+// SignChallenge 服务端签发的签名交易 WebAuthn challenge，绑定钱包和待签名消息，只能使用一次
+type SignChallenge struct {
+	Challenge   string // Base64URL（无填充）编码
+	WalletID    string
+	MessageHash string // 待签名消息的 SHA-256（hex）
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+// 钱包成员角色，权限从高到低
+const (
+	WalletRoleOwner    = "owner"    // 管理钱包和成员，批准成员变更
+	WalletRoleAdmin    = "admin"    // 发起签名、管理钱包，提出成员变更
+	WalletRoleApprover = "approver" // 审批签名请求
+	WalletRoleViewer   = "viewer"   // 只读
+)
+
+// WalletMember 钱包成员，以 Passkey 凭证标识
+type WalletMember struct {
+	WalletID     string
+	CredentialID string // Base64URL（无填充）编码的凭证 ID
+	Role         string // owner, admin, approver, viewer
 	CreatedAt    time.Time
 }
 
+// 成员变更操作
+const (
+	WalletMemberChangeActionAdd        = "add"
+	WalletMemberChangeActionUpdateRole = "update_role"
+	WalletMemberChangeActionRemove     = "remove"
+)
+
+// 成员变更状态
+const (
+	WalletMemberChangeStatusPending  = "pending"
+	WalletMemberChangeStatusApplied  = "applied"
+	WalletMemberChangeStatusRejected = "rejected"
+)
+
+// WalletMemberChange 成员变更请求，owner 用 Passkey 批准后才生效
+type WalletMemberChange struct {
+	ChangeID     string
+	WalletID     string
+	Action       string // add, update_role, remove
+	CredentialID string // 被变更的成员凭证
+	Role         string // add 和 update_role 的目标角色
+	ProposedBy   string // 提出变更的成员凭证
+	DecidedBy    string // 批准或拒绝的 owner 凭证
+	ChangeHash   string // hex，owner 审批时 WebAuthn assertion 的 challenge
+	Status       string // pending, applied, rejected
+	CreatedAt    time.Time
+	DecidedAt    *time.Time
+}
+
+// 钱包邀请状态，过期由 ValidUntil 判断，不单独写入状态
+const (
+	WalletInvitationStatusPending  = "pending"
+	WalletInvitationStatusAccepted = "accepted"
+	WalletInvitationStatusRevoked  = "revoked"
+)
+
+// WalletInvitation 通过邮件邀请加入钱包，受邀人用自己的 Passkey 凭证接受后成为成员。
+// Passkey 用户没有经过验证的邮箱，令牌只证明持有邮件链接，owner 和 admin 邀请接受后还要 owner 批准；
+// 不复用 confirmation_tokens：它关联 users 表的账号（uuid 外键）并用于确认注册邮箱，Passkey 用户不在 users 表中
+type WalletInvitation struct {
+	InvitationID         string
+	WalletID             string
+	Email                string
+	Role                 string // 接受后的成员角色
+	Token                string // 邮件链接中的一次性令牌
+	InvitedBy            string // 发出邀请的 owner 凭证
+	Status               string // pending, accepted, revoked
+	ValidUntil           time.Time
+	AcceptedCredentialID string // 接受邀请的凭证
+	CreatedAt            time.Time
+	AcceptedAt           *time.Time
+	RevokedAt            *time.Time
+}
+
+// KeyRefreshRecord 分片刷新历史记录（每次尝试一条）
+type KeyRefreshRecord struct {
+	ID          int64
+	KeyID       string
+	Trigger     string // scheduled
+	Attempt     int
+	Status      string // success, failed
+	Error       string
+	StartedAt   time.Time
+	CompletedAt *time.Time
+	DurationMs  int
+}
+
+// KeyShareDeletion 节点分片删除确认记录
+type KeyShareDeletion struct {
+	KeyID       string
+	NodeID      string
+	Status      string // pending, confirmed, failed, awaiting_device
+	Attempts    int
+	LastError   string
+	RequestedAt time.Time
+	UpdatedAt   time.Time
+	ConfirmedAt *time.Time
+}
+
+// TransactionRecord 已广播交易及其链上确认状态
+type TransactionRecord struct {
+	ID            int64
+	WalletID      string
+	ChainType     string // 注册表中的链名称
+	TxHash        string
+	FromAddress   string
+	ToAddress     string
+	Value         string  // 链上最小单位的十进制字符串
+	Asset         string  // 为空表示原生币，否则为代币合约（EVM 小写）或 Mint 地址
+	Decimals      int     // 代币精度，原生币使用链注册表的精度
+	Nonce         *uint64 // EVM 交易 nonce，用于检测替换
+	RawTx         string
+	Status        string // pending, confirmed, failed, dropped
+	BlockNumber   int64  // 打包区块高度（Solana 为 slot），未打包时为 0
+	Confirmations int64
+	ReplacedBy    string // 占用相同 nonce 并已上链的交易哈希
+	Error         string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	ConfirmedAt   *time.Time // 进入终态的时间
+}
+
+// IncomingTransfer 索引器从链上发现的转入记录
+type IncomingTransfer struct {
+	ID          int64
+	WalletID    string
+	ChainType   string
+	TxHash      string
+	LogIndex    int64  // EVM 代币转账的日志序号，原生币转账和 Solana 转账为 -1
+	Asset       string // 为空表示原生币，否则为代币合约（EVM 小写）或 Mint 地址
+	Decimals    int    // 代币精度，原生币使用链注册表的精度
+	FromAddress string
+	ToAddress   string
+	Value       string // 链上最小单位的十进制字符串
+	BlockNumber int64  // 区块高度（Solana 为 slot）
+	BlockTime   time.Time
+	CreatedAt   time.Time
+}
+
+// WalletHistoryEntry 钱包交易历史条目，合并转出交易和转入记录
+type WalletHistoryEntry struct {
+	Direction     string // outgoing, incoming
+	ID            int64  // 所在表（transactions 或 incoming_transfers）的主键
+	TxHash        string
+	FromAddress   string
+	ToAddress     string
+	Value         string
+	Asset         string
+	Decimals      int
+	Status        string // 转出交易的确认状态，转入记录为 confirmed
+	BlockNumber   int64
+	Confirmations int64
+	ReplacedBy    string
+	Error         string
+	Timestamp     time.Time // 转出为广播时间，转入为区块时间
+}
+
+// NonceReservation EVM 地址的 nonce 预留，保证并发签名时每笔交易使用不同的 nonce
+type NonceReservation struct {
+	ChainType      string
+	Address        string // 小写地址
+	Nonce          uint64
+	Status         string // reserved, broadcast, released
+	TxHash         string // 使用该 nonce 广播的交易
+	ReplacesTxHash string // 替换卡住交易时原交易的哈希，签名失败时恢复
+	ReservedAt     time.Time
+	UpdatedAt      time.Time // 乐观锁版本，UpdateNonceReservation 只在与存储一致时更新
+}
+
 // MetadataStore 密钥元数据存储接口
 type MetadataStore interface {
 	// 密钥操作
 	SaveKeyMetadata(ctx context.Context, key *KeyMetadata) error
 	GetKeyMetadata(ctx context.Context, keyID string) (*KeyMetadata, error)
-	UpdateKeyMetadata(ctx context.Context, key *KeyMetadata) error
+	UpdateKeyMetadata(ctx context.Context, key *KeyMetadata) error // 按存储中的当前状态校验状态迁移，不允许时返回 ErrInvalidKeyStatusTransition
 	DeleteKeyMetadata(ctx context.Context, keyID string) error
 	ListKeys(ctx context.Context, filter *KeyFilter) ([]*KeyMetadata, error)
 
@@ -92,6 +395,12 @@ type MetadataStore interface {
 	SaveSigningSession(ctx context.Context, session *SigningSession) error
 	GetSigningSession(ctx context.Context, sessionID string) (*SigningSession, error)
 	UpdateSigningSession(ctx context.Context, session *SigningSession) error
+	ListSigningSessionsByStatus(ctx context.Context, statuses []string) ([]*SigningSession, error)
+
+	// 会话 WAL 操作
+	AppendSessionWAL(ctx context.Context, record *SessionWALRecord) error // 由存储分配 record.Sequence
+	ListSessionWAL(ctx context.Context, sessionID string) ([]*SessionWALRecord, error)
+	DeleteSessionWAL(ctx context.Context, sessionID string) error
 
 	// 鉴权代理操作 (Delegated Guardian)
 	GetSigningPolicy(ctx context.Context, keyID string) (*SigningPolicy, error)
@@ -102,13 +411,68 @@ type MetadataStore interface {
 	GetPasskey(ctx context.Context, credentialID string) (*Passkey, error)
 	ListUserPasskeys(ctx context.Context, userID string) ([]*Passkey, error)
 	SaveUserCredential(ctx context.Context, userID string, credentialID string, deviceName string) error
+	UpdatePasskeySignCount(ctx context.Context, credentialID string, signCount uint32) error // 计数器没有增加时返回 ErrPasskeySignCountNotIncreased
+
+	// 签名 challenge 操作
+	SaveSignChallenge(ctx context.Context, challenge *SignChallenge) error
+	GetSignChallenge(ctx context.Context, challenge string) (*SignChallenge, error) // 不存在或已使用时返回 ErrSignChallengeNotFound
+	ConsumeSignChallenge(ctx context.Context, challenge string) error               // 不存在、已使用或已过期时返回 ErrSignChallengeNotFound
+	DeleteExpiredSignChallenges(ctx context.Context, before time.Time) (int64, error)
 
 	// 团队成员操作
+	SaveKeyMetadataWithOwner(ctx context.Context, key *KeyMetadata, ownerCredentialID string) error // 同一事务中保存密钥并添加 owner
 	AddWalletMember(ctx context.Context, walletID, credentialID, role string) error
 	RemoveWalletMember(ctx context.Context, walletID, credentialID string) error
 	IsWalletMember(ctx context.Context, walletID, credentialID string) (bool, string, error) // returns (isMember, role, error)
-	ListWalletMembers(ctx context.Context, walletID string) ([]string, error)                // returns credentialIDs
+	ListWalletMembers(ctx context.Context, walletID string) ([]*WalletMember, error)
+
+	// 成员变更操作
+	SaveWalletMemberChange(ctx context.Context, change *WalletMemberChange) error
+	GetWalletMemberChange(ctx context.Context, changeID string) (*WalletMemberChange, error) // 不存在时返回 ErrWalletMemberChangeNotFound
+	ListWalletMemberChanges(ctx context.Context, walletID, status string) ([]*WalletMemberChange, error)
+	DecideWalletMemberChange(ctx context.Context, change *WalletMemberChange) error // 变更不再是 pending 时返回 ErrWalletMemberChangeStatusConflict，状态为 applied 时同时修改成员
+
+	// 钱包邀请操作
+	SaveWalletInvitation(ctx context.Context, invitation *WalletInvitation) error
+	GetWalletInvitation(ctx context.Context, invitationID string) (*WalletInvitation, error) // 不存在时返回 ErrWalletInvitationNotFound
+	GetWalletInvitationByToken(ctx context.Context, token string) (*WalletInvitation, error) // 不存在时返回 ErrWalletInvitationNotFound
+	ListWalletInvitations(ctx context.Context, walletID string) ([]*WalletInvitation, error)
+	RevokeWalletInvitation(ctx context.Context, invitation *WalletInvitation) error // 邀请不再是 pending 时返回 ErrWalletInvitationStatusConflict
+	AcceptWalletInvitation(ctx context.Context, invitation *WalletInvitation, change *WalletMemberChange) error // 邀请不再是 pending 或已过期时返回 ErrWalletInvitationStatusConflict，同时添加成员或保存待 owner 批准的 change
 
+	// 分片刷新历史操作
+	SaveKeyRefreshRecord(ctx context.Context, record *KeyRefreshRecord) error
+	GetLastSuccessfulKeyRefreshes(ctx context.Context, keyIDs []string) (map[string]time.Time, error) // 没有成功刷新记录的密钥不在结果中
+	ListKeyRefreshHistory(ctx context.Context, keyID string, limit int) ([]*KeyRefreshRecord, error)
+
+	// 分片删除确认操作
+	SaveKeyShareDeletion(ctx context.Context, deletion *KeyShareDeletion) error
+	ListKeyShareDeletions(ctx context.Context, keyID string) ([]*KeyShareDeletion, error)
+
+	// 交易记录操作
+	SaveTransactionRecord(ctx context.Context, record *TransactionRecord) error // 由存储分配 record.ID
+	UpdateTransactionRecord(ctx context.Context, record *TransactionRecord) error
+	ListTransactionRecords(ctx context.Context, filter *TransactionFilter) ([]*TransactionRecord, int64, error) // 同时返回符合条件的总数
+
+	// nonce 预留操作（调用方持有地址的分布式锁）
+	CreateNonceReservation(ctx context.Context, reservation *NonceReservation) error // nonce 已有预留时返回 ErrNonceReservationConflict
+	UpdateNonceReservation(ctx context.Context, reservation *NonceReservation) error // 预留在读取后被修改（UpdatedAt 不一致）时返回 ErrNonceReservationConflict
+	ListNonceReservations(ctx context.Context, chainType, address string) ([]*NonceReservation, error)
+	DeleteNonceReservationsBelow(ctx context.Context, chainType, address string, nonce uint64) error
+
+	// 交易历史操作
+	SaveIncomingTransfer(ctx context.Context, transfer *IncomingTransfer) error // 已存在（同一交易、资产和日志序号）时忽略
+	ListWalletHistory(ctx context.Context, filter *WalletHistoryFilter) ([]*WalletHistoryEntry, error)
+	GetIndexerCursor(ctx context.Context, chainType, address string) (string, error) // 没有进度时返回空字符串
+	SaveIndexerCursor(ctx context.Context, chainType, address, cursor string) error
+
+	// 签名请求审批操作
+	SaveSignRequest(ctx context.Context, req *SignRequest) error
+	GetSignRequest(ctx context.Context, requestID string) (*SignRequest, error) // 不存在时返回 ErrSignRequestNotFound
+	ListSignRequests(ctx context.Context, filter *SignRequestFilter) ([]*SignRequest, error)
+	UpdateSignRequest(ctx context.Context, req *SignRequest, expectedStatus string) error // 当前状态不是 expectedStatus 时返回 ErrSignRequestStatusConflict
+	SaveSignRequestApproval(ctx context.Context, approval *SignRequestApproval) error     // 同一用户或凭证重复审批时返回 ErrDuplicateSignRequestApproval
+	ListSignRequestApprovals(ctx context.Context, requestID string) ([]*SignRequestApproval, error)
 }
 
 // KeyFilter 密钥过滤条件
@@ -119,6 +483,50 @@ type KeyFilter struct {
 	TagValue  string
 	Limit     int
 	Offset    int
+
+	MemberUserID string // 不为空时只返回该用户的 Passkey 凭证是成员的钱包
+}
+
+// TransactionFilter 交易记录过滤条件，结果按创建时间倒序；OldestFirst 时按主键正序
+type TransactionFilter struct {
+	WalletID    string
+	ChainType   string
+	Statuses    []string
+	FromAddress string
+	Nonce       *uint64
+	Limit       int
+	Offset      int
+
+	// OldestFirst 按主键正序返回，配合 AfterID 遍历全部记录，不受翻页期间状态变化影响
+	OldestFirst bool
+	AfterID     int64 // 大于 0 时只返回主键更大的记录
+}
+
+// WalletHistoryFilter 钱包交易历史过滤条件，结果按时间倒序
+type WalletHistoryFilter struct {
+	WalletID  string
+	ChainType string
+	Direction string     // outgoing、incoming，为空时两者都包含
+	Asset     *string    // 为空不过滤，指向空字符串时只包含原生币
+	From      *time.Time // 包含
+	To        *time.Time // 不包含
+	After     *WalletHistoryCursor
+	Limit     int
+}
+
+// WalletHistoryCursor 游标分页位置，只返回排在该条目之后（更早）的条目
+type WalletHistoryCursor struct {
+	Timestamp time.Time
+	Direction string
+	ID        int64
+}
+
+// SignRequestFilter 签名请求过滤条件，结果按创建时间倒序
+type SignRequestFilter struct {
+	WalletID string
+	Status   string
+	Limit    int
+	Offset   int
 }
 
 // NodeFilter 节点过滤条件
@@ -166,11 +574,23 @@ type SessionStore interface {
 	// 删除会话
 	DeleteSession(ctx context.Context, sessionID string) error
 
-	// 获取分布式锁
-	AcquireLock(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// 会话 WAL 缓存（write-through，PostgreSQL 为准）
+	// AppendWAL 只在缓存已存在（或 record 是第一条）时追加，避免缓存出现缺口
+	AppendWAL(ctx context.Context, record *SessionWALRecord, ttl time.Duration) error
+	// SaveWAL 用完整记录重建缓存
+	SaveWAL(ctx context.Context, sessionID string, records []*SessionWALRecord, ttl time.Duration) error
+	// GetWAL 读取缓存，缓存不存在时返回 ErrWALNotCached
+	GetWAL(ctx context.Context, sessionID string) ([]*SessionWALRecord, error)
+	DeleteWAL(ctx context.Context, sessionID string) error
+
+	// 获取分布式锁，成功时返回持有者 token，释放和续期都必须带上该 token
+	AcquireLock(ctx context.Context, key string, ttl time.Duration) (token string, acquired bool, err error)
+
+	// 续期分布式锁，锁已过期或被其他持有者获取时返回 false
+	ExtendLock(ctx context.Context, key, token string, ttl time.Duration) (bool, error)
 
-	// 释放分布式锁
-	ReleaseLock(ctx context.Context, key string) error
+	// 释放分布式锁，只删除 token 匹配的锁
+	ReleaseLock(ctx context.Context, key, token string) error
 
 	// 发布消息（用于节点间通信）
 	PublishMessage(ctx context.Context, channel string, message interface{}) error
@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"github.com/pkg/errors"
+)
+
+// 密钥生命周期状态（KeyMetadata.Status）
+const (
+	// KeyStatusPending DKG 尚未完成
+	KeyStatusPending = "Pending"
+	// KeyStatusActive 可用于签名
+	KeyStatusActive = "Active"
+	// KeyStatusDisabled 已禁用，不可签名，可重新启用
+	KeyStatusDisabled = "Disabled"
+	// KeyStatusPendingDeletion 处于删除等待期，等待期结束后销毁分片；等待期内可取消
+	KeyStatusPendingDeletion = "PendingDeletion"
+	// KeyStatusDeleted 分片已销毁，终态
+	KeyStatusDeleted = "Deleted"
+	// KeyStatusFailed DKG 失败
+	KeyStatusFailed = "Failed"
+)
+
+// ErrInvalidKeyStatusTransition 不允许的密钥状态迁移
+var ErrInvalidKeyStatusTransition = errors.New("invalid key status transition")
+
+// keyStatusTransitions 允许的状态迁移，所有状态变更都必须经过 ValidateKeyStatusTransition，
+// UpdateKeyMetadata 在数据库中按当前状态再校验一次（见 keyStatusSources）
+var keyStatusTransitions = map[string][]string{
+	KeyStatusPending:         {KeyStatusActive, KeyStatusFailed},
+	KeyStatusActive:          {KeyStatusDisabled, KeyStatusPendingDeletion},
+	KeyStatusDisabled:        {KeyStatusActive, KeyStatusPendingDeletion},
+	KeyStatusPendingDeletion: {KeyStatusDisabled, KeyStatusDeleted},
+	KeyStatusFailed:          {KeyStatusPendingDeletion},
+	KeyStatusDeleted:         {},
+}
+
+// ValidateKeyStatusTransition 校验密钥状态能否从 from 迁移到 to
+func ValidateKeyStatusTransition(from, to string) error {
+	for _, allowed := range keyStatusTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidKeyStatusTransition, "%s -> %s", from, to)
+}
+
+// keyStatusSources 可以写入 to 的当前状态：to 本身（不改变状态的元数据更新）以及允许迁移到 to 的状态
+func keyStatusSources(to string) []string {
+	sources := []string{to}
+	for from, targets := range keyStatusTransitions {
+		for _, target := range targets {
+			if target == to {
+				sources = append(sources, from)
+				break
+			}
+		}
+	}
+	return sources
+}
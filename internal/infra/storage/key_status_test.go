@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var allKeyStatuses = []string{
+	KeyStatusPending,
+	KeyStatusActive,
+	KeyStatusDisabled,
+	KeyStatusPendingDeletion,
+	KeyStatusDeleted,
+	KeyStatusFailed,
+}
+
+func TestValidateKeyStatusTransition(t *testing.T) {
+	allowed := map[string][]string{
+		KeyStatusPending:         {KeyStatusActive, KeyStatusFailed},
+		KeyStatusActive:          {KeyStatusDisabled, KeyStatusPendingDeletion},
+		KeyStatusDisabled:        {KeyStatusActive, KeyStatusPendingDeletion},
+		KeyStatusPendingDeletion: {KeyStatusDisabled, KeyStatusDeleted},
+		KeyStatusFailed:          {KeyStatusPendingDeletion},
+		KeyStatusDeleted:         {},
+	}
+
+	for _, from := range allKeyStatuses {
+		for _, to := range allKeyStatuses {
+			err := ValidateKeyStatusTransition(from, to)
+			if contains(allowed[from], to) {
+				assert.NoError(t, err, "%s -> %s", from, to)
+			} else {
+				assert.True(t, errors.Is(err, ErrInvalidKeyStatusTransition), "%s -> %s", from, to)
+			}
+		}
+	}
+}
+
+func TestValidateKeyStatusTransitionUnknownStatus(t *testing.T) {
+	assert.True(t, errors.Is(ValidateKeyStatusTransition("Unknown", KeyStatusActive), ErrInvalidKeyStatusTransition))
+	assert.True(t, errors.Is(ValidateKeyStatusTransition(KeyStatusActive, "Unknown"), ErrInvalidKeyStatusTransition))
+}
+
+func TestKeyStatusSources(t *testing.T) {
+	for _, to := range allKeyStatuses {
+		sources := keyStatusSources(to)
+
+		// 不改变状态的元数据更新总是允许
+		assert.Contains(t, sources, to)
+
+		for _, from := range allKeyStatuses {
+			if from == to {
+				continue
+			}
+			assert.Equal(t, ValidateKeyStatusTransition(from, to) == nil, contains(sources, from), "%s -> %s", from, to)
+		}
+	}
+
+	// Deleted 是终态，只能从 PendingDeletion 进入
+	assert.ElementsMatch(t, []string{KeyStatusDeleted, KeyStatusPendingDeletion}, keyStatusSources(KeyStatusDeleted))
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Lock 已持有的分布式锁
+// 持有期间后台每 ttl/3 续期一次，续期失败（锁已过期或被其他实例获取）时取消 Context()，
+// 受保护的操作应使用 Context() 作为上下文，锁丢失后尽快停止
+type Lock struct {
+	store SessionStore
+	key   string
+	token string
+	ttl   time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// TryLock 尝试获取分布式锁，锁已被其他持有者占用时返回 (nil, nil)
+func TryLock(ctx context.Context, store SessionStore, key string, ttl time.Duration) (*Lock, error) {
+	if ttl <= 0 {
+		return nil, errors.New("lock ttl must be positive")
+	}
+
+	token, acquired, err := store.AcquireLock(ctx, key, ttl)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, nil
+	}
+
+	lockCtx, cancel := context.WithCancel(ctx)
+	l := &Lock{
+		store:  store,
+		key:    key,
+		token:  token,
+		ttl:    ttl,
+		ctx:    lockCtx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go l.keepAlive()
+
+	return l, nil
+}
+
+// Context 锁丢失或释放后被取消的上下文
+func (l *Lock) Context() context.Context {
+	return l.ctx
+}
+
+// Release 停止续期并释放锁（只删除自己持有的锁），可重复调用
+func (l *Lock) Release() error {
+	var err error
+	l.once.Do(func() {
+		l.cancel()
+		<-l.done
+		err = l.store.ReleaseLock(context.Background(), l.key, l.token)
+	})
+	return err
+}
+
+func (l *Lock) keepAlive() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+			held, err := l.store.ExtendLock(l.ctx, l.key, l.token, l.ttl)
+			if err != nil && l.ctx.Err() != nil {
+				return
+			}
+			if err != nil || !held {
+				// 无法确认仍持有锁，按丢失处理
+				l.cancel()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryLockStore 内存版锁实现，语义与 RedisStore 一致：只有 token 匹配时才能续期和释放
+type memoryLockStore struct {
+	SessionStore
+
+	mu      sync.Mutex
+	seq     int
+	owners  map[string]string
+	extends int
+}
+
+func newMemoryLockStore() *memoryLockStore {
+	return &memoryLockStore{owners: make(map[string]string)}
+}
+
+func (m *memoryLockStore) AcquireLock(_ context.Context, key string, _ time.Duration) (string, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, held := m.owners[key]; held {
+		return "", false, nil
+	}
+	m.seq++
+	token := strconv.Itoa(m.seq)
+	m.owners[key] = token
+	return token, true, nil
+}
+
+func (m *memoryLockStore) ExtendLock(_ context.Context, key, token string, _ time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.extends++
+	return m.owners[key] == token, nil
+}
+
+func (m *memoryLockStore) ReleaseLock(_ context.Context, key, token string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.owners[key] == token {
+		delete(m.owners, key)
+	}
+	return nil
+}
+
+// expire 模拟锁过期后被其他实例获取
+func (m *memoryLockStore) expire(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.seq++
+	m.owners[key] = strconv.Itoa(m.seq)
+}
+
+func (m *memoryLockStore) owner(key string) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	token, ok := m.owners[key]
+	return token, ok
+}
+
+func TestTryLockExclusive(t *testing.T) {
+	store := newMemoryLockStore()
+
+	lock, err := TryLock(context.Background(), store, "job", time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+
+	other, err := TryLock(context.Background(), store, "job", time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, other)
+
+	require.NoError(t, lock.Release())
+	_, held := store.owner("job")
+	assert.False(t, held)
+
+	// 重复释放不报错
+	require.NoError(t, lock.Release())
+
+	again, err := TryLock(context.Background(), store, "job", time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, again)
+	require.NoError(t, again.Release())
+}
+
+func TestLockReleaseKeepsLockTakenByOtherOwner(t *testing.T) {
+	store := newMemoryLockStore()
+
+	lock, err := TryLock(context.Background(), store, "job", time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+
+	store.expire("job")
+	newOwner, _ := store.owner("job")
+
+	require.NoError(t, lock.Release())
+
+	current, held := store.owner("job")
+	assert.True(t, held)
+	assert.Equal(t, newOwner, current)
+}
+
+func TestLockExtendsWhileHeld(t *testing.T) {
+	store := newMemoryLockStore()
+
+	lock, err := TryLock(context.Background(), store, "job", 30*time.Millisecond)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+	defer lock.Release()
+
+	time.Sleep(100 * time.Millisecond)
+
+	assert.NoError(t, lock.Context().Err())
+	store.mu.Lock()
+	extends := store.extends
+	store.mu.Unlock()
+	assert.GreaterOrEqual(t, extends, 2)
+}
+
+func TestLockContextCancelledWhenLost(t *testing.T) {
+	store := newMemoryLockStore()
+
+	lock, err := TryLock(context.Background(), store, "job", 30*time.Millisecond)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+	defer lock.Release()
+
+	store.expire("job")
+
+	select {
+	case <-lock.Context().Done():
+	case <-time.After(time.Second):
+		t.Fatal("lock context not cancelled after lock was lost")
+	}
+}
+
+func TestTryLockRejectsNonPositiveTTL(t *testing.T) {
+	_, err := TryLock(context.Background(), newMemoryLockStore(), "job", 0)
+	assert.Error(t, err)
+}
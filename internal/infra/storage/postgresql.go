@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"strings"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -19,6 +20,27 @@ func NewPostgreSQLStore(db *sql.DB) MetadataStore {
 	return &PostgreSQLStore{db: db}
 }
 
+// saveKeyMetadataQuery 插入或更新密钥元数据
+const saveKeyMetadataQuery = `
+	INSERT INTO keys (
+		key_id, public_key, algorithm, curve, threshold, total_nodes,
+		chain_type, chain_code, address, status, description, tags, created_at, updated_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	ON CONFLICT (key_id) DO UPDATE SET
+		public_key = EXCLUDED.public_key,
+		algorithm = EXCLUDED.algorithm,
+		curve = EXCLUDED.curve,
+		threshold = EXCLUDED.threshold,
+		total_nodes = EXCLUDED.total_nodes,
+		chain_type = EXCLUDED.chain_type,
+		chain_code = EXCLUDED.chain_code,
+		address = EXCLUDED.address,
+		status = EXCLUDED.status,
+		description = EXCLUDED.description,
+		tags = EXCLUDED.tags,
+		updated_at = EXCLUDED.updated_at
+`
+
 // SaveKeyMetadata 保存密钥元数据
 func (s *PostgreSQLStore) SaveKeyMetadata(ctx context.Context, key *KeyMetadata) error {
 	tagsJSON, err := json.Marshal(key.Tags)
@@ -26,27 +48,7 @@ func (s *PostgreSQLStore) SaveKeyMetadata(ctx context.Context, key *KeyMetadata)
 		return errors.Wrap(err, "failed to marshal tags")
 	}
 
-	query := `
-		INSERT INTO keys (
-			key_id, public_key, algorithm, curve, threshold, total_nodes,
-			chain_type, chain_code, address, status, description, tags, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
-		ON CONFLICT (key_id) DO UPDATE SET
-			public_key = EXCLUDED.public_key,
-			algorithm = EXCLUDED.algorithm,
-			curve = EXCLUDED.curve,
-			threshold = EXCLUDED.threshold,
-			total_nodes = EXCLUDED.total_nodes,
-			chain_type = EXCLUDED.chain_type,
-			chain_code = EXCLUDED.chain_code,
-			address = EXCLUDED.address,
-			status = EXCLUDED.status,
-			description = EXCLUDED.description,
-			tags = EXCLUDED.tags,
-			updated_at = EXCLUDED.updated_at
-	`
-
-	result, err := s.db.ExecContext(ctx, query,
+	result, err := s.db.ExecContext(ctx, saveKeyMetadataQuery,
 		key.KeyID, key.PublicKey, key.Algorithm, key.Curve, key.Threshold, key.TotalNodes,
 		key.ChainType, key.ChainCode, key.Address, key.Status, key.Description, tagsJSON,
 		key.CreatedAt, key.UpdatedAt,
@@ -135,7 +137,7 @@ func (s *PostgreSQLStore) UpdateKeyMetadata(ctx context.Context, key *KeyMetadat
 			tags = $12,
 			updated_at = $13,
 			deletion_date = $14
-		WHERE key_id = $1
+		WHERE key_id = $1 AND status = ANY($15)
 	`
 
 	var deletionDate interface{}
@@ -143,15 +145,34 @@ func (s *PostgreSQLStore) UpdateKeyMetadata(ctx context.Context, key *KeyMetadat
 		deletionDate = *key.DeletionDate
 	}
 
-	_, err = s.db.ExecContext(ctx, query,
+	// 状态迁移按数据库中的当前状态校验，避免并发写入绕过状态机
+	result, err := s.db.ExecContext(ctx, query,
 		key.KeyID, key.PublicKey, key.Algorithm, key.Curve, key.Threshold, key.TotalNodes,
 		key.ChainType, key.ChainCode, key.Address, key.Status, key.Description, tagsJSON,
-		key.UpdatedAt, deletionDate,
+		key.UpdatedAt, deletionDate, pq.Array(keyStatusSources(key.Status)),
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to update key metadata")
 	}
 
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if rows == 0 {
+		var current string
+		if err := s.db.QueryRowContext(ctx, `SELECT status FROM keys WHERE key_id = $1`, key.KeyID).Scan(&current); err != nil {
+			if err == sql.ErrNoRows {
+				return errors.Errorf("key %s not found", key.KeyID)
+			}
+			return errors.Wrap(err, "failed to get key status")
+		}
+		if err := ValidateKeyStatusTransition(current, key.Status); err != nil {
+			return errors.Wrapf(err, "key %s", key.KeyID)
+		}
+		return errors.Wrapf(ErrInvalidKeyStatusTransition, "key %s status changed concurrently", key.KeyID)
+	}
+
 	return nil
 }
 
@@ -192,6 +213,15 @@ func (s *PostgreSQLStore) ListKeys(ctx context.Context, filter *KeyFilter) ([]*K
 		argIndex++
 	}
 
+	if filter.MemberUserID != "" {
+		query += ` AND key_id IN (
+			SELECT wm.wallet_id FROM wallet_members wm
+			INNER JOIN user_credentials uc ON wm.credential_id = uc.credential_id
+			WHERE uc.user_id = $` + string(rune('0'+argIndex)) + `)`
+		args = append(args, filter.MemberUserID)
+		argIndex++
+	}
+
 	query += ` ORDER BY created_at DESC LIMIT $` + string(rune('0'+argIndex)) + ` OFFSET $` + string(rune('0'+argIndex+1))
 	args = append(args, filter.Limit, filter.Offset)
 
@@ -243,15 +273,16 @@ func (s *PostgreSQLStore) ListKeys(ctx context.Context, filter *KeyFilter) ([]*K
 // SavePasskey 保存用户 Passkey
 func (s *PostgreSQLStore) SavePasskey(ctx context.Context, passkey *Passkey) error {
 	query := `
-		INSERT INTO passkeys (credential_id, public_key, device_name, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO passkeys (credential_id, public_key, device_name, sign_count, created_at)
+		VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (credential_id) DO UPDATE SET
 			public_key = EXCLUDED.public_key,
-			device_name = EXCLUDED.device_name
+			device_name = EXCLUDED.device_name,
+			sign_count = EXCLUDED.sign_count
 	`
 
 	_, err := s.db.ExecContext(ctx, query,
-		passkey.CredentialID, passkey.PublicKey, passkey.DeviceName, passkey.CreatedAt,
+		passkey.CredentialID, passkey.PublicKey, passkey.DeviceName, int64(passkey.SignCount), passkey.CreatedAt,
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to save user passkey")
@@ -263,14 +294,15 @@ func (s *PostgreSQLStore) SavePasskey(ctx context.Context, passkey *Passkey) err
 // GetPasskey 获取用户 Passkey
 func (s *PostgreSQLStore) GetPasskey(ctx context.Context, credentialID string) (*Passkey, error) {
 	query := `
-		SELECT credential_id, public_key, device_name, created_at
+		SELECT credential_id, public_key, device_name, sign_count, created_at
 		FROM passkeys
 		WHERE credential_id = $1
 	`
 
 	var passkey Passkey
+	var signCount int64
 	err := s.db.QueryRowContext(ctx, query, credentialID).Scan(
-		&passkey.CredentialID, &passkey.PublicKey, &passkey.DeviceName, &passkey.CreatedAt,
+		&passkey.CredentialID, &passkey.PublicKey, &passkey.DeviceName, &signCount, &passkey.CreatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -278,14 +310,33 @@ func (s *PostgreSQLStore) GetPasskey(ctx context.Context, credentialID string) (
 		}
 		return nil, errors.Wrap(err, "failed to get user passkey")
 	}
+	passkey.SignCount = uint32(signCount)
 
 	return &passkey, nil
 }
 
+// UpdatePasskeySignCount 在计数器增加时更新 Passkey 的签名计数器，并发的相同计数只有一个成功
+func (s *PostgreSQLStore) UpdatePasskeySignCount(ctx context.Context, credentialID string, signCount uint32) error {
+	query := `UPDATE passkeys SET sign_count = $2 WHERE credential_id = $1 AND sign_count < $2`
+
+	result, err := s.db.ExecContext(ctx, query, credentialID, int64(signCount))
+	if err != nil {
+		return errors.Wrap(err, "failed to update passkey sign count")
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to update passkey sign count")
+	}
+	if rows == 0 {
+		return ErrPasskeySignCountNotIncreased
+	}
+	return nil
+}
+
 // ListUserPasskeys 获取用户的所有 Passkey
 func (s *PostgreSQLStore) ListUserPasskeys(ctx context.Context, userID string) ([]*Passkey, error) {
 	query := `
-		SELECT p.credential_id, p.public_key, p.device_name, p.created_at
+		SELECT p.credential_id, p.public_key, p.device_name, p.sign_count, p.created_at
 		FROM passkeys p
 		INNER JOIN user_credentials uc ON p.credential_id = uc.credential_id
 		WHERE uc.user_id = $1
@@ -301,11 +352,13 @@ func (s *PostgreSQLStore) ListUserPasskeys(ctx context.Context, userID string) (
 	var passkeys []*Passkey
 	for rows.Next() {
 		var passkey Passkey
+		var signCount int64
 		if err := rows.Scan(
-			&passkey.CredentialID, &passkey.PublicKey, &passkey.DeviceName, &passkey.CreatedAt,
+			&passkey.CredentialID, &passkey.PublicKey, &passkey.DeviceName, &signCount, &passkey.CreatedAt,
 		); err != nil {
 			return nil, errors.Wrap(err, "failed to scan passkey")
 		}
+		passkey.SignCount = uint32(signCount)
 		passkeys = append(passkeys, &passkey)
 	}
 
@@ -341,13 +394,17 @@ func (s *PostgreSQLStore) SaveSigningSession(ctx context.Context, session *Signi
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal participating nodes")
 	}
+	policyDecisionJSON, err := marshalPolicyDecision(session.PolicyDecision)
+	if err != nil {
+		return err
+	}
 
 	query := `
 		INSERT INTO signing_sessions (
 			session_id, key_id, protocol, status, threshold, total_nodes,
 			participating_nodes, current_round, total_rounds, signature,
-			created_at, completed_at, duration_ms
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			created_at, completed_at, duration_ms, error_message, policy_decision
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		ON CONFLICT (session_id) DO UPDATE SET
 			key_id = EXCLUDED.key_id,
 			protocol = EXCLUDED.protocol,
@@ -359,7 +416,9 @@ func (s *PostgreSQLStore) SaveSigningSession(ctx context.Context, session *Signi
 			total_rounds = EXCLUDED.total_rounds,
 			signature = EXCLUDED.signature,
 			completed_at = EXCLUDED.completed_at,
-			duration_ms = EXCLUDED.duration_ms
+			duration_ms = EXCLUDED.duration_ms,
+			error_message = EXCLUDED.error_message,
+			policy_decision = EXCLUDED.policy_decision
 	`
 
 	var completedAt interface{}
@@ -384,6 +443,8 @@ func (s *PostgreSQLStore) SaveSigningSession(ctx context.Context, session *Signi
 		session.Threshold, session.TotalNodes, participatingNodesJSON,
 		session.CurrentRound, session.TotalRounds, session.Signature,
 		session.CreatedAt, completedAt, session.DurationMs,
+		sql.NullString{String: session.ErrorMessage, Valid: session.ErrorMessage != ""},
+		policyDecisionJSON,
 	)
 	if err != nil {
 		// 检查是否是外键约束错误
@@ -407,7 +468,7 @@ func (s *PostgreSQLStore) GetSigningSession(ctx context.Context, sessionID strin
 	query := `
 		SELECT session_id, key_id, protocol, status, threshold, total_nodes,
 			participating_nodes, current_round, total_rounds, signature,
-			created_at, completed_at, duration_ms
+			created_at, completed_at, duration_ms, error_message, policy_decision
 		FROM signing_sessions
 		WHERE session_id = $1
 	`
@@ -415,12 +476,15 @@ func (s *PostgreSQLStore) GetSigningSession(ctx context.Context, sessionID strin
 	var session SigningSession
 	var participatingNodesJSON []byte
 	var completedAt sql.NullTime
+	var errorMessage sql.NullString
+	var policyDecisionJSON []byte
 
 	err := s.db.QueryRowContext(ctx, query, sessionID).Scan(
 		&session.SessionID, &session.KeyID, &session.Protocol, &session.Status,
 		&session.Threshold, &session.TotalNodes, &participatingNodesJSON,
 		&session.CurrentRound, &session.TotalRounds, &session.Signature,
-		&session.CreatedAt, &completedAt, &session.DurationMs,
+		&session.CreatedAt, &completedAt, &session.DurationMs, &errorMessage,
+		&policyDecisionJSON,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -441,6 +505,14 @@ func (s *PostgreSQLStore) GetSigningSession(ctx context.Context, sessionID strin
 	if completedAt.Valid {
 		session.CompletedAt = &completedAt.Time
 	}
+	session.ErrorMessage = errorMessage.String
+
+	if len(policyDecisionJSON) > 0 {
+		session.PolicyDecision = &PolicyDecision{}
+		if err := json.Unmarshal(policyDecisionJSON, session.PolicyDecision); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal policy decision")
+		}
+	}
 
 	return &session, nil
 }
@@ -452,6 +524,11 @@ func (s *PostgreSQLStore) UpdateSigningSession(ctx context.Context, session *Sig
 		return errors.Wrap(err, "failed to marshal participating nodes")
 	}
 
+	policyDecisionJSON, err := marshalPolicyDecision(session.PolicyDecision)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE signing_sessions SET
 			key_id = $2,
@@ -464,7 +541,9 @@ func (s *PostgreSQLStore) UpdateSigningSession(ctx context.Context, session *Sig
 			total_rounds = $9,
 			signature = $10,
 			completed_at = $11,
-			duration_ms = $12
+			duration_ms = $12,
+			error_message = $13,
+			policy_decision = $14
 		WHERE session_id = $1
 	`
 
@@ -478,6 +557,8 @@ func (s *PostgreSQLStore) UpdateSigningSession(ctx context.Context, session *Sig
 		session.Threshold, session.TotalNodes, participatingNodesJSON,
 		session.CurrentRound, session.TotalRounds, session.Signature,
 		completedAt, session.DurationMs,
+		sql.NullString{String: session.ErrorMessage, Valid: session.ErrorMessage != ""},
+		policyDecisionJSON,
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to update signing session")
@@ -486,6 +567,18 @@ func (s *PostgreSQLStore) UpdateSigningSession(ctx context.Context, session *Sig
 	return nil
 }
 
+// marshalPolicyDecision 序列化策略评估结果，为空时写入 NULL
+func marshalPolicyDecision(decision *PolicyDecision) (interface{}, error) {
+	if decision == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(decision)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal policy decision")
+	}
+	return data, nil
+}
+
 // 备份功能已删除
 // 以下方法已移除：
 // - SaveBackupShare
@@ -497,42 +590,69 @@ func (s *PostgreSQLStore) UpdateSigningSession(ctx context.Context, session *Sig
 // - UpdateBackupShareDeliveryStatus
 // - ListBackupShareDeliveries
 
+// ErrSigningPolicyNotFound 钱包没有配置签名策略
+var ErrSigningPolicyNotFound = errors.New("policy not found")
+
 // GetSigningPolicy 获取签名策略
 func (s *PostgreSQLStore) GetSigningPolicy(ctx context.Context, keyID string) (*SigningPolicy, error) {
 	query := `
-		SELECT wallet_id, policy_type, min_signatures, created_at, updated_at
+		SELECT wallet_id, policy_type, min_signatures, rules, default_action, created_at, updated_at
 		FROM signing_policies
 		WHERE wallet_id = $1
 	`
 
 	var policy SigningPolicy
+	var rulesJSON []byte
 	err := s.db.QueryRowContext(ctx, query, keyID).Scan(
 		&policy.WalletID, &policy.PolicyType, &policy.MinSignatures,
+		&rulesJSON, &policy.DefaultAction,
 		&policy.CreatedAt, &policy.UpdatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, errors.New("policy not found")
+			return nil, ErrSigningPolicyNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get signing policy")
 	}
 
+	if len(rulesJSON) > 0 {
+		if err := json.Unmarshal(rulesJSON, &policy.Rules); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal policy rules")
+		}
+	}
+
 	return &policy, nil
 }
 
 // SaveSigningPolicy 保存签名策略
 func (s *PostgreSQLStore) SaveSigningPolicy(ctx context.Context, policy *SigningPolicy) error {
+	rules := policy.Rules
+	if rules == nil {
+		rules = []PolicyRule{}
+	}
+	rulesJSON, err := json.Marshal(rules)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal policy rules")
+	}
+	defaultAction := policy.DefaultAction
+	if defaultAction == "" {
+		defaultAction = PolicyActionAllow
+	}
+
 	query := `
-		INSERT INTO signing_policies (wallet_id, policy_type, min_signatures, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO signing_policies (wallet_id, policy_type, min_signatures, rules, default_action, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		ON CONFLICT (wallet_id) DO UPDATE SET
 			policy_type = EXCLUDED.policy_type,
 			min_signatures = EXCLUDED.min_signatures,
+			rules = EXCLUDED.rules,
+			default_action = EXCLUDED.default_action,
 			updated_at = EXCLUDED.updated_at
 	`
 
-	_, err := s.db.ExecContext(ctx, query,
+	_, err = s.db.ExecContext(ctx, query,
 		policy.WalletID, policy.PolicyType, policy.MinSignatures,
+		rulesJSON, defaultAction,
 		policy.CreatedAt, policy.UpdatedAt,
 	)
 	if err != nil {
@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrWalletInvitationNotFound 钱包邀请不存在
+	ErrWalletInvitationNotFound = errors.New("wallet invitation not found")
+	// ErrWalletInvitationStatusConflict 钱包邀请已被接受、撤销或已过期
+	ErrWalletInvitationStatusConflict = errors.New("wallet invitation is no longer pending")
+)
+
+const walletInvitationColumns = `invitation_id, wallet_id, email, role, token, invited_by, status, valid_until,
+	accepted_credential_id, created_at, accepted_at, revoked_at`
+
+// SaveWalletInvitation 保存新的钱包邀请
+func (s *PostgreSQLStore) SaveWalletInvitation(ctx context.Context, invitation *WalletInvitation) error {
+	query := `
+		INSERT INTO wallet_invitations (invitation_id, wallet_id, email, role, token, invited_by, status, valid_until, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING created_at
+	`
+	err := s.db.QueryRowContext(ctx, query,
+		invitation.InvitationID, invitation.WalletID, invitation.Email, invitation.Role,
+		invitation.Token, invitation.InvitedBy, invitation.Status, invitation.ValidUntil,
+	).Scan(&invitation.CreatedAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to save wallet invitation")
+	}
+	return nil
+}
+
+// GetWalletInvitation 按邀请 ID 获取钱包邀请
+func (s *PostgreSQLStore) GetWalletInvitation(ctx context.Context, invitationID string) (*WalletInvitation, error) {
+	query := `SELECT ` + walletInvitationColumns + ` FROM wallet_invitations WHERE invitation_id = $1`
+	return s.getWalletInvitation(ctx, query, invitationID)
+}
+
+// GetWalletInvitationByToken 按邮件链接中的令牌获取钱包邀请
+func (s *PostgreSQLStore) GetWalletInvitationByToken(ctx context.Context, token string) (*WalletInvitation, error) {
+	query := `SELECT ` + walletInvitationColumns + ` FROM wallet_invitations WHERE token = $1`
+	return s.getWalletInvitation(ctx, query, token)
+}
+
+func (s *PostgreSQLStore) getWalletInvitation(ctx context.Context, query string, arg string) (*WalletInvitation, error) {
+	invitation, err := scanWalletInvitation(s.db.QueryRowContext(ctx, query, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrWalletInvitationNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get wallet invitation")
+	}
+	return invitation, nil
+}
+
+// ListWalletInvitations 列出钱包的邀请（按创建时间倒序）
+func (s *PostgreSQLStore) ListWalletInvitations(ctx context.Context, walletID string) ([]*WalletInvitation, error) {
+	query := `SELECT ` + walletInvitationColumns + ` FROM wallet_invitations WHERE wallet_id = $1 ORDER BY created_at DESC`
+	rows, err := s.db.QueryContext(ctx, query, walletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet invitations")
+	}
+	defer rows.Close()
+
+	var invitations []*WalletInvitation
+	for rows.Next() {
+		invitation, err := scanWalletInvitation(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan wallet invitation")
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations, rows.Err()
+}
+
+// RevokeWalletInvitation 撤销 pending 的钱包邀请
+func (s *PostgreSQLStore) RevokeWalletInvitation(ctx context.Context, invitation *WalletInvitation) error {
+	query := `
+		UPDATE wallet_invitations SET status = $2, revoked_at = NOW()
+		WHERE invitation_id = $1 AND status = $3
+		RETURNING revoked_at
+	`
+	var revokedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, query, invitation.InvitationID, WalletInvitationStatusRevoked, WalletInvitationStatusPending).Scan(&revokedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrWalletInvitationStatusConflict
+		}
+		return errors.Wrap(err, "failed to revoke wallet invitation")
+	}
+	invitation.Status = WalletInvitationStatusRevoked
+	invitation.RevokedAt = &revokedAt.Time
+	return nil
+}
+
+// AcceptWalletInvitation 在同一事务中把未过期的 pending 邀请标记为 accepted，并以邀请的角色添加
+// invitation.AcceptedCredentialID 为钱包成员；change 不为 nil 时改为保存该成员变更，等待 owner 批准后才加入
+func (s *PostgreSQLStore) AcceptWalletInvitation(ctx context.Context, invitation *WalletInvitation, change *WalletMemberChange) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		UPDATE wallet_invitations SET status = $2, accepted_credential_id = $3, accepted_at = NOW()
+		WHERE invitation_id = $1 AND status = $4 AND valid_until > NOW()
+		RETURNING accepted_at
+	`
+	var acceptedAt sql.NullTime
+	err = tx.QueryRowContext(ctx, query, invitation.InvitationID, WalletInvitationStatusAccepted,
+		invitation.AcceptedCredentialID, WalletInvitationStatusPending).Scan(&acceptedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrWalletInvitationStatusConflict
+		}
+		return errors.Wrap(err, "failed to accept wallet invitation")
+	}
+
+	if change != nil {
+		if err := saveWalletMemberChange(ctx, tx, change); err != nil {
+			return err
+		}
+	} else if _, err := tx.ExecContext(ctx, addWalletMemberQuery, invitation.WalletID, invitation.AcceptedCredentialID, invitation.Role); err != nil {
+		return errors.Wrap(err, "failed to add wallet member")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit wallet invitation")
+	}
+	invitation.Status = WalletInvitationStatusAccepted
+	invitation.AcceptedAt = &acceptedAt.Time
+	return nil
+}
+
+func scanWalletInvitation(row walletMemberChangeScanner) (*WalletInvitation, error) {
+	invitation := &WalletInvitation{}
+	var acceptedCredentialID sql.NullString
+	var acceptedAt, revokedAt sql.NullTime
+	err := row.Scan(&invitation.InvitationID, &invitation.WalletID, &invitation.Email, &invitation.Role,
+		&invitation.Token, &invitation.InvitedBy, &invitation.Status, &invitation.ValidUntil,
+		&acceptedCredentialID, &invitation.CreatedAt, &acceptedAt, &revokedAt)
+	if err != nil {
+		return nil, err
+	}
+	invitation.AcceptedCredentialID = acceptedCredentialID.String
+	if acceptedAt.Valid {
+		invitation.AcceptedAt = &acceptedAt.Time
+	}
+	if revokedAt.Valid {
+		invitation.RevokedAt = &revokedAt.Time
+	}
+	return invitation, nil
+}
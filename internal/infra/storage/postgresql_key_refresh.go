@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// SaveKeyRefreshRecord 保存分片刷新记录
+func (s *PostgreSQLStore) SaveKeyRefreshRecord(ctx context.Context, record *KeyRefreshRecord) error {
+	query := `
+		INSERT INTO key_refresh_history (key_id, trigger, attempt, status, error, started_at, completed_at, duration_ms)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	var completedAt interface{}
+	if record.CompletedAt != nil {
+		completedAt = *record.CompletedAt
+	}
+
+	err := s.db.QueryRowContext(ctx, query,
+		record.KeyID, record.Trigger, record.Attempt, record.Status,
+		sql.NullString{String: record.Error, Valid: record.Error != ""},
+		record.StartedAt, completedAt, record.DurationMs,
+	).Scan(&record.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to save key refresh record")
+	}
+	return nil
+}
+
+// GetLastSuccessfulKeyRefreshes 批量获取密钥最近一次成功刷新的完成时间
+func (s *PostgreSQLStore) GetLastSuccessfulKeyRefreshes(ctx context.Context, keyIDs []string) (map[string]time.Time, error) {
+	result := make(map[string]time.Time, len(keyIDs))
+	if len(keyIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT key_id, MAX(completed_at)
+		FROM key_refresh_history
+		WHERE key_id = ANY($1) AND status = 'success' AND completed_at IS NOT NULL
+		GROUP BY key_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(keyIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last key refreshes")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var keyID string
+		var completedAt time.Time
+		if err := rows.Scan(&keyID, &completedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan last key refresh")
+		}
+		result[keyID] = completedAt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate last key refreshes")
+	}
+	return result, nil
+}
+
+// ListKeyRefreshHistory 列出密钥的分片刷新历史（按时间倒序）
+func (s *PostgreSQLStore) ListKeyRefreshHistory(ctx context.Context, keyID string, limit int) ([]*KeyRefreshRecord, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT id, key_id, trigger, attempt, status, error, started_at, completed_at, duration_ms
+		FROM key_refresh_history
+		WHERE key_id = $1
+		ORDER BY started_at DESC
+		LIMIT $2
+	`
+	rows, err := s.db.QueryContext(ctx, query, keyID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list key refresh history")
+	}
+	defer rows.Close()
+
+	var records []*KeyRefreshRecord
+	for rows.Next() {
+		record, err := scanKeyRefreshRecord(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan key refresh record")
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+type keyRefreshScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanKeyRefreshRecord(row keyRefreshScanner) (*KeyRefreshRecord, error) {
+	var record KeyRefreshRecord
+	var errMsg sql.NullString
+	var completedAt sql.NullTime
+	var durationMs sql.NullInt64
+
+	if err := row.Scan(
+		&record.ID, &record.KeyID, &record.Trigger, &record.Attempt, &record.Status,
+		&errMsg, &record.StartedAt, &completedAt, &durationMs,
+	); err != nil {
+		return nil, err
+	}
+
+	record.Error = errMsg.String
+	if completedAt.Valid {
+		record.CompletedAt = &completedAt.Time
+	}
+	record.DurationMs = int(durationMs.Int64)
+
+	return &record, nil
+}
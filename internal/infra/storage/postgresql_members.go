@@ -3,19 +3,53 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/pkg/errors"
 )
 
+var (
+	// ErrWalletMemberChangeNotFound 成员变更请求不存在
+	ErrWalletMemberChangeNotFound = errors.New("wallet member change not found")
+	// ErrWalletMemberChangeStatusConflict 成员变更请求已被其他请求批准或拒绝
+	ErrWalletMemberChangeStatusConflict = errors.New("wallet member change status changed concurrently")
+)
+
+// SaveKeyMetadataWithOwner 在同一事务中保存新钱包的密钥元数据并把创建者的凭证添加为 owner，
+// 保证钱包从创建起就有成员
+func (s *PostgreSQLStore) SaveKeyMetadataWithOwner(ctx context.Context, key *KeyMetadata, ownerCredentialID string) error {
+	tagsJSON, err := json.Marshal(key.Tags)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal tags")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, saveKeyMetadataQuery,
+		key.KeyID, key.PublicKey, key.Algorithm, key.Curve, key.Threshold, key.TotalNodes,
+		key.ChainType, key.ChainCode, key.Address, key.Status, key.Description, tagsJSON,
+		key.CreatedAt, key.UpdatedAt,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to save key metadata for key_id: %s", key.KeyID)
+	}
+	if _, err := tx.ExecContext(ctx, addWalletMemberQuery, key.KeyID, ownerCredentialID, WalletRoleOwner); err != nil {
+		return errors.Wrap(err, "failed to add wallet owner")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit wallet creation")
+	}
+	return nil
+}
+
 // AddWalletMember 添加钱包成员
 func (s *PostgreSQLStore) AddWalletMember(ctx context.Context, walletID, credentialID, role string) error {
-	query := `
-		INSERT INTO wallet_members (wallet_id, credential_id, role, created_at)
-		VALUES ($1, $2, $3, NOW())
-		ON CONFLICT (wallet_id, credential_id) DO UPDATE SET
-			role = EXCLUDED.role
-	`
-	_, err := s.db.ExecContext(ctx, query, walletID, credentialID, role)
+	_, err := s.db.ExecContext(ctx, addWalletMemberQuery, walletID, credentialID, role)
 	if err != nil {
 		return errors.Wrap(err, "failed to add wallet member")
 	}
@@ -24,8 +58,7 @@ func (s *PostgreSQLStore) AddWalletMember(ctx context.Context, walletID, credent
 
 // RemoveWalletMember 移除钱包成员
 func (s *PostgreSQLStore) RemoveWalletMember(ctx context.Context, walletID, credentialID string) error {
-	query := `DELETE FROM wallet_members WHERE wallet_id = $1 AND credential_id = $2`
-	_, err := s.db.ExecContext(ctx, query, walletID, credentialID)
+	_, err := s.db.ExecContext(ctx, removeWalletMemberQuery, walletID, credentialID)
 	if err != nil {
 		return errors.Wrap(err, "failed to remove wallet member")
 	}
@@ -46,22 +79,163 @@ func (s *PostgreSQLStore) IsWalletMember(ctx context.Context, walletID, credenti
 	return true, role, nil
 }
 
-// ListWalletMembers 列出钱包成员
-func (s *PostgreSQLStore) ListWalletMembers(ctx context.Context, walletID string) ([]string, error) {
-	query := `SELECT credential_id FROM wallet_members WHERE wallet_id = $1`
+// ListWalletMembers 列出钱包成员（按加入时间排序）
+func (s *PostgreSQLStore) ListWalletMembers(ctx context.Context, walletID string) ([]*WalletMember, error) {
+	query := `SELECT wallet_id, credential_id, role, created_at FROM wallet_members WHERE wallet_id = $1 ORDER BY created_at, credential_id`
 	rows, err := s.db.QueryContext(ctx, query, walletID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list wallet members")
 	}
 	defer rows.Close()
 
-	var members []string
+	var members []*WalletMember
 	for rows.Next() {
-		var credID string
-		if err := rows.Scan(&credID); err != nil {
+		member := &WalletMember{}
+		if err := rows.Scan(&member.WalletID, &member.CredentialID, &member.Role, &member.CreatedAt); err != nil {
 			return nil, errors.Wrap(err, "failed to scan wallet member")
 		}
-		members = append(members, credID)
+		members = append(members, member)
+	}
+	return members, rows.Err()
+}
+
+const (
+	addWalletMemberQuery = `
+		INSERT INTO wallet_members (wallet_id, credential_id, role, created_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (wallet_id, credential_id) DO UPDATE SET
+			role = EXCLUDED.role
+	`
+	removeWalletMemberQuery = `DELETE FROM wallet_members WHERE wallet_id = $1 AND credential_id = $2`
+
+	walletMemberChangeColumns = `change_id, wallet_id, action, credential_id, role, proposed_by, decided_by,
+	change_hash, status, created_at, decided_at`
+
+	saveWalletMemberChangeQuery = `
+		INSERT INTO wallet_member_changes (` + walletMemberChangeColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), $10)
+		RETURNING created_at
+	`
+)
+
+// SaveWalletMemberChange 保存新的成员变更请求
+func (s *PostgreSQLStore) SaveWalletMemberChange(ctx context.Context, change *WalletMemberChange) error {
+	return saveWalletMemberChange(ctx, s.db, change)
+}
+
+type walletMemberChangeQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// saveWalletMemberChange 在 db 或事务中插入成员变更请求
+func saveWalletMemberChange(ctx context.Context, db walletMemberChangeQueryer, change *WalletMemberChange) error {
+	err := db.QueryRowContext(ctx, saveWalletMemberChangeQuery,
+		change.ChangeID, change.WalletID, change.Action, change.CredentialID, change.Role,
+		change.ProposedBy, sql.NullString{String: change.DecidedBy, Valid: change.DecidedBy != ""},
+		change.ChangeHash, change.Status, change.DecidedAt,
+	).Scan(&change.CreatedAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to save wallet member change")
+	}
+	return nil
+}
+
+// GetWalletMemberChange 获取成员变更请求
+func (s *PostgreSQLStore) GetWalletMemberChange(ctx context.Context, changeID string) (*WalletMemberChange, error) {
+	query := `SELECT ` + walletMemberChangeColumns + ` FROM wallet_member_changes WHERE change_id = $1`
+	change, err := scanWalletMemberChange(s.db.QueryRowContext(ctx, query, changeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrWalletMemberChangeNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get wallet member change")
+	}
+	return change, nil
+}
+
+// ListWalletMemberChanges 列出钱包的成员变更请求（按创建时间倒序），status 为空时不过滤
+func (s *PostgreSQLStore) ListWalletMemberChanges(ctx context.Context, walletID, status string) ([]*WalletMemberChange, error) {
+	query := `
+		SELECT ` + walletMemberChangeColumns + ` FROM wallet_member_changes
+		WHERE wallet_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC
+	`
+	rows, err := s.db.QueryContext(ctx, query, walletID, status)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet member changes")
+	}
+	defer rows.Close()
+
+	var changes []*WalletMemberChange
+	for rows.Next() {
+		change, err := scanWalletMemberChange(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan wallet member change")
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+// DecideWalletMemberChange 在同一事务中把 pending 的成员变更标记为 applied 或 rejected，applied 时修改成员
+func (s *PostgreSQLStore) DecideWalletMemberChange(ctx context.Context, change *WalletMemberChange) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		UPDATE wallet_member_changes SET status = $2, decided_by = $3, decided_at = NOW()
+		WHERE change_id = $1 AND status = $4
+		RETURNING decided_at
+	`
+	var decidedAt sql.NullTime
+	err = tx.QueryRowContext(ctx, query, change.ChangeID, change.Status, change.DecidedBy, WalletMemberChangeStatusPending).Scan(&decidedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrWalletMemberChangeStatusConflict
+		}
+		return errors.Wrap(err, "failed to update wallet member change")
+	}
+
+	if change.Status == WalletMemberChangeStatusApplied {
+		switch change.Action {
+		case WalletMemberChangeActionAdd, WalletMemberChangeActionUpdateRole:
+			_, err = tx.ExecContext(ctx, addWalletMemberQuery, change.WalletID, change.CredentialID, change.Role)
+		case WalletMemberChangeActionRemove:
+			_, err = tx.ExecContext(ctx, removeWalletMemberQuery, change.WalletID, change.CredentialID)
+		default:
+			err = errors.Errorf("unknown wallet member change action %q", change.Action)
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to apply wallet member change")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit wallet member change")
+	}
+	change.DecidedAt = &decidedAt.Time
+	return nil
+}
+
+type walletMemberChangeScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWalletMemberChange(row walletMemberChangeScanner) (*WalletMemberChange, error) {
+	change := &WalletMemberChange{}
+	var decidedBy sql.NullString
+	var decidedAt sql.NullTime
+	err := row.Scan(&change.ChangeID, &change.WalletID, &change.Action, &change.CredentialID, &change.Role,
+		&change.ProposedBy, &decidedBy, &change.ChangeHash, &change.Status, &change.CreatedAt, &decidedAt)
+	if err != nil {
+		return nil, err
+	}
+	change.DecidedBy = decidedBy.String
+	if decidedAt.Valid {
+		change.DecidedAt = &decidedAt.Time
 	}
-	return members, nil
+	return change, nil
 }
@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNonceReservationConflict nonce 已被其他交易预留，或预留在读取后被并发修改
+var ErrNonceReservationConflict = errors.New("nonce reservation changed concurrently")
+
+// CreateNonceReservation 插入新的 nonce 预留，不覆盖已有预留
+func (s *PostgreSQLStore) CreateNonceReservation(ctx context.Context, reservation *NonceReservation) error {
+	query := `
+		INSERT INTO nonce_reservations (chain_type, address, nonce, status, tx_hash, replaces_tx_hash, reserved_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		ON CONFLICT (chain_type, address, nonce) DO NOTHING
+		RETURNING reserved_at, updated_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		reservation.ChainType, reservation.Address, int64(reservation.Nonce), reservation.Status,
+		sql.NullString{String: reservation.TxHash, Valid: reservation.TxHash != ""},
+		sql.NullString{String: reservation.ReplacesTxHash, Valid: reservation.ReplacesTxHash != ""},
+		reservation.ReservedAt,
+	).Scan(&reservation.ReservedAt, &reservation.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return errors.Wrapf(ErrNonceReservationConflict, "nonce %d is already reserved", reservation.Nonce)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to create nonce reservation")
+	}
+	return nil
+}
+
+// UpdateNonceReservation 以 UpdatedAt 作为版本更新 nonce 预留，成功后写回新的 UpdatedAt
+func (s *PostgreSQLStore) UpdateNonceReservation(ctx context.Context, reservation *NonceReservation) error {
+	query := `
+		UPDATE nonce_reservations
+		SET status = $4, tx_hash = $5, replaces_tx_hash = $6, reserved_at = $7, updated_at = NOW()
+		WHERE chain_type = $1 AND address = $2 AND nonce = $3 AND updated_at = $8
+		RETURNING reserved_at, updated_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		reservation.ChainType, reservation.Address, int64(reservation.Nonce), reservation.Status,
+		sql.NullString{String: reservation.TxHash, Valid: reservation.TxHash != ""},
+		sql.NullString{String: reservation.ReplacesTxHash, Valid: reservation.ReplacesTxHash != ""},
+		reservation.ReservedAt, reservation.UpdatedAt,
+	).Scan(&reservation.ReservedAt, &reservation.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return errors.Wrapf(ErrNonceReservationConflict, "nonce %d", reservation.Nonce)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to update nonce reservation")
+	}
+	return nil
+}
+
+// ListNonceReservations 列出地址的 nonce 预留（按 nonce 升序）
+func (s *PostgreSQLStore) ListNonceReservations(ctx context.Context, chainType, address string) ([]*NonceReservation, error) {
+	query := `
+		SELECT chain_type, address, nonce, status, tx_hash, replaces_tx_hash, reserved_at, updated_at
+		FROM nonce_reservations
+		WHERE chain_type = $1 AND address = $2
+		ORDER BY nonce
+	`
+	rows, err := s.db.QueryContext(ctx, query, chainType, address)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list nonce reservations")
+	}
+	defer rows.Close()
+
+	var reservations []*NonceReservation
+	for rows.Next() {
+		var reservation NonceReservation
+		var nonce int64
+		var txHash, replacesTxHash sql.NullString
+
+		if err := rows.Scan(
+			&reservation.ChainType, &reservation.Address, &nonce, &reservation.Status,
+			&txHash, &replacesTxHash, &reservation.ReservedAt, &reservation.UpdatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan nonce reservation")
+		}
+
+		reservation.Nonce = uint64(nonce)
+		reservation.TxHash = txHash.String
+		reservation.ReplacesTxHash = replacesTxHash.String
+		reservations = append(reservations, &reservation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate nonce reservations")
+	}
+
+	return reservations, nil
+}
+
+// DeleteNonceReservationsBelow 删除 nonce 小于指定值的预留（已上链或已进入交易池，不再参与分配）
+func (s *PostgreSQLStore) DeleteNonceReservationsBelow(ctx context.Context, chainType, address string, nonce uint64) error {
+	query := `DELETE FROM nonce_reservations WHERE chain_type = $1 AND address = $2 AND nonce < $3`
+	if _, err := s.db.ExecContext(ctx, query, chainType, address, int64(nonce)); err != nil {
+		return errors.Wrap(err, "failed to delete nonce reservations")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// ListSigningSessionsByStatus 按状态列出会话（用于 Coordinator 重启后恢复进行中的会话）
+func (s *PostgreSQLStore) ListSigningSessionsByStatus(ctx context.Context, statuses []string) ([]*SigningSession, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(statuses))
+	args := make([]interface{}, len(statuses))
+	for i, status := range statuses {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = status
+	}
+
+	query := `
+		SELECT session_id, key_id, protocol, status, threshold, total_nodes,
+			participating_nodes, current_round, total_rounds, signature,
+			created_at, completed_at, duration_ms, error_message
+		FROM signing_sessions
+		WHERE status IN (` + strings.Join(placeholders, ", ") + `)
+		ORDER BY created_at
+	`
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list signing sessions")
+	}
+	defer rows.Close()
+
+	var sessions []*SigningSession
+	for rows.Next() {
+		var session SigningSession
+		var participatingNodesJSON []byte
+		var signature sql.NullString
+		var completedAt sql.NullTime
+		var durationMs sql.NullInt64
+		var errorMessage sql.NullString
+
+		if err := rows.Scan(
+			&session.SessionID, &session.KeyID, &session.Protocol, &session.Status,
+			&session.Threshold, &session.TotalNodes, &participatingNodesJSON,
+			&session.CurrentRound, &session.TotalRounds, &signature,
+			&session.CreatedAt, &completedAt, &durationMs, &errorMessage,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan signing session")
+		}
+
+		session.ParticipatingNodes = []string{}
+		if len(participatingNodesJSON) > 0 {
+			if err := json.Unmarshal(participatingNodesJSON, &session.ParticipatingNodes); err != nil {
+				return nil, errors.Wrap(err, "failed to unmarshal participating nodes")
+			}
+		}
+		session.Signature = signature.String
+		if completedAt.Valid {
+			session.CompletedAt = &completedAt.Time
+		}
+		session.DurationMs = int(durationMs.Int64)
+		session.ErrorMessage = errorMessage.String
+
+		sessions = append(sessions, &session)
+	}
+	return sessions, nil
+}
+
+// sessionWALAppendAttempts 并发追加同一会话 WAL 时分配 Sequence 的最大尝试次数
+const sessionWALAppendAttempts = 5
+
+// AppendSessionWAL 追加会话 WAL 记录，Sequence 为会话内已有最大值 + 1
+// 多个实例并发追加时可能分配到同一个 Sequence，由主键 (session_id, sequence) 拒绝后重新分配
+func (s *PostgreSQLStore) AppendSessionWAL(ctx context.Context, record *SessionWALRecord) error {
+	query := `
+		INSERT INTO session_wal (session_id, sequence, round, event_type, payload, created_at)
+		SELECT $1, COALESCE(MAX(sequence), 0) + 1, $2, $3, $4, $5
+		FROM session_wal
+		WHERE session_id = $1
+		RETURNING sequence
+	`
+
+	var err error
+	for attempt := 0; attempt < sessionWALAppendAttempts; attempt++ {
+		err = s.db.QueryRowContext(ctx, query,
+			record.SessionID, record.Round, record.Type, record.Payload, record.CreatedAt,
+		).Scan(&record.Sequence)
+		if err == nil {
+			return nil
+		}
+		if !isUniqueViolation(err) {
+			return errors.Wrap(err, "failed to append session wal")
+		}
+	}
+	return errors.Wrapf(err, "failed to allocate session wal sequence after %d attempts", sessionWALAppendAttempts)
+}
+
+// isUniqueViolation 判断错误是否为 PostgreSQL 唯一约束冲突（23505）
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// ListSessionWAL 按 Sequence 顺序列出会话 WAL 记录
+func (s *PostgreSQLStore) ListSessionWAL(ctx context.Context, sessionID string) ([]*SessionWALRecord, error) {
+	query := `
+		SELECT session_id, sequence, round, event_type, payload, created_at
+		FROM session_wal
+		WHERE session_id = $1
+		ORDER BY sequence
+	`
+	rows, err := s.db.QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list session wal")
+	}
+	defer rows.Close()
+
+	var records []*SessionWALRecord
+	for rows.Next() {
+		var record SessionWALRecord
+		if err := rows.Scan(
+			&record.SessionID, &record.Sequence, &record.Round, &record.Type, &record.Payload, &record.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan session wal")
+		}
+		records = append(records, &record)
+	}
+	return records, nil
+}
+
+// DeleteSessionWAL 删除会话的全部 WAL 记录（会话进入终态后调用）
+func (s *PostgreSQLStore) DeleteSessionWAL(ctx context.Context, sessionID string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM session_wal WHERE session_id = $1`, sessionID); err != nil {
+		return errors.Wrap(err, "failed to delete session wal")
+	}
+	return nil
+}
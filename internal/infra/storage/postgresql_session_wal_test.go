@@ -0,0 +1,62 @@
+package storage_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sessionWALTestAppends 每次冲突都意味着另一个追加已成功，追加数不超过重试次数时必然全部成功
+const sessionWALTestAppends = 4
+
+func TestAppendSessionWALConcurrentStores(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO keys (key_id, public_key, algorithm, curve, threshold, total_nodes, chain_type, status)
+			VALUES ('key-wal', '02ab', 'ECDSA', 'secp256k1', 2, 2, 'ethereum', 'Active')
+		`)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO signing_sessions (session_id, key_id, protocol, status, threshold, total_nodes, total_rounds)
+			VALUES ('session-wal', 'key-wal', 'gg20', 'InProgress', 2, 2, 9)
+		`)
+		require.NoError(t, err)
+
+		// 每个 goroutine 使用独立的 store，模拟多个 Coordinator 实例并发追加
+		appends := sessionWALTestAppends
+		var wg sync.WaitGroup
+		errs := make([]error, appends)
+		for i := 0; i < appends; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				store := storage.NewPostgreSQLStore(db)
+				errs[i] = store.AppendSessionWAL(ctx, &storage.SessionWALRecord{
+					SessionID: "session-wal",
+					Round:     1,
+					Type:      "round_message",
+					CreatedAt: time.Now(),
+				})
+			}(i)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		records, err := storage.NewPostgreSQLStore(db).ListSessionWAL(ctx, "session-wal")
+		require.NoError(t, err)
+		require.Len(t, records, appends)
+		for i, record := range records {
+			assert.Equal(t, int64(i+1), record.Sequence)
+		}
+	})
+}
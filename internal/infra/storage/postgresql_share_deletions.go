@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+// SaveKeyShareDeletion 保存（或更新）节点分片删除确认记录
+func (s *PostgreSQLStore) SaveKeyShareDeletion(ctx context.Context, deletion *KeyShareDeletion) error {
+	query := `
+		INSERT INTO key_share_deletions (key_id, node_id, status, attempts, last_error, requested_at, updated_at, confirmed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (key_id, node_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			attempts = EXCLUDED.attempts,
+			last_error = EXCLUDED.last_error,
+			updated_at = EXCLUDED.updated_at,
+			confirmed_at = EXCLUDED.confirmed_at
+	`
+
+	var confirmedAt interface{}
+	if deletion.ConfirmedAt != nil {
+		confirmedAt = *deletion.ConfirmedAt
+	}
+
+	_, err := s.db.ExecContext(ctx, query,
+		deletion.KeyID, deletion.NodeID, deletion.Status, deletion.Attempts,
+		sql.NullString{String: deletion.LastError, Valid: deletion.LastError != ""},
+		deletion.RequestedAt, deletion.UpdatedAt, confirmedAt,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to save key share deletion")
+	}
+	return nil
+}
+
+// ListKeyShareDeletions 列出密钥在各节点的分片删除确认记录
+func (s *PostgreSQLStore) ListKeyShareDeletions(ctx context.Context, keyID string) ([]*KeyShareDeletion, error) {
+	query := `
+		SELECT key_id, node_id, status, attempts, last_error, requested_at, updated_at, confirmed_at
+		FROM key_share_deletions
+		WHERE key_id = $1
+		ORDER BY node_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, keyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list key share deletions")
+	}
+	defer rows.Close()
+
+	var deletions []*KeyShareDeletion
+	for rows.Next() {
+		var deletion KeyShareDeletion
+		var lastError sql.NullString
+		var confirmedAt sql.NullTime
+
+		if err := rows.Scan(
+			&deletion.KeyID, &deletion.NodeID, &deletion.Status, &deletion.Attempts,
+			&lastError, &deletion.RequestedAt, &deletion.UpdatedAt, &confirmedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan key share deletion")
+		}
+
+		deletion.LastError = lastError.String
+		if confirmedAt.Valid {
+			deletion.ConfirmedAt = &confirmedAt.Time
+		}
+		deletions = append(deletions, &deletion)
+	}
+	return deletions, nil
+}
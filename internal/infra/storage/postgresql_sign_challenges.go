@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrSignChallengeNotFound 签名 challenge 不存在或已被使用
+	ErrSignChallengeNotFound = errors.New("sign challenge not found or already used")
+	// ErrPasskeySignCountNotIncreased Passkey 的签名计数器没有增加，认证器可能被克隆或 assertion 被重放
+	ErrPasskeySignCountNotIncreased = errors.New("passkey sign count did not increase")
+)
+
+// SaveSignChallenge 保存签发的签名 challenge
+func (s *PostgreSQLStore) SaveSignChallenge(ctx context.Context, challenge *SignChallenge) error {
+	query := `
+		INSERT INTO sign_challenges (challenge, wallet_id, message_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING created_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		challenge.Challenge, challenge.WalletID, challenge.MessageHash, challenge.ExpiresAt,
+	).Scan(&challenge.CreatedAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to save sign challenge")
+	}
+	return nil
+}
+
+// GetSignChallenge 获取未使用的签名 challenge，不存在或已使用时返回 ErrSignChallengeNotFound
+func (s *PostgreSQLStore) GetSignChallenge(ctx context.Context, challenge string) (*SignChallenge, error) {
+	query := `
+		SELECT challenge, wallet_id, message_hash, expires_at, created_at
+		FROM sign_challenges
+		WHERE challenge = $1 AND consumed_at IS NULL
+	`
+
+	var result SignChallenge
+	err := s.db.QueryRowContext(ctx, query, challenge).Scan(
+		&result.Challenge, &result.WalletID, &result.MessageHash, &result.ExpiresAt, &result.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrSignChallengeNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get sign challenge")
+	}
+	return &result, nil
+}
+
+// ConsumeSignChallenge 标记未过期的签名 challenge 已使用，每个 challenge 只能成功使用一次；
+// 不存在、已使用或已过期时返回 ErrSignChallengeNotFound
+func (s *PostgreSQLStore) ConsumeSignChallenge(ctx context.Context, challenge string) error {
+	query := `
+		UPDATE sign_challenges
+		SET consumed_at = NOW()
+		WHERE challenge = $1 AND consumed_at IS NULL AND expires_at > NOW()
+	`
+
+	result, err := s.db.ExecContext(ctx, query, challenge)
+	if err != nil {
+		return errors.Wrap(err, "failed to consume sign challenge")
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to consume sign challenge")
+	}
+	if rows == 0 {
+		return ErrSignChallengeNotFound
+	}
+	return nil
+}
+
+// DeleteExpiredSignChallenges 删除在 before 之前过期的签名 challenge（包括已使用的），返回删除的数量
+func (s *PostgreSQLStore) DeleteExpiredSignChallenges(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM sign_challenges WHERE expires_at < $1`, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete expired sign challenges")
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete expired sign challenges")
+	}
+	return rows, nil
+}
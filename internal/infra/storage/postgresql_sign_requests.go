@@ -0,0 +1,222 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrSignRequestNotFound 签名请求不存在
+	ErrSignRequestNotFound = errors.New("sign request not found")
+	// ErrSignRequestStatusConflict 签名请求的状态已被其他请求修改
+	ErrSignRequestStatusConflict = errors.New("sign request status changed concurrently")
+	// ErrDuplicateSignRequestApproval 同一凭证已审批过该签名请求
+	ErrDuplicateSignRequestApproval = errors.New("sign request already reviewed by this user")
+)
+
+const signRequestColumns = `request_id, wallet_id, chain_type, message_hex, derivation_path, destination, amount, asset,
+	mobile_node_id, request_hash, status, required_approvals, policy_decision, session_id, signature, error_message,
+	expires_at, created_at, updated_at`
+
+// SaveSignRequest 保存新的签名请求
+func (s *PostgreSQLStore) SaveSignRequest(ctx context.Context, req *SignRequest) error {
+	policyDecisionJSON, err := marshalPolicyDecision(req.PolicyDecision)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO sign_requests (` + signRequestColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	err = s.db.QueryRowContext(ctx, query,
+		req.RequestID, req.WalletID, req.ChainType, req.MessageHex, req.DerivationPath,
+		req.Destination, req.Amount, req.Asset, req.MobileNodeID, req.RequestHash,
+		req.Status, req.RequiredApprovals, policyDecisionJSON,
+		sql.NullString{String: req.SessionID, Valid: req.SessionID != ""},
+		sql.NullString{String: req.Signature, Valid: req.Signature != ""},
+		sql.NullString{String: req.ErrorMessage, Valid: req.ErrorMessage != ""},
+		req.ExpiresAt,
+	).Scan(&req.CreatedAt, &req.UpdatedAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to save sign request")
+	}
+	return nil
+}
+
+// GetSignRequest 获取签名请求
+func (s *PostgreSQLStore) GetSignRequest(ctx context.Context, requestID string) (*SignRequest, error) {
+	query := `SELECT ` + signRequestColumns + ` FROM sign_requests WHERE request_id = $1`
+
+	req, err := scanSignRequest(s.db.QueryRowContext(ctx, query, requestID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrSignRequestNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get sign request")
+	}
+	return req, nil
+}
+
+// ListSignRequests 按条件列出签名请求（按创建时间倒序）
+func (s *PostgreSQLStore) ListSignRequests(ctx context.Context, filter *SignRequestFilter) ([]*SignRequest, error) {
+	if filter == nil {
+		filter = &SignRequestFilter{}
+	}
+
+	var conditions []string
+	var args []interface{}
+	if filter.WalletID != "" {
+		args = append(args, filter.WalletID)
+		conditions = append(conditions, fmt.Sprintf("wallet_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit, filter.Offset)
+	query := `SELECT ` + signRequestColumns + ` FROM sign_requests ` + where + fmt.Sprintf(`
+		ORDER BY created_at DESC, request_id
+		LIMIT $%d OFFSET $%d
+	`, len(args)-1, len(args))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sign requests")
+	}
+	defer rows.Close()
+
+	var requests []*SignRequest
+	for rows.Next() {
+		req, err := scanSignRequest(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan sign request")
+		}
+		requests = append(requests, req)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to list sign requests")
+	}
+	return requests, nil
+}
+
+// UpdateSignRequest 在状态仍为 expectedStatus 时更新签名请求的状态和签名结果，
+// 保证并发审批时每个状态转换只发生一次
+func (s *PostgreSQLStore) UpdateSignRequest(ctx context.Context, req *SignRequest, expectedStatus string) error {
+	query := `
+		UPDATE sign_requests
+		SET status = $3, session_id = $4, signature = $5, error_message = $6, updated_at = NOW()
+		WHERE request_id = $1 AND status = $2
+		RETURNING updated_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		req.RequestID, expectedStatus, req.Status,
+		sql.NullString{String: req.SessionID, Valid: req.SessionID != ""},
+		sql.NullString{String: req.Signature, Valid: req.Signature != ""},
+		sql.NullString{String: req.ErrorMessage, Valid: req.ErrorMessage != ""},
+	).Scan(&req.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Wrapf(ErrSignRequestStatusConflict, "sign request %s is no longer %s", req.RequestID, expectedStatus)
+		}
+		return errors.Wrap(err, "failed to update sign request")
+	}
+	return nil
+}
+
+// SaveSignRequestApproval 保存成员的审批结果
+func (s *PostgreSQLStore) SaveSignRequestApproval(ctx context.Context, approval *SignRequestApproval) error {
+	query := `
+		INSERT INTO sign_request_approvals (request_id, user_id, credential_id, approved, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT DO NOTHING
+		RETURNING created_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query, approval.RequestID, approval.UserID, approval.CredentialID, approval.Approved).Scan(&approval.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrDuplicateSignRequestApproval
+		}
+		return errors.Wrap(err, "failed to save sign request approval")
+	}
+	return nil
+}
+
+// ListSignRequestApprovals 列出签名请求的审批（按时间顺序）
+func (s *PostgreSQLStore) ListSignRequestApprovals(ctx context.Context, requestID string) ([]*SignRequestApproval, error) {
+	query := `
+		SELECT request_id, user_id, credential_id, approved, created_at
+		FROM sign_request_approvals
+		WHERE request_id = $1
+		ORDER BY created_at, credential_id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, requestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sign request approvals")
+	}
+	defer rows.Close()
+
+	var approvals []*SignRequestApproval
+	for rows.Next() {
+		var approval SignRequestApproval
+		if err := rows.Scan(&approval.RequestID, &approval.UserID, &approval.CredentialID, &approval.Approved, &approval.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan sign request approval")
+		}
+		approvals = append(approvals, &approval)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to list sign request approvals")
+	}
+	return approvals, nil
+}
+
+type signRequestScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSignRequest(row signRequestScanner) (*SignRequest, error) {
+	var req SignRequest
+	var policyDecisionJSON []byte
+	var sessionID, signature, errorMessage sql.NullString
+
+	if err := row.Scan(
+		&req.RequestID, &req.WalletID, &req.ChainType, &req.MessageHex, &req.DerivationPath,
+		&req.Destination, &req.Amount, &req.Asset, &req.MobileNodeID, &req.RequestHash,
+		&req.Status, &req.RequiredApprovals, &policyDecisionJSON,
+		&sessionID, &signature, &errorMessage,
+		&req.ExpiresAt, &req.CreatedAt, &req.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	req.SessionID = sessionID.String
+	req.Signature = signature.String
+	req.ErrorMessage = errorMessage.String
+	if len(policyDecisionJSON) > 0 {
+		req.PolicyDecision = &PolicyDecision{}
+		if err := json.Unmarshal(policyDecisionJSON, req.PolicyDecision); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal policy decision")
+		}
+	}
+	return &req, nil
+}
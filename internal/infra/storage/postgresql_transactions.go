@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SaveTransactionRecord 保存已广播的交易
+func (s *PostgreSQLStore) SaveTransactionRecord(ctx context.Context, record *TransactionRecord) error {
+	query := `
+		INSERT INTO transactions (wallet_id, chain_type, tx_hash, from_address, to_address, value, asset, decimals,
+			nonce, raw_tx, status, block_number, confirmations, replaced_by, error, confirmed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		record.WalletID, record.ChainType, record.TxHash, record.FromAddress,
+		sql.NullString{String: record.ToAddress, Valid: record.ToAddress != ""},
+		record.Value, record.Asset, record.Decimals, nullableNonce(record.Nonce), record.RawTx, record.Status,
+		sql.NullInt64{Int64: record.BlockNumber, Valid: record.BlockNumber > 0},
+		record.Confirmations,
+		sql.NullString{String: record.ReplacedBy, Valid: record.ReplacedBy != ""},
+		sql.NullString{String: record.Error, Valid: record.Error != ""},
+		nullableTime(record.ConfirmedAt),
+	).Scan(&record.ID, &record.CreatedAt, &record.UpdatedAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to save transaction record")
+	}
+	return nil
+}
+
+// UpdateTransactionRecord 更新交易的确认状态
+func (s *PostgreSQLStore) UpdateTransactionRecord(ctx context.Context, record *TransactionRecord) error {
+	query := `
+		UPDATE transactions
+		SET status = $2, block_number = $3, confirmations = $4, replaced_by = $5, error = $6,
+			confirmed_at = $7, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		record.ID, record.Status,
+		sql.NullInt64{Int64: record.BlockNumber, Valid: record.BlockNumber > 0},
+		record.Confirmations,
+		sql.NullString{String: record.ReplacedBy, Valid: record.ReplacedBy != ""},
+		sql.NullString{String: record.Error, Valid: record.Error != ""},
+		nullableTime(record.ConfirmedAt),
+	).Scan(&record.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Errorf("transaction record %d not found", record.ID)
+		}
+		return errors.Wrap(err, "failed to update transaction record")
+	}
+	return nil
+}
+
+// ListTransactionRecords 按条件列出交易记录（按创建时间倒序），同时返回符合条件的总数
+func (s *PostgreSQLStore) ListTransactionRecords(ctx context.Context, filter *TransactionFilter) ([]*TransactionRecord, int64, error) {
+	if filter == nil {
+		filter = &TransactionFilter{}
+	}
+
+	var conditions []string
+	var args []interface{}
+	addCondition := func(column string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if filter.WalletID != "" {
+		addCondition("wallet_id", filter.WalletID)
+	}
+	if filter.ChainType != "" {
+		addCondition("chain_type", filter.ChainType)
+	}
+	if filter.FromAddress != "" {
+		addCondition("from_address", filter.FromAddress)
+	}
+	if filter.Nonce != nil {
+		addCondition("nonce", int64(*filter.Nonce))
+	}
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			args = append(args, status)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		conditions = append(conditions, "status IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if filter.AfterID > 0 {
+		args = append(args, filter.AfterID)
+		conditions = append(conditions, fmt.Sprintf("id > $%d", len(args)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM transactions "+where, args...).Scan(&total); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count transaction records")
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	orderBy := "created_at DESC, id DESC"
+	if filter.OldestFirst {
+		orderBy = "id ASC"
+	}
+	args = append(args, limit, filter.Offset)
+	query := `
+		SELECT id, wallet_id, chain_type, tx_hash, from_address, to_address, value, asset, decimals, nonce,
+			raw_tx, status, block_number, confirmations, replaced_by, error,
+			created_at, updated_at, confirmed_at
+		FROM transactions
+		` + where + fmt.Sprintf(`
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, orderBy, len(args)-1, len(args))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list transaction records")
+	}
+	defer rows.Close()
+
+	var records []*TransactionRecord
+	for rows.Next() {
+		var record TransactionRecord
+		var toAddress, replacedBy, errMsg sql.NullString
+		var nonce, blockNumber sql.NullInt64
+		var confirmedAt sql.NullTime
+
+		if err := rows.Scan(
+			&record.ID, &record.WalletID, &record.ChainType, &record.TxHash, &record.FromAddress,
+			&toAddress, &record.Value, &record.Asset, &record.Decimals, &nonce, &record.RawTx, &record.Status,
+			&blockNumber, &record.Confirmations, &replacedBy, &errMsg,
+			&record.CreatedAt, &record.UpdatedAt, &confirmedAt,
+		); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to scan transaction record")
+		}
+
+		record.ToAddress = toAddress.String
+		record.ReplacedBy = replacedBy.String
+		record.Error = errMsg.String
+		record.BlockNumber = blockNumber.Int64
+		if nonce.Valid {
+			n := uint64(nonce.Int64)
+			record.Nonce = &n
+		}
+		if confirmedAt.Valid {
+			record.ConfirmedAt = &confirmedAt.Time
+		}
+		records = append(records, &record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to iterate transaction records")
+	}
+
+	return records, total, nil
+}
+
+func nullableNonce(nonce *uint64) interface{} {
+	if nonce == nil {
+		return nil
+	}
+	return int64(*nonce)
+}
+
+func nullableTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
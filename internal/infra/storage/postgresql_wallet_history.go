@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SaveIncomingTransfer 保存索引器发现的转入记录，重复扫描到的记录被忽略
+func (s *PostgreSQLStore) SaveIncomingTransfer(ctx context.Context, transfer *IncomingTransfer) error {
+	query := `
+		INSERT INTO incoming_transfers (wallet_id, chain_type, tx_hash, log_index, asset, decimals,
+			from_address, to_address, value, block_number, block_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (chain_type, tx_hash, wallet_id, asset, log_index) DO NOTHING
+	`
+
+	_, err := s.db.ExecContext(ctx, query,
+		transfer.WalletID, transfer.ChainType, transfer.TxHash, transfer.LogIndex, transfer.Asset, transfer.Decimals,
+		transfer.FromAddress, transfer.ToAddress, transfer.Value, transfer.BlockNumber, transfer.BlockTime,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to save incoming transfer")
+	}
+	return nil
+}
+
+// ListWalletHistory 合并转出交易和转入记录，按时间倒序返回一页
+func (s *PostgreSQLStore) ListWalletHistory(ctx context.Context, filter *WalletHistoryFilter) ([]*WalletHistoryEntry, error) {
+	if filter == nil || filter.WalletID == "" || filter.ChainType == "" {
+		return nil, errors.New("wallet id and chain type are required")
+	}
+
+	args := []interface{}{filter.WalletID, filter.ChainType}
+	var conditions []string
+	addCondition := func(format string, values ...interface{}) {
+		placeholders := make([]interface{}, len(values))
+		for i, value := range values {
+			args = append(args, value)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		conditions = append(conditions, fmt.Sprintf(format, placeholders...))
+	}
+	if filter.Direction != "" {
+		addCondition("direction = %s", filter.Direction)
+	}
+	if filter.Asset != nil {
+		addCondition("asset = %s", *filter.Asset)
+	}
+	if filter.From != nil {
+		addCondition("ts >= %s", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("ts < %s", *filter.To)
+	}
+	if filter.After != nil {
+		// 排序键 (ts, direction, id) 全部倒序，行比较即可跳过游标之前的条目
+		addCondition("(ts, direction, id) < (%s, %s, %s)", filter.After.Timestamp, filter.After.Direction, filter.After.ID)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit)
+
+	// 转入记录由索引器在达到确认数后写入，状态固定为 confirmed
+	query := `
+		SELECT direction, id, tx_hash, from_address, to_address, value, asset, decimals,
+			status, block_number, confirmations, replaced_by, error, ts
+		FROM (
+			SELECT 'outgoing' AS direction, id, tx_hash, from_address, COALESCE(to_address, '') AS to_address,
+				value, asset, decimals, status, COALESCE(block_number, 0) AS block_number, confirmations,
+				COALESCE(replaced_by, '') AS replaced_by, COALESCE(error, '') AS error, created_at AS ts
+			FROM transactions
+			WHERE wallet_id = $1 AND chain_type = $2
+			UNION ALL
+			SELECT 'incoming', id, tx_hash, from_address, to_address,
+				value, asset, decimals, 'confirmed', block_number, 0,
+				'', '', block_time
+			FROM incoming_transfers
+			WHERE wallet_id = $1 AND chain_type = $2
+		) history
+		` + where + fmt.Sprintf(`
+		ORDER BY ts DESC, direction DESC, id DESC
+		LIMIT $%d
+	`, len(args))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet history")
+	}
+	defer rows.Close()
+
+	var entries []*WalletHistoryEntry
+	for rows.Next() {
+		var entry WalletHistoryEntry
+		if err := rows.Scan(
+			&entry.Direction, &entry.ID, &entry.TxHash, &entry.FromAddress, &entry.ToAddress, &entry.Value,
+			&entry.Asset, &entry.Decimals, &entry.Status, &entry.BlockNumber, &entry.Confirmations,
+			&entry.ReplacedBy, &entry.Error, &entry.Timestamp,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan wallet history entry")
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate wallet history")
+	}
+
+	return entries, nil
+}
+
+// GetIndexerCursor 获取索引器扫描进度
+func (s *PostgreSQLStore) GetIndexerCursor(ctx context.Context, chainType, address string) (string, error) {
+	var cursor string
+	err := s.db.QueryRowContext(ctx,
+		`SELECT last_seen FROM indexer_cursors WHERE chain_type = $1 AND address = $2`,
+		chainType, address,
+	).Scan(&cursor)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", errors.Wrap(err, "failed to get indexer cursor")
+	}
+	return cursor, nil
+}
+
+// SaveIndexerCursor 保存索引器扫描进度
+func (s *PostgreSQLStore) SaveIndexerCursor(ctx context.Context, chainType, address, cursor string) error {
+	query := `
+		INSERT INTO indexer_cursors (chain_type, address, last_seen, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (chain_type, address) DO UPDATE SET
+			last_seen = EXCLUDED.last_seen,
+			updated_at = NOW()
+	`
+	if _, err := s.db.ExecContext(ctx, query, chainType, address, cursor); err != nil {
+		return errors.Wrap(err, "failed to save indexer cursor")
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
 )
@@ -67,20 +68,50 @@ func (s *RedisStore) DeleteSession(ctx context.Context, sessionID string) error
 	return nil
 }
 
+// releaseLockScript 只在锁仍由 token 持有时删除，避免误删过期后被其他实例获取的锁
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// extendLockScript 只在锁仍由 token 持有时续期
+var extendLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
 // AcquireLock 获取分布式锁
-func (s *RedisStore) AcquireLock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+func (s *RedisStore) AcquireLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	lockKey := "mpc:lock:" + key
+	token := uuid.New().String()
+	result, err := s.client.SetNX(ctx, lockKey, token, ttl).Result()
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to acquire lock")
+	}
+	if !result {
+		return "", false, nil
+	}
+	return token, true, nil
+}
+
+// ExtendLock 续期分布式锁
+func (s *RedisStore) ExtendLock(ctx context.Context, key, token string, ttl time.Duration) (bool, error) {
 	lockKey := "mpc:lock:" + key
-	result, err := s.client.SetNX(ctx, lockKey, "1", ttl).Result()
+	result, err := extendLockScript.Run(ctx, s.client, []string{lockKey}, token, ttl.Milliseconds()).Int()
 	if err != nil {
-		return false, errors.Wrap(err, "failed to acquire lock")
+		return false, errors.Wrap(err, "failed to extend lock")
 	}
-	return result, nil
+	return result == 1, nil
 }
 
 // ReleaseLock 释放分布式锁
-func (s *RedisStore) ReleaseLock(ctx context.Context, key string) error {
+func (s *RedisStore) ReleaseLock(ctx context.Context, key, token string) error {
 	lockKey := "mpc:lock:" + key
-	if err := s.client.Del(ctx, lockKey).Err(); err != nil {
+	if err := releaseLockScript.Run(ctx, s.client, []string{lockKey}, token).Err(); err != nil {
 		return errors.Wrap(err, "failed to release lock")
 	}
 	return nil
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrWALNotCached 会话 WAL 不在缓存中（过期、被淘汰或 Redis 重启），需要回退到 PostgreSQL
+var ErrWALNotCached = errors.New("session wal not cached")
+
+func walCacheKey(sessionID string) string {
+	return "mpc:session:wal:" + sessionID
+}
+
+// AppendWAL 追加 WAL 记录到缓存
+// 第一条记录创建列表；之后只在列表仍存在时追加（RPUSHX），缓存缺失时保持缺失，由读取方从 PostgreSQL 重建
+func (s *RedisStore) AppendWAL(ctx context.Context, record *SessionWALRecord, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal wal record")
+	}
+
+	key := walCacheKey(record.SessionID)
+	pipe := s.client.TxPipeline()
+	if record.Sequence == 1 {
+		pipe.RPush(ctx, key, data)
+	} else {
+		pipe.RPushX(ctx, key, data)
+	}
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to append wal record to cache")
+	}
+	return nil
+}
+
+// SaveWAL 用完整记录重建缓存
+func (s *RedisStore) SaveWAL(ctx context.Context, sessionID string, records []*SessionWALRecord, ttl time.Duration) error {
+	key := walCacheKey(sessionID)
+	pipe := s.client.TxPipeline()
+	pipe.Del(ctx, key)
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal wal record")
+		}
+		pipe.RPush(ctx, key, data)
+	}
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to save wal to cache")
+	}
+	return nil
+}
+
+// GetWAL 读取缓存中的 WAL 记录
+func (s *RedisStore) GetWAL(ctx context.Context, sessionID string) ([]*SessionWALRecord, error) {
+	key := walCacheKey(sessionID)
+	items, err := s.client.LRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ErrWALNotCached
+		}
+		return nil, errors.Wrap(err, "failed to get wal from cache")
+	}
+	if len(items) == 0 {
+		return nil, ErrWALNotCached
+	}
+
+	records := make([]*SessionWALRecord, 0, len(items))
+	for _, item := range items {
+		var record SessionWALRecord
+		if err := json.Unmarshal([]byte(item), &record); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal wal record")
+		}
+		records = append(records, &record)
+	}
+	return orderCachedWAL(records)
+}
+
+// orderCachedWAL 按 Sequence 排序缓存记录，并要求 Sequence 从 1 开始连续
+// 多个实例并发追加时写入缓存的顺序可能与 Sequence 不一致，排序后仍有缺口说明缓存不完整，交由调用方回退到 PostgreSQL
+func orderCachedWAL(records []*SessionWALRecord) ([]*SessionWALRecord, error) {
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Sequence < records[j].Sequence
+	})
+	for i, record := range records {
+		if record.Sequence != int64(i+1) {
+			return nil, ErrWALNotCached
+		}
+	}
+	return records, nil
+}
+
+// DeleteWAL 删除缓存中的 WAL 记录
+func (s *RedisStore) DeleteWAL(ctx context.Context, sessionID string) error {
+	if err := s.client.Del(ctx, walCacheKey(sessionID)).Err(); err != nil {
+		return errors.Wrap(err, "failed to delete wal from cache")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func walRecords(sequences ...int64) []*SessionWALRecord {
+	records := make([]*SessionWALRecord, 0, len(sequences))
+	for _, sequence := range sequences {
+		records = append(records, &SessionWALRecord{SessionID: "session-1", Sequence: sequence})
+	}
+	return records
+}
+
+func TestOrderCachedWALSortsBySequence(t *testing.T) {
+	records, err := orderCachedWAL(walRecords(2, 1, 4, 3))
+	require.NoError(t, err)
+
+	sequences := make([]int64, 0, len(records))
+	for _, record := range records {
+		sequences = append(sequences, record.Sequence)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4}, sequences)
+}
+
+func TestOrderCachedWALRejectsIncompleteCache(t *testing.T) {
+	cases := map[string][]int64{
+		"gap":           {1, 3},
+		"missing first": {2, 3},
+		"duplicate":     {1, 1, 2},
+	}
+	for name, sequences := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := orderCachedWAL(walRecords(sequences...))
+			assert.ErrorIs(t, err, ErrWALNotCached)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package storage
+
+// CountApprovedUsers 统计批准签名请求的不同用户数，同一用户的多个凭证只计一次；没有用户 ID 的历史记录按凭证计数
+func CountApprovedUsers(approvals []*SignRequestApproval) int {
+	users := make(map[string]bool, len(approvals))
+	for _, approval := range approvals {
+		if !approval.Approved {
+			continue
+		}
+		if approval.UserID != "" {
+			users["user:"+approval.UserID] = true
+		} else {
+			users["credential:"+approval.CredentialID] = true
+		}
+	}
+	return len(users)
+}
@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountApprovedUsers(t *testing.T) {
+	approvals := []*SignRequestApproval{
+		{UserID: "user-1", CredentialID: "cred-1a", Approved: true},
+		{UserID: "user-1", CredentialID: "cred-1b", Approved: true},
+		{UserID: "user-2", CredentialID: "cred-2", Approved: false},
+		{CredentialID: "cred-legacy", Approved: true},
+	}
+	assert.Equal(t, 2, CountApprovedUsers(approvals))
+	assert.Equal(t, 0, CountApprovedUsers(nil))
+}
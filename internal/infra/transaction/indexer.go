@@ -0,0 +1,606 @@
+package transaction
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/ethereum"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/solana"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// indexerLockKey 多个 Service 实例只由一个实例扫描
+	indexerLockKey = "transaction_indexer"
+	// indexerLockTTL 扫描锁的有效期，持有期间由心跳续期，持有锁的实例崩溃后自动释放
+	indexerLockTTL = time.Minute
+	// indexerKeyPageSize 列出钱包时的分页大小
+	indexerKeyPageSize = 100
+	// evmTopicBatchSize 单次 eth_getLogs 过滤的接收地址数量上限
+	evmTopicBatchSize = 100
+	// solanaSignaturePageSize getSignaturesForAddress 单页数量（节点上限为 1000）
+	solanaSignaturePageSize = 1000
+	// solanaMaxSignatures 单次轮询单个地址最多处理的新签名数量，超过时只处理最新的部分
+	solanaMaxSignatures = 5000
+)
+
+// indexedKeyStatuses 需要索引转入交易的钱包状态，禁用或等待删除的钱包仍可能收到转账
+var indexedKeyStatuses = []string{storage.KeyStatusActive, storage.KeyStatusDisabled, storage.KeyStatusPendingDeletion}
+
+var (
+	indexerMetricsOnce sync.Once
+	indexerTransfers   *prometheus.CounterVec
+)
+
+// Indexer 转入交易索引器
+// EVM 链按区块扫描：原生币转账通过完整区块的交易 to 字段匹配，ERC-20 转账通过 Transfer 事件日志匹配；
+// 只扫描达到链配置确认数的区块，因此写入的转入记录不会因重组回滚。合约内部调用产生的原生币转账不会被发现。
+// Solana 按地址查询 finalized 签名，通过交易前后的 lamports 和代币余额变化识别转入。
+// 首次运行时 EVM 链从当前安全高度开始扫描，不回填历史区块
+type Indexer struct {
+	metadataStore storage.MetadataStore
+	sessionStore  storage.SessionStore
+	chains        *registry.Registry
+
+	pollInterval time.Duration
+	maxBlocks    uint64
+
+	// tokenDecimals ERC-20 代币精度缓存，键为 "链名称:合约地址"
+	tokenDecimals sync.Map
+
+	started  atomic.Bool
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// NewIndexer 创建转入交易索引器，sessionStore 为空时不加分布式锁
+// maxBlocks 为单次轮询每条 EVM 链最多扫描的区块数
+func NewIndexer(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	chains *registry.Registry,
+	pollInterval time.Duration,
+	maxBlocks uint64,
+) *Indexer {
+	ensureIndexerMetrics()
+
+	if pollInterval <= 0 {
+		pollInterval = 30 * time.Second
+	}
+	if maxBlocks == 0 {
+		maxBlocks = 50
+	}
+
+	return &Indexer{
+		metadataStore: metadataStore,
+		sessionStore:  sessionStore,
+		chains:        chains,
+		pollInterval:  pollInterval,
+		maxBlocks:     maxBlocks,
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+}
+
+// Start 启动后台扫描（立即执行一次，之后按 pollInterval 周期执行）
+func (i *Indexer) Start(ctx context.Context) {
+	if !i.started.CompareAndSwap(false, true) {
+		return
+	}
+
+	log.Info().
+		Dur("poll_interval", i.pollInterval).
+		Uint64("max_blocks", i.maxBlocks).
+		Msg("Starting transaction indexer")
+
+	go func() {
+		defer close(i.doneCh)
+
+		ticker := time.NewTicker(i.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			i.RunOnce(ctx)
+
+			select {
+			case <-ticker.C:
+			case <-i.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止后台扫描，等待正在进行的扫描退出
+func (i *Indexer) Stop(ctx context.Context) {
+	i.stopOnce.Do(func() {
+		close(i.stopCh)
+	})
+	if !i.started.Load() {
+		return
+	}
+
+	select {
+	case <-i.doneCh:
+	case <-ctx.Done():
+		log.Warn().Msg("Timed out waiting for transaction indexer to stop")
+	}
+}
+
+// RunOnce 扫描一次所有配置了 RPC 端点的链
+func (i *Indexer) RunOnce(ctx context.Context) {
+	if i.sessionStore != nil {
+		lock, err := storage.TryLock(ctx, i.sessionStore, indexerLockKey, indexerLockTTL)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to acquire transaction indexer lock")
+			return
+		}
+		if lock == nil {
+			log.Debug().Msg("Transaction indexer running on another instance, skipping")
+			return
+		}
+		defer func() {
+			if err := lock.Release(); err != nil {
+				log.Warn().Err(err).Msg("Failed to release transaction indexer lock")
+			}
+		}()
+		ctx = lock.Context()
+	}
+
+	wallets, err := i.watchedAddresses(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list wallets to index")
+		return
+	}
+
+	for _, chainInfo := range i.chains.Chains() {
+		select {
+		case <-i.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if len(chainInfo.RPCEndpoints) == 0 {
+			continue
+		}
+
+		switch chainInfo.Family {
+		case registry.FamilyEVM:
+			err = i.indexEVM(ctx, chainInfo, wallets[registry.FamilyEVM])
+		case registry.FamilySolana:
+			err = i.indexSolana(ctx, chainInfo, wallets[registry.FamilySolana])
+		default:
+			continue
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("chain", chainInfo.Name).Msg("Failed to index incoming transfers, will retry on next poll")
+		}
+	}
+}
+
+// watchedAddresses 按链家族返回 地址 -> 钱包 ID（EVM 地址统一小写，同一地址在所有 EVM 链上有效）
+func (i *Indexer) watchedAddresses(ctx context.Context) (map[registry.Family]map[string]string, error) {
+	wallets := make(map[registry.Family]map[string]string)
+
+	for _, status := range indexedKeyStatuses {
+		for offset := 0; ; offset += indexerKeyPageSize {
+			keys, err := i.metadataStore.ListKeys(ctx, &storage.KeyFilter{
+				Status: status,
+				Limit:  indexerKeyPageSize,
+				Offset: offset,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list %s keys", status)
+			}
+
+			for _, keyMeta := range keys {
+				if keyMeta.Address == "" {
+					continue
+				}
+				chainInfo, err := i.chains.Lookup(keyMeta.ChainType)
+				if err != nil {
+					continue
+				}
+
+				address := keyMeta.Address
+				if chainInfo.Family == registry.FamilyEVM {
+					address = strings.ToLower(address)
+				}
+				if wallets[chainInfo.Family] == nil {
+					wallets[chainInfo.Family] = make(map[string]string)
+				}
+				wallets[chainInfo.Family][address] = keyMeta.KeyID
+			}
+
+			if len(keys) < indexerKeyPageSize {
+				break
+			}
+		}
+	}
+
+	return wallets, nil
+}
+
+// indexEVM 扫描上次进度之后、已达到确认数的区块
+func (i *Indexer) indexEVM(ctx context.Context, chainInfo *registry.Chain, wallets map[string]string) error {
+	adapter, err := chainInfo.EthereumAdapter()
+	if err != nil {
+		return err
+	}
+
+	head, err := adapter.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	// 区块 b 的确认数为 head - b + 1
+	if head+1 < chainInfo.Confirmations {
+		return nil
+	}
+	safe := head + 1 - chainInfo.Confirmations
+
+	cursor, err := i.metadataStore.GetIndexerCursor(ctx, chainInfo.Name, "")
+	if err != nil {
+		return err
+	}
+	if cursor == "" {
+		return i.metadataStore.SaveIndexerCursor(ctx, chainInfo.Name, "", strconv.FormatUint(safe, 10))
+	}
+	last, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid indexer cursor %q", cursor)
+	}
+
+	from := last + 1
+	if from > safe {
+		return nil
+	}
+	to := safe
+	if to-from+1 > i.maxBlocks {
+		to = from + i.maxBlocks - 1
+	}
+
+	if len(wallets) > 0 {
+		blockTimes := make(map[uint64]time.Time, to-from+1)
+		for number := from; number <= to; number++ {
+			block, err := adapter.GetBlockByNumber(ctx, number)
+			if err != nil {
+				return err
+			}
+			if block == nil {
+				return errors.Errorf("block %d not available", number)
+			}
+			blockTimes[number] = time.Unix(int64(block.Timestamp), 0)
+
+			if err := i.indexEVMBlock(ctx, chainInfo, block, wallets); err != nil {
+				return err
+			}
+		}
+
+		if err := i.indexERC20Transfers(ctx, chainInfo, adapter, from, to, wallets, blockTimes); err != nil {
+			return err
+		}
+	}
+
+	return i.metadataStore.SaveIndexerCursor(ctx, chainInfo.Name, "", strconv.FormatUint(to, 10))
+}
+
+// indexEVMBlock 记录区块中转入钱包的原生币转账
+// 发往外部账户的转账不执行代码，打包即成功，无需查询回执
+func (i *Indexer) indexEVMBlock(ctx context.Context, chainInfo *registry.Chain, block *ethereum.Block, wallets map[string]string) error {
+	for _, tx := range block.Transactions {
+		to := strings.ToLower(tx.To)
+		from := strings.ToLower(tx.From)
+		walletID, ok := wallets[to]
+		if !ok || from == to || tx.Value.Sign() == 0 {
+			continue
+		}
+
+		if err := i.saveTransfer(ctx, &storage.IncomingTransfer{
+			WalletID:    walletID,
+			ChainType:   chainInfo.Name,
+			TxHash:      tx.Hash,
+			LogIndex:    -1,
+			FromAddress: from,
+			ToAddress:   to,
+			Value:       tx.Value.String(),
+			BlockNumber: int64(block.Number),
+			BlockTime:   time.Unix(int64(block.Timestamp), 0),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexERC20Transfers 通过 Transfer 事件记录转入钱包的 ERC-20 代币
+func (i *Indexer) indexERC20Transfers(
+	ctx context.Context,
+	chainInfo *registry.Chain,
+	adapter *chain.EthereumAdapter,
+	from, to uint64,
+	wallets map[string]string,
+	blockTimes map[uint64]time.Time,
+) error {
+	topics := make([]string, 0, len(wallets))
+	for address := range wallets {
+		topics = append(topics, chain.AddressTopic(address))
+	}
+
+	for start := 0; start < len(topics); start += evmTopicBatchSize {
+		end := start + evmTopicBatchSize
+		if end > len(topics) {
+			end = len(topics)
+		}
+
+		logs, err := adapter.GetLogs(ctx, &ethereum.LogFilter{
+			FromBlock: from,
+			ToBlock:   to,
+			Topics:    [][]string{{chain.ERC20TransferTopic}, nil, topics[start:end]},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range logs {
+			if entry.Removed {
+				continue
+			}
+			transfer, err := chain.ParseERC20Transfer(entry)
+			if err != nil {
+				// ERC-721 等同名事件
+				continue
+			}
+			walletID, ok := wallets[transfer.To]
+			if !ok || transfer.From == transfer.To || transfer.Value.Sign() == 0 {
+				continue
+			}
+
+			decimals, err := i.erc20Decimals(ctx, chainInfo, adapter, transfer.Token)
+			if err != nil {
+				return err
+			}
+
+			if err := i.saveTransfer(ctx, &storage.IncomingTransfer{
+				WalletID:    walletID,
+				ChainType:   chainInfo.Name,
+				TxHash:      transfer.TxHash,
+				LogIndex:    int64(transfer.LogIndex),
+				Asset:       transfer.Token,
+				Decimals:    int(decimals),
+				FromAddress: transfer.From,
+				ToAddress:   transfer.To,
+				Value:       transfer.Value.String(),
+				BlockNumber: int64(transfer.Block),
+				BlockTime:   blockTimes[transfer.Block],
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// erc20Decimals 查询并缓存代币精度
+func (i *Indexer) erc20Decimals(ctx context.Context, chainInfo *registry.Chain, adapter *chain.EthereumAdapter, token string) (uint8, error) {
+	cacheKey := chainInfo.Name + ":" + token
+	if decimals, ok := i.tokenDecimals.Load(cacheKey); ok {
+		return decimals.(uint8), nil
+	}
+
+	decimals, err := adapter.ERC20Decimals(ctx, token)
+	if err != nil {
+		return 0, err
+	}
+	i.tokenDecimals.Store(cacheKey, decimals)
+	return decimals, nil
+}
+
+// indexSolana 处理每个钱包地址上次进度之后的 finalized 交易
+func (i *Indexer) indexSolana(ctx context.Context, chainInfo *registry.Chain, wallets map[string]string) error {
+	adapter, err := chainInfo.SolanaAdapter()
+	if err != nil {
+		return err
+	}
+
+	for address, walletID := range wallets {
+		if err := i.indexSolanaAddress(ctx, chainInfo, adapter, address, walletID); err != nil {
+			return errors.Wrapf(err, "failed to index address %s", address)
+		}
+	}
+	return nil
+}
+
+// indexSolanaAddress 按时间正序处理地址的新签名，每处理一笔保存一次进度
+func (i *Indexer) indexSolanaAddress(ctx context.Context, chainInfo *registry.Chain, adapter *chain.SolanaAdapter, address, walletID string) error {
+	cursor, err := i.metadataStore.GetIndexerCursor(ctx, chainInfo.Name, address)
+	if err != nil {
+		return err
+	}
+
+	// 签名按时间倒序返回，向前翻页直到上次处理的签名
+	var signatures []*solana.SignatureInfo
+	before := ""
+	for len(signatures) < solanaMaxSignatures {
+		page, err := adapter.GetSignaturesForAddress(ctx, address, before, cursor, solanaSignaturePageSize)
+		if err != nil {
+			return err
+		}
+		signatures = append(signatures, page...)
+		if len(page) < solanaSignaturePageSize {
+			break
+		}
+		before = page[len(page)-1].Signature
+	}
+	if len(signatures) >= solanaMaxSignatures {
+		log.Warn().
+			Str("chain", chainInfo.Name).
+			Str("address", address).
+			Int("signatures", len(signatures)).
+			Msg("Too many new signatures for address, older transfers are skipped")
+	}
+
+	for idx := len(signatures) - 1; idx >= 0; idx-- {
+		info := signatures[idx]
+		if !info.Failed() {
+			detail, err := adapter.GetTransaction(ctx, info.Signature)
+			if err != nil {
+				return err
+			}
+			if detail == nil {
+				return errors.Errorf("transaction %s not available", info.Signature)
+			}
+			if err := i.indexSolanaTransaction(ctx, chainInfo, info, detail, address, walletID); err != nil {
+				return err
+			}
+		}
+
+		if err := i.metadataStore.SaveIndexerCursor(ctx, chainInfo.Name, address, info.Signature); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexSolanaTransaction 根据交易前后余额变化记录转入的 SOL 和 SPL 代币
+// 钱包支付手续费的交易由钱包发起，已作为转出交易记录；发送方取余额减少的账户，找不到时取手续费支付方
+func (i *Indexer) indexSolanaTransaction(
+	ctx context.Context,
+	chainInfo *registry.Chain,
+	info *solana.SignatureInfo,
+	detail *solana.TransactionDetail,
+	address, walletID string,
+) error {
+	if detail.Failed() || len(detail.AccountKeys) == 0 || detail.AccountKeys[0] == address {
+		return nil
+	}
+	feePayer := detail.AccountKeys[0]
+
+	blockTime := time.Now()
+	if detail.BlockTime != nil {
+		blockTime = time.Unix(*detail.BlockTime, 0)
+	} else if info.BlockTime != nil {
+		blockTime = time.Unix(*info.BlockTime, 0)
+	}
+
+	transfer := func(asset string, decimals int, from string, amount *big.Int) error {
+		return i.saveTransfer(ctx, &storage.IncomingTransfer{
+			WalletID:    walletID,
+			ChainType:   chainInfo.Name,
+			TxHash:      info.Signature,
+			LogIndex:    -1,
+			Asset:       asset,
+			Decimals:    decimals,
+			FromAddress: from,
+			ToAddress:   address,
+			Value:       amount.String(),
+			BlockNumber: int64(detail.Slot),
+			BlockTime:   blockTime,
+		})
+	}
+
+	// SOL：钱包账户的 lamports 增加
+	for idx, key := range detail.AccountKeys {
+		if key != address || idx >= len(detail.PreBalances) || idx >= len(detail.PostBalances) {
+			continue
+		}
+		if detail.PostBalances[idx] > detail.PreBalances[idx] {
+			amount := new(big.Int).SetUint64(detail.PostBalances[idx] - detail.PreBalances[idx])
+			if err := transfer("", chainInfo.Decimals, feePayer, amount); err != nil {
+				return err
+			}
+		}
+		break
+	}
+
+	// SPL：钱包拥有的代币账户按 Mint 汇总余额变化
+	deltas := make(map[string]*big.Int)
+	decimals := make(map[string]int)
+	senders := make(map[string]string)
+	preAmounts := make(map[int]*big.Int)
+	for _, balance := range detail.PreTokenBalances {
+		preAmounts[balance.AccountIndex] = parseAmount(balance.Amount)
+	}
+	for _, balance := range detail.PostTokenBalances {
+		delta := parseAmount(balance.Amount)
+		if pre, ok := preAmounts[balance.AccountIndex]; ok {
+			delta.Sub(delta, pre)
+		}
+		if balance.Owner == address {
+			if deltas[balance.Mint] == nil {
+				deltas[balance.Mint] = new(big.Int)
+			}
+			deltas[balance.Mint].Add(deltas[balance.Mint], delta)
+			decimals[balance.Mint] = balance.Decimals
+		} else if delta.Sign() < 0 && senders[balance.Mint] == "" {
+			senders[balance.Mint] = balance.Owner
+		}
+	}
+	for mint, delta := range deltas {
+		if delta.Sign() <= 0 {
+			continue
+		}
+		from := senders[mint]
+		if from == "" {
+			from = feePayer
+		}
+		if err := transfer(mint, decimals[mint], from, delta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveTransfer 保存转入记录并计数
+func (i *Indexer) saveTransfer(ctx context.Context, transfer *storage.IncomingTransfer) error {
+	if err := i.metadataStore.SaveIncomingTransfer(ctx, transfer); err != nil {
+		return err
+	}
+
+	indexerTransfers.WithLabelValues(transfer.ChainType).Inc()
+	log.Debug().
+		Str("wallet_id", transfer.WalletID).
+		Str("chain", transfer.ChainType).
+		Str("tx_hash", transfer.TxHash).
+		Str("asset", transfer.Asset).
+		Msg("Incoming transfer indexed")
+	return nil
+}
+
+// parseAmount 解析十进制金额，无效时为 0
+func parseAmount(amount string) *big.Int {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return value
+}
+
+func ensureIndexerMetrics() {
+	indexerMetricsOnce.Do(func() {
+		indexerTransfers = promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpc",
+			Subsystem: "transactions",
+			Name:      "incoming_indexed_total",
+			Help:      "Total number of incoming transfers found by the indexer, by chain",
+		}, []string{"chain"})
+	})
+}
@@ -0,0 +1,208 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIndexerStore 只实现索引器所需操作的内存存储
+type fakeIndexerStore struct {
+	storage.MetadataStore
+	keys      []*storage.KeyMetadata
+	cursors   map[string]string
+	transfers []*storage.IncomingTransfer
+}
+
+func (f *fakeIndexerStore) ListKeys(ctx context.Context, filter *storage.KeyFilter) ([]*storage.KeyMetadata, error) {
+	var result []*storage.KeyMetadata
+	if filter.Offset > 0 {
+		return result, nil
+	}
+	for _, keyMeta := range f.keys {
+		if keyMeta.Status == filter.Status {
+			result = append(result, keyMeta)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeIndexerStore) GetIndexerCursor(ctx context.Context, chainType, address string) (string, error) {
+	return f.cursors[chainType+"/"+address], nil
+}
+
+func (f *fakeIndexerStore) SaveIndexerCursor(ctx context.Context, chainType, address, cursor string) error {
+	f.cursors[chainType+"/"+address] = cursor
+	return nil
+}
+
+func (f *fakeIndexerStore) SaveIncomingTransfer(ctx context.Context, transfer *storage.IncomingTransfer) error {
+	copied := *transfer
+	f.transfers = append(f.transfers, &copied)
+	return nil
+}
+
+func TestIndexerEVM(t *testing.T) {
+	const (
+		wallet = "0x00000000000000000000000000000000000000AA"
+		sender = "0x00000000000000000000000000000000000000bb"
+		token  = "0x00000000000000000000000000000000000000cc"
+	)
+	lowerWallet := "0x00000000000000000000000000000000000000aa"
+
+	node := rpcServer(t, map[string]string{
+		"eth_blockNumber": `"0x11"`,
+		"eth_getBlockByNumber 0xf": fmt.Sprintf(`{"number":"0xf","hash":"0xb15","timestamp":"0x64","transactions":[
+			{"hash":"0xnative","from":"%s","to":"%s","value":"0xde0b6b3a7640000"},
+			{"hash":"0xzero","from":"%s","to":"%s","value":"0x0"},
+			{"hash":"0xself","from":"%s","to":"%s","value":"0x1"},
+			{"hash":"0xdeploy","from":"%s","to":null,"value":"0x1"}
+		]}`, sender, wallet, sender, wallet, wallet, wallet, sender),
+		"eth_getBlockByNumber 0x10": `{"number":"0x10","hash":"0xb16","timestamp":"0x70","transactions":[]}`,
+		"eth_getLogs": fmt.Sprintf(`[
+			{"address":"%s","topics":["%s","%s","%s"],"data":"0x00000000000000000000000000000000000000000000000000000000000f4240",
+				"blockNumber":"0x10","transactionHash":"0xtoken","logIndex":"0x3","removed":false},
+			{"address":"%s","topics":["%s","%s","%s","0x0000000000000000000000000000000000000000000000000000000000000001"],"data":"0x",
+				"blockNumber":"0x10","transactionHash":"0xnft","logIndex":"0x4","removed":false}
+		]`, token, chain.ERC20TransferTopic, chain.AddressTopic(sender), chain.AddressTopic(wallet),
+			token, chain.ERC20TransferTopic, chain.AddressTopic(sender), chain.AddressTopic(wallet)),
+		"eth_call": `"0x0000000000000000000000000000000000000000000000000000000000000006"`,
+	})
+	defer node.Close()
+
+	chains, err := registry.New([]registry.Chain{{
+		Name: "anvil", Family: registry.FamilyEVM, ChainID: 31337, RPCEndpoints: []string{node.URL},
+		Symbol: "ETH", Decimals: 18, Confirmations: 2,
+	}})
+	require.NoError(t, err)
+
+	store := &fakeIndexerStore{
+		keys: []*storage.KeyMetadata{
+			{KeyID: "wallet-1", ChainType: "anvil", Address: wallet, Status: storage.KeyStatusActive},
+		},
+		cursors: map[string]string{},
+	}
+	indexer := NewIndexer(store, nil, chains, time.Minute, 10)
+
+	// 首次运行只记录当前安全高度（head 17，2 个确认）
+	indexer.RunOnce(context.Background())
+	assert.Equal(t, "16", store.cursors["anvil/"])
+	assert.Empty(t, store.transfers)
+
+	store.cursors["anvil/"] = "14"
+	indexer.RunOnce(context.Background())
+	assert.Equal(t, "16", store.cursors["anvil/"])
+	require.Len(t, store.transfers, 2)
+
+	native := store.transfers[0]
+	assert.Equal(t, "wallet-1", native.WalletID)
+	assert.Equal(t, "0xnative", native.TxHash)
+	assert.Empty(t, native.Asset)
+	assert.Equal(t, "1000000000000000000", native.Value)
+	assert.Equal(t, lowerWallet, native.ToAddress)
+	assert.Equal(t, int64(15), native.BlockNumber)
+	assert.Equal(t, time.Unix(100, 0), native.BlockTime)
+
+	// ERC-721 同名事件被忽略
+	erc20 := store.transfers[1]
+	assert.Equal(t, "0xtoken", erc20.TxHash)
+	assert.Equal(t, token, erc20.Asset)
+	assert.Equal(t, 6, erc20.Decimals)
+	assert.Equal(t, "1000000", erc20.Value)
+	assert.Equal(t, sender, erc20.FromAddress)
+	assert.Equal(t, int64(3), erc20.LogIndex)
+	assert.Equal(t, time.Unix(112, 0), erc20.BlockTime)
+}
+
+func TestIndexerSolana(t *testing.T) {
+	const (
+		wallet = "WalletAddress"
+		sender = "SenderAddress"
+		mint   = "MintAddress"
+	)
+
+	node := rpcServer(t, map[string]string{
+		// 按时间倒序
+		"getSignaturesForAddress " + wallet: `[
+			{"signature":"spl","slot":40,"blockTime":1400,"err":null},
+			{"signature":"own","slot":30,"blockTime":1300,"err":null},
+			{"signature":"failed","slot":20,"blockTime":1200,"err":{"InstructionError":[0,"Custom"]}},
+			{"signature":"sol","slot":10,"blockTime":1100,"err":null}
+		]`,
+		"getTransaction sol": fmt.Sprintf(`{"slot":10,"blockTime":1100,
+			"meta":{"err":null,"preBalances":[5000000000,0,1],"postBalances":[3999995000,1000000000,1],"preTokenBalances":[],"postTokenBalances":[]},
+			"transaction":{"message":{"accountKeys":["%s","%s","11111111111111111111111111111111"]}}}`, sender, wallet),
+		"getTransaction own": fmt.Sprintf(`{"slot":30,"blockTime":1300,
+			"meta":{"err":null,"preBalances":[2000000000,0],"postBalances":[1999995000,5000],"preTokenBalances":[],"postTokenBalances":[]},
+			"transaction":{"message":{"accountKeys":["%s","%s"]}}}`, wallet, sender),
+		"getTransaction spl": fmt.Sprintf(`{"slot":40,"blockTime":1400,
+			"meta":{"err":null,"preBalances":[1000000000,2039280,2039280],"postBalances":[999995000,2039280,2039280],
+				"preTokenBalances":[
+					{"accountIndex":1,"mint":"%[3]s","owner":"%[1]s","uiTokenAmount":{"amount":"900","decimals":6}},
+					{"accountIndex":2,"mint":"%[3]s","owner":"%[2]s","uiTokenAmount":{"amount":"100","decimals":6}}],
+				"postTokenBalances":[
+					{"accountIndex":1,"mint":"%[3]s","owner":"%[1]s","uiTokenAmount":{"amount":"650","decimals":6}},
+					{"accountIndex":2,"mint":"%[3]s","owner":"%[2]s","uiTokenAmount":{"amount":"350","decimals":6}}]},
+			"transaction":{"message":{"accountKeys":["%[1]s","SenderTokenAccount","WalletTokenAccount"]}}}`, sender, wallet, mint),
+	})
+	defer node.Close()
+
+	chains, err := registry.New([]registry.Chain{{
+		Name: "solana-devnet", Family: registry.FamilySolana, RPCEndpoints: []string{node.URL}, Symbol: "SOL", Decimals: 9,
+	}})
+	require.NoError(t, err)
+
+	store := &fakeIndexerStore{
+		keys: []*storage.KeyMetadata{
+			{KeyID: "wallet-1", ChainType: "solana-devnet", Address: wallet, Status: storage.KeyStatusDisabled},
+		},
+		cursors: map[string]string{},
+	}
+	NewIndexer(store, nil, chains, time.Minute, 0).RunOnce(context.Background())
+
+	// 失败的交易和钱包自己发起的交易不记录为转入
+	require.Len(t, store.transfers, 2)
+	assert.Equal(t, "spl", store.cursors["solana-devnet/"+wallet])
+
+	sol := store.transfers[0]
+	assert.Equal(t, "sol", sol.TxHash)
+	assert.Empty(t, sol.Asset)
+	assert.Equal(t, 9, sol.Decimals)
+	assert.Equal(t, "1000000000", sol.Value)
+	assert.Equal(t, sender, sol.FromAddress)
+	assert.Equal(t, time.Unix(1100, 0), sol.BlockTime)
+
+	spl := store.transfers[1]
+	assert.Equal(t, "spl", spl.TxHash)
+	assert.Equal(t, mint, spl.Asset)
+	assert.Equal(t, 6, spl.Decimals)
+	assert.Equal(t, "250", spl.Value)
+	assert.Equal(t, sender, spl.FromAddress)
+	assert.Equal(t, int64(40), spl.BlockNumber)
+}
+
+func TestHistoryCursor(t *testing.T) {
+	entry := &storage.WalletHistoryEntry{
+		Direction: DirectionIncoming,
+		ID:        42,
+		Timestamp: time.Date(2026, 10, 16, 12, 0, 0, 123456000, time.UTC),
+	}
+
+	cursor, err := decodeHistoryCursor(encodeHistoryCursor(entry))
+	require.NoError(t, err)
+	assert.True(t, entry.Timestamp.Equal(cursor.Timestamp))
+	assert.Equal(t, DirectionIncoming, cursor.Direction)
+	assert.Equal(t, int64(42), cursor.ID)
+
+	for _, invalid := range []string{"!!!", "MTIzOmluY29taW5n", "MTIzOnNpZGV3YXlzOjQy"} {
+		_, err := decodeHistoryCursor(invalid)
+		assert.ErrorIs(t, err, ErrInvalidCursor, invalid)
+	}
+}
@@ -0,0 +1,371 @@
+package transaction
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// nonce 预留状态（storage.NonceReservation.Status）
+const (
+	// NonceReserved 已分配给正在签名的交易
+	NonceReserved = "reserved"
+	// NonceBroadcast 使用该 nonce 的交易已广播
+	NonceBroadcast = "broadcast"
+	// NonceReleased 签名或广播失败后释放，下次分配时优先复用以填补空洞
+	NonceReleased = "released"
+)
+
+const (
+	// nonceLockTTL 地址锁的过期时间，持有锁的实例崩溃后自动释放
+	nonceLockTTL = 10 * time.Second
+	// nonceLockRetryInterval 地址锁被占用时的重试间隔
+	nonceLockRetryInterval = 50 * time.Millisecond
+	// defaultNonceLockWait 等待地址锁的默认超时
+	defaultNonceLockWait = 5 * time.Second
+	// defaultReservationTimeout 预留后既未广播也未释放的 nonce 在超过该时间后视为已释放（签名进程崩溃），
+	// 需要长于阈值签名等待移动端参与的最长时间
+	defaultReservationTimeout = 15 * time.Minute
+	// nonceReserveAttempts 分配时预留被并发占用（例如地址锁过期）后重新对账的次数
+	nonceReserveAttempts = 3
+)
+
+var (
+	// ErrNonceLockTimeout 等待地址锁超时
+	ErrNonceLockTimeout = errors.New("timed out waiting for nonce lock")
+	// ErrReplacementNotFound 没有使用该交易哈希且可替换的 nonce 预留
+	ErrReplacementNotFound = errors.New("no replaceable transaction found")
+	// ErrNonceAlreadyMined 交易的 nonce 已被打包，无法替换
+	ErrNonceAlreadyMined = errors.New("nonce has already been mined")
+)
+
+// NonceManager 为 EVM 地址分配 nonce，保证并发签名（例如 BatchSign）时每笔交易使用不同的 nonce
+// 每次分配或状态变更都持有 (chain, address) 的 Redis 分布式锁；分配时与 RPC 的 pending nonce 对账，
+// 已打包的预留会被清理，签名失败释放的 nonce 被优先复用，避免后续交易因 nonce 空洞卡在交易池中
+type NonceManager struct {
+	metadataStore storage.MetadataStore
+	sessionStore  storage.SessionStore
+	chains        *registry.Registry
+
+	lockWait           time.Duration
+	reservationTimeout time.Duration
+
+	// localMu sessionStore 为空时（单实例开发环境）用进程内锁代替分布式锁
+	localMu sync.Mutex
+}
+
+// NewNonceManager 创建 nonce 管理器，sessionStore 为空时只在进程内加锁
+func NewNonceManager(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	chains *registry.Registry,
+	lockWait time.Duration,
+	reservationTimeout time.Duration,
+) *NonceManager {
+	if lockWait <= 0 {
+		lockWait = defaultNonceLockWait
+	}
+	if reservationTimeout <= 0 {
+		reservationTimeout = defaultReservationTimeout
+	}
+
+	return &NonceManager{
+		metadataStore:      metadataStore,
+		sessionStore:       sessionStore,
+		chains:             chains,
+		lockWait:           lockWait,
+		reservationTimeout: reservationTimeout,
+	}
+}
+
+// Reserve 为地址分配下一个可用 nonce
+// 调用方签名并广播后必须调用 MarkBroadcast，签名或广播失败时必须调用 Release
+func (m *NonceManager) Reserve(ctx context.Context, chainType, address string) (*storage.NonceReservation, error) {
+	chainInfo, address, err := m.resolve(chainType, address)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservation *storage.NonceReservation
+	for attempt := 1; ; attempt++ {
+		err = m.withLock(ctx, chainInfo.Name, address, func() error {
+			reservation, err = m.reserveNext(ctx, chainInfo, address)
+			return err
+		})
+		// 地址锁过期等情况下其他实例可能同时分配了同一个 nonce，存储层拒绝覆盖后重新对账
+		if errors.Is(err, storage.ErrNonceReservationConflict) && attempt < nonceReserveAttempts {
+			log.Debug().Err(err).Str("chain", chainInfo.Name).Str("address", address).Msg("Nonce reservation conflict, retrying")
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	log.Debug().
+		Str("chain", reservation.ChainType).
+		Str("address", reservation.Address).
+		Uint64("nonce", reservation.Nonce).
+		Msg("Nonce reserved")
+
+	return reservation, nil
+}
+
+// reserveNext 对账后预留下一个可用 nonce：优先复用已释放（或预留超时）的 nonce，否则接在最大的预留之后
+func (m *NonceManager) reserveNext(ctx context.Context, chainInfo *registry.Chain, address string) (*storage.NonceReservation, error) {
+	reservations, pendingNonce, err := m.reconcile(ctx, chainInfo, address)
+	if err != nil {
+		return nil, err
+	}
+
+	next := pendingNonce
+	for _, existing := range reservations {
+		if existing.Nonce < pendingNonce {
+			continue
+		}
+		if m.reusable(existing) {
+			existing.Status = NonceReserved
+			existing.TxHash = ""
+			existing.ReplacesTxHash = ""
+			existing.ReservedAt = time.Now()
+			return existing, m.metadataStore.UpdateNonceReservation(ctx, existing)
+		}
+		if existing.Nonce >= next {
+			next = existing.Nonce + 1
+		}
+	}
+
+	reservation := &storage.NonceReservation{
+		ChainType:  chainInfo.Name,
+		Address:    address,
+		Nonce:      next,
+		Status:     NonceReserved,
+		ReservedAt: time.Now(),
+	}
+	return reservation, m.metadataStore.CreateNonceReservation(ctx, reservation)
+}
+
+// ReserveReplacement 为替换卡住的交易（加速或取消）预留与原交易相同的 nonce
+// 新交易的手续费必须不低于 ReplacementFee 返回的值，否则节点会拒绝替换；
+// 签名或广播失败时调用 Release 会恢复原交易的预留
+func (m *NonceManager) ReserveReplacement(ctx context.Context, chainType, address, txHash string) (*storage.NonceReservation, error) {
+	chainInfo, address, err := m.resolve(chainType, address)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservation *storage.NonceReservation
+	err = m.withLock(ctx, chainInfo.Name, address, func() error {
+		adapter, err := chainInfo.EthereumAdapter()
+		if err != nil {
+			return err
+		}
+		reservations, err := m.metadataStore.ListNonceReservations(ctx, chainInfo.Name, address)
+		if err != nil {
+			return err
+		}
+
+		for _, existing := range reservations {
+			if existing.Status == NonceBroadcast && strings.EqualFold(existing.TxHash, txHash) {
+				reservation = existing
+				break
+			}
+		}
+		if reservation == nil {
+			return errors.Wrapf(ErrReplacementNotFound, "transaction %s", txHash)
+		}
+
+		minedNonce, err := adapter.GetTransactionCount(ctx, address)
+		if err != nil {
+			return err
+		}
+		if reservation.Nonce < minedNonce {
+			return errors.Wrapf(ErrNonceAlreadyMined, "nonce %d", reservation.Nonce)
+		}
+
+		reservation.Status = NonceReserved
+		reservation.ReplacesTxHash = reservation.TxHash
+		reservation.TxHash = ""
+		reservation.ReservedAt = time.Now()
+		return m.metadataStore.UpdateNonceReservation(ctx, reservation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("chain", reservation.ChainType).
+		Str("address", reservation.Address).
+		Uint64("nonce", reservation.Nonce).
+		Str("replaces_tx_hash", reservation.ReplacesTxHash).
+		Msg("Nonce reserved for replacement transaction")
+
+	return reservation, nil
+}
+
+// MarkBroadcast 记录使用该 nonce 的交易已广播
+// 预留超时后已被重新分配时返回 storage.ErrNonceReservationConflict
+func (m *NonceManager) MarkBroadcast(ctx context.Context, reservation *storage.NonceReservation, txHash string) error {
+	if reservation == nil {
+		return errors.New("nonce reservation is nil")
+	}
+
+	return m.withLock(ctx, reservation.ChainType, reservation.Address, func() error {
+		reservation.Status = NonceBroadcast
+		reservation.TxHash = txHash
+		reservation.ReplacesTxHash = ""
+		return m.metadataStore.UpdateNonceReservation(ctx, reservation)
+	})
+}
+
+// Release 签名或广播失败时释放 nonce：替换交易的预留恢复为原交易，其他预留标记为 released 供下次复用
+func (m *NonceManager) Release(ctx context.Context, reservation *storage.NonceReservation) error {
+	if reservation == nil {
+		return errors.New("nonce reservation is nil")
+	}
+
+	err := m.withLock(ctx, reservation.ChainType, reservation.Address, func() error {
+		if reservation.ReplacesTxHash != "" {
+			reservation.Status = NonceBroadcast
+			reservation.TxHash = reservation.ReplacesTxHash
+			reservation.ReplacesTxHash = ""
+		} else {
+			reservation.Status = NonceReleased
+			reservation.TxHash = ""
+		}
+		return m.metadataStore.UpdateNonceReservation(ctx, reservation)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("chain", reservation.ChainType).
+		Str("address", reservation.Address).
+		Uint64("nonce", reservation.Nonce).
+		Str("status", reservation.Status).
+		Msg("Nonce released")
+
+	return nil
+}
+
+// ReplacementFee 替换交易池中交易所需的最低费用（原费用上浮 10% 并向上取整），
+// EIP-1559 交易的 maxFeePerGas 和 maxPriorityFeePerGas 都需要上浮
+func ReplacementFee(fee *big.Int) *big.Int {
+	if fee == nil {
+		return new(big.Int)
+	}
+	bumped := new(big.Int).Mul(fee, big.NewInt(110))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// reconcile 与链上状态对账：清理已打包的预留，返回剩余预留（按 nonce 升序）和 pending nonce
+func (m *NonceManager) reconcile(ctx context.Context, chainInfo *registry.Chain, address string) ([]*storage.NonceReservation, uint64, error) {
+	adapter, err := chainInfo.EthereumAdapter()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	minedNonce, err := adapter.GetTransactionCount(ctx, address)
+	if err != nil {
+		return nil, 0, err
+	}
+	pendingNonce, err := adapter.GetPendingTransactionCount(ctx, address)
+	if err != nil {
+		return nil, 0, err
+	}
+	// 负载均衡后的节点可能落后，pending 不会小于已打包的 nonce
+	if pendingNonce < minedNonce {
+		pendingNonce = minedNonce
+	}
+
+	// 交易池中的交易仍可能被替换，只清理已打包的预留
+	if err := m.metadataStore.DeleteNonceReservationsBelow(ctx, chainInfo.Name, address, minedNonce); err != nil {
+		return nil, 0, err
+	}
+	reservations, err := m.metadataStore.ListNonceReservations(ctx, chainInfo.Name, address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reservations, pendingNonce, nil
+}
+
+// reusable 预留是否可以重新分配
+func (m *NonceManager) reusable(reservation *storage.NonceReservation) bool {
+	switch reservation.Status {
+	case NonceReleased:
+		return true
+	case NonceReserved:
+		// 替换交易超时不复用，原交易仍在交易池中
+		return reservation.ReplacesTxHash == "" && time.Since(reservation.ReservedAt) > m.reservationTimeout
+	default:
+		return false
+	}
+}
+
+// resolve 解析 EVM 链并规范化地址
+func (m *NonceManager) resolve(chainType, address string) (*registry.Chain, string, error) {
+	chainInfo, err := m.chains.Lookup(chainType)
+	if err != nil {
+		return nil, "", err
+	}
+	if chainInfo.Family != registry.FamilyEVM {
+		return nil, "", errors.Errorf("nonce management is not supported for chain %s", chainInfo.Name)
+	}
+	if address == "" {
+		return nil, "", errors.New("address is required")
+	}
+	// EVM 地址不区分大小写，与交易记录一致统一小写
+	return chainInfo, strings.ToLower(address), nil
+}
+
+// withLock 持有 (chain, address) 的锁执行 fn
+func (m *NonceManager) withLock(ctx context.Context, chainType, address string, fn func() error) error {
+	if m.sessionStore == nil {
+		m.localMu.Lock()
+		defer m.localMu.Unlock()
+		return fn()
+	}
+
+	lockKey := "nonce:" + chainType + ":" + address
+	waitCtx, cancel := context.WithTimeout(ctx, m.lockWait)
+	defer cancel()
+
+	var lock *storage.Lock
+	for {
+		var err error
+		lock, err = storage.TryLock(ctx, m.sessionStore, lockKey, nonceLockTTL)
+		if err != nil {
+			return errors.Wrap(err, "failed to acquire nonce lock")
+		}
+		if lock != nil {
+			break
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return errors.Wrapf(ErrNonceLockTimeout, "%s on %s", address, chainType)
+		case <-time.After(nonceLockRetryInterval):
+		}
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			log.Warn().Err(err).Str("lock", lockKey).Msg("Failed to release nonce lock")
+		}
+	}()
+
+	return fn()
+}
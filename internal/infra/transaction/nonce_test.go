@@ -0,0 +1,265 @@
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNonceStore 只实现 nonce 预留操作的内存存储
+type fakeNonceStore struct {
+	storage.MetadataStore
+	mu           sync.Mutex
+	reservations map[uint64]storage.NonceReservation
+	version      int64
+	// beforeCreate 模拟插入前其他实例抢先预留了同一个 nonce
+	beforeCreate func(nonce uint64)
+}
+
+func (f *fakeNonceStore) CreateNonceReservation(ctx context.Context, reservation *storage.NonceReservation) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.beforeCreate != nil {
+		f.beforeCreate(reservation.Nonce)
+	}
+	if _, ok := f.reservations[reservation.Nonce]; ok {
+		return storage.ErrNonceReservationConflict
+	}
+	f.save(reservation)
+	return nil
+}
+
+func (f *fakeNonceStore) UpdateNonceReservation(ctx context.Context, reservation *storage.NonceReservation) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	existing, ok := f.reservations[reservation.Nonce]
+	if !ok || !existing.UpdatedAt.Equal(reservation.UpdatedAt) {
+		return storage.ErrNonceReservationConflict
+	}
+	f.save(reservation)
+	return nil
+}
+
+func (f *fakeNonceStore) save(reservation *storage.NonceReservation) {
+	f.version++
+	reservation.UpdatedAt = time.Unix(0, f.version)
+	f.reservations[reservation.Nonce] = *reservation
+}
+
+func (f *fakeNonceStore) ListNonceReservations(ctx context.Context, chainType, address string) ([]*storage.NonceReservation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []*storage.NonceReservation
+	for _, reservation := range f.reservations {
+		copied := reservation
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Nonce < result[j].Nonce })
+	return result, nil
+}
+
+func (f *fakeNonceStore) DeleteNonceReservationsBelow(ctx context.Context, chainType, address string, nonce uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for n := range f.reservations {
+		if n < nonce {
+			delete(f.reservations, n)
+		}
+	}
+	return nil
+}
+
+// nonceNode 返回可调整的 latest/pending 交易计数的 JSON-RPC 节点
+func nonceNode(t *testing.T, mined, pending *atomic.Uint64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string   `json:"method"`
+			Params []string `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_getTransactionCount", req.Method)
+
+		count := mined.Load()
+		if req.Params[1] == "pending" {
+			count = pending.Load()
+		}
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, count)
+	}))
+}
+
+func newTestNonceManager(t *testing.T, mined, pending *atomic.Uint64) (*NonceManager, *fakeNonceStore) {
+	node := nonceNode(t, mined, pending)
+	t.Cleanup(node.Close)
+
+	chains, err := registry.New([]registry.Chain{{
+		Name: "anvil", Family: registry.FamilyEVM, ChainID: 31337, RPCEndpoints: []string{node.URL}, Symbol: "ETH", Decimals: 18,
+	}})
+	require.NoError(t, err)
+
+	store := &fakeNonceStore{reservations: make(map[uint64]storage.NonceReservation)}
+	return NewNonceManager(store, nil, chains, 0, 0), store
+}
+
+func TestNonceManagerConcurrentReserve(t *testing.T) {
+	var mined, pending atomic.Uint64
+	mined.Store(3)
+	pending.Store(5)
+	manager, _ := newTestNonceManager(t, &mined, &pending)
+
+	const workers = 10
+	nonces := make([]uint64, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			reservation, err := manager.Reserve(context.Background(), "anvil", "0x00000000000000000000000000000000000000AA")
+			if assert.NoError(t, err) {
+				nonces[i] = reservation.Nonce
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// 从 pending nonce 开始连续分配，没有重复
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	for i, n := range nonces {
+		assert.Equal(t, uint64(5+i), n)
+	}
+}
+
+func TestNonceManagerReleaseAndReconcile(t *testing.T) {
+	const from = "0x00000000000000000000000000000000000000aa"
+	ctx := context.Background()
+
+	var mined, pending atomic.Uint64
+	manager, store := newTestNonceManager(t, &mined, &pending)
+
+	first, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	second, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	third, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 1, 2}, []uint64{first.Nonce, second.Nonce, third.Nonce})
+
+	require.NoError(t, manager.MarkBroadcast(ctx, first, "0xfirst"))
+	require.NoError(t, manager.MarkBroadcast(ctx, third, "0xthird"))
+
+	// 签名失败释放的 nonce 被下一笔交易复用，填补空洞
+	require.NoError(t, manager.Release(ctx, second))
+	assert.Equal(t, NonceReleased, store.reservations[1].Status)
+	reused, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), reused.Nonce)
+	require.NoError(t, manager.MarkBroadcast(ctx, reused, "0xsecond"))
+
+	// 已打包的预留被清理，新的 nonce 接在最大的预留之后
+	mined.Store(2)
+	pending.Store(3)
+	next, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), next.Nonce)
+	assert.NotContains(t, store.reservations, uint64(0))
+	assert.NotContains(t, store.reservations, uint64(1))
+
+	// 其他钱包软件占用了 nonce：pending nonce 超过所有预留
+	pending.Store(7)
+	external, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), external.Nonce)
+}
+
+func TestNonceManagerReplacement(t *testing.T) {
+	const from = "0x00000000000000000000000000000000000000aa"
+	ctx := context.Background()
+
+	var mined, pending atomic.Uint64
+	manager, store := newTestNonceManager(t, &mined, &pending)
+
+	stuck, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	require.NoError(t, manager.MarkBroadcast(ctx, stuck, "0xstuck"))
+	pending.Store(1)
+
+	_, err = manager.ReserveReplacement(ctx, "anvil", from, "0xunknown")
+	assert.ErrorIs(t, err, ErrReplacementNotFound)
+
+	replacement, err := manager.ReserveReplacement(ctx, "anvil", from, "0xSTUCK")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), replacement.Nonce)
+	assert.Equal(t, "0xstuck", replacement.ReplacesTxHash)
+
+	// 替换交易的预留不会分配给其他交易
+	other, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), other.Nonce)
+
+	// 替换交易签名失败时恢复原交易
+	require.NoError(t, manager.Release(ctx, replacement))
+	assert.Equal(t, NonceBroadcast, store.reservations[0].Status)
+	assert.Equal(t, "0xstuck", store.reservations[0].TxHash)
+
+	replacement, err = manager.ReserveReplacement(ctx, "anvil", from, "0xstuck")
+	require.NoError(t, err)
+	require.NoError(t, manager.MarkBroadcast(ctx, replacement, "0xspeedup"))
+	assert.Equal(t, "0xspeedup", store.reservations[0].TxHash)
+	assert.Empty(t, store.reservations[0].ReplacesTxHash)
+
+	// 已打包的交易不能再替换
+	mined.Store(1)
+	_, err = manager.ReserveReplacement(ctx, "anvil", from, "0xspeedup")
+	assert.ErrorIs(t, err, ErrNonceAlreadyMined)
+}
+
+func TestNonceManagerStaleReservation(t *testing.T) {
+	const from = "0x00000000000000000000000000000000000000aa"
+	ctx := context.Background()
+
+	var mined, pending atomic.Uint64
+	manager, store := newTestNonceManager(t, &mined, &pending)
+
+	stale, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+
+	// 预留超时后被重新分配，原持有者不能再标记广播或释放
+	manager.reservationTimeout = 0
+	reused, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	assert.Equal(t, stale.Nonce, reused.Nonce)
+
+	assert.ErrorIs(t, manager.MarkBroadcast(ctx, stale, "0xstale"), storage.ErrNonceReservationConflict)
+	assert.ErrorIs(t, manager.Release(ctx, stale), storage.ErrNonceReservationConflict)
+
+	require.NoError(t, manager.MarkBroadcast(ctx, reused, "0xreused"))
+	assert.Equal(t, "0xreused", store.reservations[0].TxHash)
+
+	// 其他实例在对账后抢先插入的 nonce 不会被覆盖，重新对账后分配下一个
+	manager.reservationTimeout = time.Hour
+	store.beforeCreate = func(nonce uint64) {
+		store.beforeCreate = nil
+		store.reservations[nonce] = storage.NonceReservation{ChainType: "anvil", Address: from, Nonce: nonce, Status: NonceReserved, ReservedAt: time.Now()}
+	}
+	next, err := manager.Reserve(ctx, "anvil", from)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), next.Nonce)
+}
+
+func TestReplacementFee(t *testing.T) {
+	assert.Equal(t, big.NewInt(110), ReplacementFee(big.NewInt(100)))
+	assert.Equal(t, big.NewInt(2), ReplacementFee(big.NewInt(1)))
+	assert.Equal(t, big.NewInt(1_100_000_000), ReplacementFee(big.NewInt(1_000_000_000)))
+}
@@ -0,0 +1,274 @@
+package transaction
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 交易状态（storage.TransactionRecord.Status）
+const (
+	// StatusPending 已广播，等待打包或确认数不足
+	StatusPending = "pending"
+	// StatusConfirmed 已上链且达到所需确认数，终态
+	StatusConfirmed = "confirmed"
+	// StatusFailed 已上链但执行失败（EVM revert、Solana 指令错误），终态
+	StatusFailed = "failed"
+	// StatusDropped 交易被节点丢弃或被相同 nonce 的交易替换（ReplacedBy），终态
+	StatusDropped = "dropped"
+)
+
+// 交易历史方向（storage.WalletHistoryEntry.Direction）
+const (
+	// DirectionOutgoing 钱包通过本服务广播的交易
+	DirectionOutgoing = "outgoing"
+	// DirectionIncoming 索引器发现的转入
+	DirectionIncoming = "incoming"
+)
+
+// NativeAsset 查询交易历史时表示原生币的资产名称（也可以使用链的原生代币符号）
+const NativeAsset = "native"
+
+// ErrInvalidCursor 无法解析的分页游标
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// BroadcastRequest 广播已签名交易的请求
+type BroadcastRequest struct {
+	WalletID  string
+	ChainType string
+	RawTx     string // 链适配器 AssembleSignedTx 返回的 Raw
+	From      string
+	To        string
+	Value     *big.Int // 链上最小单位
+	Asset     string   // 为空表示原生币，否则为代币合约或 Mint 地址
+	Decimals  int      // 代币精度
+	Nonce     *uint64  // EVM 交易必须提供，用于检测替换
+
+	// Reservation NonceManager 分配的 nonce，设置后可省略 Nonce；广播成功后标记为已广播，节点拒绝时释放
+	Reservation *storage.NonceReservation
+}
+
+// Service 广播已签名交易并记录，确认状态由 Tracker 在后台更新
+type Service struct {
+	metadataStore storage.MetadataStore
+	chains        *registry.Registry
+	nonces        *NonceManager
+}
+
+// NewService 创建交易服务
+func NewService(metadataStore storage.MetadataStore, chains *registry.Registry, nonces *NonceManager) *Service {
+	return &Service{
+		metadataStore: metadataStore,
+		chains:        chains,
+		nonces:        nonces,
+	}
+}
+
+// Broadcast 通过链适配器广播交易并记录为 pending
+// 节点拒绝的交易不会记录；广播成功但保存失败时返回已广播的记录和错误
+func (s *Service) Broadcast(ctx context.Context, req *BroadcastRequest) (*storage.TransactionRecord, error) {
+	if req == nil {
+		return nil, errors.New("broadcast request is nil")
+	}
+	if req.WalletID == "" || req.RawTx == "" || req.From == "" {
+		return nil, errors.New("wallet id, raw transaction and from address are required")
+	}
+
+	chainInfo, err := s.chains.Lookup(req.ChainType)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &storage.TransactionRecord{
+		WalletID:    req.WalletID,
+		ChainType:   chainInfo.Name,
+		FromAddress: req.From,
+		ToAddress:   req.To,
+		Value:       "0",
+		Asset:       req.Asset,
+		Decimals:    req.Decimals,
+		RawTx:       req.RawTx,
+		Status:      StatusPending,
+	}
+	if req.Value != nil {
+		record.Value = req.Value.String()
+	}
+
+	switch chainInfo.Family {
+	case registry.FamilyEVM:
+		if req.Reservation != nil {
+			if s.nonces == nil {
+				return nil, errors.New("nonce manager not configured")
+			}
+			req.Nonce = &req.Reservation.Nonce
+		}
+		if req.Nonce == nil {
+			return nil, errors.New("nonce is required for evm transactions")
+		}
+		adapter, err := chainInfo.EthereumAdapter()
+		if err != nil {
+			return nil, err
+		}
+		// EVM 地址不区分大小写，统一小写以便按 nonce 查找替换交易
+		record.FromAddress = strings.ToLower(req.From)
+		record.ToAddress = strings.ToLower(req.To)
+		record.Asset = strings.ToLower(req.Asset)
+		record.Nonce = req.Nonce
+		if record.TxHash, err = adapter.BroadcastTransaction(ctx, req.RawTx); err != nil {
+			if req.Reservation != nil {
+				if releaseErr := s.nonces.Release(ctx, req.Reservation); releaseErr != nil {
+					log.Warn().Err(releaseErr).Uint64("nonce", req.Reservation.Nonce).Msg("Failed to release nonce after rejected broadcast")
+				}
+			}
+			return nil, errors.Wrap(err, "failed to broadcast transaction")
+		}
+		if req.Reservation != nil {
+			if err := s.nonces.MarkBroadcast(ctx, req.Reservation, record.TxHash); err != nil {
+				log.Warn().Err(err).Str("tx_hash", record.TxHash).Msg("Failed to mark nonce as broadcast")
+			}
+		}
+	case registry.FamilySolana:
+		adapter, err := chainInfo.SolanaAdapter()
+		if err != nil {
+			return nil, err
+		}
+		if record.TxHash, err = adapter.BroadcastTransaction(ctx, req.RawTx); err != nil {
+			return nil, errors.Wrap(err, "failed to broadcast transaction")
+		}
+	default:
+		return nil, errors.Errorf("broadcasting is not supported for chain %s", chainInfo.Name)
+	}
+
+	log.Info().
+		Str("wallet_id", record.WalletID).
+		Str("chain", record.ChainType).
+		Str("tx_hash", record.TxHash).
+		Msg("Transaction broadcast")
+
+	if err := s.metadataStore.SaveTransactionRecord(ctx, record); err != nil {
+		return record, errors.Wrapf(err, "transaction %s was broadcast but could not be recorded", record.TxHash)
+	}
+	return record, nil
+}
+
+// ReplacedRecord 返回 NonceManager.ReserveReplacement 预留对应的原交易记录，替换交易的手续费需要参考原交易
+func (s *Service) ReplacedRecord(ctx context.Context, reservation *storage.NonceReservation) (*storage.TransactionRecord, error) {
+	if reservation == nil || reservation.ReplacesTxHash == "" {
+		return nil, errors.New("reservation does not replace a transaction")
+	}
+
+	records, _, err := s.metadataStore.ListTransactionRecords(ctx, &storage.TransactionFilter{
+		ChainType:   reservation.ChainType,
+		FromAddress: reservation.Address,
+		Nonce:       &reservation.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		if strings.EqualFold(record.TxHash, reservation.ReplacesTxHash) {
+			return record, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrReplacementNotFound, "transaction %s was not recorded", reservation.ReplacesTxHash)
+}
+
+// HistoryQuery 钱包交易历史查询条件
+type HistoryQuery struct {
+	WalletID  string
+	ChainType string
+	Direction string     // outgoing、incoming，为空时两者都包含
+	Asset     string     // 为空不过滤；NativeAsset 或原生代币符号表示原生币；其他为代币合约或 Mint 地址
+	From      *time.Time // 包含
+	To        *time.Time // 不包含
+	Cursor    string     // 上一页返回的下一页游标
+	Limit     int
+}
+
+// History 按时间倒序查询钱包交易历史，合并已广播的转出交易和索引器发现的转入记录
+// 返回下一页游标，没有更多条目时为空
+func (s *Service) History(ctx context.Context, query *HistoryQuery) ([]*storage.WalletHistoryEntry, string, error) {
+	chainInfo, err := s.chains.Lookup(query.ChainType)
+	if err != nil {
+		return nil, "", err
+	}
+	if query.Limit <= 0 {
+		query.Limit = 20
+	}
+
+	filter := &storage.WalletHistoryFilter{
+		WalletID:  query.WalletID,
+		ChainType: chainInfo.Name,
+		Direction: query.Direction,
+		From:      query.From,
+		To:        query.To,
+		Limit:     query.Limit + 1, // 多取一条判断是否还有下一页
+	}
+	if query.Asset != "" {
+		asset := query.Asset
+		switch {
+		case strings.EqualFold(asset, NativeAsset) || strings.EqualFold(asset, chainInfo.Symbol):
+			asset = ""
+		case chainInfo.Family == registry.FamilyEVM:
+			asset = strings.ToLower(asset)
+		}
+		filter.Asset = &asset
+	}
+	if query.Cursor != "" {
+		if filter.After, err = decodeHistoryCursor(query.Cursor); err != nil {
+			return nil, "", err
+		}
+	}
+
+	entries, err := s.metadataStore.ListWalletHistory(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(entries) > query.Limit {
+		entries = entries[:query.Limit]
+		nextCursor = encodeHistoryCursor(entries[len(entries)-1])
+	}
+	return entries, nextCursor, nil
+}
+
+// encodeHistoryCursor 游标为排序键 (时间戳微秒, 方向, 主键) 的 URL 安全 Base64 编码
+func encodeHistoryCursor(entry *storage.WalletHistoryEntry) string {
+	raw := fmt.Sprintf("%d:%s:%d", entry.Timestamp.UnixMicro(), entry.Direction, entry.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeHistoryCursor(cursor string) (*storage.WalletHistoryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || (parts[1] != DirectionOutgoing && parts[1] != DirectionIncoming) {
+		return nil, ErrInvalidCursor
+	}
+	micros, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &storage.WalletHistoryCursor{
+		Timestamp: time.UnixMicro(micros),
+		Direction: parts[1],
+		ID:        id,
+	}, nil
+}
@@ -0,0 +1,381 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// trackerLockKey 多个 Service 实例只由一个实例轮询
+	trackerLockKey = "transaction_tracker"
+	// trackerLockTTL 轮询锁的有效期，持有期间由心跳续期，持有锁的实例崩溃后自动释放
+	trackerLockTTL = time.Minute
+	// trackerBatchSize 每次从数据库读取的 pending 交易数量，一轮轮询会按主键翻页处理全部 pending 交易
+	trackerBatchSize = 200
+	// solanaFinalized Solana 最终确认的承诺级别
+	solanaFinalized = "finalized"
+)
+
+var (
+	trackerMetricsOnce  sync.Once
+	trackerFinalized    *prometheus.CounterVec
+	trackerPendingTotal prometheus.Gauge
+)
+
+// Tracker 交易确认跟踪器
+// 定期轮询 pending 交易：EVM 查询回执并按链配置的确认数确认，回执缺失时根据账户 nonce 判断是否被替换；
+// Solana 查询签名状态，finalized 后确认；超过 dropTimeout 仍不被节点知晓的交易标记为 dropped
+type Tracker struct {
+	metadataStore storage.MetadataStore
+	sessionStore  storage.SessionStore
+	chains        *registry.Registry
+
+	pollInterval time.Duration
+	dropTimeout  time.Duration
+
+	started  atomic.Bool
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// NewTracker 创建交易确认跟踪器，sessionStore 为空时不加分布式锁
+func NewTracker(
+	metadataStore storage.MetadataStore,
+	sessionStore storage.SessionStore,
+	chains *registry.Registry,
+	pollInterval time.Duration,
+	dropTimeout time.Duration,
+) *Tracker {
+	ensureTrackerMetrics()
+
+	if pollInterval <= 0 {
+		pollInterval = 15 * time.Second
+	}
+	if dropTimeout <= 0 {
+		dropTimeout = 30 * time.Minute
+	}
+
+	return &Tracker{
+		metadataStore: metadataStore,
+		sessionStore:  sessionStore,
+		chains:        chains,
+		pollInterval:  pollInterval,
+		dropTimeout:   dropTimeout,
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+}
+
+// Start 启动后台轮询（立即执行一次，之后按 pollInterval 周期执行）
+func (t *Tracker) Start(ctx context.Context) {
+	if !t.started.CompareAndSwap(false, true) {
+		return
+	}
+
+	log.Info().
+		Dur("poll_interval", t.pollInterval).
+		Dur("drop_timeout", t.dropTimeout).
+		Msg("Starting transaction tracker")
+
+	go func() {
+		defer close(t.doneCh)
+
+		ticker := time.NewTicker(t.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			t.RunOnce(ctx)
+
+			select {
+			case <-ticker.C:
+			case <-t.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止后台轮询，等待正在进行的轮询退出
+func (t *Tracker) Stop(ctx context.Context) {
+	t.stopOnce.Do(func() {
+		close(t.stopCh)
+	})
+	if !t.started.Load() {
+		return
+	}
+
+	select {
+	case <-t.doneCh:
+	case <-ctx.Done():
+		log.Warn().Msg("Timed out waiting for transaction tracker to stop")
+	}
+}
+
+// RunOnce 轮询一次所有 pending 交易并更新状态
+func (t *Tracker) RunOnce(ctx context.Context) {
+	if t.sessionStore != nil {
+		lock, err := storage.TryLock(ctx, t.sessionStore, trackerLockKey, trackerLockTTL)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to acquire transaction tracker lock")
+			return
+		}
+		if lock == nil {
+			log.Debug().Msg("Transaction tracker running on another instance, skipping")
+			return
+		}
+		defer func() {
+			if err := lock.Release(); err != nil {
+				log.Warn().Err(err).Msg("Failed to release transaction tracker lock")
+			}
+		}()
+		ctx = lock.Context()
+	}
+
+	// 按主键从旧到新翻页，保证每轮都能检查到所有 pending 交易
+	filter := &storage.TransactionFilter{
+		Statuses:    []string{StatusPending},
+		Limit:       trackerBatchSize,
+		OldestFirst: true,
+	}
+	// 同一轮中每条链只查询一次最新区块高度
+	heads := make(map[string]uint64)
+	for {
+		pending, total, err := t.metadataStore.ListTransactionRecords(ctx, filter)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list pending transactions")
+			return
+		}
+		if filter.AfterID == 0 {
+			trackerPendingTotal.Set(float64(total))
+		}
+
+		for _, record := range pending {
+			select {
+			case <-t.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			if err := t.track(ctx, record, heads); err != nil {
+				log.Warn().Err(err).
+					Str("chain", record.ChainType).
+					Str("tx_hash", record.TxHash).
+					Msg("Failed to check transaction status, will retry on next poll")
+			}
+		}
+
+		if len(pending) < trackerBatchSize {
+			return
+		}
+		filter.AfterID = pending[len(pending)-1].ID
+	}
+}
+
+// track 检查单笔交易，状态有变化时保存
+func (t *Tracker) track(ctx context.Context, record *storage.TransactionRecord, heads map[string]uint64) error {
+	chainInfo, err := t.chains.Lookup(record.ChainType)
+	if err != nil {
+		return err
+	}
+
+	before := *record
+	switch chainInfo.Family {
+	case registry.FamilyEVM:
+		err = t.checkEVM(ctx, chainInfo, record, heads)
+	case registry.FamilySolana:
+		err = t.checkSolana(ctx, chainInfo, record)
+	default:
+		err = errors.Errorf("transaction tracking is not supported for chain %s", chainInfo.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	if record.Status == before.Status && record.BlockNumber == before.BlockNumber &&
+		record.Confirmations == before.Confirmations && record.ReplacedBy == before.ReplacedBy {
+		return nil
+	}
+
+	if record.Status != StatusPending {
+		now := time.Now()
+		record.ConfirmedAt = &now
+		trackerFinalized.WithLabelValues(record.ChainType, record.Status).Inc()
+		log.Info().
+			Str("wallet_id", record.WalletID).
+			Str("chain", record.ChainType).
+			Str("tx_hash", record.TxHash).
+			Str("status", record.Status).
+			Str("replaced_by", record.ReplacedBy).
+			Msg("Transaction finalized")
+	}
+
+	return t.metadataStore.UpdateTransactionRecord(ctx, record)
+}
+
+// checkEVM 根据回执更新 EVM 交易状态
+func (t *Tracker) checkEVM(ctx context.Context, chainInfo *registry.Chain, record *storage.TransactionRecord, heads map[string]uint64) error {
+	adapter, err := chainInfo.EthereumAdapter()
+	if err != nil {
+		return err
+	}
+
+	receipt, err := adapter.GetTransactionReceipt(ctx, record.TxHash)
+	if err != nil {
+		return err
+	}
+	if receipt != nil {
+		head, ok := heads[chainInfo.Name]
+		if !ok {
+			if head, err = adapter.BlockNumber(ctx); err != nil {
+				return err
+			}
+			heads[chainInfo.Name] = head
+		}
+
+		record.BlockNumber = int64(receipt.BlockNumber)
+		record.Confirmations = 0
+		if head >= receipt.BlockNumber {
+			record.Confirmations = int64(head - receipt.BlockNumber + 1)
+		}
+		if uint64(record.Confirmations) < chainInfo.Confirmations {
+			return nil
+		}
+
+		if receipt.Status == 0 {
+			record.Status = StatusFailed
+			record.Error = "transaction reverted"
+		} else {
+			record.Status = StatusConfirmed
+		}
+		return nil
+	}
+
+	// 没有回执：之前已打包说明发生了重组，交易回到交易池
+	record.BlockNumber = 0
+	record.Confirmations = 0
+
+	pooled, err := adapter.GetTransactionByHash(ctx, record.TxHash)
+	if err != nil {
+		return err
+	}
+	if pooled != nil {
+		return nil
+	}
+
+	// 节点不知道该交易：账户已打包的 nonce 超过该交易说明 nonce 被其他交易占用
+	if record.Nonce != nil {
+		minedNonce, err := adapter.GetTransactionCount(ctx, record.FromAddress)
+		if err != nil {
+			return err
+		}
+		if minedNonce > *record.Nonce {
+			replacedBy, err := t.findReplacement(ctx, record)
+			if err != nil {
+				return err
+			}
+			record.Status = StatusDropped
+			record.ReplacedBy = replacedBy
+			record.Error = fmt.Sprintf("nonce %d was used by another transaction", *record.Nonce)
+			return nil
+		}
+	}
+
+	t.dropIfExpired(record)
+	return nil
+}
+
+// checkSolana 根据签名状态更新 Solana 交易状态
+func (t *Tracker) checkSolana(ctx context.Context, chainInfo *registry.Chain, record *storage.TransactionRecord) error {
+	adapter, err := chainInfo.SolanaAdapter()
+	if err != nil {
+		return err
+	}
+
+	status, err := adapter.GetSignatureStatus(ctx, record.TxHash)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		// 区块哈希过期后交易不可能再上链
+		record.BlockNumber = 0
+		record.Confirmations = 0
+		t.dropIfExpired(record)
+		return nil
+	}
+
+	record.BlockNumber = int64(status.Slot)
+	if status.Confirmations != nil {
+		record.Confirmations = int64(*status.Confirmations)
+	}
+	if status.ConfirmationStatus != solanaFinalized {
+		return nil
+	}
+
+	if status.Failed() {
+		record.Status = StatusFailed
+		record.Error = string(status.Err)
+	} else {
+		record.Status = StatusConfirmed
+	}
+	return nil
+}
+
+// dropIfExpired 超过 dropTimeout 仍未上链的交易标记为 dropped
+func (t *Tracker) dropIfExpired(record *storage.TransactionRecord) {
+	if time.Since(record.CreatedAt) < t.dropTimeout {
+		return
+	}
+	record.Status = StatusDropped
+	record.Error = fmt.Sprintf("transaction not found on chain after %s", t.dropTimeout)
+}
+
+// findReplacement 查找使用相同 nonce 的其他已记录交易，未记录（例如在其他钱包软件中发送）时返回空
+func (t *Tracker) findReplacement(ctx context.Context, record *storage.TransactionRecord) (string, error) {
+	candidates, _, err := t.metadataStore.ListTransactionRecords(ctx, &storage.TransactionFilter{
+		ChainType:   record.ChainType,
+		FromAddress: record.FromAddress,
+		Nonce:       record.Nonce,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to find replacement transaction")
+	}
+
+	for _, candidate := range candidates {
+		if candidate.ID != record.ID && candidate.Status != StatusDropped {
+			return candidate.TxHash, nil
+		}
+	}
+	return "", nil
+}
+
+func ensureTrackerMetrics() {
+	trackerMetricsOnce.Do(func() {
+		trackerFinalized = promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpc",
+			Subsystem: "transactions",
+			Name:      "finalized_total",
+			Help:      "Total number of broadcast transactions that reached a final state, by chain and status",
+		}, []string{"chain", "status"})
+		trackerPendingTotal = promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: "mpc",
+			Subsystem: "transactions",
+			Name:      "pending",
+			Help:      "Number of broadcast transactions waiting for confirmation",
+		})
+	})
+}
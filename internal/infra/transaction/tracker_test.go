@@ -0,0 +1,244 @@
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTransactionStore 只实现交易记录操作的内存存储
+type fakeTransactionStore struct {
+	storage.MetadataStore
+	records []*storage.TransactionRecord
+}
+
+func (f *fakeTransactionStore) ListTransactionRecords(ctx context.Context, filter *storage.TransactionFilter) ([]*storage.TransactionRecord, int64, error) {
+	var result []*storage.TransactionRecord
+	for _, record := range f.records {
+		if filter.ChainType != "" && record.ChainType != filter.ChainType {
+			continue
+		}
+		if filter.FromAddress != "" && record.FromAddress != filter.FromAddress {
+			continue
+		}
+		if filter.Nonce != nil && (record.Nonce == nil || *record.Nonce != *filter.Nonce) {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !contains(filter.Statuses, record.Status) {
+			continue
+		}
+		if record.ID <= filter.AfterID {
+			continue
+		}
+		copied := *record
+		result = append(result, &copied)
+	}
+	total := int64(len(result))
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+	return result, total, nil
+}
+
+func (f *fakeTransactionStore) UpdateTransactionRecord(ctx context.Context, record *storage.TransactionRecord) error {
+	for i, existing := range f.records {
+		if existing.ID == record.ID {
+			copied := *record
+			f.records[i] = &copied
+			return nil
+		}
+	}
+	return nil
+}
+
+func (f *fakeTransactionStore) get(txHash string) *storage.TransactionRecord {
+	for _, record := range f.records {
+		if record.TxHash == txHash {
+			return record
+		}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// rpcServer 按方法名和第一个字符串参数返回固定结果的 JSON-RPC 节点
+func rpcServer(t *testing.T, results map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		key := req.Method
+		if len(req.Params) > 0 {
+			var param string
+			if json.Unmarshal(req.Params[0], &param) == nil {
+				key += " " + param
+			} else {
+				// 对象参数（eth_call、eth_getLogs）只按方法名匹配
+				var params []string
+				if json.Unmarshal(req.Params[0], &params) == nil {
+					key += " " + params[0]
+				}
+			}
+		}
+
+		result, ok := results[key]
+		if !ok {
+			result = "null"
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + result + `}`))
+	}))
+}
+
+func nonce(n uint64) *uint64 {
+	return &n
+}
+
+func TestTrackerEVM(t *testing.T) {
+	const from = "0x00000000000000000000000000000000000000aa"
+	node := rpcServer(t, map[string]string{
+		"eth_blockNumber":                       `"0x11"`,
+		"eth_getTransactionReceipt 0xconfirmed": `{"blockNumber":"0x10","status":"0x1"}`,
+		"eth_getTransactionReceipt 0xshallow":   `{"blockNumber":"0x11","status":"0x1"}`,
+		"eth_getTransactionReceipt 0xreverted":  `{"blockNumber":"0x10","status":"0x0"}`,
+		"eth_getTransactionReceipt 0xspeedup":   `{"blockNumber":"0x10","status":"0x1"}`,
+		"eth_getTransactionByHash 0xpooled":     `{"hash":"0xpooled","nonce":"0x4"}`,
+		"eth_getTransactionCount " + from:       `"0x6"`,
+	})
+	defer node.Close()
+
+	chains, err := registry.New([]registry.Chain{{
+		Name: "anvil", Family: registry.FamilyEVM, ChainID: 31337, RPCEndpoints: []string{node.URL},
+		Symbol: "ETH", Decimals: 18, Confirmations: 2,
+	}})
+	require.NoError(t, err)
+
+	now := time.Now()
+	store := &fakeTransactionStore{}
+	for i, tx := range []struct {
+		hash    string
+		nonce   uint64
+		created time.Time
+	}{
+		{"0xconfirmed", 1, now},
+		{"0xshallow", 2, now},
+		{"0xreverted", 3, now},
+		{"0xpooled", 4, now},
+		{"0xreplaced", 5, now},
+		{"0xspeedup", 5, now},
+		{"0xlost", 6, now.Add(-time.Hour)},
+		{"0xrecent", 6, now},
+	} {
+		store.records = append(store.records, &storage.TransactionRecord{
+			ID: int64(i + 1), ChainType: "anvil", TxHash: tx.hash, FromAddress: from,
+			Nonce: nonce(tx.nonce), Status: StatusPending, CreatedAt: tx.created,
+		})
+	}
+
+	NewTracker(store, nil, chains, time.Minute, 30*time.Minute).RunOnce(context.Background())
+
+	assert.Equal(t, StatusConfirmed, store.get("0xconfirmed").Status)
+	assert.Equal(t, int64(2), store.get("0xconfirmed").Confirmations)
+	assert.NotNil(t, store.get("0xconfirmed").ConfirmedAt)
+
+	// 确认数不足时保持 pending，但记录所在区块
+	shallow := store.get("0xshallow")
+	assert.Equal(t, StatusPending, shallow.Status)
+	assert.Equal(t, int64(17), shallow.BlockNumber)
+	assert.Equal(t, int64(1), shallow.Confirmations)
+
+	assert.Equal(t, StatusFailed, store.get("0xreverted").Status)
+	assert.Equal(t, StatusPending, store.get("0xpooled").Status)
+
+	// nonce 已被已记录的另一笔交易占用
+	replaced := store.get("0xreplaced")
+	assert.Equal(t, StatusDropped, replaced.Status)
+	assert.Equal(t, "0xspeedup", replaced.ReplacedBy)
+	assert.Equal(t, StatusConfirmed, store.get("0xspeedup").Status)
+
+	// nonce 未被占用：超时后标记为 dropped，未超时继续等待
+	assert.Equal(t, StatusDropped, store.get("0xlost").Status)
+	assert.Empty(t, store.get("0xlost").ReplacedBy)
+	assert.Equal(t, StatusPending, store.get("0xrecent").Status)
+}
+
+func TestTrackerSolana(t *testing.T) {
+	node := rpcServer(t, map[string]string{
+		"getSignatureStatuses final":   `{"value":[{"slot":100,"confirmations":null,"err":null,"confirmationStatus":"finalized"}]}`,
+		"getSignatureStatuses failed":  `{"value":[{"slot":101,"confirmations":null,"err":{"InstructionError":[0,"Custom"]},"confirmationStatus":"finalized"}]}`,
+		"getSignatureStatuses landing": `{"value":[{"slot":102,"confirmations":3,"err":null,"confirmationStatus":"confirmed"}]}`,
+		"getSignatureStatuses expired": `{"value":[null]}`,
+	})
+	defer node.Close()
+
+	chains, err := registry.New([]registry.Chain{{
+		Name: "solana-devnet", Family: registry.FamilySolana, RPCEndpoints: []string{node.URL}, Symbol: "SOL", Decimals: 9,
+	}})
+	require.NoError(t, err)
+
+	store := &fakeTransactionStore{}
+	for i, sig := range []string{"final", "failed", "landing", "expired"} {
+		store.records = append(store.records, &storage.TransactionRecord{
+			ID: int64(i + 1), ChainType: "solana-devnet", TxHash: sig, Status: StatusPending,
+			CreatedAt: time.Now().Add(-time.Hour),
+		})
+	}
+
+	NewTracker(store, nil, chains, time.Minute, 30*time.Minute).RunOnce(context.Background())
+
+	assert.Equal(t, StatusConfirmed, store.get("final").Status)
+	assert.Equal(t, int64(100), store.get("final").BlockNumber)
+
+	assert.Equal(t, StatusFailed, store.get("failed").Status)
+	assert.Contains(t, store.get("failed").Error, "InstructionError")
+
+	landing := store.get("landing")
+	assert.Equal(t, StatusPending, landing.Status)
+	assert.Equal(t, int64(3), landing.Confirmations)
+
+	assert.Equal(t, StatusDropped, store.get("expired").Status)
+}
+
+func TestTrackerPagesThroughAllPending(t *testing.T) {
+	node := rpcServer(t, map[string]string{
+		"getSignatureStatuses final": `{"value":[{"slot":100,"confirmations":null,"err":null,"confirmationStatus":"finalized"}]}`,
+	})
+	defer node.Close()
+
+	chains, err := registry.New([]registry.Chain{{
+		Name: "solana-devnet", Family: registry.FamilySolana, RPCEndpoints: []string{node.URL}, Symbol: "SOL", Decimals: 9,
+	}})
+	require.NoError(t, err)
+
+	// 超过一页的 pending 交易在同一轮中全部检查
+	store := &fakeTransactionStore{}
+	for i := 0; i < trackerBatchSize+5; i++ {
+		store.records = append(store.records, &storage.TransactionRecord{
+			ID: int64(i + 1), ChainType: "solana-devnet", TxHash: "final", Status: StatusPending, CreatedAt: time.Now(),
+		})
+	}
+
+	NewTracker(store, nil, chains, time.Minute, 30*time.Minute).RunOnce(context.Background())
+
+	for _, record := range store.records {
+		assert.Equal(t, StatusConfirmed, record.Status, "transaction %d", record.ID)
+	}
+}
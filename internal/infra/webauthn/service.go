@@ -1,14 +1,18 @@
 package webauthn
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
-	"fmt"
+	"encoding/json"
+	"time"
 
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/SafeMPC/mpc-service/internal/auth"
 	"github.com/SafeMPC/mpc-service/internal/infra/storage"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
@@ -21,6 +25,9 @@ type Service struct {
 	rpID          string
 	rpName        string
 	rpOrigin      string
+
+	signChallengeTTL time.Duration
+	now              func() time.Time
 }
 
 // GetMetadataStore 获取元数据存储（用于 gRPC Server）
@@ -52,6 +59,9 @@ func NewService(
 		rpID:          rpID,
 		rpName:        rpName,
 		rpOrigin:      rpOrigin,
+
+		signChallengeTTL: DefaultSignChallengeTTL,
+		now:              time.Now,
 	}, nil
 }
 
@@ -147,6 +157,7 @@ func (s *Service) FinishRegistration(ctx context.Context, userID string, userNam
 		CredentialID: credentialIDBase64,
 		PublicKey:    publicKeyHex,
 		DeviceName:   "", // 可以从 authenticator data 中提取
+		SignCount:    credential.Authenticator.SignCount,
 	}
 
 	// 1. 保存 Passkey
@@ -260,7 +271,8 @@ func (s *Service) FinishLogin(ctx context.Context, userID string, sessionData st
 	return nil
 }
 
-// VerifyAssertion 验证 Passkey 签名（用于关键操作的二次验证）
+// VerifyAssertion 验证 Passkey 签名（用于关键操作的二次验证），credentialID 为原始凭证 ID；
+// 除签名和 challenge 外还校验 RP ID、Origin、用户验证标志，并要求认证器签名计数器递增
 func (s *Service) VerifyAssertion(ctx context.Context, credentialID string, challenge []byte, authData []byte, clientDataJSON []byte, signature []byte) error {
 	// 从数据库获取 Passkey 公钥
 	credentialIDBase64 := base64.RawURLEncoding.EncodeToString([]byte(credentialID))
@@ -269,14 +281,13 @@ func (s *Service) VerifyAssertion(ctx context.Context, credentialID string, chal
 		return errors.Wrap(err, "failed to get passkey")
 	}
 
-	// 使用 auth 包的验证函数
 	expectedChallenge := base64.RawURLEncoding.EncodeToString(challenge)
-	
-	// 导入验证函数（从 internal/auth/passkey.go）
-	// 这里需要调用 auth.VerifyPasskeySignature
-	// 为了避免循环依赖，我们直接实现验证逻辑
-	
-	return s.verifyPasskeySignature(passkey.PublicKey, signature, authData, clientDataJSON, expectedChallenge)
+	authenticatorData, err := s.verifyPasskeySignature(passkey.PublicKey, signature, authData, clientDataJSON, expectedChallenge)
+	if err != nil {
+		return errors.Wrap(ErrInvalidAssertion, err.Error())
+	}
+
+	return s.checkSignCount(ctx, passkey, authenticatorData.Counter)
 }
 
 // getUserCredentials 获取用户的所有凭证
@@ -311,10 +322,52 @@ func (s *Service) getUserCredentials(ctx context.Context, userID string) ([]weba
 	return credentials, nil
 }
 
-// verifyPasskeySignature 验证 Passkey 签名（内部实现）
-func (s *Service) verifyPasskeySignature(publicKeyHex string, signature []byte, authData []byte, clientDataJSON []byte, expectedChallenge string) error {
-	// 使用 auth 包的实现
-	// 这里应该调用 auth.VerifyPasskeySignature
-	// 为了避免循环依赖，建议将验证逻辑移到 pkg/ 或单独的包
-	return fmt.Errorf("not implemented: use auth.VerifyPasskeySignature")
+// verifyPasskeySignature 校验 clientDataJSON 和 authenticatorData 与本服务的 RP 配置一致，
+// 签名由 auth.VerifyPasskeySignature 验证
+func (s *Service) verifyPasskeySignature(publicKeyHex string, signature []byte, authData []byte, clientDataJSON []byte, expectedChallenge string) (*protocol.AuthenticatorData, error) {
+	var clientData protocol.CollectedClientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return nil, errors.Wrap(err, "failed to parse client data")
+	}
+	if clientData.Type != protocol.AssertCeremony {
+		return nil, errors.Errorf("unexpected client data type %q", clientData.Type)
+	}
+	if clientData.Origin != s.rpOrigin {
+		return nil, errors.Errorf("unexpected origin %q", clientData.Origin)
+	}
+
+	var authenticatorData protocol.AuthenticatorData
+	if err := authenticatorData.Unmarshal(authData); err != nil {
+		return nil, errors.Wrap(err, "failed to parse authenticator data")
+	}
+	rpIDHash := sha256.Sum256([]byte(s.rpID))
+	if !bytes.Equal(authenticatorData.RPIDHash, rpIDHash[:]) {
+		return nil, errors.New("rp id hash mismatch")
+	}
+	if !authenticatorData.Flags.UserVerified() {
+		return nil, errors.New("user not verified (UV flag not set)")
+	}
+
+	if err := auth.VerifyPasskeySignature(publicKeyHex, signature, authData, clientDataJSON, expectedChallenge); err != nil {
+		return nil, err
+	}
+	return &authenticatorData, nil
+}
+
+// checkSignCount 认证器签名计数器必须大于已记录的值，否则认证器可能被克隆或 assertion 被重放；
+// 计数器始终为 0 表示认证器不支持计数（如同步的 Passkey）
+func (s *Service) checkSignCount(ctx context.Context, passkey *storage.Passkey, signCount uint32) error {
+	if signCount == 0 && passkey.SignCount == 0 {
+		return nil
+	}
+	if signCount <= passkey.SignCount {
+		return errors.Wrapf(storage.ErrPasskeySignCountNotIncreased, "got %d, last seen %d", signCount, passkey.SignCount)
+	}
+	if err := s.metadataStore.UpdatePasskeySignCount(ctx, passkey.CredentialID, signCount); err != nil {
+		if errors.Is(err, storage.ErrPasskeySignCountNotIncreased) {
+			return errors.Wrapf(err, "sign count %d already used", signCount)
+		}
+		return errors.Wrap(err, "failed to update passkey sign count")
+	}
+	return nil
 }
@@ -0,0 +1,142 @@
+package webauthn
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/member"
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrInvalidAssertion WebAuthn assertion 校验失败
+	ErrInvalidAssertion = errors.New("invalid webauthn assertion")
+	// ErrNotWalletMember assertion 的凭证不是钱包成员或角色低于 admin
+	ErrNotWalletMember = errors.New("credential is not a wallet member")
+	// ErrInvalidSignChallenge challenge 不是服务端为该钱包和消息签发的、已过期或已使用
+	ErrInvalidSignChallenge = errors.New("invalid sign challenge")
+)
+
+// DefaultSignChallengeTTL 签名 challenge 默认的有效期
+const DefaultSignChallengeTTL = 5 * time.Minute
+
+// signChallengeDomain challenge 的域分隔前缀，避免与其他用途的 Passkey 签名混用
+const signChallengeDomain = "SafeMPC sign challenge"
+
+// Assertion 钱包成员对签名 challenge 的 WebAuthn assertion
+type Assertion struct {
+	CredentialID      []byte // 原始凭证 ID
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+	Signature         []byte
+}
+
+// SetSignChallengeTTL 设置签名 challenge 的有效期，不大于 0 时使用 DefaultSignChallengeTTL
+func (s *Service) SetSignChallengeTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultSignChallengeTTL
+	}
+	s.signChallengeTTL = ttl
+}
+
+// BeginSignChallenge 为钱包的待签名消息签发一次性 challenge：challenge 由随机数、钱包 ID 和消息哈希计算，
+// 客户端用 navigator.credentials.get() 对其签名后随签名请求提交；签发时顺带删除已过期的 challenge
+func (s *Service) BeginSignChallenge(ctx context.Context, walletID string, message []byte) (*storage.SignChallenge, error) {
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate challenge")
+	}
+
+	messageHash := sha256.Sum256(message)
+	h := sha256.New()
+	h.Write([]byte(signChallengeDomain))
+	h.Write([]byte{0})
+	h.Write([]byte(walletID))
+	h.Write([]byte{0})
+	h.Write(messageHash[:])
+	h.Write(nonce)
+
+	challenge := &storage.SignChallenge{
+		Challenge:   base64.RawURLEncoding.EncodeToString(h.Sum(nil)),
+		WalletID:    walletID,
+		MessageHash: hex.EncodeToString(messageHash[:]),
+		ExpiresAt:   s.now().Add(s.signChallengeTTL),
+	}
+	if err := s.metadataStore.SaveSignChallenge(ctx, challenge); err != nil {
+		return nil, errors.Wrap(err, "failed to save sign challenge")
+	}
+
+	if _, err := s.metadataStore.DeleteExpiredSignChallenges(ctx, s.now()); err != nil {
+		log.Warn().Err(err).Msg("Failed to delete expired sign challenges")
+	}
+	return challenge, nil
+}
+
+// VerifySignAssertion 校验签名交易的 assertion：凭证必须是 admin 以上的钱包成员，challenge 必须是为该钱包和消息签发、
+// 未过期且未使用过的，签名和计数器由 VerifyAssertion 校验。全部校验通过后才原子地消耗 challenge，
+// 无效的 assertion 不会使 challenge 失效，重放或并发提交的 assertion 只有一个能成功
+func (s *Service) VerifySignAssertion(ctx context.Context, walletID string, message []byte, assertion *Assertion) error {
+	if assertion == nil || len(assertion.CredentialID) == 0 {
+		return errors.Wrap(ErrInvalidAssertion, "credential id is required")
+	}
+
+	credentialIDBase64 := base64.RawURLEncoding.EncodeToString(assertion.CredentialID)
+	isMember, role, err := s.metadataStore.IsWalletMember(ctx, walletID, credentialIDBase64)
+	if err != nil {
+		return errors.Wrap(err, "failed to check wallet member")
+	}
+	if !isMember {
+		return ErrNotWalletMember
+	}
+	if !member.RoleAtLeast(role, storage.WalletRoleAdmin) {
+		return errors.Wrapf(ErrNotWalletMember, "role %s cannot sign", role)
+	}
+
+	var clientData protocol.CollectedClientData
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		return errors.Wrap(ErrInvalidAssertion, "failed to parse client data")
+	}
+	challenge, err := s.metadataStore.GetSignChallenge(ctx, strings.TrimRight(clientData.Challenge, "="))
+	if err != nil {
+		if errors.Is(err, storage.ErrSignChallengeNotFound) {
+			return errors.Wrap(ErrInvalidSignChallenge, err.Error())
+		}
+		return errors.Wrap(err, "failed to get sign challenge")
+	}
+
+	messageHash := sha256.Sum256(message)
+	switch {
+	case challenge.WalletID != walletID:
+		return errors.Wrap(ErrInvalidSignChallenge, "challenge was issued for another wallet")
+	case challenge.MessageHash != hex.EncodeToString(messageHash[:]):
+		return errors.Wrap(ErrInvalidSignChallenge, "challenge was issued for another message")
+	case !s.now().Before(challenge.ExpiresAt):
+		return errors.Wrap(ErrInvalidSignChallenge, "challenge expired")
+	}
+
+	challengeBytes, err := base64.RawURLEncoding.DecodeString(challenge.Challenge)
+	if err != nil {
+		return errors.Wrap(ErrInvalidSignChallenge, "malformed challenge")
+	}
+	if err := s.VerifyAssertion(ctx, string(assertion.CredentialID), challengeBytes,
+		assertion.AuthenticatorData, assertion.ClientDataJSON, assertion.Signature); err != nil {
+		return err
+	}
+
+	if err := s.metadataStore.ConsumeSignChallenge(ctx, challenge.Challenge); err != nil {
+		if errors.Is(err, storage.ErrSignChallengeNotFound) {
+			return errors.Wrap(ErrInvalidSignChallenge, "challenge already used")
+		}
+		return errors.Wrap(err, "failed to consume sign challenge")
+	}
+	return nil
+}
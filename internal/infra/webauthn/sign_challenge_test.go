@@ -0,0 +1,303 @@
+package webauthn
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SafeMPC/mpc-service/internal/infra/storage"
+)
+
+const (
+	walletID = "wallet-1"
+	rpID     = "wallet.example.com"
+	rpOrigin = "https://wallet.example.com"
+)
+
+// memoryStore 只实现签名 challenge 校验用到的 MetadataStore 方法
+type memoryStore struct {
+	storage.MetadataStore
+
+	mu         sync.Mutex
+	challenges map[string]*storage.SignChallenge
+	consumed   map[string]bool
+	members    map[string]string // credentialID -> role
+	passkeys   map[string]*storage.Passkey
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		challenges: map[string]*storage.SignChallenge{},
+		consumed:   map[string]bool{},
+		members:    map[string]string{},
+		passkeys:   map[string]*storage.Passkey{},
+	}
+}
+
+func (m *memoryStore) SaveSignChallenge(ctx context.Context, challenge *storage.SignChallenge) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *challenge
+	m.challenges[challenge.Challenge] = &saved
+	return nil
+}
+
+func (m *memoryStore) GetSignChallenge(ctx context.Context, challenge string) (*storage.SignChallenge, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	found, ok := m.challenges[challenge]
+	if !ok || m.consumed[challenge] {
+		return nil, storage.ErrSignChallengeNotFound
+	}
+	result := *found
+	return &result, nil
+}
+
+func (m *memoryStore) ConsumeSignChallenge(ctx context.Context, challenge string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	found, ok := m.challenges[challenge]
+	if !ok || m.consumed[challenge] || !time.Now().Before(found.ExpiresAt) {
+		return storage.ErrSignChallengeNotFound
+	}
+	m.consumed[challenge] = true
+	return nil
+}
+
+func (m *memoryStore) DeleteExpiredSignChallenges(ctx context.Context, before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var deleted int64
+	for challenge, found := range m.challenges {
+		if found.ExpiresAt.Before(before) {
+			delete(m.challenges, challenge)
+			delete(m.consumed, challenge)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (m *memoryStore) IsWalletMember(ctx context.Context, walletID, credentialID string) (bool, string, error) {
+	role, ok := m.members[credentialID]
+	return ok, role, nil
+}
+
+func (m *memoryStore) GetPasskey(ctx context.Context, credentialID string) (*storage.Passkey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	passkey, ok := m.passkeys[credentialID]
+	if !ok {
+		return nil, errors.New("passkey not found")
+	}
+	found := *passkey
+	return &found, nil
+}
+
+func (m *memoryStore) UpdatePasskeySignCount(ctx context.Context, credentialID string, signCount uint32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	passkey := m.passkeys[credentialID]
+	if passkey.SignCount >= signCount {
+		return storage.ErrPasskeySignCountNotIncreased
+	}
+	passkey.SignCount = signCount
+	return nil
+}
+
+// testPasskey 模拟 ES256 Passkey 认证器
+type testPasskey struct {
+	credentialID []byte
+	key          *ecdsa.PrivateKey
+	signCount    uint32
+}
+
+func newTestPasskey(t *testing.T, store *memoryStore, name string) *testPasskey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	cose, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{KeyType: int64(webauthncose.EllipticKey), Algorithm: int64(webauthncose.AlgES256)},
+		Curve:         int64(webauthncose.P256),
+		XCoord:        key.X.FillBytes(make([]byte, 32)),
+		YCoord:        key.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	passkey := &testPasskey{credentialID: []byte(name), key: key}
+	credentialIDBase64 := base64.RawURLEncoding.EncodeToString(passkey.credentialID)
+	store.members[credentialIDBase64] = storage.WalletRoleAdmin
+	store.passkeys[credentialIDBase64] = &storage.Passkey{CredentialID: credentialIDBase64, PublicKey: hex.EncodeToString(cose)}
+	return passkey
+}
+
+// assert 对 challenge 签名，每次签名计数器加一
+func (p *testPasskey) assert(t *testing.T, challenge string, origin string) *Assertion {
+	clientDataJSON, err := json.Marshal(map[string]string{
+		"type":      "webauthn.get",
+		"challenge": challenge,
+		"origin":    origin,
+	})
+	require.NoError(t, err)
+
+	p.signCount++
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], 0x05) // UP | UV
+	authData = binary.BigEndian.AppendUint32(authData, p.signCount)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, p.key, digest[:])
+	require.NoError(t, err)
+
+	return &Assertion{CredentialID: p.credentialID, AuthenticatorData: authData, ClientDataJSON: clientDataJSON, Signature: signature}
+}
+
+func newTestService(t *testing.T, store *memoryStore) *Service {
+	service, err := NewService(rpID, "SafeMPC", rpOrigin, store)
+	require.NoError(t, err)
+	return service
+}
+
+func TestVerifySignAssertion(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	service := newTestService(t, store)
+	alice := newTestPasskey(t, store, "alice")
+	message := []byte("transfer 1 ETH")
+
+	challenge, err := service.BeginSignChallenge(ctx, walletID, message)
+	require.NoError(t, err)
+	messageHash := sha256.Sum256(message)
+	assert.Equal(t, hex.EncodeToString(messageHash[:]), challenge.MessageHash)
+	assert.WithinDuration(t, time.Now().Add(DefaultSignChallengeTTL), challenge.ExpiresAt, time.Minute)
+
+	assertion := alice.assert(t, challenge.Challenge, rpOrigin)
+	require.NoError(t, service.VerifySignAssertion(ctx, walletID, message, assertion))
+	assert.Equal(t, uint32(1), store.passkeys[base64.RawURLEncoding.EncodeToString(alice.credentialID)].SignCount)
+
+	// challenge 只能使用一次
+	err = service.VerifySignAssertion(ctx, walletID, message, assertion)
+	assert.ErrorIs(t, err, ErrInvalidSignChallenge)
+}
+
+func TestVerifySignAssertionRejectsMismatchedChallenge(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	service := newTestService(t, store)
+	alice := newTestPasskey(t, store, "alice")
+	message := []byte("transfer 1 ETH")
+
+	// challenge 是为其他消息或其他钱包签发的
+	challenge, err := service.BeginSignChallenge(ctx, walletID, []byte("transfer 100 ETH"))
+	require.NoError(t, err)
+	err = service.VerifySignAssertion(ctx, walletID, message, alice.assert(t, challenge.Challenge, rpOrigin))
+	assert.ErrorIs(t, err, ErrInvalidSignChallenge)
+
+	challenge, err = service.BeginSignChallenge(ctx, "wallet-2", message)
+	require.NoError(t, err)
+	err = service.VerifySignAssertion(ctx, walletID, message, alice.assert(t, challenge.Challenge, rpOrigin))
+	assert.ErrorIs(t, err, ErrInvalidSignChallenge)
+
+	// 不是服务端签发的 challenge
+	err = service.VerifySignAssertion(ctx, walletID, message, alice.assert(t, base64.RawURLEncoding.EncodeToString(make([]byte, 32)), rpOrigin))
+	assert.ErrorIs(t, err, ErrInvalidSignChallenge)
+
+	// 过期的 challenge
+	challenge, err = service.BeginSignChallenge(ctx, walletID, message)
+	require.NoError(t, err)
+	service.now = func() time.Time { return time.Now().Add(DefaultSignChallengeTTL) }
+	err = service.VerifySignAssertion(ctx, walletID, message, alice.assert(t, challenge.Challenge, rpOrigin))
+	assert.ErrorIs(t, err, ErrInvalidSignChallenge)
+}
+
+func TestVerifySignAssertionRejectsInvalidAssertion(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	service := newTestService(t, store)
+	alice := newTestPasskey(t, store, "alice")
+	message := []byte("transfer 1 ETH")
+
+	// 非钱包成员
+	mallory := newTestPasskey(t, store, "mallory")
+	delete(store.members, base64.RawURLEncoding.EncodeToString(mallory.credentialID))
+	challenge, err := service.BeginSignChallenge(ctx, walletID, message)
+	require.NoError(t, err)
+	err = service.VerifySignAssertion(ctx, walletID, message, mallory.assert(t, challenge.Challenge, rpOrigin))
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+
+	// 只读成员不能签名
+	viewer := newTestPasskey(t, store, "viewer")
+	store.members[base64.RawURLEncoding.EncodeToString(viewer.credentialID)] = storage.WalletRoleViewer
+	err = service.VerifySignAssertion(ctx, walletID, message, viewer.assert(t, challenge.Challenge, rpOrigin))
+	assert.ErrorIs(t, err, ErrNotWalletMember)
+
+	// Origin 不匹配
+	err = service.VerifySignAssertion(ctx, walletID, message, alice.assert(t, challenge.Challenge, "https://evil.example.com"))
+	assert.ErrorIs(t, err, ErrInvalidAssertion)
+
+	// 签名与凭证不匹配
+	challenge, err = service.BeginSignChallenge(ctx, walletID, message)
+	require.NoError(t, err)
+	assertion := alice.assert(t, challenge.Challenge, rpOrigin)
+	assertion.Signature = mallory.assert(t, challenge.Challenge, rpOrigin).Signature
+	err = service.VerifySignAssertion(ctx, walletID, message, assertion)
+	assert.ErrorIs(t, err, ErrInvalidAssertion)
+
+	// 无效的 assertion 不消耗 challenge，之后有效的 assertion 仍可使用
+	require.NoError(t, service.VerifySignAssertion(ctx, walletID, message, alice.assert(t, challenge.Challenge, rpOrigin)))
+
+	assert.ErrorIs(t, service.VerifySignAssertion(ctx, walletID, message, nil), ErrInvalidAssertion)
+}
+
+func TestVerifySignAssertionRequiresIncreasingSignCount(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	service := newTestService(t, store)
+	alice := newTestPasskey(t, store, "alice")
+	message := []byte("transfer 1 ETH")
+
+	alice.signCount = 9
+	challenge, err := service.BeginSignChallenge(ctx, walletID, message)
+	require.NoError(t, err)
+	require.NoError(t, service.VerifySignAssertion(ctx, walletID, message, alice.assert(t, challenge.Challenge, rpOrigin)))
+
+	// 克隆的认证器计数器落后于已记录的值
+	alice.signCount = 5
+	challenge, err = service.BeginSignChallenge(ctx, walletID, message)
+	require.NoError(t, err)
+	err = service.VerifySignAssertion(ctx, walletID, message, alice.assert(t, challenge.Challenge, rpOrigin))
+	assert.ErrorIs(t, err, storage.ErrPasskeySignCountNotIncreased)
+}
+
+func TestBeginSignChallengeDeletesExpiredChallenges(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	service := newTestService(t, store)
+	message := []byte("transfer 1 ETH")
+
+	expired, err := service.BeginSignChallenge(ctx, walletID, message)
+	require.NoError(t, err)
+
+	service.now = func() time.Time { return time.Now().Add(2 * DefaultSignChallengeTTL) }
+	current, err := service.BeginSignChallenge(ctx, walletID, message)
+	require.NoError(t, err)
+
+	assert.NotContains(t, store.challenges, expired.Challenge)
+	assert.Contains(t, store.challenges, current.Challenge)
+}
@@ -21,6 +21,7 @@ var (
 	ErrEmailTemplateNotFound         = errors.New("email template not found")
 	emailTemplatePasswordReset       = "password_reset"       // /app/templates/email/password_reset/**.
 	emailTemplateAccountConfirmation = "account_confirmation" // /app/templates/email/account_confirmation/**
+	emailTemplateWalletInvitation    = "wallet_invitation"    // /app/templates/email/wallet_invitation/**
 )
 
 type Mailer struct {
@@ -160,3 +161,46 @@ func (m *Mailer) SendAccountConfirmation(ctx context.Context, to string, payload
 
 	return nil
 }
+
+func (m *Mailer) SendWalletInvitation(ctx context.Context, to string, walletID string, role string, invitationLink string) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateWalletInvitation).Logger()
+
+	tmpl, ok := m.Templates[emailTemplateWalletInvitation]
+	if !ok {
+		log.Error().Msg("Wallet invitation email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"walletID":       walletID,
+		"role":           role,
+		"invitationLink": invitationLink,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute wallet invitation email template")
+		return fmt.Errorf("failed to execute wallet invitation email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = "Wallet invitation"
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("walletID", walletID).Msg("Sending has been disabled in mailer config, skipping wallet invitation email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send wallet invitation email")
+		return fmt.Errorf("failed to send wallet invitation email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent wallet invitation email")
+
+	return nil
+}
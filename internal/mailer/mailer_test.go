@@ -36,3 +36,26 @@ func TestMailerSendPasswordReset(t *testing.T) {
 	assert.Equal(t, "Password reset", mail.Subject)
 	assert.Contains(t, string(mail.HTML), passwordResetLink)
 }
+
+func TestMailerSendWalletInvitation(t *testing.T) {
+	ctx := t.Context()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(1)
+
+	invitationLink := "http://localhost/wallet-invitation?token=12345"
+	err := mailer.SendWalletInvitation(ctx, "invitee@example.com", "wallet-1", "approver", invitationLink)
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mail := mailTransport.GetLastSentMail()
+	require.NotNil(t, mail)
+	assert.Equal(t, test.TestMailerDefaultSender, mail.From)
+	assert.Equal(t, []string{"invitee@example.com"}, mail.To)
+	assert.Equal(t, "Wallet invitation", mail.Subject)
+	assert.Contains(t, string(mail.HTML), "wallet-1")
+	assert.Contains(t, string(mail.HTML), "approver")
+	assert.Contains(t, string(mail.HTML), invitationLink)
+}
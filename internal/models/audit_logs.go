@@ -35,6 +35,9 @@ type AuditLog struct {
 	Result    string      `boil:"result" json:"result" toml:"result" yaml:"result"`
 	Details   null.JSON   `boil:"details" json:"details,omitempty" toml:"details" yaml:"details,omitempty"`
 	IPAddress null.String `boil:"ip_address" json:"ip_address,omitempty" toml:"ip_address" yaml:"ip_address,omitempty"`
+	PrevHash  null.String `boil:"prev_hash" json:"prev_hash,omitempty" toml:"prev_hash" yaml:"prev_hash,omitempty"`
+	EntryHash null.String `boil:"entry_hash" json:"entry_hash,omitempty" toml:"entry_hash" yaml:"entry_hash,omitempty"`
+	Signature null.String `boil:"signature" json:"signature,omitempty" toml:"signature" yaml:"signature,omitempty"`
 
 	R *auditLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L auditLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +55,9 @@ var AuditLogColumns = struct {
 	Result    string
 	Details   string
 	IPAddress string
+	PrevHash  string
+	EntryHash string
+	Signature string
 }{
 	ID:        "id",
 	Timestamp: "timestamp",
@@ -64,6 +70,9 @@ var AuditLogColumns = struct {
 	Result:    "result",
 	Details:   "details",
 	IPAddress: "ip_address",
+	PrevHash:  "prev_hash",
+	EntryHash: "entry_hash",
+	Signature: "signature",
 }
 
 var AuditLogTableColumns = struct {
@@ -78,6 +87,9 @@ var AuditLogTableColumns = struct {
 	Result    string
 	Details   string
 	IPAddress string
+	PrevHash  string
+	EntryHash string
+	Signature string
 }{
 	ID:        "audit_logs.id",
 	Timestamp: "audit_logs.timestamp",
@@ -90,6 +102,9 @@ var AuditLogTableColumns = struct {
 	Result:    "audit_logs.result",
 	Details:   "audit_logs.details",
 	IPAddress: "audit_logs.ip_address",
+	PrevHash:  "audit_logs.prev_hash",
+	EntryHash: "audit_logs.entry_hash",
+	Signature: "audit_logs.signature",
 }
 
 // Generated where
@@ -209,6 +224,9 @@ var AuditLogWhere = struct {
 	Result    whereHelperstring
 	Details   whereHelpernull_JSON
 	IPAddress whereHelpernull_String
+	PrevHash  whereHelpernull_String
+	EntryHash whereHelpernull_String
+	Signature whereHelpernull_String
 }{
 	ID:        whereHelperint64{field: "\"audit_logs\".\"id\""},
 	Timestamp: whereHelpertime_Time{field: "\"audit_logs\".\"timestamp\""},
@@ -221,6 +239,9 @@ var AuditLogWhere = struct {
 	Result:    whereHelperstring{field: "\"audit_logs\".\"result\""},
 	Details:   whereHelpernull_JSON{field: "\"audit_logs\".\"details\""},
 	IPAddress: whereHelpernull_String{field: "\"audit_logs\".\"ip_address\""},
+	PrevHash:  whereHelpernull_String{field: "\"audit_logs\".\"prev_hash\""},
+	EntryHash: whereHelpernull_String{field: "\"audit_logs\".\"entry_hash\""},
+	Signature: whereHelpernull_String{field: "\"audit_logs\".\"signature\""},
 }
 
 // AuditLogRels is where relationship names are stored.
@@ -240,9 +261,9 @@ func (*auditLogR) NewStruct() *auditLogR {
 type auditLogL struct{}
 
 var (
-	auditLogAllColumns            = []string{"id", "timestamp", "event_type", "user_id", "key_id", "node_id", "session_id", "operation", "result", "details", "ip_address"}
+	auditLogAllColumns            = []string{"id", "timestamp", "event_type", "user_id", "key_id", "node_id", "session_id", "operation", "result", "details", "ip_address", "prev_hash", "entry_hash", "signature"}
 	auditLogColumnsWithoutDefault = []string{"event_type", "operation", "result"}
-	auditLogColumnsWithDefault    = []string{"id", "timestamp", "user_id", "key_id", "node_id", "session_id", "details", "ip_address"}
+	auditLogColumnsWithDefault    = []string{"id", "timestamp", "user_id", "key_id", "node_id", "session_id", "details", "ip_address", "prev_hash", "entry_hash", "signature"}
 	auditLogPrimaryKeyColumns     = []string{"id"}
 	auditLogGeneratedColumns      = []string{}
 )
@@ -0,0 +1,261 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha3"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/aptos"
+)
+
+const (
+	// aptosEd25519Scheme 单签 Ed25519 认证密钥的方案标识
+	aptosEd25519Scheme = 0x00
+	// aptosDefaultMaxGasAmount 未指定时的 gas 上限，覆盖转账时创建接收方账户的存储费用
+	aptosDefaultMaxGasAmount = 10000
+	// aptosDefaultGasUnitPrice 未指定时的 gas 单价（octas），即网络最低单价
+	aptosDefaultGasUnitPrice = 100
+	// aptosDefaultExpiration 未指定过期时间时交易的有效期，需要覆盖 MPC 签名耗时
+	aptosDefaultExpiration = 10 * time.Minute
+)
+
+// Aptos 交易枚举变体（BCS 序号）
+const (
+	aptosPayloadEntryFunction   = 2 // TransactionPayload::EntryFunction
+	aptosAuthenticatorEd25519   = 0 // TransactionAuthenticator::Ed25519
+	aptosTransactionUserVariant = 0 // Transaction::UserTransaction
+)
+
+const (
+	// 签名原文和交易哈希的域分隔前缀
+	aptosRawTransactionSalt = "APTOS::RawTransaction"
+	aptosTransactionSalt    = "APTOS::Transaction"
+
+	// 0x1::aptos_account::transfer(to: address, amount: u64)
+	aptosTransferModule   = "aptos_account"
+	aptosTransferFunction = "transfer"
+)
+
+// AptosAdapter 实现 Aptos 链基础能力，使用 Ed25519 单签账户转账 APT，交易通过全节点 REST API 查询序列号和提交
+type AptosAdapter struct {
+	chainID   uint8
+	rpcClient *aptos.RPCClient
+}
+
+// NewAptosAdapter 创建 Aptos 适配器，chainID 写入交易防止跨网络重放（主网 1、测试网 2），
+// 配置多个 REST API 端点时按顺序故障转移
+func NewAptosAdapter(chainID uint8, rpcEndpoints ...string) *AptosAdapter {
+	adapter := &AptosAdapter{chainID: chainID}
+	if endpoints := nonEmptyEndpoints(rpcEndpoints); len(endpoints) > 0 {
+		adapter.rpcClient = aptos.NewRPCClient(endpoints...)
+	}
+	return adapter
+}
+
+// GenerateAddress 根据 Ed25519 公钥生成账户地址
+func (a *AptosAdapter) GenerateAddress(pubKey []byte) (string, error) {
+	return AptosAddressFromPublicKey(pubKey)
+}
+
+// AptosAddressFromPublicKey 由 Ed25519 公钥生成 0x 前缀的 64 位十六进制账户地址
+// 地址即初始认证密钥 SHA3-256(公钥 || 0x00)
+func AptosAddressFromPublicKey(pubKey []byte) (string, error) {
+	if len(pubKey) != ed25519.PublicKeySize {
+		return "", errors.Errorf("invalid public key length: expected %d bytes, got %d", ed25519.PublicKeySize, len(pubKey))
+	}
+	authKey := sha3.Sum256(append(append([]byte(nil), pubKey...), aptosEd25519Scheme))
+	return encodeMoveAddress(authKey[:]), nil
+}
+
+// ParseAptosAddress 解析 0x 前缀的十六进制地址，短格式（如 0x1）左侧补零，返回 32 字节地址
+func ParseAptosAddress(address string) ([]byte, error) {
+	parsed, err := parseMoveAddress(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid aptos address")
+	}
+	return parsed, nil
+}
+
+// GetBalance 查询 APT 余额（octas）
+func (a *AptosAdapter) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	parsed, err := ParseAptosAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return a.rpcClient.GetBalance(ctx, encodeMoveAddress(parsed))
+}
+
+// BroadcastTransaction 提交 AssembleSignedTx 返回的已签名交易，返回交易哈希
+func (a *AptosAdapter) BroadcastTransaction(ctx context.Context, rawTx string) (string, error) {
+	if a.rpcClient == nil {
+		return "", errors.New("RPC client not configured")
+	}
+	signed, err := hex.DecodeString(strings.TrimPrefix(rawTx, "0x"))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid raw transaction")
+	}
+	return a.rpcClient.SubmitTransaction(ctx, signed)
+}
+
+// BuildTransactionWithAccount 查询发送方序列号后构建交易，链上尚无 Account 资源的账户序列号为 0；
+// 未指定 gas 单价时使用节点建议值
+func (a *AptosAdapter) BuildTransactionWithAccount(ctx context.Context, req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+
+	sender, err := ParseAptosAddress(req.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+	sequence, err := a.rpcClient.GetSequenceNumber(ctx, encodeMoveAddress(sender))
+	if err != nil && !errors.Is(err, aptos.ErrAccountNotFound) {
+		return nil, errors.Wrap(err, "failed to get sequence number")
+	}
+
+	withAccount := *req
+	withAccount.Nonce = sequence
+	if withAccount.AptosGasUnitPrice == 0 {
+		estimate, err := a.rpcClient.EstimateGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		withAccount.AptosGasUnitPrice = estimate.GasEstimate
+	}
+	return a.BuildTransaction(&withAccount)
+}
+
+// BuildTransaction 构建调用 0x1::aptos_account::transfer 的 APT 转账交易（接收方账户不存在时自动创建）
+// 返回的 Raw 为 BCS 编码的 RawTransaction（十六进制），SigningHashes 仅包含签名原文
+// SHA3-256("APTOS::RawTransaction") || RawTransaction，即 Ed25519 ThresholdSign 的消息；
+// 交易哈希包含签名，因此 Hash 为空
+func (a *AptosAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if a.chainID == 0 {
+		return nil, errors.New("chain id is not configured")
+	}
+	if req.Amount == nil || req.Amount.Sign() <= 0 || !req.Amount.IsUint64() {
+		return nil, errors.New("amount must be a positive 64-bit integer")
+	}
+
+	sender, err := ParseAptosAddress(req.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+	to, err := ParseAptosAddress(req.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid to address")
+	}
+
+	maxGasAmount := req.AptosMaxGasAmount
+	if maxGasAmount == 0 {
+		maxGasAmount = aptosDefaultMaxGasAmount
+	}
+	gasUnitPrice := req.AptosGasUnitPrice
+	if gasUnitPrice == 0 {
+		gasUnitPrice = aptosDefaultGasUnitPrice
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(maxGasAmount), new(big.Int).SetUint64(gasUnitPrice))
+	if !fee.IsUint64() {
+		return nil, errors.New("max gas amount times gas unit price exceeds uint64")
+	}
+	expiration := req.AptosExpiration
+	if expiration.IsZero() {
+		expiration = time.Now().Add(aptosDefaultExpiration)
+	}
+	if expiration.Unix() <= 0 {
+		return nil, errors.New("expiration must be after the unix epoch")
+	}
+
+	framework := make([]byte, moveAddressLength) // 0x1
+	framework[moveAddressLength-1] = 1
+
+	var raw []byte
+	raw = append(raw, sender...)
+	raw = bcsAppendU64(raw, req.Nonce) // sequence_number
+	raw = bcsAppendULEB128(raw, aptosPayloadEntryFunction)
+	raw = append(raw, framework...) // module.address
+	raw = bcsAppendString(raw, aptosTransferModule)
+	raw = bcsAppendString(raw, aptosTransferFunction)
+	raw = bcsAppendULEB128(raw, 0) // ty_args
+	raw = bcsAppendULEB128(raw, 2) // args：每个参数为其 BCS 编码的字节序列
+	raw = bcsAppendBytes(raw, to)
+	raw = bcsAppendBytes(raw, bcsU64(req.Amount.Uint64()))
+	raw = bcsAppendU64(raw, maxGasAmount)
+	raw = bcsAppendU64(raw, gasUnitPrice)
+	raw = bcsAppendU64(raw, uint64(expiration.Unix())) // expiration_timestamp_secs
+	raw = append(raw, a.chainID)
+
+	return &Transaction{
+		Raw:           hex.EncodeToString(raw),
+		SigningHashes: [][]byte{aptosSigningMessage(raw)},
+		Fee:           fee, // 最大手续费，实际按消耗的 gas 收取
+	}, nil
+}
+
+// AssembleSignedTx 将 Ed25519 签名附加到 BuildTransaction 生成的 RawTransaction，
+// 返回 BCS 编码的 SignedTransaction（十六进制），Hash 为 0x 前缀的交易哈希
+func (a *AptosAdapter) AssembleSignedTx(rawTx string, signature []byte, pubKey []byte) (*Transaction, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(rawTx, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid raw transaction")
+	}
+	if len(raw) <= moveAddressLength+1 {
+		return nil, errors.New("raw transaction is too short")
+	}
+	if chainID := raw[len(raw)-1]; chainID != a.chainID {
+		return nil, errors.Errorf("transaction chain id %d does not match adapter chain id %d", chainID, a.chainID)
+	}
+
+	// 发送方地址为 RawTransaction 的前 32 字节，必须是 pubKey 对应的地址（未轮换认证密钥）
+	signer, err := AptosAddressFromPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if signer != encodeMoveAddress(raw[:moveAddressLength]) {
+		return nil, errors.New("public key is not the sender of the transaction")
+	}
+	if len(signature) != ed25519.SignatureSize {
+		return nil, errors.Errorf("invalid signature length: expected %d bytes, got %d", ed25519.SignatureSize, len(signature))
+	}
+	if !ed25519.Verify(pubKey, aptosSigningMessage(raw), signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	signed := append([]byte(nil), raw...)
+	signed = bcsAppendULEB128(signed, aptosAuthenticatorEd25519)
+	signed = bcsAppendBytes(signed, pubKey)
+	signed = bcsAppendBytes(signed, signature)
+
+	hasher := sha3.New256()
+	prefix := sha3.Sum256([]byte(aptosTransactionSalt))
+	hasher.Write(prefix[:])
+	hasher.Write([]byte{aptosTransactionUserVariant})
+	hasher.Write(signed)
+
+	return &Transaction{
+		Raw:  hex.EncodeToString(signed),
+		Hash: "0x" + hex.EncodeToString(hasher.Sum(nil)),
+	}, nil
+}
+
+// aptosSigningMessage RawTransaction 的签名原文：域分隔前缀 SHA3-256("APTOS::RawTransaction") 加 BCS 编码
+func aptosSigningMessage(raw []byte) []byte {
+	prefix := sha3.Sum256([]byte(aptosRawTransactionSalt))
+	return bytes.Join([][]byte{prefix[:], raw}, nil)
+}
@@ -0,0 +1,199 @@
+package aptos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// aptosCoinType 原生代币 APT 的币种
+const aptosCoinType = "0x1::aptos_coin::AptosCoin"
+
+// signedTransactionContentType 以 BCS 编码提交已签名交易时的 Content-Type
+const signedTransactionContentType = "application/x.aptos.signed_transaction+bcs"
+
+// ErrAccountNotFound 链上不存在的账户（没有 Account 资源）
+var ErrAccountNotFound = errors.New("account not found")
+
+// RPCClient Aptos 全节点 REST API 客户端，配置多个端点时按顺序故障转移
+type RPCClient struct {
+	endpoints []string
+	current   atomic.Int32 // 最近一次调用成功的端点，下次调用优先使用
+	client    *http.Client
+}
+
+// NewRPCClient 创建 Aptos REST 客户端，端点为包含版本的 API 根地址（如 https://api.mainnet.aptoslabs.com/v1）
+func NewRPCClient(endpoints ...string) *RPCClient {
+	return &RPCClient{
+		endpoints: endpoints,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// APIError 节点拒绝请求时返回的错误，提交交易失败时 VMErrorCode 为 Move VM 的状态码
+type APIError struct {
+	StatusCode  int
+	ErrorCode   string
+	Message     string
+	VMErrorCode int
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("aptos API error: %s (code: %s)", e.Message, e.ErrorCode)
+}
+
+// request 一次 REST 调用，body 为空时发送 GET 请求
+type request struct {
+	path        string
+	body        []byte
+	contentType string
+}
+
+// call 调用 REST 接口，端点不可用（网络错误或 5xx/429 响应）时依次尝试下一个端点，
+// 节点返回的 API 错误直接返回
+func (c *RPCClient) call(ctx context.Context, req *request, result interface{}) error {
+	if len(c.endpoints) == 0 {
+		return errors.New("no RPC endpoint configured")
+	}
+
+	start := int(c.current.Load())
+	for i := 0; i < len(c.endpoints); i++ {
+		idx := (start + i) % len(c.endpoints)
+		err := c.callEndpoint(ctx, strings.TrimRight(c.endpoints[idx], "/")+req.path, req, result)
+		if err == nil {
+			c.current.Store(int32(idx))
+			return nil
+		}
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) || ctx.Err() != nil || i == len(c.endpoints)-1 {
+			return err
+		}
+	}
+
+	return errors.New("no RPC endpoint available")
+}
+
+// callEndpoint 向单个端点发送请求，4xx 响应解析为 APIError
+func (c *RPCClient) callEndpoint(ctx context.Context, url string, req *request, result interface{}) error {
+	method := http.MethodGet
+	var body io.Reader
+	if req.body != nil {
+		method = http.MethodPost
+		body = bytes.NewReader(req.body)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return errors.Wrap(err, "failed to create HTTP request")
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	if req.body != nil {
+		httpReq.Header.Set("Content-Type", req.contentType)
+	}
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute HTTP request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return errors.Errorf("RPC endpoint returned HTTP %d", resp.StatusCode)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Message     string `json:"message"`
+			ErrorCode   string `json:"error_code"`
+			VMErrorCode int    `json:"vm_error_code"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err != nil || apiErr.ErrorCode == "" {
+			return errors.Errorf("RPC endpoint returned HTTP %d", resp.StatusCode)
+		}
+		return &APIError{StatusCode: resp.StatusCode, ErrorCode: apiErr.ErrorCode, Message: apiErr.Message, VMErrorCode: apiErr.VMErrorCode}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Wrap(err, "failed to decode response")
+	}
+	return nil
+}
+
+// GetSequenceNumber 查询账户序列号，账户不存在时返回 ErrAccountNotFound
+func (c *RPCClient) GetSequenceNumber(ctx context.Context, address string) (uint64, error) {
+	var resp struct {
+		SequenceNumber string `json:"sequence_number"`
+	}
+	if err := c.call(ctx, &request{path: "/accounts/" + url.PathEscape(address)}, &resp); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode == "account_not_found" {
+			return 0, errors.Wrapf(ErrAccountNotFound, "account %s", address)
+		}
+		return 0, errors.Wrap(err, "failed to query account")
+	}
+
+	sequence, err := strconv.ParseUint(resp.SequenceNumber, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid sequence number %q", resp.SequenceNumber)
+	}
+	return sequence, nil
+}
+
+// GetBalance 查询 APT 余额（octas），同时包含 CoinStore 和同质化资产（FA）余额
+func (c *RPCClient) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	var balance json.Number
+	path := "/accounts/" + url.PathEscape(address) + "/balance/" + url.PathEscape(aptosCoinType)
+	if err := c.call(ctx, &request{path: path}, &balance); err != nil {
+		return nil, errors.Wrap(err, "failed to query balance")
+	}
+
+	value, ok := new(big.Int).SetString(balance.String(), 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance %q", balance)
+	}
+	return value, nil
+}
+
+// GasEstimate 节点建议的 gas 单价（octas）
+type GasEstimate struct {
+	DeprioritizedGasEstimate uint64 `json:"deprioritized_gas_estimate"`
+	GasEstimate              uint64 `json:"gas_estimate"`
+	PrioritizedGasEstimate   uint64 `json:"prioritized_gas_estimate"`
+}
+
+// EstimateGasPrice 查询建议的 gas 单价
+func (c *RPCClient) EstimateGasPrice(ctx context.Context) (*GasEstimate, error) {
+	var estimate GasEstimate
+	if err := c.call(ctx, &request{path: "/estimate_gas_price"}, &estimate); err != nil {
+		return nil, errors.Wrap(err, "failed to estimate gas price")
+	}
+	return &estimate, nil
+}
+
+// SubmitTransaction 提交 BCS 编码的已签名交易，节点接受后返回交易哈希（0x 前缀十六进制）
+func (c *RPCClient) SubmitTransaction(ctx context.Context, signedTx []byte) (string, error) {
+	var resp struct {
+		Hash string `json:"hash"`
+	}
+	req := &request{path: "/transactions", body: signedTx, contentType: signedTransactionContentType}
+	if err := c.call(ctx, req, &resp); err != nil {
+		return "", errors.Wrap(err, "failed to submit transaction")
+	}
+	if resp.Hash == "" {
+		return "", errors.New("node returned an empty transaction hash")
+	}
+	return resp.Hash, nil
+}
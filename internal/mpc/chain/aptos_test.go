@@ -0,0 +1,215 @@
+package chain
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha3"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/aptos"
+)
+
+const aptosReceiver = "0x1234"
+
+// zeroSeedEd25519Key 全零种子的 Ed25519 密钥，公钥为 3b6a27bc…59da29（RFC 8032 测试向量 1）
+func zeroSeedEd25519Key() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+}
+
+func TestAptosAddress(t *testing.T) {
+	pubKey := zeroSeedEd25519Key().Public().(ed25519.PublicKey)
+	address, err := NewAptosAdapter(1).GenerateAddress(pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, "0x08e845d10bbb594fcffceb36d934a188bb84d9cdf7362e4e2522265b185127cb", address)
+
+	_, err = AptosAddressFromPublicKey(pubKey[:31])
+	assert.Error(t, err)
+
+	parsed, err := ParseAptosAddress("0x1")
+	require.NoError(t, err)
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000001", encodeMoveAddress(parsed))
+
+	for _, invalid := range []string{"", "0x", "0xzz", "0x" + hex.EncodeToString(make([]byte, 33))} {
+		_, err := ParseAptosAddress(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestAptosBuildAndAssembleTransfer(t *testing.T) {
+	privKey := zeroSeedEd25519Key()
+	pubKey := privKey.Public().(ed25519.PublicKey)
+
+	adapter := NewAptosAdapter(2)
+	from, err := adapter.GenerateAddress(pubKey)
+	require.NoError(t, err)
+
+	expiration := time.Unix(1700000000, 0)
+	unsigned, err := adapter.BuildTransaction(&BuildTxRequest{
+		From: from, To: aptosReceiver, Amount: big.NewInt(150000000), Nonce: 9,
+		AptosMaxGasAmount: 2000, AptosGasUnitPrice: 150, AptosExpiration: expiration,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, unsigned.Hash)
+	assert.Equal(t, "300000", unsigned.Fee.String())
+
+	raw, err := hex.DecodeString(unsigned.Raw)
+	require.NoError(t, err)
+	sender, _ := ParseAptosAddress(from)
+	receiver, _ := ParseAptosAddress(aptosReceiver)
+
+	// RawTransaction：sender | sequence | EntryFunction(0x1::aptos_account::transfer, [to, amount]) | gas | expiration | chain id
+	var expected []byte
+	expected = append(expected, sender...)
+	expected = binary.LittleEndian.AppendUint64(expected, 9)
+	expected = append(expected, 2)
+	expected = append(expected, make([]byte, 31)...)
+	expected = append(expected, 1)
+	expected = append(expected, 13)
+	expected = append(expected, "aptos_account"...)
+	expected = append(expected, 8)
+	expected = append(expected, "transfer"...)
+	expected = append(expected, 0, 2, 32)
+	expected = append(expected, receiver...)
+	expected = append(expected, 8)
+	expected = binary.LittleEndian.AppendUint64(expected, 150000000)
+	expected = binary.LittleEndian.AppendUint64(expected, 2000)
+	expected = binary.LittleEndian.AppendUint64(expected, 150)
+	expected = binary.LittleEndian.AppendUint64(expected, 1700000000)
+	expected = append(expected, 2)
+	assert.Equal(t, expected, raw)
+
+	prefix, _ := hex.DecodeString("b5e97db07fa0bd0e5598aa3643a9bc6f6693bddc1a9fec9e674a461eaa00b193")
+	require.Len(t, unsigned.SigningHashes, 1)
+	assert.Equal(t, append(prefix, raw...), unsigned.SigningHashes[0])
+
+	signature := ed25519.Sign(privKey, unsigned.SigningHashes[0])
+	signed, err := adapter.AssembleSignedTx(unsigned.Raw, signature, pubKey)
+	require.NoError(t, err)
+
+	signedTx, err := hex.DecodeString(signed.Raw)
+	require.NoError(t, err)
+	authenticator := append(append([]byte{0, 32}, pubKey...), 64)
+	assert.Equal(t, append(append(raw, authenticator...), signature...), signedTx)
+
+	txPrefix := sha3.Sum256([]byte("APTOS::Transaction"))
+	hash := sha3.Sum256(append(append(txPrefix[:], 0), signedTx...))
+	assert.Equal(t, "0x"+hex.EncodeToString(hash[:]), signed.Hash)
+
+	// 签名与交易不匹配、公钥不是发送方或链 ID 不一致时拒绝组装
+	_, err = adapter.AssembleSignedTx(unsigned.Raw, ed25519.Sign(privKey, []byte("other")), pubKey)
+	assert.Error(t, err)
+	otherKey := ed25519.NewKeyFromSeed(common32(7))
+	_, err = adapter.AssembleSignedTx(unsigned.Raw, ed25519.Sign(otherKey, unsigned.SigningHashes[0]), otherKey.Public().(ed25519.PublicKey))
+	assert.Error(t, err)
+	_, err = NewAptosAdapter(1).AssembleSignedTx(unsigned.Raw, signature, pubKey)
+	assert.Error(t, err)
+}
+
+func TestAptosBuildTransactionValidation(t *testing.T) {
+	from, err := AptosAddressFromPublicKey(zeroSeedEd25519Key().Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+
+	tx, err := NewAptosAdapter(1).BuildTransaction(&BuildTxRequest{From: from, To: aptosReceiver, Amount: big.NewInt(1)})
+	require.NoError(t, err)
+	assert.Equal(t, "1000000", tx.Fee.String()) // 默认 10000 × 100
+
+	tests := []struct {
+		name    string
+		adapter *AptosAdapter
+		req     *BuildTxRequest
+	}{
+		{"nil request", NewAptosAdapter(1), nil},
+		{"chain id not configured", NewAptosAdapter(0), &BuildTxRequest{From: from, To: aptosReceiver, Amount: big.NewInt(1)}},
+		{"zero amount", NewAptosAdapter(1), &BuildTxRequest{From: from, To: aptosReceiver, Amount: big.NewInt(0)}},
+		{"amount overflows u64", NewAptosAdapter(1), &BuildTxRequest{From: from, To: aptosReceiver, Amount: new(big.Int).Lsh(big.NewInt(1), 64)}},
+		{"invalid from", NewAptosAdapter(1), &BuildTxRequest{From: "alice", To: aptosReceiver, Amount: big.NewInt(1)}},
+		{"invalid to", NewAptosAdapter(1), &BuildTxRequest{From: from, To: "0x", Amount: big.NewInt(1)}},
+		{"fee overflows u64", NewAptosAdapter(1), &BuildTxRequest{From: from, To: aptosReceiver, Amount: big.NewInt(1),
+			AptosMaxGasAmount: 1 << 40, AptosGasUnitPrice: 1 << 40}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.adapter.BuildTransaction(tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAptosREST(t *testing.T) {
+	privKey := zeroSeedEd25519Key()
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	from, err := AptosAddressFromPublicKey(pubKey)
+	require.NoError(t, err)
+
+	var submitted []byte
+	newAccount := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/accounts/" + from:
+			if newAccount {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]string{"message": "Account not found", "error_code": "account_not_found"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"sequence_number": "42"})
+		case "/v1/accounts/" + from + "/balance/0x1::aptos_coin::AptosCoin":
+			_, _ = w.Write([]byte("123456789"))
+		case "/v1/estimate_gas_price":
+			_ = json.NewEncoder(w).Encode(map[string]uint64{"gas_estimate": 120})
+		case "/v1/transactions":
+			assert.Equal(t, "application/x.aptos.signed_transaction+bcs", r.Header.Get("Content-Type"))
+			submitted, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(map[string]string{"hash": "0xabc"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// 第一个端点不可用时故障转移到第二个
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	ctx := context.Background()
+	adapter := NewAptosAdapter(1, unavailable.URL+"/v1", server.URL+"/v1")
+
+	balance, err := adapter.GetBalance(ctx, from)
+	require.NoError(t, err)
+	assert.Equal(t, "123456789", balance.String())
+
+	unsigned, err := adapter.BuildTransactionWithAccount(ctx, &BuildTxRequest{From: from, To: aptosReceiver, Amount: big.NewInt(1000)})
+	require.NoError(t, err)
+	raw, _ := hex.DecodeString(unsigned.Raw)
+	assert.Equal(t, uint64(42), binary.LittleEndian.Uint64(raw[32:40]))
+	assert.Equal(t, "1200000", unsigned.Fee.String()) // 10000 × 120
+
+	newAccount = true
+	unsigned, err = adapter.BuildTransactionWithAccount(ctx, &BuildTxRequest{From: from, To: aptosReceiver, Amount: big.NewInt(1000)})
+	require.NoError(t, err)
+	raw, _ = hex.DecodeString(unsigned.Raw)
+	assert.Equal(t, uint64(0), binary.LittleEndian.Uint64(raw[32:40]))
+
+	signed, err := adapter.AssembleSignedTx(unsigned.Raw, ed25519.Sign(privKey, unsigned.SigningHashes[0]), pubKey)
+	require.NoError(t, err)
+	hash, err := adapter.BroadcastTransaction(ctx, signed.Raw)
+	require.NoError(t, err)
+	assert.Equal(t, "0xabc", hash)
+	assert.Equal(t, signed.Raw, hex.EncodeToString(submitted))
+
+	_, err = aptos.NewRPCClient(server.URL+"/v1").GetSequenceNumber(ctx, from)
+	assert.ErrorIs(t, err, aptos.ErrAccountNotFound)
+}
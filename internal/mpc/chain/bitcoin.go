@@ -1,36 +1,82 @@
 package chain
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"math/big"
+	"strings"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/ripemd160"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/bitcoin"
 )
 
 // BitcoinAdapter 基于 btcsuite 的简单实现
 type BitcoinAdapter struct {
-	params *chaincfg.Params
+	params      *chaincfg.Params
+	addressType BitcoinAddressType
+	rpcClient   *bitcoin.RPCClient
 }
 
-// NewBitcoinAdapter 创建一个 Bitcoin 适配器
+// NewBitcoinAdapter 创建一个生成 P2PKH 地址的 Bitcoin 适配器
 func NewBitcoinAdapter(params *chaincfg.Params) *BitcoinAdapter {
+	return NewBitcoinAdapterWithAddressType(params, BitcoinAddressP2PKH)
+}
+
+// NewBitcoinAdapterWithAddressType 创建生成指定类型地址的 Bitcoin 适配器
+func NewBitcoinAdapterWithAddressType(params *chaincfg.Params, addressType BitcoinAddressType) *BitcoinAdapter {
 	if params == nil {
 		params = &chaincfg.MainNetParams
 	}
-	return &BitcoinAdapter{params: params}
+	if addressType == "" {
+		addressType = BitcoinAddressP2PKH
+	}
+	return &BitcoinAdapter{params: params, addressType: addressType}
+}
+
+// NewBitcoinAdapterWithRPC 创建连接 Bitcoin Core 节点的适配器，用于手续费估算
+// 配置多个 RPC 端点时按顺序故障转移
+func NewBitcoinAdapterWithRPC(params *chaincfg.Params, addressType BitcoinAddressType, rpcEndpoints ...string) *BitcoinAdapter {
+	adapter := NewBitcoinAdapterWithAddressType(params, addressType)
+	if endpoints := nonEmptyEndpoints(rpcEndpoints); len(endpoints) > 0 {
+		adapter.rpcClient = bitcoin.NewRPCClient(endpoints...)
+	}
+	return adapter
+}
+
+// WithAddressType 返回生成 addressType 类型地址的适配器，与原适配器共享网络参数和 RPC 客户端
+func (a *BitcoinAdapter) WithAddressType(addressType BitcoinAddressType) *BitcoinAdapter {
+	if addressType == "" {
+		addressType = BitcoinAddressP2PKH
+	}
+	return &BitcoinAdapter{params: a.params, addressType: addressType, rpcClient: a.rpcClient}
 }
 
-// GenerateAddress 根据公钥生成标准的 Bitcoin P2PKH 地址（Base58 编码）
+// GenerateAddress 根据公钥生成适配器地址类型对应的地址
+// SegWit 地址（P2WPKH、P2SH-P2WPKH、P2TR）始终使用压缩公钥
 func (a *BitcoinAdapter) GenerateAddress(pubKey []byte) (string, error) {
 	if len(pubKey) == 0 {
 		return "", errors.New("public key is required")
 	}
 
+	if a.addressType != BitcoinAddressP2PKH {
+		publicKey, err := btcec.ParsePubKey(pubKey)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid public key")
+		}
+		return generateSegWitAddress(publicKey, a.addressType, a.params)
+	}
+
 	// 1. 计算公钥哈希：SHA256 -> RIPEMD160
 	sha := sha256.Sum256(pubKey)
 	ripemd := ripemd160.New()
@@ -63,7 +109,11 @@ func (a *BitcoinAdapter) GenerateAddress(pubKey []byte) (string, error) {
 	return address, nil
 }
 
-// BuildTransaction 构建一个简单的原始交易描述并返回双哈希
+// BuildTransaction 从 req.UTXOs 中选币，构建未签名交易并封装为 PSBT（BIP-174）
+// From 必须是 P2WPKH、P2SH-P2WPKH 或 P2TR（BIP-86）地址，所有 UTXO 都属于该地址；
+// 花费 P2SH-P2WPKH 输出时需要 BitcoinPublicKey 重建 redeemScript；找零低于粉尘阈值时并入手续费
+// 返回的 Raw 为 Base64 编码的 PSBT，Hash 为交易 ID（SegWit 交易的 txid 不受签名影响），
+// SigningHashes 为每个输入的 sighash（SegWit v0 为 BIP-143，P2TR 为 BIP-341），需逐个交给 ThresholdSign 签名后调用 FinalizeTransaction
 func (a *BitcoinAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, error) {
 	if req == nil {
 		return nil, errors.New("build request is nil")
@@ -71,20 +121,306 @@ func (a *BitcoinAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, er
 	if req.Amount == nil {
 		return nil, errors.New("amount is required")
 	}
+	if !req.Amount.IsInt64() || req.Amount.Sign() <= 0 {
+		return nil, errors.New("amount must be a positive number of satoshis")
+	}
+	if req.FeeRate == 0 {
+		return nil, errors.New("fee rate is required")
+	}
+	if len(req.UTXOs) == 0 {
+		return nil, errors.New("utxos are required")
+	}
 
-	raw := fmt.Sprintf(
-		"btc-tx|from:%s|to:%s|amount:%s|nonce:%d|feerate:%d|data:%s",
-		req.From,
-		req.To,
-		req.Amount.String(),
-		req.Nonce,
-		req.FeeRate,
-		hex.EncodeToString(req.Data),
-	)
+	fromScript, err := addressToScript(req.From, a.params)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+	spend, err := newBitcoinSpend(fromScript, req.BitcoinPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	toScript, err := addressToScript(req.To, a.params)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid to address")
+	}
+	changeScript := fromScript
+	if req.ChangeAddress != "" {
+		if changeScript, err = addressToScript(req.ChangeAddress, a.params); err != nil {
+			return nil, errors.Wrap(err, "invalid change address")
+		}
+	}
+
+	outputs := []*wire.TxOut{wire.NewTxOut(req.Amount.Int64(), toScript)}
+	if len(req.Data) > 0 {
+		dataScript, err := nullDataScript(req.Data)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, wire.NewTxOut(0, dataScript))
+	}
+
+	seen := make(map[string]struct{}, len(req.UTXOs))
+	for _, utxo := range req.UTXOs {
+		outpoint := fmt.Sprintf("%s:%d", strings.ToLower(utxo.TxID), utxo.Vout)
+		if _, ok := seen[outpoint]; ok {
+			return nil, errors.Errorf("duplicate utxo %s", outpoint)
+		}
+		seen[outpoint] = struct{}{}
+		if utxo.Amount <= 0 {
+			return nil, errors.Errorf("utxo %s:%d has invalid amount", utxo.TxID, utxo.Vout)
+		}
+		if utxo.ScriptPubKey != "" && !strings.EqualFold(utxo.ScriptPubKey, hex.EncodeToString(fromScript)) {
+			return nil, errors.Errorf("utxo %s:%d does not belong to from address", utxo.TxID, utxo.Vout)
+		}
+	}
+
+	params := &coinSelectionParams{
+		amount:       req.Amount.Int64(),
+		feeRate:      int64(req.FeeRate),
+		changeScript: changeScript,
+		inputWeight:  spend.inputWeight,
+	}
+	for _, out := range outputs {
+		params.outputs = append(params.outputs, out.PkScript)
+	}
+	selection, err := selectCoins(req.UTXOs, params, req.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(2)
+	for _, utxo := range selection.inputs {
+		hash, err := chainhash.NewHashFromStr(utxo.TxID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid utxo txid %s", utxo.TxID)
+		}
+		txIn := wire.NewTxIn(wire.NewOutPoint(hash, utxo.Vout), nil, nil)
+		// 启用 RBF（BIP-125），手续费不足时可替换
+		txIn.Sequence = wire.MaxTxInSequenceNum - 2
+		tx.AddTxIn(txIn)
+	}
+	for _, out := range outputs {
+		tx.AddTxOut(out)
+	}
+	if selection.change > 0 {
+		tx.AddTxOut(wire.NewTxOut(selection.change, changeScript))
+	}
+
+	packet, err := NewPSBT(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	prevOuts := make([]*wire.TxOut, len(selection.inputs))
+	for i, utxo := range selection.inputs {
+		prevOuts[i] = wire.NewTxOut(utxo.Amount, fromScript)
+		packet.Inputs[i].WitnessUtxo = prevOuts[i]
+		packet.Inputs[i].RedeemScript = spend.redeemScript
+		if !spend.taproot {
+			packet.Inputs[i].SighashType = SigHashAll
+		}
+	}
+	sigHashes := make([][]byte, len(selection.inputs))
+	for i := range selection.inputs {
+		if sigHashes[i], err = spend.sigHash(tx, i, prevOuts); err != nil {
+			return nil, errors.Wrapf(err, "failed to compute sighash for input %d", i)
+		}
+	}
+
+	encoded, err := packet.B64Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	// 嵌套 SegWit 的 scriptSig 计入 txid，但内容与签名无关，按最终的 scriptSig 计算交易 ID
+	signedShape := tx
+	if len(spend.redeemScript) > 0 {
+		signedShape = tx.Copy()
+		for _, txIn := range signedShape.TxIn {
+			txIn.SignatureScript = spend.scriptSig()
+		}
+	}
+
+	return &Transaction{
+		Raw:           encoded,
+		Hash:          signedShape.TxHash().String(),
+		SigningHashes: sigHashes,
+		Fee:           big.NewInt(selection.fee),
+	}, nil
+}
+
+// FinalizeTransaction 将阈值签名写入 BuildTransaction 生成的 PSBT 并提取可广播的交易
+// signatures 按输入顺序排列：SegWit v0 输入为 DER 编码或 64 字节 r||s 的 ECDSA 签名，会被规范化为 low-S；
+// P2TR 输入为 64 字节 BIP-340 Schnorr 签名，必须能用 BIP-86 调整后的输出公钥验证。所有签名都会先校验
+// 返回的 Raw 为十六进制编码的已签名交易
+func (a *BitcoinAdapter) FinalizeTransaction(encodedPSBT string, pubKey []byte, signatures [][]byte) (*Transaction, error) {
+	packet, err := ParsePSBTBase64(encodedPSBT)
+	if err != nil {
+		return nil, err
+	}
+	if len(signatures) != len(packet.Inputs) {
+		return nil, errors.Errorf("expected %d signatures, got %d", len(packet.Inputs), len(signatures))
+	}
+
+	publicKey, err := btcec.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	compressed := publicKey.SerializeCompressed()
+
+	// Taproot sighash 覆盖所有输入花费的 UTXO
+	prevOuts := make([]*wire.TxOut, len(packet.Inputs))
+	for i := range packet.Inputs {
+		if packet.Inputs[i].WitnessUtxo == nil {
+			return nil, errors.Errorf("input %d is missing its witness utxo", i)
+		}
+		prevOuts[i] = packet.Inputs[i].WitnessUtxo
+	}
+
+	for i := range packet.Inputs {
+		in := &packet.Inputs[i]
+		if in.IsFinalized() {
+			continue
+		}
+		if !scriptMatchesPubKey(in.WitnessUtxo.PkScript, compressed) {
+			return nil, errors.Errorf("input %d is not spendable by the public key", i)
+		}
+		spend, err := newBitcoinSpend(in.WitnessUtxo.PkScript, compressed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "input %d", i)
+		}
+		if len(in.RedeemScript) > 0 && !bytes.Equal(in.RedeemScript, spend.redeemScript) {
+			return nil, errors.Errorf("input %d has an unexpected redeem script", i)
+		}
+		if expected := spend.sigHashType(); in.SighashType != 0 && in.SighashType != expected {
+			return nil, errors.Errorf("input %d uses unsupported sighash type %d", i, in.SighashType)
+		}
+
+		sigHash, err := spend.sigHash(packet.UnsignedTx, i, prevOuts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compute sighash for input %d", i)
+		}
+
+		if spend.taproot {
+			sig, err := schnorr.ParseSignature(signatures[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid signature for input %d", i)
+			}
+			outputKey, err := schnorr.ParsePubKey(in.WitnessUtxo.PkScript[2:])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid taproot output key for input %d", i)
+			}
+			if !sig.Verify(sigHash, outputKey) {
+				return nil, errors.Errorf("signature for input %d does not verify", i)
+			}
+			// SIGHASH_DEFAULT 的签名不附加类型字节
+			in.FinalScriptWitness = wire.TxWitness{sig.Serialize()}
+			continue
+		}
+
+		sig, err := parseECDSASignature(signatures[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid signature for input %d", i)
+		}
+		if !sig.Verify(sigHash, publicKey) {
+			return nil, errors.Errorf("signature for input %d does not verify", i)
+		}
+
+		// Serialize 输出规范的 low-S DER 编码（BIP-62），末尾追加 sighash 类型
+		sigBytes := append(sig.Serialize(), byte(SigHashAll))
+		in.FinalScriptWitness = wire.TxWitness{sigBytes, compressed}
+		if len(spend.redeemScript) > 0 {
+			in.FinalScriptSig = spend.scriptSig()
+		}
+	}
+
+	signedTx, err := packet.Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := signedTx.Serialize(&buf); err != nil {
+		return nil, errors.Wrap(err, "failed to serialize signed transaction")
+	}
 
-	hash := chainhash.DoubleHashH([]byte(raw)).String()
 	return &Transaction{
-		Raw:  raw,
-		Hash: hash,
+		Raw:  hex.EncodeToString(buf.Bytes()),
+		Hash: signedTx.TxHash().String(),
 	}, nil
 }
+
+// bitcoinSpend 花费某种输出脚本所需的签名信息
+type bitcoinSpend struct {
+	taproot      bool   // P2TR 密钥路径，使用 BIP-341 sighash 和 Schnorr 签名
+	redeemScript []byte // P2SH-P2WPKH 的 redeemScript，其他类型为空
+	scriptCode   []byte // SegWit v0 输入的 BIP-143 scriptCode
+	inputWeight  int64  // 手续费估算使用的输入重量
+}
+
+// newBitcoinSpend 解析 BuildTransaction 能够花费的输出脚本
+// P2SH 输出只能是压缩公钥 pubKey 对应的 P2SH-P2WPKH，pubKey 为空时无法花费
+func newBitcoinSpend(pkScript []byte, pubKey []byte) (*bitcoinSpend, error) {
+	switch {
+	case isP2WPKHScript(pkScript):
+		scriptCode, err := scriptCodeForP2WPKH(pkScript)
+		if err != nil {
+			return nil, err
+		}
+		return &bitcoinSpend{scriptCode: scriptCode, inputWeight: p2wpkhInputWeight}, nil
+	case isP2SHScript(pkScript):
+		if len(pubKey) == 0 {
+			return nil, errors.New("public key is required to spend a P2SH-P2WPKH address")
+		}
+		publicKey, err := btcec.ParsePubKey(pubKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid public key")
+		}
+		redeemScript := p2shP2WPKHRedeemScript(publicKey.SerializeCompressed())
+		if !bytes.Equal(pkScript, p2shScript(hash160(redeemScript))) {
+			return nil, errors.New("P2SH address is not the P2SH-P2WPKH address of the public key")
+		}
+		scriptCode, err := scriptCodeForP2WPKH(redeemScript)
+		if err != nil {
+			return nil, err
+		}
+		return &bitcoinSpend{redeemScript: redeemScript, scriptCode: scriptCode, inputWeight: p2shP2WPKHInputWeight}, nil
+	case isP2TRScript(pkScript):
+		return &bitcoinSpend{taproot: true, inputWeight: p2trInputWeight}, nil
+	default:
+		return nil, errors.New("from address must be a P2WPKH, P2SH-P2WPKH or P2TR address")
+	}
+}
+
+// sigHashType 输入使用的 sighash 类型
+func (sp *bitcoinSpend) sigHashType() uint32 {
+	if sp.taproot {
+		return SigHashDefault
+	}
+	return SigHashAll
+}
+
+// scriptSig 嵌套 SegWit 输入的 scriptSig，只推送 redeemScript
+func (sp *bitcoinSpend) scriptSig() []byte {
+	return append([]byte{byte(len(sp.redeemScript))}, sp.redeemScript...)
+}
+
+// sigHash 计算第 idx 个输入的签名摘要，prevOuts 为所有输入花费的 UTXO
+func (sp *bitcoinSpend) sigHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut) ([]byte, error) {
+	if sp.taproot {
+		return calcTaprootSigHash(tx, idx, prevOuts)
+	}
+	return calcWitnessV0SigHash(tx, idx, sp.scriptCode, prevOuts[idx].Value)
+}
+
+// parseECDSASignature 解析 DER 编码或 64 字节 r||s 格式的 ECDSA 签名
+func parseECDSASignature(sig []byte) (*ecdsa.Signature, error) {
+	if len(sig) == 64 {
+		var r, s btcec.ModNScalar
+		if r.SetByteSlice(sig[:32]) || s.SetByteSlice(sig[32:]) || r.IsZero() || s.IsZero() {
+			return nil, errors.New("signature r or s out of range")
+		}
+		return ecdsa.NewSignature(&r, &s), nil
+	}
+	return ecdsa.ParseDERSignature(sig)
+}
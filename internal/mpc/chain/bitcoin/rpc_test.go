@@ -0,0 +1,66 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCClientEstimateSmartFee(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "rpcuser", user)
+		assert.Equal(t, "rpcpass", password)
+
+		var req RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "estimatesmartfee", req.Method)
+		assert.Equal(t, []interface{}{float64(6)}, req.Params)
+		_, _ = w.Write([]byte(`{"result":{"feerate":0.00012345,"blocks":6},"error":null,"id":1}`))
+	}))
+	defer node.Close()
+
+	endpoint := "http://rpcuser:rpcpass@" + node.Listener.Addr().String()
+	estimate, err := NewRPCClient(endpoint).EstimateSmartFee(context.Background(), 6)
+	require.NoError(t, err)
+	assert.Equal(t, "0.00012345", estimate.FeeRate.String())
+	assert.Equal(t, uint64(6), estimate.Blocks)
+}
+
+func TestRPCClientErrorDoesNotFailover(t *testing.T) {
+	// Bitcoin Core 对 RPC 错误返回 HTTP 500
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"result":null,"error":{"code":-8,"message":"Invalid conf_target"},"id":1}`))
+	}))
+	defer rejecting.Close()
+
+	var secondCalls atomic.Int32
+	second := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secondCalls.Add(1)
+		_, _ = w.Write([]byte(`{"result":{"feerate":0.0001,"blocks":2},"error":null,"id":1}`))
+	}))
+	defer second.Close()
+
+	_, err := NewRPCClient(rejecting.URL, second.URL).EstimateSmartFee(context.Background(), 2000)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid conf_target")
+	assert.Equal(t, int32(0), secondCalls.Load())
+
+	// 没有 RPC 错误的 5xx 响应视为端点不可用
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	estimate, err := NewRPCClient(unavailable.URL, second.URL).EstimateSmartFee(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), estimate.Blocks)
+}
@@ -0,0 +1,124 @@
+package chain
+
+import (
+	"crypto/sha256"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/pkg/errors"
+)
+
+// BitcoinAddressType Bitcoin 地址类型
+type BitcoinAddressType string
+
+const (
+	// BitcoinAddressP2PKH 传统地址（Base58，1...）
+	BitcoinAddressP2PKH BitcoinAddressType = "p2pkh"
+	// BitcoinAddressP2WPKH 原生 SegWit 地址（Bech32，bc1q...）
+	BitcoinAddressP2WPKH BitcoinAddressType = "p2wpkh"
+	// BitcoinAddressP2SHP2WPKH 嵌套 SegWit 地址（Base58，3...），兼容不支持 Bech32 的钱包
+	BitcoinAddressP2SHP2WPKH BitcoinAddressType = "p2sh-p2wpkh"
+	// BitcoinAddressP2TR Taproot 地址（Bech32m，bc1p...），BIP-86 单密钥路径
+	BitcoinAddressP2TR BitcoinAddressType = "p2tr"
+)
+
+// ParseBitcoinAddressType 解析地址类型（不区分大小写）
+func ParseBitcoinAddressType(addressType string) (BitcoinAddressType, error) {
+	switch t := BitcoinAddressType(strings.ToLower(addressType)); t {
+	case BitcoinAddressP2PKH, BitcoinAddressP2WPKH, BitcoinAddressP2SHP2WPKH, BitcoinAddressP2TR:
+		return t, nil
+	default:
+		return "", errors.Errorf("unsupported bitcoin address type: %s", addressType)
+	}
+}
+
+// Spendable BuildTransaction 能否花费该类型地址的输出，P2PKH 输出不能花费
+func (t BitcoinAddressType) Spendable() bool {
+	return t == BitcoinAddressP2WPKH || t == BitcoinAddressP2SHP2WPKH || t == BitcoinAddressP2TR
+}
+
+// SignatureAlgorithm 花费该类型输出需要的钱包签名算法：P2TR 为 Schnorr（BIP-340），其余为 ECDSA
+func (t BitcoinAddressType) SignatureAlgorithm() string {
+	if t == BitcoinAddressP2TR {
+		return "Schnorr"
+	}
+	return "ECDSA"
+}
+
+// BitcoinNetworkParams 根据网络名称返回链参数：mainnet、testnet（testnet3）、testnet4、signet、regtest
+func BitcoinNetworkParams(network string) (*chaincfg.Params, error) {
+	switch strings.ToLower(network) {
+	case "", "mainnet", "main":
+		return &chaincfg.MainNetParams, nil
+	case "testnet", "testnet3":
+		return &chaincfg.TestNet3Params, nil
+	case "testnet4":
+		return &chaincfg.TestNet4Params, nil
+	case "signet":
+		return &chaincfg.SigNetParams, nil
+	case "regtest":
+		return &chaincfg.RegressionNetParams, nil
+	default:
+		return nil, errors.Errorf("unsupported bitcoin network: %s", network)
+	}
+}
+
+// generateSegWitAddress 按地址类型为压缩公钥生成 SegWit 地址
+func generateSegWitAddress(pubKey *btcec.PublicKey, addressType BitcoinAddressType, params *chaincfg.Params) (string, error) {
+	compressed := pubKey.SerializeCompressed()
+
+	switch addressType {
+	case BitcoinAddressP2WPKH:
+		return encodeSegWitAddress(params.Bech32HRPSegwit, 0, hash160(compressed))
+	case BitcoinAddressP2SHP2WPKH:
+		// redeemScript 为 P2WPKH 输出脚本，地址为其 hash160 的 P2SH 地址
+		redeemScript := p2shP2WPKHRedeemScript(compressed)
+		return base58.CheckEncode(hash160(redeemScript), params.ScriptHashAddrID), nil
+	case BitcoinAddressP2TR:
+		outputKey, err := taprootOutputKey(pubKey)
+		if err != nil {
+			return "", err
+		}
+		return encodeSegWitAddress(params.Bech32HRPSegwit, 1, outputKey)
+	default:
+		return "", errors.Errorf("unsupported bitcoin address type: %s", addressType)
+	}
+}
+
+// taprootOutputKey 按 BIP-86 计算没有脚本路径的 Taproot 输出公钥（x-only）：
+// Q = P + int(hash_TapTweak(bytes(P)))·G，其中 P 为 y 坐标为偶数的内部公钥
+func taprootOutputKey(pubKey *btcec.PublicKey) ([]byte, error) {
+	internalKey := pubKey.SerializeCompressed()[1:]
+	evenKey, err := btcec.ParsePubKey(append([]byte{0x02}, internalKey...))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid taproot internal key")
+	}
+
+	var tweak btcec.ModNScalar
+	if overflow := tweak.SetByteSlice(taggedHash("TapTweak", internalKey)); overflow {
+		return nil, errors.New("taproot tweak exceeds curve order")
+	}
+
+	var p, tweakPoint, q btcec.JacobianPoint
+	evenKey.AsJacobian(&p)
+	btcec.ScalarBaseMultNonConst(&tweak, &tweakPoint)
+	btcec.AddNonConst(&p, &tweakPoint, &q)
+	if (q.X.IsZero() && q.Y.IsZero()) || q.Z.IsZero() {
+		return nil, errors.New("taproot output key is infinity")
+	}
+	q.ToAffine()
+
+	return btcec.NewPublicKey(&q.X, &q.Y).SerializeCompressed()[1:], nil
+}
+
+// taggedHash BIP-340 标签哈希：SHA256(SHA256(tag) || SHA256(tag) || msg)
+func taggedHash(tag string, msg []byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	h.Write(msg)
+	return h.Sum(nil)
+}
@@ -0,0 +1,119 @@
+package chain
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitcoinGenerateAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		addressType BitcoinAddressType
+		params      *chaincfg.Params
+		pubKey      string
+		address     string
+	}{
+		// BIP-84 m/84'/0'/0'/0/0
+		{"p2wpkh", BitcoinAddressP2WPKH, &chaincfg.MainNetParams, "0330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c", "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		// BIP-49 m/49'/1'/0'/0/0（testnet）
+		{"p2sh-p2wpkh", BitcoinAddressP2SHP2WPKH, &chaincfg.TestNet3Params, "03a1af804ac108a8a51782198c2d034b28bf90c8803f5a53f76276fa69a4eae77f", "2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2"},
+		// BIP-86 m/86'/0'/0'/0/0
+		{"p2tr", BitcoinAddressP2TR, &chaincfg.MainNetParams, "02cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115", "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"},
+		{"p2pkh", BitcoinAddressP2PKH, &chaincfg.MainNetParams, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubKey, err := hex.DecodeString(tt.pubKey)
+			require.NoError(t, err)
+
+			address, err := NewBitcoinAdapterWithAddressType(tt.params, tt.addressType).GenerateAddress(pubKey)
+			require.NoError(t, err)
+			assert.Equal(t, tt.address, address)
+
+			// 生成的地址可以作为交易输出
+			_, err = addressToScript(address, tt.params)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestBitcoinTaprootOutputKey(t *testing.T) {
+	// BIP-86 m/86'/0'/0'/0/0，内部公钥 y 坐标为奇数时结果相同
+	for _, prefix := range []string{"02", "03"} {
+		pubKey, err := hex.DecodeString(prefix + "cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+		require.NoError(t, err)
+
+		address, err := NewBitcoinAdapterWithAddressType(&chaincfg.MainNetParams, BitcoinAddressP2TR).GenerateAddress(pubKey)
+		require.NoError(t, err)
+
+		script, err := addressToScript(address, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		assert.Equal(t, "5120a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c", hex.EncodeToString(script))
+	}
+}
+
+func TestDecodeSegWitAddress(t *testing.T) {
+	// BIP-173 / BIP-350 测试向量
+	valid := []struct {
+		hrp     string
+		address string
+		script  string
+	}{
+		{"bc", "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "0014751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"tb", "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"},
+		{"bc", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", "512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+		{"tb", "tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c", "5120000000c4a5cad46221b2a187905e5266362b99d5e91c6ce24d165dab93e86433"},
+	}
+	for _, tt := range valid {
+		version, program, err := decodeSegWitAddress(tt.hrp, tt.address)
+		require.NoError(t, err, tt.address)
+		assert.Equal(t, tt.script, hex.EncodeToString(witnessProgramScript(version, program)), tt.address)
+	}
+
+	program, err := convertBits(make([]byte, 32), 8, 5, true)
+	require.NoError(t, err)
+
+	invalid := []struct {
+		hrp     string
+		address string
+	}{
+		// v0 地址使用 Bech32m 校验和
+		{"bc", bech32Encode("bc", append([]byte{0}, program...), bech32mConst)},
+		// v1 地址使用 Bech32 校验和
+		{"bc", bech32Encode("bc", append([]byte{1}, program...), bech32Const)},
+		// 校验和错误
+		{"bc", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5"},
+		// 大小写混合
+		{"tb", "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sL5k7"},
+		// 网络不匹配
+		{"bc", "tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c"},
+		// 程序长度无效
+		{"bc", "bc1pw5dgrnzv"},
+	}
+	for _, tt := range invalid {
+		_, _, err := decodeSegWitAddress(tt.hrp, tt.address)
+		assert.Error(t, err, tt.address)
+	}
+}
+
+func TestBitcoinNetworkParams(t *testing.T) {
+	for network, hrp := range map[string]string{"": "bc", "mainnet": "bc", "testnet": "tb", "testnet4": "tb", "signet": "tb", "regtest": "bcrt"} {
+		params, err := BitcoinNetworkParams(network)
+		require.NoError(t, err)
+		assert.Equal(t, hrp, params.Bech32HRPSegwit)
+	}
+
+	_, err := BitcoinNetworkParams("litecoin")
+	assert.Error(t, err)
+
+	addressType, err := ParseBitcoinAddressType("P2TR")
+	require.NoError(t, err)
+	assert.Equal(t, BitcoinAddressP2TR, addressType)
+	_, err = ParseBitcoinAddressType("p2wsh")
+	assert.Error(t, err)
+}
@@ -0,0 +1,182 @@
+package chain
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SegWit 地址编码：v0 使用 Bech32（BIP-173），v1 及以上使用 Bech32m（BIP-350）
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+
+	// bech32MaxLength SegWit 地址最大长度
+	bech32MaxLength = 90
+)
+
+var bech32Generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= bech32Generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// bech32Encode 编码 5 位分组数据，checksumConst 区分 Bech32 与 Bech32m
+func bech32Encode(hrp string, data []byte, checksumConst uint32) string {
+	values := append(bech32HRPExpand(hrp), data...)
+	polymod := bech32Polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ checksumConst
+
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, d := range data {
+		sb.WriteByte(bech32Charset[d])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	return sb.String()
+}
+
+// bech32Decode 解码 Bech32/Bech32m 字符串，返回 hrp、5 位分组数据（不含校验和）和校验常量
+func bech32Decode(s string) (string, []byte, uint32, error) {
+	if len(s) > bech32MaxLength {
+		return "", nil, 0, errors.New("bech32 string too long")
+	}
+	lower := strings.ToLower(s)
+	if lower != s && strings.ToUpper(s) != s {
+		return "", nil, 0, errors.New("bech32 string has mixed case")
+	}
+
+	sep := strings.LastIndexByte(lower, '1')
+	if sep < 1 || sep+7 > len(lower) {
+		return "", nil, 0, errors.New("invalid bech32 separator position")
+	}
+
+	hrp := lower[:sep]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, errors.New("invalid bech32 human-readable part")
+		}
+	}
+
+	data := make([]byte, 0, len(lower)-sep-1)
+	for i := sep + 1; i < len(lower); i++ {
+		idx := strings.IndexByte(bech32Charset, lower[i])
+		if idx < 0 {
+			return "", nil, 0, errors.Errorf("invalid bech32 character %q", lower[i])
+		}
+		data = append(data, byte(idx))
+	}
+
+	checksumConst := bech32Polymod(append(bech32HRPExpand(hrp), data...))
+	if checksumConst != bech32Const && checksumConst != bech32mConst {
+		return "", nil, 0, errors.New("invalid bech32 checksum")
+	}
+
+	return hrp, data[:len(data)-6], checksumConst, nil
+}
+
+// convertBits 在不同位宽的分组之间转换（8 位 <-> 5 位）
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var (
+		acc    uint32
+		bits   uint
+		result []byte
+	)
+	maxv := uint32(1)<<toBits - 1
+	for _, v := range data {
+		if uint32(v)>>fromBits != 0 {
+			return nil, errors.New("invalid data range")
+		}
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxv))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, errors.New("invalid padding")
+	}
+	return result, nil
+}
+
+// encodeSegWitAddress 编码 SegWit 地址
+func encodeSegWitAddress(hrp string, version byte, program []byte) (string, error) {
+	data, err := convertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	checksumConst := uint32(bech32Const)
+	if version > 0 {
+		checksumConst = bech32mConst
+	}
+	address := bech32Encode(hrp, append([]byte{version}, data...), checksumConst)
+
+	// 编码结果必须能按规则解码回来（校验版本与程序长度）
+	if _, _, err := decodeSegWitAddress(hrp, address); err != nil {
+		return "", err
+	}
+	return address, nil
+}
+
+// decodeSegWitAddress 解码 SegWit 地址，返回见证版本和见证程序
+func decodeSegWitAddress(hrp string, address string) (byte, []byte, error) {
+	decodedHRP, data, checksumConst, err := bech32Decode(address)
+	if err != nil {
+		return 0, nil, err
+	}
+	if decodedHRP != hrp {
+		return 0, nil, errors.Errorf("unexpected human-readable part %q", decodedHRP)
+	}
+	if len(data) == 0 || data[0] > 16 {
+		return 0, nil, errors.New("invalid witness version")
+	}
+
+	version := data[0]
+	program, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return 0, nil, errors.Errorf("invalid witness program length %d", len(program))
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return 0, nil, errors.Errorf("invalid witness v0 program length %d", len(program))
+	}
+	if (version == 0 && checksumConst != bech32Const) || (version != 0 && checksumConst != bech32mConst) {
+		return 0, nil, errors.New("invalid checksum variant for witness version")
+	}
+
+	return version, program, nil
+}
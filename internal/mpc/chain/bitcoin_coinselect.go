@@ -0,0 +1,230 @@
+package chain
+
+import (
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+)
+
+// CoinSelectionStrategy UTXO 选币策略
+type CoinSelectionStrategy string
+
+const (
+	// CoinSelectionLargestFirst 按金额从大到小选择，直到覆盖金额和手续费
+	CoinSelectionLargestFirst CoinSelectionStrategy = "largest-first"
+	// CoinSelectionBranchAndBound 搜索无需找零的精确组合，找不到时回退到 largest-first
+	CoinSelectionBranchAndBound CoinSelectionStrategy = "branch-and-bound"
+)
+
+const (
+	// dustLimit 低于该金额的输出不会被节点转发（P2PKH 标准粉尘阈值），找零低于它时并入手续费
+	dustLimit int64 = 546
+
+	// bnbMaxTries branch-and-bound 最多搜索的节点数
+	bnbMaxTries = 100000
+
+	// 交易重量（weight unit）估算，vsize = ceil(weight / 4)
+	// 固定部分：version(4) + locktime(4) 为非见证数据，SegWit marker+flag 为见证数据
+	txOverheadWeight = (4+4)*4 + 2
+	// P2WPKH 输入：outpoint(36) + scriptSig 长度(1) + sequence(4) 为非见证数据，
+	// witness 为 项数(1) + 签名(1+72) + 压缩公钥(1+33)
+	p2wpkhInputWeight = (36+1+4)*4 + (1 + 1 + 72 + 1 + 33)
+	// P2SH-P2WPKH 输入：在 P2WPKH 的基础上 scriptSig 为 redeemScript 的推送（1+22）
+	p2shP2WPKHInputWeight = (36+1+23+4)*4 + (1 + 1 + 72 + 1 + 33)
+	// P2TR 密钥路径输入：witness 为 项数(1) + Schnorr 签名(1+64)
+	p2trInputWeight = (36+1+4)*4 + (1 + 1 + 64)
+)
+
+// ErrInsufficientFunds UTXO 不足以支付金额和手续费
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// UTXO 可花费的交易输出
+type UTXO struct {
+	TxID         string // 交易 ID（区块浏览器中的十六进制格式）
+	Vout         uint32
+	Amount       int64  // satoshi
+	ScriptPubKey string // 十六进制输出脚本，为空时视为属于 From 地址
+}
+
+// coinSelectionParams 选币所需的交易参数
+type coinSelectionParams struct {
+	amount       int64    // 需要支付给接收方的总金额
+	feeRate      int64    // sat/vB
+	outputs      [][]byte // 除找零外的输出脚本
+	changeScript []byte
+	inputWeight  int64 // 每个输入的重量，为 0 时按 P2WPKH 估算
+}
+
+// coinSelection 选币结果
+type coinSelection struct {
+	inputs []UTXO
+	fee    int64
+	change int64 // 0 表示没有找零输出
+}
+
+// txOutputWeight 一个输出的重量：金额(8) + 脚本长度 + 脚本
+func txOutputWeight(pkScript []byte) int64 {
+	return int64(8+wire.VarIntSerializeSize(uint64(len(pkScript)))+len(pkScript)) * 4
+}
+
+// spendWeight 花费一个输入的重量
+func (p *coinSelectionParams) spendWeight() int64 {
+	if p.inputWeight == 0 {
+		return p2wpkhInputWeight
+	}
+	return p.inputWeight
+}
+
+// estimateFee 估算包含 numInputs 个输入的交易手续费
+func (p *coinSelectionParams) estimateFee(numInputs int, withChange bool) int64 {
+	numOutputs := len(p.outputs)
+	weight := int64(txOverheadWeight)
+	weight += int64(wire.VarIntSerializeSize(uint64(numInputs))) * 4
+	weight += int64(numInputs) * p.spendWeight()
+	for _, script := range p.outputs {
+		weight += txOutputWeight(script)
+	}
+	if withChange {
+		numOutputs++
+		weight += txOutputWeight(p.changeScript)
+	}
+	weight += int64(wire.VarIntSerializeSize(uint64(numOutputs))) * 4
+
+	vsize := (weight + 3) / 4
+	return vsize * p.feeRate
+}
+
+// selectCoins 按策略选择 UTXO
+func selectCoins(utxos []UTXO, params *coinSelectionParams, strategy CoinSelectionStrategy) (*coinSelection, error) {
+	if params.amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	if params.feeRate <= 0 {
+		return nil, errors.New("fee rate must be positive")
+	}
+
+	// 从大到小排序，两种策略都依赖该顺序
+	sorted := make([]UTXO, len(utxos))
+	copy(sorted, utxos)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Amount > sorted[j].Amount
+	})
+
+	switch strategy {
+	case "", CoinSelectionBranchAndBound:
+		if selection := selectBranchAndBound(sorted, params); selection != nil {
+			return selection, nil
+		}
+		return selectLargestFirst(sorted, params)
+	case CoinSelectionLargestFirst:
+		return selectLargestFirst(sorted, params)
+	default:
+		return nil, errors.Errorf("unknown coin selection strategy %q", strategy)
+	}
+}
+
+// selectLargestFirst 依次加入最大的 UTXO，直到足以支付金额、手续费以及（可选的）找零输出
+// 剩余金额不足以构成非粉尘找零时，直接计入手续费
+func selectLargestFirst(sorted []UTXO, params *coinSelectionParams) (*coinSelection, error) {
+	var total int64
+	for i, utxo := range sorted {
+		total += utxo.Amount
+		n := i + 1
+
+		feeWithChange := params.estimateFee(n, true)
+		if change := total - params.amount - feeWithChange; change >= dustLimit {
+			return &coinSelection{inputs: sorted[:n], fee: feeWithChange, change: change}, nil
+		}
+		if total >= params.amount+params.estimateFee(n, false) {
+			return &coinSelection{inputs: sorted[:n], fee: total - params.amount}, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrInsufficientFunds, "available %d sat, required at least %d sat plus fees", total, params.amount)
+}
+
+// selectBranchAndBound 深度优先搜索一组 UTXO，使其有效金额（金额减去花费该输入的手续费）
+// 落在 [目标, 目标 + 找零成本] 区间内，从而不需要找零输出；多余部分计入手续费
+// 返回超出目标最少的组合，找不到时返回 nil
+func selectBranchAndBound(sorted []UTXO, params *coinSelectionParams) *coinSelection {
+	inputFee := (params.spendWeight() + 3) / 4 * params.feeRate
+
+	// 有效金额为负的 UTXO 只会增加成本，不参与搜索
+	var (
+		candidates []UTXO
+		values     []int64
+	)
+	for _, utxo := range sorted {
+		if value := utxo.Amount - inputFee; value > 0 {
+			candidates = append(candidates, utxo)
+			values = append(values, value)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// 目标包含除输入以外的手续费；找零成本为增加找零输出及将来花费它的手续费
+	target := params.amount + params.estimateFee(0, false)
+	costOfChange := params.estimateFee(0, true) - params.estimateFee(0, false) + inputFee
+	upper := target + costOfChange
+
+	remaining := make([]int64, len(values)+1)
+	for i := len(values) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + values[i]
+	}
+	if remaining[0] < target {
+		return nil
+	}
+
+	var (
+		selected   []int
+		best       []int
+		bestExcess int64 = -1
+		tries      int
+	)
+
+	var search func(i int, sum int64)
+	search = func(i int, sum int64) {
+		tries++
+		if tries > bnbMaxTries || bestExcess == 0 {
+			return
+		}
+		if sum >= target {
+			// 继续加入只会增加超出部分
+			if sum <= upper && (bestExcess < 0 || sum-target < bestExcess) {
+				bestExcess = sum - target
+				best = append(best[:0], selected...)
+			}
+			return
+		}
+		if i == len(values) || sum+remaining[i] < target {
+			return
+		}
+
+		selected = append(selected, i)
+		search(i+1, sum+values[i])
+		selected = selected[:len(selected)-1]
+
+		// 不选 i 时，跳过与它金额相同的 UTXO，避免重复搜索等价组合
+		next := i + 1
+		for next < len(values) && values[next] == values[i] {
+			next++
+		}
+		search(next, sum)
+	}
+	search(0, 0)
+
+	if best == nil {
+		return nil
+	}
+
+	inputs := make([]UTXO, len(best))
+	var total int64
+	for i, idx := range best {
+		inputs[i] = candidates[idx]
+		total += candidates[idx].Amount
+	}
+	return &coinSelection{inputs: inputs, fee: total - params.amount}
+}
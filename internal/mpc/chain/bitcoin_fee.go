@@ -0,0 +1,64 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// 慢、正常、快三个档位的目标确认区块数
+const (
+	bitcoinSlowTargetBlocks   = 24
+	bitcoinNormalTargetBlocks = 6
+	bitcoinFastTargetBlocks   = 2
+)
+
+// satPerVBytePerBTCPerKvB 1 BTC/kvB = 1e8 sat / 1000 vB
+var satPerVBytePerBTCPerKvB = big.NewRat(100000, 1)
+
+// EstimateFees 通过节点的 estimatesmartfee 估算各档位的 sat/vB 费率（向上取整，最低 1 sat/vB）
+func (a *BitcoinAdapter) EstimateFees(ctx context.Context) (*FeeEstimate, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+
+	estimate := &FeeEstimate{Unit: FeeUnitSatPerVByte}
+	for _, target := range []struct {
+		blocks uint64
+		tier   **FeeTier
+	}{
+		{bitcoinSlowTargetBlocks, &estimate.Slow},
+		{bitcoinNormalTargetBlocks, &estimate.Normal},
+		{bitcoinFastTargetBlocks, &estimate.Fast},
+	} {
+		smartFee, err := a.rpcClient.EstimateSmartFee(ctx, target.blocks)
+		if err != nil {
+			return nil, err
+		}
+		if smartFee.FeeRate == "" {
+			return nil, errors.Errorf("node cannot estimate fee for %d blocks: %v", target.blocks, smartFee.Errors)
+		}
+
+		rate, ok := new(big.Rat).SetString(smartFee.FeeRate.String())
+		if !ok || rate.Sign() < 0 {
+			return nil, errors.Errorf("invalid fee rate %s", smartFee.FeeRate)
+		}
+		rate.Mul(rate, satPerVBytePerBTCPerKvB)
+		satPerVByte, remainder := new(big.Int).QuoRem(rate.Num(), rate.Denom(), new(big.Int))
+		if remainder.Sign() > 0 {
+			satPerVByte.Add(satPerVByte, big.NewInt(1))
+		}
+		if satPerVByte.Sign() == 0 {
+			satPerVByte.SetInt64(1)
+		}
+
+		blocks := smartFee.Blocks
+		if blocks == 0 {
+			blocks = target.blocks
+		}
+		*target.tier = &FeeTier{FeeRate: satPerVByte, TargetBlocks: blocks}
+	}
+
+	return estimate, nil
+}
@@ -0,0 +1,361 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+)
+
+// BIP-174 PSBT（v0）的最小实现：只解析和生成本服务用到的字段，其余键值对原样保留
+
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff} // "psbt" 0xff
+
+// PSBT 键类型
+const (
+	psbtGlobalUnsignedTx = 0x00
+
+	psbtInWitnessUtxo        = 0x01
+	psbtInPartialSig         = 0x02
+	psbtInSighashType        = 0x03
+	psbtInRedeemScript       = 0x04
+	psbtInFinalScriptSig     = 0x07
+	psbtInFinalScriptWitness = 0x08
+)
+
+// maxPSBTValueSize 单个键或值的最大长度，防止恶意输入导致超大分配
+const maxPSBTValueSize = 4 * 1024 * 1024
+
+// ErrInvalidPSBT PSBT 格式错误
+var ErrInvalidPSBT = errors.New("invalid psbt")
+
+// psbtUnknown 未识别的键值对，序列化时原样写回
+type psbtUnknown struct {
+	Key   []byte
+	Value []byte
+}
+
+// PartialSig 某个公钥对输入的签名（DER 编码 + sighash 类型字节）
+type PartialSig struct {
+	PubKey    []byte
+	Signature []byte
+}
+
+// PSBTInput 输入的签名数据
+type PSBTInput struct {
+	WitnessUtxo        *wire.TxOut
+	PartialSigs        []PartialSig
+	SighashType        uint32
+	RedeemScript       []byte
+	FinalScriptSig     []byte
+	FinalScriptWitness wire.TxWitness
+	unknowns           []psbtUnknown
+}
+
+// PSBTOutput 输出的附加数据，本服务不使用任何输出字段
+type PSBTOutput struct {
+	unknowns []psbtUnknown
+}
+
+// PSBT 部分签名的 Bitcoin 交易
+type PSBT struct {
+	UnsignedTx *wire.MsgTx
+	Inputs     []PSBTInput
+	Outputs    []PSBTOutput
+	unknowns   []psbtUnknown
+}
+
+// NewPSBT 基于未签名交易创建空的 PSBT，交易的 scriptSig 和 witness 必须为空
+func NewPSBT(tx *wire.MsgTx) (*PSBT, error) {
+	for _, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) > 0 || len(txIn.Witness) > 0 {
+			return nil, errors.Wrap(ErrInvalidPSBT, "unsigned transaction has signature data")
+		}
+	}
+	return &PSBT{
+		UnsignedTx: tx,
+		Inputs:     make([]PSBTInput, len(tx.TxIn)),
+		Outputs:    make([]PSBTOutput, len(tx.TxOut)),
+	}, nil
+}
+
+// ParsePSBTBase64 解析 Base64 编码的 PSBT
+func ParsePSBTBase64(encoded string) (*PSBT, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPSBT, err.Error())
+	}
+	return ParsePSBT(raw)
+}
+
+// ParsePSBT 解析二进制 PSBT
+func ParsePSBT(raw []byte) (*PSBT, error) {
+	r := bytes.NewReader(raw)
+
+	magic := make([]byte, len(psbtMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, psbtMagic) {
+		return nil, errors.Wrap(ErrInvalidPSBT, "bad magic")
+	}
+
+	p := &PSBT{}
+	err := readPSBTMap(r, func(key, value []byte) error {
+		if key[0] != psbtGlobalUnsignedTx {
+			p.unknowns = append(p.unknowns, psbtUnknown{Key: key, Value: value})
+			return nil
+		}
+		if len(key) != 1 || p.UnsignedTx != nil {
+			return errors.Wrap(ErrInvalidPSBT, "invalid unsigned tx key")
+		}
+		tx := wire.NewMsgTx(wire.TxVersion)
+		if err := tx.DeserializeNoWitness(bytes.NewReader(value)); err != nil {
+			return errors.Wrap(ErrInvalidPSBT, err.Error())
+		}
+		p.UnsignedTx = tx
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if p.UnsignedTx == nil {
+		return nil, errors.Wrap(ErrInvalidPSBT, "missing unsigned tx")
+	}
+
+	p.Inputs = make([]PSBTInput, len(p.UnsignedTx.TxIn))
+	for i := range p.Inputs {
+		if err := p.Inputs[i].parse(r); err != nil {
+			return nil, errors.Wrapf(err, "input %d", i)
+		}
+	}
+
+	p.Outputs = make([]PSBTOutput, len(p.UnsignedTx.TxOut))
+	for i := range p.Outputs {
+		output := &p.Outputs[i]
+		err := readPSBTMap(r, func(key, value []byte) error {
+			output.unknowns = append(output.unknowns, psbtUnknown{Key: key, Value: value})
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "output %d", i)
+		}
+	}
+
+	return p, nil
+}
+
+func (in *PSBTInput) parse(r io.Reader) error {
+	return readPSBTMap(r, func(key, value []byte) error {
+		keyData := key[1:]
+		switch key[0] {
+		case psbtInWitnessUtxo:
+			if len(keyData) != 0 {
+				return errors.Wrap(ErrInvalidPSBT, "invalid witness utxo key")
+			}
+			txOut, err := readTxOut(value)
+			if err != nil {
+				return err
+			}
+			in.WitnessUtxo = txOut
+		case psbtInPartialSig:
+			if len(keyData) != 33 && len(keyData) != 65 {
+				return errors.Wrap(ErrInvalidPSBT, "invalid partial signature public key")
+			}
+			in.PartialSigs = append(in.PartialSigs, PartialSig{PubKey: keyData, Signature: value})
+		case psbtInSighashType:
+			if len(keyData) != 0 || len(value) != 4 {
+				return errors.Wrap(ErrInvalidPSBT, "invalid sighash type")
+			}
+			in.SighashType = binary.LittleEndian.Uint32(value)
+		case psbtInRedeemScript:
+			in.RedeemScript = value
+		case psbtInFinalScriptSig:
+			in.FinalScriptSig = value
+		case psbtInFinalScriptWitness:
+			witness, err := readWitness(value)
+			if err != nil {
+				return err
+			}
+			in.FinalScriptWitness = witness
+		default:
+			in.unknowns = append(in.unknowns, psbtUnknown{Key: key, Value: value})
+		}
+		return nil
+	})
+}
+
+// IsFinalized 输入是否已包含最终的 scriptSig 或 witness
+func (in *PSBTInput) IsFinalized() bool {
+	return len(in.FinalScriptSig) > 0 || len(in.FinalScriptWitness) > 0
+}
+
+// Serialize 序列化为二进制 PSBT
+func (p *PSBT) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(psbtMagic)
+
+	var tx bytes.Buffer
+	if err := p.UnsignedTx.SerializeNoWitness(&tx); err != nil {
+		return nil, errors.Wrap(err, "failed to serialize unsigned tx")
+	}
+	writePSBTPair(&buf, []byte{psbtGlobalUnsignedTx}, tx.Bytes())
+	writePSBTUnknowns(&buf, p.unknowns)
+	buf.WriteByte(0x00)
+
+	for i := range p.Inputs {
+		if err := p.Inputs[i].serialize(&buf); err != nil {
+			return nil, errors.Wrapf(err, "input %d", i)
+		}
+	}
+	for _, output := range p.Outputs {
+		writePSBTUnknowns(&buf, output.unknowns)
+		buf.WriteByte(0x00)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// B64Encode 序列化为 Base64 编码的 PSBT
+func (p *PSBT) B64Encode() (string, error) {
+	raw, err := p.Serialize()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+func (in *PSBTInput) serialize(buf *bytes.Buffer) error {
+	if in.WitnessUtxo != nil {
+		var txOut bytes.Buffer
+		if err := wire.WriteTxOut(&txOut, 0, 0, in.WitnessUtxo); err != nil {
+			return errors.Wrap(err, "failed to serialize witness utxo")
+		}
+		writePSBTPair(buf, []byte{psbtInWitnessUtxo}, txOut.Bytes())
+	}
+
+	// 最终化后签名数据不再需要（BIP-174 要求清除）
+	if !in.IsFinalized() {
+		for _, sig := range in.PartialSigs {
+			writePSBTPair(buf, append([]byte{psbtInPartialSig}, sig.PubKey...), sig.Signature)
+		}
+		if in.SighashType != 0 {
+			var v [4]byte
+			binary.LittleEndian.PutUint32(v[:], in.SighashType)
+			writePSBTPair(buf, []byte{psbtInSighashType}, v[:])
+		}
+		if len(in.RedeemScript) > 0 {
+			writePSBTPair(buf, []byte{psbtInRedeemScript}, in.RedeemScript)
+		}
+	}
+
+	if len(in.FinalScriptSig) > 0 {
+		writePSBTPair(buf, []byte{psbtInFinalScriptSig}, in.FinalScriptSig)
+	}
+	if len(in.FinalScriptWitness) > 0 {
+		var witness bytes.Buffer
+		if err := writeWitness(&witness, in.FinalScriptWitness); err != nil {
+			return err
+		}
+		writePSBTPair(buf, []byte{psbtInFinalScriptWitness}, witness.Bytes())
+	}
+
+	writePSBTUnknowns(buf, in.unknowns)
+	buf.WriteByte(0x00)
+	return nil
+}
+
+// Extract 从已全部最终化的 PSBT 中提取可广播的签名交易
+func (p *PSBT) Extract() (*wire.MsgTx, error) {
+	tx := p.UnsignedTx.Copy()
+	for i := range p.Inputs {
+		in := &p.Inputs[i]
+		if !in.IsFinalized() {
+			return nil, errors.Errorf("input %d is not finalized", i)
+		}
+		tx.TxIn[i].SignatureScript = in.FinalScriptSig
+		tx.TxIn[i].Witness = in.FinalScriptWitness
+	}
+	return tx, nil
+}
+
+// readPSBTMap 读取一个以 0x00 结尾的键值映射，键重复时返回错误
+func readPSBTMap(r io.Reader, handle func(key, value []byte) error) error {
+	seen := make(map[string]struct{})
+	for {
+		key, err := wire.ReadVarBytes(r, 0, maxPSBTValueSize, "psbt key")
+		if err != nil {
+			return errors.Wrap(ErrInvalidPSBT, err.Error())
+		}
+		if len(key) == 0 {
+			return nil
+		}
+		if _, ok := seen[string(key)]; ok {
+			return errors.Wrap(ErrInvalidPSBT, "duplicate key")
+		}
+		seen[string(key)] = struct{}{}
+
+		value, err := wire.ReadVarBytes(r, 0, maxPSBTValueSize, "psbt value")
+		if err != nil {
+			return errors.Wrap(ErrInvalidPSBT, err.Error())
+		}
+		if err := handle(key, value); err != nil {
+			return err
+		}
+	}
+}
+
+func writePSBTPair(buf *bytes.Buffer, key, value []byte) {
+	// 写入 bytes.Buffer 不会失败
+	_ = wire.WriteVarBytes(buf, 0, key)
+	_ = wire.WriteVarBytes(buf, 0, value)
+}
+
+func writePSBTUnknowns(buf *bytes.Buffer, unknowns []psbtUnknown) {
+	for _, u := range unknowns {
+		writePSBTPair(buf, u.Key, u.Value)
+	}
+}
+
+func readTxOut(value []byte) (*wire.TxOut, error) {
+	if len(value) < 9 {
+		return nil, errors.Wrap(ErrInvalidPSBT, "invalid witness utxo")
+	}
+	r := bytes.NewReader(value[8:])
+	script, err := wire.ReadVarBytes(r, 0, maxPSBTValueSize, "pkScript")
+	if err != nil || r.Len() != 0 {
+		return nil, errors.Wrap(ErrInvalidPSBT, "invalid witness utxo")
+	}
+	return wire.NewTxOut(int64(binary.LittleEndian.Uint64(value[:8])), script), nil
+}
+
+func readWitness(value []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(value)
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil || count > uint64(len(value)) {
+		return nil, errors.Wrap(ErrInvalidPSBT, "invalid final script witness")
+	}
+	witness := make(wire.TxWitness, count)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(r, 0, maxPSBTValueSize, "witness item")
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidPSBT, "invalid final script witness")
+		}
+	}
+	if r.Len() != 0 {
+		return nil, errors.Wrap(ErrInvalidPSBT, "invalid final script witness")
+	}
+	return witness, nil
+}
+
+func writeWitness(w io.Writer, witness wire.TxWitness) error {
+	if err := wire.WriteVarInt(w, 0, uint64(len(witness))); err != nil {
+		return errors.Wrap(err, "failed to serialize witness")
+	}
+	for _, item := range witness {
+		if err := wire.WriteVarBytes(w, 0, item); err != nil {
+			return errors.Wrap(err, "failed to serialize witness")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// Bitcoin 脚本操作码（仅包含构建标准输出脚本所需的部分）
+const (
+	op1           = 0x51
+	opReturn      = 0x6a
+	opDup         = 0x76
+	opEqual       = 0x87
+	opEqualVerify = 0x88
+	opHash160     = 0xa9
+	opCheckSig    = 0xac
+	opData20      = 0x14
+	opData32      = 0x20
+	opPushData1   = 0x4c
+
+	// maxOpReturnData 标准 OP_RETURN 输出允许携带的最大数据长度
+	maxOpReturnData = 80
+)
+
+// hash160 计算 RIPEMD160(SHA256(data))
+func hash160(data []byte) []byte {
+	sha := sha256.Sum256(data)
+	ripemd := ripemd160.New()
+	ripemd.Write(sha[:])
+	return ripemd.Sum(nil)
+}
+
+// p2pkhScript OP_DUP OP_HASH160 <20> OP_EQUALVERIFY OP_CHECKSIG
+func p2pkhScript(pubKeyHash []byte) []byte {
+	script := []byte{opDup, opHash160, opData20}
+	script = append(script, pubKeyHash...)
+	return append(script, opEqualVerify, opCheckSig)
+}
+
+// p2shScript OP_HASH160 <20> OP_EQUAL
+func p2shScript(scriptHash []byte) []byte {
+	script := []byte{opHash160, opData20}
+	script = append(script, scriptHash...)
+	return append(script, opEqual)
+}
+
+// p2wpkhScript OP_0 <20>
+func p2wpkhScript(pubKeyHash []byte) []byte {
+	return append([]byte{0x00, opData20}, pubKeyHash...)
+}
+
+// witnessProgramScript OP_n <program>
+func witnessProgramScript(version byte, program []byte) []byte {
+	versionOp := byte(0x00)
+	if version > 0 {
+		versionOp = op1 - 1 + version
+	}
+	return append([]byte{versionOp, byte(len(program))}, program...)
+}
+
+// nullDataScript OP_RETURN <data>
+func nullDataScript(data []byte) ([]byte, error) {
+	if len(data) > maxOpReturnData {
+		return nil, errors.Errorf("OP_RETURN data exceeds %d bytes", maxOpReturnData)
+	}
+	script := []byte{opReturn}
+	if len(data) < opPushData1 {
+		script = append(script, byte(len(data)))
+	} else {
+		script = append(script, opPushData1, byte(len(data)))
+	}
+	return append(script, data...), nil
+}
+
+// isP2WPKHScript 判断输出脚本是否为 P2WPKH
+func isP2WPKHScript(script []byte) bool {
+	return len(script) == 22 && script[0] == 0x00 && script[1] == opData20
+}
+
+// isP2SHScript 判断输出脚本是否为 P2SH
+func isP2SHScript(script []byte) bool {
+	return len(script) == 23 && script[0] == opHash160 && script[1] == opData20 && script[22] == opEqual
+}
+
+// isP2TRScript 判断输出脚本是否为 P2TR（SegWit v1，32 字节 x-only 输出公钥）
+func isP2TRScript(script []byte) bool {
+	return len(script) == 34 && script[0] == op1 && script[1] == opData32
+}
+
+// addressToScript 将地址解码为输出脚本，支持 P2PKH、P2SH（Base58）和 SegWit（Bech32/Bech32m）
+// 地址必须属于 params 指定的网络
+func addressToScript(address string, params *chaincfg.Params) ([]byte, error) {
+	if address == "" {
+		return nil, errors.New("address is required")
+	}
+
+	if strings.HasPrefix(strings.ToLower(address), params.Bech32HRPSegwit+"1") {
+		version, program, err := decodeSegWitAddress(params.Bech32HRPSegwit, strings.ToLower(address))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid segwit address %s", address)
+		}
+		return witnessProgramScript(version, program), nil
+	}
+
+	decoded, version, err := base58.CheckDecode(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address %s", address)
+	}
+	if len(decoded) != 20 {
+		return nil, errors.Errorf("invalid address %s", address)
+	}
+	switch version {
+	case params.PubKeyHashAddrID:
+		return p2pkhScript(decoded), nil
+	case params.ScriptHashAddrID:
+		return p2shScript(decoded), nil
+	default:
+		return nil, errors.Errorf("address %s is not a %s address", address, params.Name)
+	}
+}
+
+// scriptCodeForP2WPKH BIP-143 中 P2WPKH 输入使用的 scriptCode，即对应的 P2PKH 脚本
+func scriptCodeForP2WPKH(pkScript []byte) ([]byte, error) {
+	if !isP2WPKHScript(pkScript) {
+		return nil, errors.New("script is not P2WPKH")
+	}
+	return p2pkhScript(pkScript[2:]), nil
+}
+
+// p2shP2WPKHRedeemScript 嵌套 SegWit 输出的 redeemScript，即压缩公钥的 P2WPKH 输出脚本
+func p2shP2WPKHRedeemScript(compressedPubKey []byte) []byte {
+	return p2wpkhScript(hash160(compressedPubKey))
+}
+
+// scriptMatchesPubKey 判断 P2WPKH、P2SH-P2WPKH 或 P2TR 输出脚本是否属于给定的压缩公钥
+func scriptMatchesPubKey(pkScript []byte, compressedPubKey []byte) bool {
+	switch {
+	case isP2WPKHScript(pkScript):
+		return bytes.Equal(pkScript[2:], hash160(compressedPubKey))
+	case isP2SHScript(pkScript):
+		return bytes.Equal(pkScript[2:22], hash160(p2shP2WPKHRedeemScript(compressedPubKey)))
+	case isP2TRScript(pkScript):
+		publicKey, err := btcec.ParsePubKey(compressedPubKey)
+		if err != nil {
+			return false
+		}
+		outputKey, err := taprootOutputKey(publicKey)
+		return err == nil && bytes.Equal(pkScript[2:], outputKey)
+	default:
+		return false
+	}
+}
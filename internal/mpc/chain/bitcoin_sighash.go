@@ -0,0 +1,111 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SigHashAll 签名覆盖所有输入和输出，是 SegWit v0 输入唯一支持的 sighash 类型
+	SigHashAll uint32 = 0x01
+	// SigHashDefault Taproot 输入的默认 sighash 类型（BIP-341），语义同 SIGHASH_ALL，签名不附加类型字节
+	SigHashDefault uint32 = 0x00
+)
+
+// calcWitnessV0SigHash 按 BIP-143 计算 SegWit v0 输入的签名摘要（SIGHASH_ALL）
+// scriptCode 为不带长度前缀的脚本，amount 为该输入花费的 UTXO 金额（satoshi）
+func calcWitnessV0SigHash(tx *wire.MsgTx, idx int, scriptCode []byte, amount int64) ([]byte, error) {
+	if idx < 0 || idx >= len(tx.TxIn) {
+		return nil, errors.Errorf("input index %d out of range", idx)
+	}
+
+	var prevouts, sequences, outputs bytes.Buffer
+	for _, txIn := range tx.TxIn {
+		prevouts.Write(txIn.PreviousOutPoint.Hash[:])
+		writeUint32(&prevouts, txIn.PreviousOutPoint.Index)
+		writeUint32(&sequences, txIn.Sequence)
+	}
+	for _, txOut := range tx.TxOut {
+		if err := wire.WriteTxOut(&outputs, 0, 0, txOut); err != nil {
+			return nil, errors.Wrap(err, "failed to serialize output")
+		}
+	}
+
+	txIn := tx.TxIn[idx]
+	var preimage bytes.Buffer
+	writeUint32(&preimage, uint32(tx.Version))
+	preimage.Write(chainhash.DoubleHashB(prevouts.Bytes()))
+	preimage.Write(chainhash.DoubleHashB(sequences.Bytes()))
+	preimage.Write(txIn.PreviousOutPoint.Hash[:])
+	writeUint32(&preimage, txIn.PreviousOutPoint.Index)
+	if err := wire.WriteVarBytes(&preimage, 0, scriptCode); err != nil {
+		return nil, errors.Wrap(err, "failed to serialize script code")
+	}
+	writeUint64(&preimage, uint64(amount))
+	writeUint32(&preimage, txIn.Sequence)
+	preimage.Write(chainhash.DoubleHashB(outputs.Bytes()))
+	writeUint32(&preimage, tx.LockTime)
+	writeUint32(&preimage, SigHashAll)
+
+	return chainhash.DoubleHashB(preimage.Bytes()), nil
+}
+
+// calcTaprootSigHash 按 BIP-341 计算 Taproot 密钥路径输入的签名摘要（SIGHASH_DEFAULT，无 annex）
+// prevOuts 为所有输入花费的 UTXO，按输入顺序排列
+func calcTaprootSigHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut) ([]byte, error) {
+	if idx < 0 || idx >= len(tx.TxIn) {
+		return nil, errors.Errorf("input index %d out of range", idx)
+	}
+	if len(prevOuts) != len(tx.TxIn) {
+		return nil, errors.Errorf("expected %d previous outputs, got %d", len(tx.TxIn), len(prevOuts))
+	}
+
+	var prevouts, amounts, scriptPubKeys, sequences, outputs bytes.Buffer
+	for i, txIn := range tx.TxIn {
+		prevouts.Write(txIn.PreviousOutPoint.Hash[:])
+		writeUint32(&prevouts, txIn.PreviousOutPoint.Index)
+		writeUint64(&amounts, uint64(prevOuts[i].Value))
+		if err := wire.WriteVarBytes(&scriptPubKeys, 0, prevOuts[i].PkScript); err != nil {
+			return nil, errors.Wrap(err, "failed to serialize previous output script")
+		}
+		writeUint32(&sequences, txIn.Sequence)
+	}
+	for _, txOut := range tx.TxOut {
+		if err := wire.WriteTxOut(&outputs, 0, 0, txOut); err != nil {
+			return nil, errors.Wrap(err, "failed to serialize output")
+		}
+	}
+
+	// SigMsg 之前的 0x00 为 sighash epoch
+	var msg bytes.Buffer
+	msg.WriteByte(0x00)
+	msg.WriteByte(byte(SigHashDefault))
+	writeUint32(&msg, uint32(tx.Version))
+	writeUint32(&msg, tx.LockTime)
+	msg.Write(chainhash.HashB(prevouts.Bytes()))
+	msg.Write(chainhash.HashB(amounts.Bytes()))
+	msg.Write(chainhash.HashB(scriptPubKeys.Bytes()))
+	msg.Write(chainhash.HashB(sequences.Bytes()))
+	msg.Write(chainhash.HashB(outputs.Bytes()))
+	// spend_type：密钥路径且没有 annex
+	msg.WriteByte(0x00)
+	writeUint32(&msg, uint32(idx))
+
+	return taggedHash("TapSighash", msg.Bytes()), nil
+}
+
+func writeUint32(buf *bytes.Buffer, v uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	buf.Write(b[:])
+}
+
+func writeUint64(buf *bytes.Buffer, v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	buf.Write(b[:])
+}
@@ -0,0 +1,286 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcWitnessV0SigHash(t *testing.T) {
+	// BIP-143 "Native P2WPKH" test vector
+	rawTx, err := hex.DecodeString("0100000002fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f0000000000eeffffffef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a0100000000ffffffff02202cb206000000001976a9148280b37df378db99f66f85c95a783a76ac7a6d5988ac9093510d000000001976a9143bde42dbee7e4dbe6a21b2d50ce2f0167faa815988ac11000000")
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	require.NoError(t, tx.Deserialize(bytes.NewReader(rawTx)))
+
+	pkScript, err := hex.DecodeString("00141d0f172a0ecb48aee1be1f2687d2963ae33f71a1")
+	require.NoError(t, err)
+	scriptCode, err := scriptCodeForP2WPKH(pkScript)
+	require.NoError(t, err)
+	assert.Equal(t, "76a9141d0f172a0ecb48aee1be1f2687d2963ae33f71a188ac", hex.EncodeToString(scriptCode))
+
+	sigHash, err := calcWitnessV0SigHash(tx, 1, scriptCode, 600000000)
+	require.NoError(t, err)
+	assert.Equal(t, "c37af31116d1b27caf68aae9e3ac82f1477929014d5b917657d0eb49478cb670", hex.EncodeToString(sigHash))
+}
+
+func TestSelectCoins(t *testing.T) {
+	changeScript := p2wpkhScript(make([]byte, 20))
+	params := &coinSelectionParams{
+		amount:       100000,
+		feeRate:      10,
+		outputs:      [][]byte{p2wpkhScript(bytes.Repeat([]byte{1}, 20))},
+		changeScript: changeScript,
+	}
+	// 1 个输入、1 个输出：10.5 + 68 + 31 = 110 vB
+	require.Equal(t, int64(1100), params.estimateFee(1, false))
+
+	utxos := []UTXO{
+		{TxID: "a", Amount: 500000},
+		{TxID: "b", Amount: 101100},
+		{TxID: "c", Amount: 50000},
+	}
+
+	// largest-first 选择最大的 UTXO 并找零
+	selection, err := selectCoins(utxos, params, CoinSelectionLargestFirst)
+	require.NoError(t, err)
+	require.Len(t, selection.inputs, 1)
+	assert.Equal(t, "a", selection.inputs[0].TxID)
+	assert.Equal(t, params.estimateFee(1, true), selection.fee)
+	assert.Equal(t, int64(500000)-100000-selection.fee, selection.change)
+
+	// branch-and-bound 找到恰好覆盖金额和手续费的 UTXO，不需要找零
+	selection, err = selectCoins(utxos, params, CoinSelectionBranchAndBound)
+	require.NoError(t, err)
+	require.Len(t, selection.inputs, 1)
+	assert.Equal(t, "b", selection.inputs[0].TxID)
+	assert.Equal(t, int64(0), selection.change)
+	assert.Equal(t, int64(1100), selection.fee)
+
+	// 没有精确组合时回退到 largest-first
+	selection, err = selectCoins(utxos[:1], params, CoinSelectionBranchAndBound)
+	require.NoError(t, err)
+	assert.Greater(t, selection.change, int64(0))
+
+	// 剩余金额低于粉尘阈值时并入手续费
+	selection, err = selectCoins([]UTXO{{TxID: "d", Amount: 101500}}, params, CoinSelectionLargestFirst)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), selection.change)
+	assert.Equal(t, int64(1500), selection.fee)
+
+	_, err = selectCoins(utxos[2:], params, CoinSelectionLargestFirst)
+	assert.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	_, err = selectCoins(utxos, params, "random")
+	assert.Error(t, err)
+}
+
+func TestBitcoinBuildAndFinalizeTransaction(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+	from := testP2WPKHAddress(t, pubKey)
+
+	adapter := NewBitcoinAdapter(&chaincfg.MainNetParams)
+	unsigned, err := adapter.BuildTransaction(&BuildTxRequest{
+		From:    from,
+		To:      "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		Amount:  big.NewInt(150000),
+		FeeRate: 5,
+		Data:    []byte("memo"),
+		UTXOs: []UTXO{
+			{TxID: "9f96ade4b41d5433f4eda31e1738ec2b36f6e7d1420d94a6af99801a88f7f7ff", Vout: 0, Amount: 100000},
+			{TxID: "8ac60eb9575db5b2d987e29f301b5b819ea83e5c6579d282d189cc04b8e151ef", Vout: 1, Amount: 80000},
+		},
+		CoinSelection: CoinSelectionLargestFirst,
+	})
+	require.NoError(t, err)
+	require.Len(t, unsigned.SigningHashes, 2)
+
+	packet, err := ParsePSBTBase64(unsigned.Raw)
+	require.NoError(t, err)
+	require.Len(t, packet.UnsignedTx.TxOut, 3)
+	assert.Equal(t, int64(150000), packet.UnsignedTx.TxOut[0].Value)
+	assert.Equal(t, byte(opReturn), packet.UnsignedTx.TxOut[1].PkScript[0])
+	assert.Equal(t, int64(180000)-150000-unsigned.Fee.Int64(), packet.UnsignedTx.TxOut[2].Value)
+	assert.Equal(t, unsigned.Hash, packet.UnsignedTx.TxHash().String())
+
+	// PSBT 序列化往返保持不变
+	reencoded, err := packet.B64Encode()
+	require.NoError(t, err)
+	assert.Equal(t, unsigned.Raw, reencoded)
+
+	// 第一个输入使用 DER 签名，第二个使用 r||s 格式
+	signatures := make([][]byte, len(unsigned.SigningHashes))
+	for i, sigHash := range unsigned.SigningHashes {
+		if i == 0 {
+			signatures[i] = ecdsa.Sign(privKey, sigHash).Serialize()
+			continue
+		}
+		signatures[i] = compactToRS(t, privKey, sigHash)
+	}
+
+	_, err = adapter.FinalizeTransaction(unsigned.Raw, pubKey, [][]byte{signatures[1], signatures[0]})
+	assert.Error(t, err)
+
+	signed, err := adapter.FinalizeTransaction(unsigned.Raw, pubKey, signatures)
+	require.NoError(t, err)
+	assert.Equal(t, unsigned.Hash, signed.Hash)
+
+	rawTx, err := hex.DecodeString(signed.Raw)
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	require.NoError(t, tx.Deserialize(bytes.NewReader(rawTx)))
+	for _, txIn := range tx.TxIn {
+		require.Len(t, txIn.Witness, 2)
+		assert.Equal(t, pubKey, []byte(txIn.Witness[1]))
+		assert.Equal(t, byte(SigHashAll), txIn.Witness[0][len(txIn.Witness[0])-1])
+	}
+
+	// 实际 vsize 不超过手续费估算所用的大小
+	vsize := (tx.SerializeSizeStripped()*3 + tx.SerializeSize() + 3) / 4
+	assert.LessOrEqual(t, int64(vsize)*5, unsigned.Fee.Int64())
+}
+
+func TestBitcoinBuildAndFinalizeNestedAndTaprootTransactions(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+	utxos := []UTXO{
+		{TxID: "9f96ade4b41d5433f4eda31e1738ec2b36f6e7d1420d94a6af99801a88f7f7ff", Vout: 0, Amount: 100000},
+		{TxID: "8ac60eb9575db5b2d987e29f301b5b819ea83e5c6579d282d189cc04b8e151ef", Vout: 1, Amount: 80000},
+	}
+
+	tests := []struct {
+		addressType BitcoinAddressType
+		sign        func(sigHash []byte) []byte
+	}{
+		{BitcoinAddressP2SHP2WPKH, func(sigHash []byte) []byte { return compactToRS(t, privKey, sigHash) }},
+		{BitcoinAddressP2TR, func(sigHash []byte) []byte {
+			// MPC 节点用 BIP-86 调整后的密钥签名
+			sig, err := schnorr.Sign(txscript.TweakTaprootPrivKey(*privKey, nil), sigHash)
+			require.NoError(t, err)
+			return sig.Serialize()
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.addressType), func(t *testing.T) {
+			adapter := NewBitcoinAdapterWithAddressType(&chaincfg.MainNetParams, tt.addressType)
+			from, err := adapter.GenerateAddress(pubKey)
+			require.NoError(t, err)
+
+			unsigned, err := adapter.BuildTransaction(&BuildTxRequest{
+				From:             from,
+				To:               "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+				Amount:           big.NewInt(150000),
+				FeeRate:          5,
+				UTXOs:            utxos,
+				CoinSelection:    CoinSelectionLargestFirst,
+				BitcoinPublicKey: pubKey,
+			})
+			require.NoError(t, err)
+			require.Len(t, unsigned.SigningHashes, 2)
+
+			signatures := make([][]byte, len(unsigned.SigningHashes))
+			for i, sigHash := range unsigned.SigningHashes {
+				signatures[i] = tt.sign(sigHash)
+			}
+			_, err = adapter.FinalizeTransaction(unsigned.Raw, pubKey, [][]byte{signatures[1], signatures[0]})
+			assert.Error(t, err)
+
+			signed, err := adapter.FinalizeTransaction(unsigned.Raw, pubKey, signatures)
+			require.NoError(t, err)
+			assert.Equal(t, unsigned.Hash, signed.Hash)
+
+			rawTx, err := hex.DecodeString(signed.Raw)
+			require.NoError(t, err)
+			tx := wire.NewMsgTx(wire.TxVersion)
+			require.NoError(t, tx.Deserialize(bytes.NewReader(rawTx)))
+
+			// 用 btcd 脚本引擎按共识规则验证每个输入
+			fromScript, err := addressToScript(from, &chaincfg.MainNetParams)
+			require.NoError(t, err)
+			prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+			for i, txIn := range tx.TxIn {
+				prevOuts.AddPrevOut(txIn.PreviousOutPoint, wire.NewTxOut(utxos[i].Amount, fromScript))
+			}
+			sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+			for i := range tx.TxIn {
+				engine, err := txscript.NewEngine(fromScript, tx, i, txscript.StandardVerifyFlags, nil, sigHashes, utxos[i].Amount, prevOuts)
+				require.NoError(t, err)
+				assert.NoError(t, engine.Execute(), "input %d", i)
+			}
+
+			// 实际 vsize 不超过手续费估算所用的大小
+			vsize := (tx.SerializeSizeStripped()*3 + tx.SerializeSize() + 3) / 4
+			assert.LessOrEqual(t, int64(vsize)*5, unsigned.Fee.Int64())
+		})
+	}
+
+	// 没有公钥时无法重建 P2SH-P2WPKH 的 redeemScript
+	adapter := NewBitcoinAdapterWithAddressType(&chaincfg.MainNetParams, BitcoinAddressP2SHP2WPKH)
+	from, err := adapter.GenerateAddress(pubKey)
+	require.NoError(t, err)
+	_, err = adapter.BuildTransaction(&BuildTxRequest{From: from, To: from, Amount: big.NewInt(1000), FeeRate: 1, UTXOs: utxos})
+	assert.Error(t, err)
+
+	// 未调整的内部密钥签名不能花费 P2TR 输出
+	adapter = NewBitcoinAdapterWithAddressType(&chaincfg.MainNetParams, BitcoinAddressP2TR)
+	from, err = adapter.GenerateAddress(pubKey)
+	require.NoError(t, err)
+	unsigned, err := adapter.BuildTransaction(&BuildTxRequest{From: from, To: from, Amount: big.NewInt(1000), FeeRate: 1, UTXOs: utxos[:1]})
+	require.NoError(t, err)
+	sig, err := schnorr.Sign(privKey, unsigned.SigningHashes[0])
+	require.NoError(t, err)
+	_, err = adapter.FinalizeTransaction(unsigned.Raw, pubKey, [][]byte{sig.Serialize()})
+	assert.Error(t, err)
+}
+
+func TestBitcoinBuildTransactionValidation(t *testing.T) {
+	adapter := NewBitcoinAdapter(&chaincfg.MainNetParams)
+	from := testP2WPKHAddress(t, make([]byte, 33))
+	utxos := []UTXO{{TxID: "9f96ade4b41d5433f4eda31e1738ec2b36f6e7d1420d94a6af99801a88f7f7ff", Amount: 100000}}
+
+	tests := []struct {
+		name string
+		req  BuildTxRequest
+	}{
+		{"missing utxos", BuildTxRequest{From: from, To: from, Amount: big.NewInt(1000), FeeRate: 1}},
+		{"missing fee rate", BuildTxRequest{From: from, To: from, Amount: big.NewInt(1000), UTXOs: utxos}},
+		{"p2pkh from", BuildTxRequest{From: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", To: from, Amount: big.NewInt(1000), FeeRate: 1, UTXOs: utxos}},
+		{"testnet to", BuildTxRequest{From: from, To: "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn", Amount: big.NewInt(1000), FeeRate: 1, UTXOs: utxos}},
+		{"duplicate utxo", BuildTxRequest{From: from, To: from, Amount: big.NewInt(1000), FeeRate: 1, UTXOs: append(utxos, utxos...)}},
+		{"foreign utxo", BuildTxRequest{From: from, To: from, Amount: big.NewInt(1000), FeeRate: 1, UTXOs: []UTXO{{TxID: utxos[0].TxID, Amount: 100000, ScriptPubKey: "0014" + hex.EncodeToString(make([]byte, 20))}}}},
+		{"insufficient funds", BuildTxRequest{From: from, To: from, Amount: big.NewInt(100000), FeeRate: 1, UTXOs: utxos}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := adapter.BuildTransaction(&tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func testP2WPKHAddress(t *testing.T, pubKey []byte) string {
+	t.Helper()
+	address, err := encodeSegWitAddress(chaincfg.MainNetParams.Bech32HRPSegwit, 0, hash160(pubKey))
+	require.NoError(t, err)
+	return address
+}
+
+// compactToRS 生成 64 字节 r||s 格式的签名，模拟 MPC 节点返回的原始签名
+func compactToRS(t *testing.T, privKey *btcec.PrivateKey, hash []byte) []byte {
+	t.Helper()
+	return ecdsa.SignCompact(privKey, hash, true)[1:]
+}
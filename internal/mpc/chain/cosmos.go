@@ -0,0 +1,380 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/cosmos"
+)
+
+const (
+	// cosmosDefaultGasLimit 未指定 gas 上限时的默认值，足够覆盖单条 MsgSend
+	cosmosDefaultGasLimit = 200000
+	// cosmosMaxMemoLength x/auth 默认参数 MaxMemoCharacters
+	cosmosMaxMemoLength = 256
+	// cosmosSignModeDirect SIGN_MODE_DIRECT 枚举值
+	cosmosSignModeDirect = 1
+)
+
+const (
+	cosmosMsgSendURL = "/cosmos.bank.v1beta1.MsgSend"
+	cosmosPubKeyURL  = "/cosmos.crypto.secp256k1.PubKey"
+)
+
+// CosmosConfig Cosmos SDK 链参数
+type CosmosConfig struct {
+	ChainID      string   // 链 ID，如 cosmoshub-4，写入 SignDoc
+	Bech32Prefix string   // 账户地址前缀，如 cosmos、osmo
+	Denom        string   // 原生代币最小单位，如 uatom
+	GasPrice     *big.Rat // 每单位 gas 的手续费（Denom），未指定手续费时按 gas 上限计算，为空时为 0
+}
+
+// CosmosAdapter 实现 Cosmos SDK 链基础能力，使用 SIGN_MODE_DIRECT 签名 MsgSend 转账，
+// 账户编号和序列号通过 LCD（gRPC-gateway）查询
+type CosmosAdapter struct {
+	config    CosmosConfig
+	rpcClient *cosmos.RPCClient
+}
+
+// NewCosmosAdapter 创建 Cosmos 适配器，配置多个 LCD 端点时按顺序故障转移
+func NewCosmosAdapter(config CosmosConfig, rpcEndpoints ...string) *CosmosAdapter {
+	adapter := &CosmosAdapter{config: config}
+	if endpoints := nonEmptyEndpoints(rpcEndpoints); len(endpoints) > 0 {
+		adapter.rpcClient = cosmos.NewRPCClient(endpoints...)
+	}
+	return adapter
+}
+
+// GenerateAddress 根据 secp256k1 公钥生成 Bech32 账户地址
+func (a *CosmosAdapter) GenerateAddress(pubKey []byte) (string, error) {
+	return CosmosAddressFromPublicKey(a.config.Bech32Prefix, pubKey)
+}
+
+// GetBalance 查询原生代币余额（Denom 最小单位）
+func (a *CosmosAdapter) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	if _, err := ParseCosmosAddress(a.config.Bech32Prefix, address); err != nil {
+		return nil, err
+	}
+	return a.rpcClient.GetBalance(ctx, address, a.config.Denom)
+}
+
+// GetAccount 查询账户编号和序列号，从未收到过转账的账户返回 cosmos.ErrAccountNotFound
+func (a *CosmosAdapter) GetAccount(ctx context.Context, address string) (*cosmos.Account, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	if _, err := ParseCosmosAddress(a.config.Bech32Prefix, address); err != nil {
+		return nil, err
+	}
+	return a.rpcClient.GetAccount(ctx, address)
+}
+
+// BroadcastTransaction 广播 AssembleSignedTx 返回的 Base64 编码 TxRaw，返回交易哈希
+func (a *CosmosAdapter) BroadcastTransaction(ctx context.Context, rawTx string) (string, error) {
+	if a.rpcClient == nil {
+		return "", errors.New("RPC client not configured")
+	}
+	return a.rpcClient.BroadcastTx(ctx, rawTx)
+}
+
+// BuildTransactionWithAccount 查询发送方的账户编号和序列号后构建交易
+func (a *CosmosAdapter) BuildTransactionWithAccount(ctx context.Context, req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+
+	account, err := a.GetAccount(ctx, req.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+
+	withAccount := *req
+	withAccount.CosmosAccountNumber = account.AccountNumber
+	withAccount.Nonce = account.Sequence
+	return a.BuildTransaction(&withAccount)
+}
+
+// BuildTransaction 构建单条 MsgSend 的 SIGN_MODE_DIRECT 交易
+// 返回的 Raw 为 Base64 编码的 SignDoc，SigningHashes 中唯一的摘要为 SHA-256(SignDoc)；
+// 交易哈希取决于签名，因此 Hash 为空
+func (a *CosmosAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if a.config.ChainID == "" {
+		return nil, errors.New("chain id is not configured")
+	}
+	if a.config.Denom == "" {
+		return nil, errors.New("denom is not configured")
+	}
+	if req.Amount == nil || req.Amount.Sign() <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	if !utf8.Valid(req.Data) || utf8.RuneCount(req.Data) > cosmosMaxMemoLength {
+		return nil, errors.Errorf("memo must be valid UTF-8 with at most %d characters", cosmosMaxMemoLength)
+	}
+
+	if _, err := ParseCosmosAddress(a.config.Bech32Prefix, req.To); err != nil {
+		return nil, errors.Wrap(err, "invalid to address")
+	}
+	if _, err := ParseCosmosAddress(a.config.Bech32Prefix, req.From); err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+	// SignerInfo 需要发送方公钥，且公钥必须对应 From，否则链上验签失败
+	publicKey, err := btcec.ParsePubKey(req.CosmosPublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	signer, err := CosmosAddressFromPublicKey(a.config.Bech32Prefix, req.CosmosPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if signer != strings.ToLower(strings.TrimSpace(req.From)) {
+		return nil, errors.Errorf("public key does not match from address %s", req.From)
+	}
+
+	gasLimit := req.CosmosGasLimit
+	if gasLimit == 0 {
+		gasLimit = cosmosDefaultGasLimit
+	}
+	fee := req.CosmosFee
+	if fee == nil {
+		fee = a.feeForGas(gasLimit)
+	}
+	if fee.Sign() < 0 {
+		return nil, errors.New("fee must not be negative")
+	}
+
+	var msgSend []byte
+	msgSend = protowire.AppendTag(msgSend, 1, protowire.BytesType) // from_address
+	msgSend = protowire.AppendString(msgSend, signer)
+	msgSend = protowire.AppendTag(msgSend, 2, protowire.BytesType) // to_address
+	msgSend = protowire.AppendString(msgSend, strings.ToLower(strings.TrimSpace(req.To)))
+	msgSend = protowire.AppendTag(msgSend, 3, protowire.BytesType) // amount
+	msgSend = protowire.AppendBytes(msgSend, cosmosCoin(a.config.Denom, req.Amount))
+
+	var body []byte
+	body = protowire.AppendTag(body, 1, protowire.BytesType) // messages
+	body = protowire.AppendBytes(body, cosmosAny(cosmosMsgSendURL, msgSend))
+	if len(req.Data) > 0 {
+		body = protowire.AppendTag(body, 2, protowire.BytesType) // memo
+		body = protowire.AppendBytes(body, req.Data)
+	}
+
+	var pubKey []byte
+	pubKey = protowire.AppendTag(pubKey, 1, protowire.BytesType) // key：33 字节压缩公钥
+	pubKey = protowire.AppendBytes(pubKey, publicKey.SerializeCompressed())
+
+	var single []byte
+	single = protowire.AppendTag(single, 1, protowire.VarintType) // mode
+	single = protowire.AppendVarint(single, cosmosSignModeDirect)
+	var modeInfo []byte
+	modeInfo = protowire.AppendTag(modeInfo, 1, protowire.BytesType) // single
+	modeInfo = protowire.AppendBytes(modeInfo, single)
+
+	// proto3 零值字段省略，与节点的序列化一致
+	var signerInfo []byte
+	signerInfo = protowire.AppendTag(signerInfo, 1, protowire.BytesType) // public_key
+	signerInfo = protowire.AppendBytes(signerInfo, cosmosAny(cosmosPubKeyURL, pubKey))
+	signerInfo = protowire.AppendTag(signerInfo, 2, protowire.BytesType) // mode_info
+	signerInfo = protowire.AppendBytes(signerInfo, modeInfo)
+	if req.Nonce > 0 {
+		signerInfo = protowire.AppendTag(signerInfo, 3, protowire.VarintType) // sequence
+		signerInfo = protowire.AppendVarint(signerInfo, req.Nonce)
+	}
+
+	var feeInfo []byte
+	if fee.Sign() > 0 {
+		feeInfo = protowire.AppendTag(feeInfo, 1, protowire.BytesType) // amount
+		feeInfo = protowire.AppendBytes(feeInfo, cosmosCoin(a.config.Denom, fee))
+	}
+	feeInfo = protowire.AppendTag(feeInfo, 2, protowire.VarintType) // gas_limit
+	feeInfo = protowire.AppendVarint(feeInfo, gasLimit)
+
+	var authInfo []byte
+	authInfo = protowire.AppendTag(authInfo, 1, protowire.BytesType) // signer_infos
+	authInfo = protowire.AppendBytes(authInfo, signerInfo)
+	authInfo = protowire.AppendTag(authInfo, 2, protowire.BytesType) // fee
+	authInfo = protowire.AppendBytes(authInfo, feeInfo)
+
+	var signDoc []byte
+	signDoc = protowire.AppendTag(signDoc, 1, protowire.BytesType) // body_bytes
+	signDoc = protowire.AppendBytes(signDoc, body)
+	signDoc = protowire.AppendTag(signDoc, 2, protowire.BytesType) // auth_info_bytes
+	signDoc = protowire.AppendBytes(signDoc, authInfo)
+	signDoc = protowire.AppendTag(signDoc, 3, protowire.BytesType) // chain_id
+	signDoc = protowire.AppendString(signDoc, a.config.ChainID)
+	if req.CosmosAccountNumber > 0 {
+		signDoc = protowire.AppendTag(signDoc, 4, protowire.VarintType) // account_number
+		signDoc = protowire.AppendVarint(signDoc, req.CosmosAccountNumber)
+	}
+
+	hash := sha256.Sum256(signDoc)
+	return &Transaction{
+		Raw:           base64.StdEncoding.EncodeToString(signDoc),
+		SigningHashes: [][]byte{hash[:]},
+		Fee:           fee,
+	}, nil
+}
+
+// AssembleSignedTx 将 MPC 签名与 BuildTransaction 生成的 SignDoc 组装为 TxRaw，返回 Base64 编码的可广播交易，
+// Hash 为 SHA-256(TxRaw) 的大写十六进制；signature 可以是 DER 编码、64 字节 r||s 或 65 字节 r||s||v，
+// s 会被规范化为 low-S（Cosmos SDK 拒绝 high-S 签名）
+func (a *CosmosAdapter) AssembleSignedTx(rawTx string, signature []byte, pubKey []byte) (*Transaction, error) {
+	signDoc, err := base64.StdEncoding.DecodeString(rawTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid raw transaction")
+	}
+	fields, err := cosmosProtoFields(signDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode sign doc")
+	}
+	body, authInfo := fields[1], fields[2]
+	if len(body) == 0 || len(authInfo) == 0 {
+		return nil, errors.New("sign doc is missing body or auth info")
+	}
+	if chainID := string(fields[3]); chainID != a.config.ChainID {
+		return nil, errors.Errorf("sign doc chain id %s does not match adapter chain id %s", chainID, a.config.ChainID)
+	}
+
+	publicKey, err := btcec.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	signerKey, err := cosmosSignerPublicKey(authInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode auth info")
+	}
+	if !bytes.Equal(signerKey, publicKey.SerializeCompressed()) {
+		return nil, errors.New("public key is not the signer of the transaction")
+	}
+
+	if len(signature) == 65 {
+		signature = signature[:64]
+	}
+	sig, err := parseECDSASignature(signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+	hash := sha256.Sum256(signDoc)
+	if !sig.Verify(hash[:], publicKey) {
+		return nil, errors.New("signature does not match public key")
+	}
+	rs, err := compactSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	var txRaw []byte
+	txRaw = protowire.AppendTag(txRaw, 1, protowire.BytesType) // body_bytes
+	txRaw = protowire.AppendBytes(txRaw, body)
+	txRaw = protowire.AppendTag(txRaw, 2, protowire.BytesType) // auth_info_bytes
+	txRaw = protowire.AppendBytes(txRaw, authInfo)
+	txRaw = protowire.AppendTag(txRaw, 3, protowire.BytesType) // signatures
+	txRaw = protowire.AppendBytes(txRaw, rs)
+
+	txHash := sha256.Sum256(txRaw)
+	return &Transaction{
+		Raw:  base64.StdEncoding.EncodeToString(txRaw),
+		Hash: strings.ToUpper(hex.EncodeToString(txHash[:])),
+	}, nil
+}
+
+// feeForGas 按配置的 gas 价格计算手续费，向上取整
+func (a *CosmosAdapter) feeForGas(gasLimit uint64) *big.Int {
+	if a.config.GasPrice == nil {
+		return new(big.Int)
+	}
+	total := new(big.Rat).Mul(a.config.GasPrice, new(big.Rat).SetInt(new(big.Int).SetUint64(gasLimit)))
+	fee, remainder := new(big.Int).QuoRem(total.Num(), total.Denom(), new(big.Int))
+	if remainder.Sign() > 0 {
+		fee.Add(fee, big.NewInt(1))
+	}
+	return fee
+}
+
+// cosmosCoin 编码 cosmos.base.v1beta1.Coin，金额为十进制字符串
+func cosmosCoin(denom string, amount *big.Int) []byte {
+	var coin []byte
+	coin = protowire.AppendTag(coin, 1, protowire.BytesType) // denom
+	coin = protowire.AppendString(coin, denom)
+	coin = protowire.AppendTag(coin, 2, protowire.BytesType) // amount
+	return protowire.AppendString(coin, amount.String())
+}
+
+// cosmosAny 编码 google.protobuf.Any
+func cosmosAny(typeURL string, value []byte) []byte {
+	var wrapped []byte
+	wrapped = protowire.AppendTag(wrapped, 1, protowire.BytesType) // type_url
+	wrapped = protowire.AppendString(wrapped, typeURL)
+	wrapped = protowire.AppendTag(wrapped, 2, protowire.BytesType) // value
+	return protowire.AppendBytes(wrapped, value)
+}
+
+// cosmosSignerPublicKey 从 AuthInfo 中取出第一个 SignerInfo 的 secp256k1 公钥
+func cosmosSignerPublicKey(authInfo []byte) ([]byte, error) {
+	fields, err := cosmosProtoFields(authInfo)
+	if err != nil {
+		return nil, err
+	}
+	signerInfo, err := cosmosProtoFields(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := cosmosProtoFields(signerInfo[1])
+	if err != nil {
+		return nil, err
+	}
+	if string(wrapped[1]) != cosmosPubKeyURL {
+		return nil, errors.Errorf("unsupported public key type %q", wrapped[1])
+	}
+	pubKey, err := cosmosProtoFields(wrapped[2])
+	if err != nil {
+		return nil, err
+	}
+	return pubKey[1], nil
+}
+
+// cosmosProtoFields 解析一层 protobuf 消息中的长度分隔字段（重复字段取第一个），跳过其他类型的字段
+func cosmosProtoFields(b []byte) (map[protowire.Number][]byte, error) {
+	fields := make(map[protowire.Number][]byte)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			if _, ok := fields[num]; !ok {
+				fields[num] = value
+			}
+			b = b[n:]
+			continue
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return fields, nil
+}
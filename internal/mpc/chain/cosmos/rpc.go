@@ -0,0 +1,242 @@
+package cosmos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// codeNotFound gRPC NotFound 状态码，LCD 查询不存在的账户时返回
+const codeNotFound = 5
+
+// ErrAccountNotFound 链上不存在的账户（从未收到过转账）
+var ErrAccountNotFound = errors.New("account not found")
+
+// RPCClient Cosmos SDK LCD（REST/gRPC-gateway）客户端，配置多个端点时按顺序故障转移
+type RPCClient struct {
+	endpoints []string
+	current   atomic.Int32 // 最近一次调用成功的端点，下次调用优先使用
+	client    *http.Client
+}
+
+// NewRPCClient 创建 LCD 客户端，端点为 REST API 根地址（如 https://rest.cosmos.directory/cosmoshub）
+func NewRPCClient(endpoints ...string) *RPCClient {
+	return &RPCClient{
+		endpoints: endpoints,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// APIError 节点返回的 gRPC 错误（查询失败）或 CheckTx 失败（广播时的签名、序列号或余额错误）
+type APIError struct {
+	Code    int
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("cosmos API error: %s (code: %d)", e.Message, e.Code)
+}
+
+// call 调用 LCD 接口，body 为空时发送 GET 请求；端点不可用（网络错误或 5xx/429 响应）时依次尝试下一个端点，
+// 节点返回的 API 错误直接返回
+func (c *RPCClient) call(ctx context.Context, path string, body interface{}, result interface{}) error {
+	if len(c.endpoints) == 0 {
+		return errors.New("no RPC endpoint configured")
+	}
+
+	var reqBody []byte
+	if body != nil {
+		var err error
+		if reqBody, err = json.Marshal(body); err != nil {
+			return errors.Wrap(err, "failed to marshal request")
+		}
+	}
+
+	start := int(c.current.Load())
+	for i := 0; i < len(c.endpoints); i++ {
+		idx := (start + i) % len(c.endpoints)
+		err := c.callEndpoint(ctx, strings.TrimRight(c.endpoints[idx], "/")+path, reqBody, result)
+		if err == nil {
+			c.current.Store(int32(idx))
+			return nil
+		}
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) || ctx.Err() != nil || i == len(c.endpoints)-1 {
+			return err
+		}
+	}
+
+	return errors.New("no RPC endpoint available")
+}
+
+// callEndpoint 向单个端点发送请求，4xx 响应解析为 APIError
+func (c *RPCClient) callEndpoint(ctx context.Context, url string, reqBody []byte, result interface{}) error {
+	method := http.MethodGet
+	var body io.Reader
+	if reqBody != nil {
+		method = http.MethodPost
+		body = bytes.NewReader(reqBody)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return errors.Wrap(err, "failed to create HTTP request")
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute HTTP request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return errors.Errorf("RPC endpoint returned HTTP %d", resp.StatusCode)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var status struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&status); err != nil || status.Code == 0 {
+			return errors.Errorf("RPC endpoint returned HTTP %d", resp.StatusCode)
+		}
+		return &APIError{Code: status.Code, Message: status.Message}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Wrap(err, "failed to decode response")
+	}
+	return nil
+}
+
+// Account 签名需要的账户编号和序列号
+type Account struct {
+	Address       string
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// baseAccount BaseAccount 的 JSON 表示，uint64 字段编码为字符串
+type baseAccount struct {
+	Address       string `json:"address"`
+	AccountNumber string `json:"account_number"`
+	Sequence      string `json:"sequence"`
+}
+
+// GetAccount 查询账户编号和序列号，账户不存在时返回 ErrAccountNotFound
+// 兼容 BaseAccount、嵌套 base_account 的账户（如 EthAccount）以及归属账户（vesting）
+func (c *RPCClient) GetAccount(ctx context.Context, address string) (*Account, error) {
+	var resp struct {
+		Account struct {
+			baseAccount
+			BaseAccount        *baseAccount `json:"base_account"`
+			BaseVestingAccount *struct {
+				BaseAccount *baseAccount `json:"base_account"`
+			} `json:"base_vesting_account"`
+		} `json:"account"`
+	}
+	err := c.call(ctx, "/cosmos/auth/v1beta1/accounts/"+url.PathEscape(address), nil, &resp)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.Code == codeNotFound {
+			return nil, errors.Wrapf(ErrAccountNotFound, "account %s", address)
+		}
+		return nil, errors.Wrap(err, "failed to query account")
+	}
+
+	account := &resp.Account.baseAccount
+	if resp.Account.BaseAccount != nil {
+		account = resp.Account.BaseAccount
+	} else if resp.Account.BaseVestingAccount != nil && resp.Account.BaseVestingAccount.BaseAccount != nil {
+		account = resp.Account.BaseVestingAccount.BaseAccount
+	}
+	if account.Address == "" {
+		return nil, errors.New("node returned an unsupported account type")
+	}
+
+	accountNumber, err := parseUint(account.AccountNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid account number")
+	}
+	sequence, err := parseUint(account.Sequence)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sequence")
+	}
+	return &Account{Address: account.Address, AccountNumber: accountNumber, Sequence: sequence}, nil
+}
+
+// GetBalance 查询地址在 denom 上的余额（最小单位），账户不存在时余额为 0
+func (c *RPCClient) GetBalance(ctx context.Context, address, denom string) (*big.Int, error) {
+	var resp struct {
+		Balance struct {
+			Denom  string `json:"denom"`
+			Amount string `json:"amount"`
+		} `json:"balance"`
+	}
+	path := "/cosmos/bank/v1beta1/balances/" + url.PathEscape(address) + "/by_denom?denom=" + url.QueryEscape(denom)
+	if err := c.call(ctx, path, nil, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to query balance")
+	}
+	if resp.Balance.Amount == "" {
+		return new(big.Int), nil
+	}
+
+	balance, ok := new(big.Int).SetString(resp.Balance.Amount, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance %q", resp.Balance.Amount)
+	}
+	return balance, nil
+}
+
+// BroadcastTx 以 BROADCAST_MODE_SYNC 广播 Base64 编码的 TxRaw，通过 CheckTx 后返回交易哈希（大写十六进制）
+func (c *RPCClient) BroadcastTx(ctx context.Context, txBytes string) (string, error) {
+	var resp struct {
+		TxResponse struct {
+			TxHash    string `json:"txhash"`
+			Code      int    `json:"code"`
+			Codespace string `json:"codespace"`
+			RawLog    string `json:"raw_log"`
+		} `json:"tx_response"`
+	}
+	params := map[string]string{"tx_bytes": txBytes, "mode": "BROADCAST_MODE_SYNC"}
+	if err := c.call(ctx, "/cosmos/tx/v1beta1/txs", params, &resp); err != nil {
+		return "", errors.Wrap(err, "failed to broadcast transaction")
+	}
+	if resp.TxResponse.Code != 0 {
+		message := resp.TxResponse.RawLog
+		if resp.TxResponse.Codespace != "" {
+			message = resp.TxResponse.Codespace + ": " + message
+		}
+		return "", &APIError{Code: resp.TxResponse.Code, Message: message}
+	}
+	if resp.TxResponse.TxHash == "" {
+		return "", errors.New("node returned an empty transaction hash")
+	}
+	return resp.TxResponse.TxHash, nil
+}
+
+// parseUint 解析字符串编码的 uint64，空字符串（proto3 默认值）为 0
+func parseUint(value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
@@ -0,0 +1,63 @@
+package chain
+
+import (
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/pkg/errors"
+)
+
+const (
+	// cosmosAddressLength secp256k1 账户地址为压缩公钥的 hash160（20 字节）
+	cosmosAddressLength = 20
+	// cosmosModuleAddressLength 模块账户和 CosmWasm 合约地址长度
+	cosmosModuleAddressLength = 32
+)
+
+// CosmosAddressFromPublicKey 由 secp256k1 公钥（压缩或非压缩）生成 prefix 前缀的 Bech32 账户地址
+// 地址为 RIPEMD160(SHA256(压缩公钥))，与 Bitcoin P2WPKH 的公钥哈希相同
+func CosmosAddressFromPublicKey(prefix string, pubKey []byte) (string, error) {
+	if len(pubKey) == 0 {
+		return "", errors.New("public key is required")
+	}
+	publicKey, err := btcec.ParsePubKey(pubKey)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid secp256k1 public key")
+	}
+	return EncodeCosmosAddress(prefix, hash160(publicKey.SerializeCompressed()))
+}
+
+// EncodeCosmosAddress 将账户地址字节编码为 Bech32（非 Bech32m）地址
+func EncodeCosmosAddress(prefix string, address []byte) (string, error) {
+	if prefix == "" || strings.ToLower(prefix) != prefix {
+		return "", errors.Errorf("invalid bech32 prefix %q", prefix)
+	}
+	data, err := convertBits(address, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32Encode(prefix, data, bech32Const), nil
+}
+
+// ParseCosmosAddress 解析 prefix 前缀的 Bech32 地址，返回 20 字节账户地址或 32 字节模块/合约地址
+func ParseCosmosAddress(prefix string, address string) ([]byte, error) {
+	hrp, data, checksumConst, err := bech32Decode(strings.TrimSpace(address))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cosmos address %q", address)
+	}
+	if hrp != prefix {
+		return nil, errors.Errorf("cosmos address %q does not have prefix %q", address, prefix)
+	}
+	if checksumConst != bech32Const {
+		return nil, errors.Errorf("cosmos address %q must use bech32 checksum", address)
+	}
+
+	decoded, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cosmos address %q", address)
+	}
+	if len(decoded) != cosmosAddressLength && len(decoded) != cosmosModuleAddressLength {
+		return nil, errors.Errorf("invalid cosmos address length %d", len(decoded))
+	}
+	return decoded, nil
+}
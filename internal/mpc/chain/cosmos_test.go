@@ -0,0 +1,258 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/cosmos"
+)
+
+const cosmosReceiver = "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a" // 全零地址
+
+func cosmosHubConfig() CosmosConfig {
+	return CosmosConfig{ChainID: "cosmoshub-4", Bech32Prefix: "cosmos", Denom: "uatom", GasPrice: big.NewRat(5, 1000)}
+}
+
+func TestCosmosAddress(t *testing.T) {
+	// 私钥 1 的 hash160 与 BIP-173 测试向量 bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4 相同
+	privKey, _ := btcec.PrivKeyFromBytes(common32(1))
+	for _, pubKey := range [][]byte{privKey.PubKey().SerializeCompressed(), privKey.PubKey().SerializeUncompressed()} {
+		address, err := NewCosmosAdapter(cosmosHubConfig()).GenerateAddress(pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, "cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c", address)
+
+		address, err = CosmosAddressFromPublicKey("osmo", pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, "osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2", address)
+	}
+
+	parsed, err := ParseCosmosAddress("cosmos", "COSMOS1W508D6QEJXTDG4Y5R3ZARVARY0C5XW7K6AH60C")
+	require.NoError(t, err)
+	assert.Equal(t, "751e76e8199196d454941c45d1b3a323f1433bd6", hex.EncodeToString(parsed))
+
+	for _, invalid := range []string{"", "cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60d", "osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2",
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"} {
+		_, err := ParseCosmosAddress("cosmos", invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestCosmosBuildAndAssembleTransfer(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+
+	adapter := NewCosmosAdapter(cosmosHubConfig())
+	from, err := adapter.GenerateAddress(pubKey)
+	require.NoError(t, err)
+
+	unsigned, err := adapter.BuildTransaction(&BuildTxRequest{
+		From: from, To: cosmosReceiver, Amount: big.NewInt(1500000), Nonce: 7, Data: []byte("invoice 42"),
+		CosmosAccountNumber: 12345, CosmosPublicKey: pubKey, CosmosGasLimit: 90000,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, unsigned.Hash)
+	assert.Equal(t, "450", unsigned.Fee.String()) // 90000 × 0.005
+
+	signDoc, err := base64.StdEncoding.DecodeString(unsigned.Raw)
+	require.NoError(t, err)
+	hash := sha256.Sum256(signDoc)
+	require.Len(t, unsigned.SigningHashes, 1)
+	assert.Equal(t, hash[:], unsigned.SigningHashes[0])
+
+	doc, docVarints := decodeProto(t, signDoc)
+	assert.Equal(t, "cosmoshub-4", string(doc[3]))
+	assert.Equal(t, uint64(12345), docVarints[4])
+
+	body, _ := decodeProto(t, doc[1])
+	assert.Equal(t, "invoice 42", string(body[2]))
+	msg, _ := decodeProto(t, body[1])
+	assert.Equal(t, cosmosMsgSendURL, string(msg[1]))
+	send, _ := decodeProto(t, msg[2])
+	assert.Equal(t, from, string(send[1]))
+	assert.Equal(t, cosmosReceiver, string(send[2]))
+	coin, _ := decodeProto(t, send[3])
+	assert.Equal(t, "uatom", string(coin[1]))
+	assert.Equal(t, "1500000", string(coin[2]))
+
+	authInfo, _ := decodeProto(t, doc[2])
+	signerInfo, signerVarints := decodeProto(t, authInfo[1])
+	assert.Equal(t, uint64(7), signerVarints[3])
+	signerKey, err := cosmosSignerPublicKey(doc[2])
+	require.NoError(t, err)
+	assert.Equal(t, pubKey, signerKey)
+	modeInfo, _ := decodeProto(t, signerInfo[2])
+	_, singleVarints := decodeProto(t, modeInfo[1])
+	assert.Equal(t, uint64(cosmosSignModeDirect), singleVarints[1])
+	fee, feeVarints := decodeProto(t, authInfo[2])
+	assert.Equal(t, uint64(90000), feeVarints[2])
+	feeCoin, _ := decodeProto(t, fee[1])
+	assert.Equal(t, "450", string(feeCoin[2]))
+
+	// MPC 签名为 DER 编码
+	der := ecdsa.Sign(privKey, unsigned.SigningHashes[0]).Serialize()
+	signed, err := adapter.AssembleSignedTx(unsigned.Raw, der, pubKey)
+	require.NoError(t, err)
+
+	txRaw, err := base64.StdEncoding.DecodeString(signed.Raw)
+	require.NoError(t, err)
+	txHash := sha256.Sum256(txRaw)
+	assert.Equal(t, strings.ToUpper(hex.EncodeToString(txHash[:])), signed.Hash)
+
+	tx, _ := decodeProto(t, txRaw)
+	assert.Equal(t, doc[1], tx[1])
+	assert.Equal(t, doc[2], tx[2])
+	assert.Equal(t, compactToRS(t, privKey, hash[:]), tx[3])
+
+	other, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	_, err = adapter.AssembleSignedTx(unsigned.Raw, compactToRS(t, other, hash[:]), pubKey)
+	assert.Error(t, err)
+	_, err = adapter.AssembleSignedTx(unsigned.Raw, compactToRS(t, other, hash[:]), other.PubKey().SerializeCompressed())
+	assert.Error(t, err)
+
+	// SignDoc 绑定链 ID
+	osmosis := NewCosmosAdapter(CosmosConfig{ChainID: "osmosis-1", Bech32Prefix: "osmo", Denom: "uosmo"})
+	_, err = osmosis.AssembleSignedTx(unsigned.Raw, der, pubKey)
+	assert.Error(t, err)
+}
+
+func TestCosmosBuildTransactionDefaults(t *testing.T) {
+	privKey, _ := btcec.PrivKeyFromBytes(common32(1))
+	pubKey := privKey.PubKey().SerializeCompressed()
+	from := "cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c"
+
+	// 新账户的账户编号和序列号为 0，按 proto3 规则省略
+	unsigned, err := NewCosmosAdapter(CosmosConfig{ChainID: "theta-testnet-001", Bech32Prefix: "cosmos", Denom: "uatom"}).
+		BuildTransaction(&BuildTxRequest{From: from, To: cosmosReceiver, Amount: big.NewInt(1), CosmosPublicKey: pubKey})
+	require.NoError(t, err)
+	assert.Equal(t, "0", unsigned.Fee.String())
+
+	signDoc, err := base64.StdEncoding.DecodeString(unsigned.Raw)
+	require.NoError(t, err)
+	doc, docVarints := decodeProto(t, signDoc)
+	assert.NotContains(t, docVarints, protowire.Number(4))
+	body, _ := decodeProto(t, doc[1])
+	assert.NotContains(t, body, protowire.Number(2))
+	authInfo, _ := decodeProto(t, doc[2])
+	_, signerVarints := decodeProto(t, authInfo[1])
+	assert.NotContains(t, signerVarints, protowire.Number(3))
+	fee, feeVarints := decodeProto(t, authInfo[2])
+	assert.NotContains(t, fee, protowire.Number(1))
+	assert.Equal(t, uint64(cosmosDefaultGasLimit), feeVarints[2])
+
+	// gas 价格计算的手续费向上取整
+	unsigned, err = NewCosmosAdapter(cosmosHubConfig()).BuildTransaction(&BuildTxRequest{
+		From: from, To: cosmosReceiver, Amount: big.NewInt(1), CosmosPublicKey: pubKey, CosmosGasLimit: 100001,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "501", unsigned.Fee.String())
+}
+
+func TestCosmosBuildTransactionValidation(t *testing.T) {
+	privKey, _ := btcec.PrivKeyFromBytes(common32(1))
+	pubKey := privKey.PubKey().SerializeCompressed()
+	from := "cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c"
+	tests := []struct {
+		name   string
+		config CosmosConfig
+		req    *BuildTxRequest
+	}{
+		{"nil request", cosmosHubConfig(), nil},
+		{"missing chain id", CosmosConfig{Bech32Prefix: "cosmos", Denom: "uatom"},
+			&BuildTxRequest{From: from, To: cosmosReceiver, Amount: big.NewInt(1), CosmosPublicKey: pubKey}},
+		{"zero amount", cosmosHubConfig(), &BuildTxRequest{From: from, To: cosmosReceiver, Amount: big.NewInt(0), CosmosPublicKey: pubKey}},
+		{"invalid to", cosmosHubConfig(), &BuildTxRequest{From: from, To: "osmo1w508d6qejxtdg4y5r3zarvary0c5xw7kjxy2e2", Amount: big.NewInt(1), CosmosPublicKey: pubKey}},
+		{"missing public key", cosmosHubConfig(), &BuildTxRequest{From: from, To: cosmosReceiver, Amount: big.NewInt(1)}},
+		{"public key mismatch", cosmosHubConfig(), &BuildTxRequest{From: cosmosReceiver, To: from, Amount: big.NewInt(1), CosmosPublicKey: pubKey}},
+		{"negative fee", cosmosHubConfig(), &BuildTxRequest{From: from, To: cosmosReceiver, Amount: big.NewInt(1), CosmosPublicKey: pubKey, CosmosFee: big.NewInt(-1)}},
+		{"memo too long", cosmosHubConfig(), &BuildTxRequest{From: from, To: cosmosReceiver, Amount: big.NewInt(1), CosmosPublicKey: pubKey,
+			Data: []byte(strings.Repeat("x", cosmosMaxMemoLength+1))}},
+		{"invalid memo", cosmosHubConfig(), &BuildTxRequest{From: from, To: cosmosReceiver, Amount: big.NewInt(1), CosmosPublicKey: pubKey, Data: []byte{0xff}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCosmosAdapter(tt.config).BuildTransaction(tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCosmosLCD(t *testing.T) {
+	privKey, _ := btcec.PrivKeyFromBytes(common32(1))
+	pubKey := privKey.PubKey().SerializeCompressed()
+	const owner = "cosmos1w508d6qejxtdg4y5r3zarvary0c5xw7k6ah60c"
+
+	lcd := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cosmos/auth/v1beta1/accounts/" + owner:
+			_, _ = w.Write([]byte(`{"account":{"@type":"/cosmos.auth.v1beta1.BaseAccount","address":"` + owner + `",
+				"account_number":"12345","sequence":"7"}}`))
+		case "/cosmos/auth/v1beta1/accounts/" + cosmosReceiver:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":5,"message":"account ` + cosmosReceiver + ` not found","details":[]}`))
+		case "/cosmos/bank/v1beta1/balances/" + owner + "/by_denom":
+			assert.Equal(t, "uatom", r.URL.Query().Get("denom"))
+			_, _ = w.Write([]byte(`{"balance":{"denom":"uatom","amount":"25000000"}}`))
+		case "/cosmos/tx/v1beta1/txs":
+			var params map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+			assert.Equal(t, "BROADCAST_MODE_SYNC", params["mode"])
+			_, _ = w.Write([]byte(`{"tx_response":{"txhash":"ABCD","code":32,"codespace":"sdk","raw_log":"account sequence mismatch"}}`))
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer lcd.Close()
+
+	// 第一个端点不可用时故障转移到下一个
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+
+	adapter := NewCosmosAdapter(cosmosHubConfig(), down.URL, lcd.URL+"/")
+	ctx := context.Background()
+
+	balance, err := adapter.GetBalance(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, "25000000", balance.String())
+
+	account, err := adapter.GetAccount(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12345), account.AccountNumber)
+	assert.Equal(t, uint64(7), account.Sequence)
+
+	_, err = adapter.GetAccount(ctx, cosmosReceiver)
+	assert.ErrorIs(t, err, cosmos.ErrAccountNotFound)
+
+	req := &BuildTxRequest{From: owner, To: cosmosReceiver, Amount: big.NewInt(1), CosmosPublicKey: pubKey}
+	unsigned, err := adapter.BuildTransactionWithAccount(ctx, req)
+	require.NoError(t, err)
+	withAccount := *req
+	withAccount.CosmosAccountNumber, withAccount.Nonce = 12345, 7
+	expected, err := adapter.BuildTransaction(&withAccount)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Raw, unsigned.Raw)
+
+	_, err = adapter.BroadcastTransaction(ctx, "CgA=")
+	var apiErr *cosmos.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 32, apiErr.Code)
+	assert.Contains(t, apiErr.Message, "account sequence mismatch")
+}
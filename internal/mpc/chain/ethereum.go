@@ -1,14 +1,19 @@
 package chain
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
 
 	"github.com/SafeMPC/mpc-service/internal/mpc/chain/ethereum"
@@ -20,15 +25,15 @@ type EthereumAdapter struct {
 	rpcClient *ethereum.RPCClient
 }
 
-// NewEthereumAdapter 创建以太坊适配器
-func NewEthereumAdapter(chainID *big.Int, rpcEndpoint string) *EthereumAdapter {
+// NewEthereumAdapter 创建以太坊适配器，配置多个 RPC 端点时按顺序故障转移
+func NewEthereumAdapter(chainID *big.Int, rpcEndpoints ...string) *EthereumAdapter {
 	if chainID == nil {
 		chainID = big.NewInt(1) // mainnet
 	}
 
 	var rpcClient *ethereum.RPCClient
-	if rpcEndpoint != "" {
-		rpcClient = ethereum.NewRPCClient(rpcEndpoint)
+	if endpoints := nonEmptyEndpoints(rpcEndpoints); len(endpoints) > 0 {
+		rpcClient = ethereum.NewRPCClient(endpoints...)
 	}
 
 	return &EthereumAdapter{
@@ -45,7 +50,7 @@ func (a *EthereumAdapter) GetBalance(ctx context.Context, address string) (*big.
 	return a.rpcClient.GetBalance(ctx, address)
 }
 
-// GetTransactionCount 获取交易计数（用于 nonce）
+// GetTransactionCount 获取已打包的交易计数（latest）
 func (a *EthereumAdapter) GetTransactionCount(ctx context.Context, address string) (uint64, error) {
 	if a.rpcClient == nil {
 		return 0, errors.New("RPC client not configured")
@@ -53,6 +58,14 @@ func (a *EthereumAdapter) GetTransactionCount(ctx context.Context, address strin
 	return a.rpcClient.GetTransactionCount(ctx, address)
 }
 
+// GetPendingTransactionCount 获取包含交易池中交易的交易计数（pending），并发发送时应通过 transaction.NonceManager 分配 nonce
+func (a *EthereumAdapter) GetPendingTransactionCount(ctx context.Context, address string) (uint64, error) {
+	if a.rpcClient == nil {
+		return 0, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.GetPendingTransactionCount(ctx, address)
+}
+
 // BroadcastTransaction 广播交易
 func (a *EthereumAdapter) BroadcastTransaction(ctx context.Context, rawTx string) (string, error) {
 	if a.rpcClient == nil {
@@ -69,6 +82,46 @@ func (a *EthereumAdapter) GetGasPrice(ctx context.Context) (*big.Int, error) {
 	return a.rpcClient.GetGasPrice(ctx)
 }
 
+// GetTransactionReceipt 查询交易回执，交易尚未打包时返回 (nil, nil)
+func (a *EthereumAdapter) GetTransactionReceipt(ctx context.Context, txHash string) (*ethereum.TransactionReceipt, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.GetTransactionReceipt(ctx, txHash)
+}
+
+// GetTransactionByHash 查询节点已知的交易，不存在时返回 (nil, nil)
+func (a *EthereumAdapter) GetTransactionByHash(ctx context.Context, txHash string) (*ethereum.TransactionInfo, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.GetTransactionByHash(ctx, txHash)
+}
+
+// BlockNumber 获取最新区块高度
+func (a *EthereumAdapter) BlockNumber(ctx context.Context) (uint64, error) {
+	if a.rpcClient == nil {
+		return 0, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.BlockNumber(ctx)
+}
+
+// GetBlockByNumber 查询包含完整交易的区块，区块尚不存在时返回 (nil, nil)
+func (a *EthereumAdapter) GetBlockByNumber(ctx context.Context, number uint64) (*ethereum.Block, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.GetBlockByNumber(ctx, number)
+}
+
+// GetLogs 查询区块范围内的事件日志
+func (a *EthereumAdapter) GetLogs(ctx context.Context, filter *ethereum.LogFilter) ([]*ethereum.Log, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.GetLogs(ctx, filter)
+}
+
 // GenerateAddress 通过 Keccak256(pubKey[1:]) 生成地址
 func (a *EthereumAdapter) GenerateAddress(pubKey []byte) (string, error) {
 	if len(pubKey) == 0 {
@@ -92,7 +145,9 @@ func (a *EthereumAdapter) GenerateAddress(pubKey []byte) (string, error) {
 	return fmt.Sprintf("0x%s", hex.EncodeToString(hash[12:])), nil
 }
 
-// BuildTransaction 构建一个简化的 RLP 交易负载
+// BuildTransaction 构建未签名的类型化交易（EIP-2930 或 EIP-1559，默认 EIP-1559）
+// 返回的 Raw 为未签名交易的类型化编码（0x 前缀十六进制），SigningHashes 仅包含一个签名哈希，
+// 交给 ThresholdSign 签名后调用 AssembleSignedTx；交易哈希取决于签名，因此 Hash 为空
 func (a *EthereumAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, error) {
 	if req == nil {
 		return nil, errors.New("build request is nil")
@@ -100,27 +155,189 @@ func (a *EthereumAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, e
 	if req.Amount == nil {
 		return nil, errors.New("amount is required")
 	}
+	if req.Amount.Sign() < 0 {
+		return nil, errors.New("amount must not be negative")
+	}
+	if !common.IsHexAddress(req.To) {
+		return nil, errors.Errorf("invalid to address %s", req.To)
+	}
+	to := common.HexToAddress(req.To)
+
+	// ERC-20 转账：交易发往代币合约，To 和 Amount 编码为 transfer(to, amount) 调用数据
+	value, data := req.Amount, req.Data
+	if req.TokenContract != "" {
+		if len(req.Data) > 0 {
+			return nil, errors.New("data must be empty for token transfers")
+		}
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, errors.Errorf("invalid token contract address %s", req.TokenContract)
+		}
+		if req.Amount.BitLen() > 256 {
+			return nil, errors.New("token amount exceeds uint256")
+		}
+		data = ERC20TransferData(to, req.Amount)
+		to = common.HexToAddress(req.TokenContract)
+		value = new(big.Int)
+	}
 
-	txPayload := []interface{}{
-		req.Nonce,
-		req.FeeRate, // 这里复用 FeeRate 作为 gas price
-		uint64(21000),
-		req.To,
-		req.Amount,
-		req.Data,
-		a.chainID,
-		uint(0),
-		uint(0),
+	gasLimit := req.GasLimit
+	if gasLimit == 0 {
+		if len(data) > 0 {
+			return nil, errors.New("gas limit is required for contract calls")
+		}
+		gasLimit = params.TxGas
 	}
 
-	raw, err := rlp.EncodeToBytes(txPayload)
+	// FeeRate（wei/gas）作为未指定 gas 价格时的兼容取值
+	feeRate := new(big.Int).SetUint64(req.FeeRate)
+
+	var txData types.TxData
+	switch req.EVMTxType {
+	case types.DynamicFeeTxType, 0:
+		maxFee := req.MaxFeePerGas
+		if maxFee == nil {
+			maxFee = feeRate
+		}
+		tip := req.MaxPriorityFeePerGas
+		if tip == nil {
+			tip = new(big.Int)
+		}
+		if maxFee.Sign() <= 0 {
+			return nil, errors.New("max fee per gas is required")
+		}
+		if tip.Cmp(maxFee) > 0 {
+			return nil, errors.New("max priority fee per gas exceeds max fee per gas")
+		}
+		txData = &types.DynamicFeeTx{
+			ChainID:    a.chainID,
+			Nonce:      req.Nonce,
+			GasTipCap:  tip,
+			GasFeeCap:  maxFee,
+			Gas:        gasLimit,
+			To:         &to,
+			Value:      value,
+			Data:       data,
+			AccessList: req.AccessList,
+		}
+	case types.AccessListTxType:
+		gasPrice := req.GasPrice
+		if gasPrice == nil {
+			gasPrice = feeRate
+		}
+		if gasPrice.Sign() <= 0 {
+			return nil, errors.New("gas price is required")
+		}
+		txData = &types.AccessListTx{
+			ChainID:    a.chainID,
+			Nonce:      req.Nonce,
+			GasPrice:   gasPrice,
+			Gas:        gasLimit,
+			To:         &to,
+			Value:      value,
+			Data:       data,
+			AccessList: req.AccessList,
+		}
+	default:
+		return nil, errors.Errorf("unsupported transaction type %d", req.EVMTxType)
+	}
+
+	tx := types.NewTx(txData)
+	raw, err := tx.MarshalBinary()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to RLP encode tx payload")
+		return nil, errors.Wrap(err, "failed to encode transaction")
 	}
 
-	hash := crypto.Keccak256Hash(raw).Hex()
+	signingHash := types.LatestSignerForChainID(a.chainID).Hash(tx)
 	return &Transaction{
-		Raw:  fmt.Sprintf("0x%s", hex.EncodeToString(raw)),
-		Hash: hash,
+		Raw:           hexutil.Encode(raw),
+		SigningHashes: [][]byte{signingHash.Bytes()},
+		Fee:           new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(gasLimit)), // 最大手续费
 	}, nil
 }
+
+// AssembleSignedTx 将 MPC 签名附加到 BuildTransaction 生成的未签名交易，返回可广播的原始交易
+// signature 可以是 DER 编码、64 字节 r||s 或 65 字节 r||s||v；s 会被规范化为 low-S（EIP-2），
+// 恢复 ID（y_parity）通过与 pubKey 比对公钥恢复结果确定
+func (a *EthereumAdapter) AssembleSignedTx(rawTx string, signature []byte, pubKey []byte) (*Transaction, error) {
+	raw, err := hexutil.Decode(rawTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid raw transaction")
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction")
+	}
+	if tx.ChainId().Cmp(a.chainID) != 0 {
+		return nil, errors.Errorf("transaction chain id %s does not match adapter chain id %s", tx.ChainId(), a.chainID)
+	}
+
+	publicKey, err := btcec.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	expected := publicKey.SerializeUncompressed()
+
+	if len(signature) == crypto.SignatureLength {
+		signature = signature[:64]
+	}
+	sig, err := parseECDSASignature(signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+	rs, err := compactSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := types.LatestSignerForChainID(a.chainID)
+	hash := signer.Hash(tx)
+
+	for v := byte(0); v < 2; v++ {
+		recoverable := append(rs, v)
+		recovered, err := crypto.Ecrecover(hash.Bytes(), recoverable)
+		if err != nil || !bytes.Equal(recovered, expected) {
+			continue
+		}
+
+		signed, err := tx.WithSignature(signer, recoverable)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to attach signature")
+		}
+		encoded, err := signed.MarshalBinary()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode signed transaction")
+		}
+		return &Transaction{
+			Raw:  hexutil.Encode(encoded),
+			Hash: signed.Hash().Hex(),
+			Fee:  new(big.Int).Mul(signed.GasFeeCap(), new(big.Int).SetUint64(signed.Gas())),
+		}, nil
+	}
+
+	return nil, errors.New("signature does not match public key")
+}
+
+// compactSignature 将签名转换为 64 字节 r||s，s 取 low-S 形式
+// ecdsa.Signature.Serialize 输出规范 DER（已是 low-S），从中取出 r 和 s
+func compactSignature(sig *ecdsa.Signature) ([]byte, error) {
+	der := sig.Serialize()
+	// 0x30 <len> 0x02 <rlen> <r> 0x02 <slen> <s>
+	if len(der) < 8 || der[0] != 0x30 || der[2] != 0x02 {
+		return nil, errors.New("malformed DER signature")
+	}
+	rLen := int(der[3])
+	if 4+rLen+2 > len(der) || der[4+rLen] != 0x02 {
+		return nil, errors.New("malformed DER signature")
+	}
+	r := der[4 : 4+rLen]
+	sLen := int(der[5+rLen])
+	if 6+rLen+sLen != len(der) {
+		return nil, errors.New("malformed DER signature")
+	}
+	s := der[6+rLen:]
+
+	out := make([]byte, 64)
+	copy(out[32-len(bytes.TrimLeft(r, "\x00")):32], bytes.TrimLeft(r, "\x00"))
+	copy(out[64-len(bytes.TrimLeft(s, "\x00")):], bytes.TrimLeft(s, "\x00"))
+	return out, nil
+}
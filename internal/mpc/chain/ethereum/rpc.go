@@ -3,26 +3,29 @@ package ethereum
 import (
 	"bytes"
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
-// RPCClient Ethereum RPC 客户端
+// RPCClient Ethereum RPC 客户端，配置多个端点时按顺序故障转移
 type RPCClient struct {
-	endpoint string
-	client   *http.Client
+	endpoints []string
+	current   atomic.Int32 // 最近一次调用成功的端点，下次调用优先使用
+	client    *http.Client
 }
 
 // NewRPCClient 创建 Ethereum RPC 客户端
-func NewRPCClient(endpoint string) *RPCClient {
+func NewRPCClient(endpoints ...string) *RPCClient {
 	return &RPCClient{
-		endpoint: endpoint,
+		endpoints: endpoints,
 		client: &http.Client{
 			Timeout: 30 * time.Second,
 		},
@@ -51,8 +54,17 @@ type RPCError struct {
 	Message string `json:"message"`
 }
 
-// call 执行 RPC 调用
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("RPC error: %s (code: %d)", e.Message, e.Code)
+}
+
+// call 执行 RPC 调用，端点不可用（网络错误或 5xx/429 响应）时依次尝试下一个端点，
+// 节点返回的 RPC 错误直接返回
 func (c *RPCClient) call(ctx context.Context, method string, params []interface{}) (json.RawMessage, error) {
+	if len(c.endpoints) == 0 {
+		return nil, errors.New("no RPC endpoint configured")
+	}
+
 	req := &RPCRequest{
 		JSONRPC: "2.0",
 		Method:  method,
@@ -65,7 +77,27 @@ func (c *RPCClient) call(ctx context.Context, method string, params []interface{
 		return nil, errors.Wrap(err, "failed to marshal RPC request")
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.endpoint, bytes.NewReader(reqBody))
+	start := int(c.current.Load())
+	for i := 0; i < len(c.endpoints); i++ {
+		idx := (start + i) % len(c.endpoints)
+		result, err := c.callEndpoint(ctx, c.endpoints[idx], reqBody)
+		if err == nil {
+			c.current.Store(int32(idx))
+			return result, nil
+		}
+
+		var rpcErr *RPCError
+		if errors.As(err, &rpcErr) || ctx.Err() != nil || i == len(c.endpoints)-1 {
+			return nil, err
+		}
+	}
+
+	return nil, errors.New("no RPC endpoint available")
+}
+
+// callEndpoint 向单个端点发送请求
+func (c *RPCClient) callEndpoint(ctx context.Context, endpoint string, reqBody []byte) (json.RawMessage, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create HTTP request")
 	}
@@ -78,13 +110,17 @@ func (c *RPCClient) call(ctx context.Context, method string, params []interface{
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return nil, errors.Errorf("RPC endpoint returned HTTP %d", resp.StatusCode)
+	}
+
 	var rpcResp RPCResponse
 	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
 		return nil, errors.Wrap(err, "failed to decode RPC response")
 	}
 
 	if rpcResp.Error != nil {
-		return nil, fmt.Errorf("RPC error: %s (code: %d)", rpcResp.Error.Message, rpcResp.Error.Code)
+		return nil, rpcResp.Error
 	}
 
 	return rpcResp.Result, nil
@@ -119,9 +155,18 @@ func (c *RPCClient) GetBalance(ctx context.Context, address string) (*big.Int, e
 	return balance, nil
 }
 
-// GetTransactionCount 获取交易计数（用于 nonce）
+// GetTransactionCount 获取已打包的交易计数（latest），即下一笔可上链交易的 nonce
 func (c *RPCClient) GetTransactionCount(ctx context.Context, address string) (uint64, error) {
-	result, err := c.call(ctx, "eth_getTransactionCount", []interface{}{address, "latest"})
+	return c.getTransactionCount(ctx, address, "latest")
+}
+
+// GetPendingTransactionCount 获取包含交易池中可执行交易的交易计数（pending）
+func (c *RPCClient) GetPendingTransactionCount(ctx context.Context, address string) (uint64, error) {
+	return c.getTransactionCount(ctx, address, "pending")
+}
+
+func (c *RPCClient) getTransactionCount(ctx context.Context, address, block string) (uint64, error) {
+	result, err := c.call(ctx, "eth_getTransactionCount", []interface{}{address, block})
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to call eth_getTransactionCount")
 	}
@@ -131,19 +176,12 @@ func (c *RPCClient) GetTransactionCount(ctx context.Context, address string) (ui
 		return 0, errors.Wrap(err, "failed to unmarshal nonce")
 	}
 
-	// 移除 "0x" 前缀
-	if len(nonceHex) > 2 && nonceHex[:2] == "0x" {
-		nonceHex = nonceHex[2:]
-	}
-
-	nonce, err := hex.DecodeString(nonceHex)
+	// 节点返回的数量不补零（如 "0x1"），不能按字节解码
+	nonce, err := decodeQuantity(nonceHex)
 	if err != nil {
-		return 0, errors.Wrap(err, "failed to decode nonce hex")
+		return 0, errors.Wrap(err, "failed to decode nonce")
 	}
-
-	// 转换为 uint64
-	nonceBig := new(big.Int).SetBytes(nonce)
-	return nonceBig.Uint64(), nil
+	return nonce, nil
 }
 
 // SendRawTransaction 广播交易
@@ -186,3 +224,377 @@ func (c *RPCClient) GetGasPrice(ctx context.Context) (*big.Int, error) {
 
 	return gasPrice, nil
 }
+
+// TransactionReceipt 交易回执（只包含确认跟踪需要的字段）
+type TransactionReceipt struct {
+	TxHash      string
+	BlockNumber uint64
+	BlockHash   string
+	Status      uint64 // 1 成功，0 执行失败（revert）
+	GasUsed     uint64
+}
+
+// GetTransactionReceipt 查询交易回执，交易尚未打包时返回 (nil, nil)
+func (c *RPCClient) GetTransactionReceipt(ctx context.Context, txHash string) (*TransactionReceipt, error) {
+	result, err := c.call(ctx, "eth_getTransactionReceipt", []interface{}{txHash})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call eth_getTransactionReceipt")
+	}
+
+	var raw *struct {
+		TransactionHash string `json:"transactionHash"`
+		BlockNumber     string `json:"blockNumber"`
+		BlockHash       string `json:"blockHash"`
+		Status          string `json:"status"`
+		GasUsed         string `json:"gasUsed"`
+	}
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal transaction receipt")
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	receipt := &TransactionReceipt{TxHash: raw.TransactionHash, BlockHash: raw.BlockHash}
+	if receipt.BlockNumber, err = decodeQuantity(raw.BlockNumber); err != nil {
+		return nil, errors.Wrap(err, "invalid receipt block number")
+	}
+	if receipt.Status, err = decodeQuantity(raw.Status); err != nil {
+		return nil, errors.Wrap(err, "invalid receipt status")
+	}
+	if raw.GasUsed != "" {
+		if receipt.GasUsed, err = decodeQuantity(raw.GasUsed); err != nil {
+			return nil, errors.Wrap(err, "invalid receipt gas used")
+		}
+	}
+
+	return receipt, nil
+}
+
+// TransactionInfo 节点已知的交易（交易池中或已打包）
+type TransactionInfo struct {
+	Hash        string
+	From        string
+	Nonce       uint64
+	BlockNumber *uint64 // 仍在交易池中时为空
+}
+
+// GetTransactionByHash 查询交易，节点不知道该交易（未广播成功或已被交易池丢弃）时返回 (nil, nil)
+func (c *RPCClient) GetTransactionByHash(ctx context.Context, txHash string) (*TransactionInfo, error) {
+	result, err := c.call(ctx, "eth_getTransactionByHash", []interface{}{txHash})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call eth_getTransactionByHash")
+	}
+
+	var raw *struct {
+		Hash        string  `json:"hash"`
+		From        string  `json:"from"`
+		Nonce       string  `json:"nonce"`
+		BlockNumber *string `json:"blockNumber"`
+	}
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal transaction")
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	info := &TransactionInfo{Hash: raw.Hash, From: raw.From}
+	if info.Nonce, err = decodeQuantity(raw.Nonce); err != nil {
+		return nil, errors.Wrap(err, "invalid transaction nonce")
+	}
+	if raw.BlockNumber != nil {
+		blockNumber, err := decodeQuantity(*raw.BlockNumber)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid transaction block number")
+		}
+		info.BlockNumber = &blockNumber
+	}
+
+	return info, nil
+}
+
+// BlockNumber 获取最新区块高度
+func (c *RPCClient) BlockNumber(ctx context.Context) (uint64, error) {
+	result, err := c.call(ctx, "eth_blockNumber", []interface{}{})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to call eth_blockNumber")
+	}
+
+	var blockHex string
+	if err := json.Unmarshal(result, &blockHex); err != nil {
+		return 0, errors.Wrap(err, "failed to unmarshal block number")
+	}
+
+	blockNumber, err := decodeQuantity(blockHex)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to decode block number")
+	}
+	return blockNumber, nil
+}
+
+// Block 区块（只包含索引转入交易需要的字段）
+type Block struct {
+	Number       uint64
+	Hash         string
+	Timestamp    uint64 // Unix 秒
+	Transactions []*BlockTransaction
+}
+
+// BlockTransaction 区块中的交易
+type BlockTransaction struct {
+	Hash  string
+	From  string
+	To    string // 合约创建交易为空
+	Value *big.Int
+}
+
+// GetBlockByNumber 查询包含完整交易的区块，区块尚不存在时返回 (nil, nil)
+func (c *RPCClient) GetBlockByNumber(ctx context.Context, number uint64) (*Block, error) {
+	result, err := c.call(ctx, "eth_getBlockByNumber", []interface{}{fmt.Sprintf("0x%x", number), true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call eth_getBlockByNumber")
+	}
+
+	var raw *struct {
+		Number       string `json:"number"`
+		Hash         string `json:"hash"`
+		Timestamp    string `json:"timestamp"`
+		Transactions []struct {
+			Hash  string  `json:"hash"`
+			From  string  `json:"from"`
+			To    *string `json:"to"`
+			Value string  `json:"value"`
+		} `json:"transactions"`
+	}
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal block")
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	block := &Block{Hash: raw.Hash, Transactions: make([]*BlockTransaction, 0, len(raw.Transactions))}
+	if block.Number, err = decodeQuantity(raw.Number); err != nil {
+		return nil, errors.Wrap(err, "invalid block number")
+	}
+	if block.Timestamp, err = decodeQuantity(raw.Timestamp); err != nil {
+		return nil, errors.Wrap(err, "invalid block timestamp")
+	}
+	for _, rawTx := range raw.Transactions {
+		tx := &BlockTransaction{Hash: rawTx.Hash, From: rawTx.From}
+		if rawTx.To != nil {
+			tx.To = *rawTx.To
+		}
+		if tx.Value, err = decodeBigQuantity(rawTx.Value); err != nil {
+			return nil, errors.Wrapf(err, "invalid value of transaction %s", rawTx.Hash)
+		}
+		block.Transactions = append(block.Transactions, tx)
+	}
+
+	return block, nil
+}
+
+// LogFilter eth_getLogs 过滤条件，Topics 的每个位置为空表示任意值，多个值表示匹配其中之一
+type LogFilter struct {
+	FromBlock uint64
+	ToBlock   uint64
+	Addresses []string
+	Topics    [][]string
+}
+
+// Log 合约事件日志
+type Log struct {
+	Address     string
+	Topics      []string
+	Data        string
+	BlockNumber uint64
+	TxHash      string
+	LogIndex    uint64
+	Removed     bool // 所在区块被重组移除
+}
+
+// GetLogs 查询区块范围内的事件日志
+func (c *RPCClient) GetLogs(ctx context.Context, filter *LogFilter) ([]*Log, error) {
+	params := map[string]interface{}{
+		"fromBlock": fmt.Sprintf("0x%x", filter.FromBlock),
+		"toBlock":   fmt.Sprintf("0x%x", filter.ToBlock),
+	}
+	if len(filter.Addresses) > 0 {
+		params["address"] = filter.Addresses
+	}
+	if len(filter.Topics) > 0 {
+		topics := make([]interface{}, len(filter.Topics))
+		for i, values := range filter.Topics {
+			if len(values) > 0 {
+				topics[i] = values
+			}
+		}
+		params["topics"] = topics
+	}
+
+	result, err := c.call(ctx, "eth_getLogs", []interface{}{params})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call eth_getLogs")
+	}
+
+	var raw []struct {
+		Address         string   `json:"address"`
+		Topics          []string `json:"topics"`
+		Data            string   `json:"data"`
+		BlockNumber     string   `json:"blockNumber"`
+		TransactionHash string   `json:"transactionHash"`
+		LogIndex        string   `json:"logIndex"`
+		Removed         bool     `json:"removed"`
+	}
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal logs")
+	}
+
+	logs := make([]*Log, 0, len(raw))
+	for _, rawLog := range raw {
+		log := &Log{
+			Address: rawLog.Address,
+			Topics:  rawLog.Topics,
+			Data:    rawLog.Data,
+			TxHash:  rawLog.TransactionHash,
+			Removed: rawLog.Removed,
+		}
+		if log.BlockNumber, err = decodeQuantity(rawLog.BlockNumber); err != nil {
+			return nil, errors.Wrap(err, "invalid log block number")
+		}
+		if log.LogIndex, err = decodeQuantity(rawLog.LogIndex); err != nil {
+			return nil, errors.Wrap(err, "invalid log index")
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+// Call 在最新区块上执行只读合约调用（eth_call），data 和返回值均为 0x 前缀十六进制
+func (c *RPCClient) Call(ctx context.Context, to, data string) (string, error) {
+	result, err := c.call(ctx, "eth_call", []interface{}{map[string]string{"to": to, "data": data}, "latest"})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to call eth_call")
+	}
+
+	var output string
+	if err := json.Unmarshal(result, &output); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal call result")
+	}
+	return output, nil
+}
+
+// FeeHistory eth_feeHistory 的结果
+type FeeHistory struct {
+	OldestBlock   uint64
+	BaseFeePerGas []*big.Int   // 比请求的区块数多一个，最后一个为下一个区块的 base fee
+	GasUsedRatio  []float64    // 每个区块的 gas 使用率
+	Reward        [][]*big.Int // 每个区块按请求的百分位数统计的优先费，节点未返回时为空
+}
+
+// FeeHistory 查询最近 blockCount 个区块的 base fee 和按百分位数统计的优先费
+func (c *RPCClient) FeeHistory(ctx context.Context, blockCount uint64, percentiles []float64) (*FeeHistory, error) {
+	result, err := c.call(ctx, "eth_feeHistory", []interface{}{fmt.Sprintf("0x%x", blockCount), "latest", percentiles})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call eth_feeHistory")
+	}
+
+	var raw struct {
+		OldestBlock   string     `json:"oldestBlock"`
+		BaseFeePerGas []string   `json:"baseFeePerGas"`
+		GasUsedRatio  []float64  `json:"gasUsedRatio"`
+		Reward        [][]string `json:"reward"`
+	}
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal fee history")
+	}
+
+	history := &FeeHistory{GasUsedRatio: raw.GasUsedRatio}
+	if history.OldestBlock, err = decodeQuantity(raw.OldestBlock); err != nil {
+		return nil, errors.Wrap(err, "invalid oldest block")
+	}
+	for _, baseFee := range raw.BaseFeePerGas {
+		value, err := decodeBigQuantity(baseFee)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid base fee")
+		}
+		history.BaseFeePerGas = append(history.BaseFeePerGas, value)
+	}
+	for _, rewards := range raw.Reward {
+		values := make([]*big.Int, 0, len(rewards))
+		for _, reward := range rewards {
+			value, err := decodeBigQuantity(reward)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid priority fee reward")
+			}
+			values = append(values, value)
+		}
+		history.Reward = append(history.Reward, values)
+	}
+
+	return history, nil
+}
+
+// MaxPriorityFeePerGas 节点建议的 EIP-1559 优先费（eth_maxPriorityFeePerGas）
+func (c *RPCClient) MaxPriorityFeePerGas(ctx context.Context) (*big.Int, error) {
+	result, err := c.call(ctx, "eth_maxPriorityFeePerGas", []interface{}{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call eth_maxPriorityFeePerGas")
+	}
+
+	var tipHex string
+	if err := json.Unmarshal(result, &tipHex); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal priority fee")
+	}
+	return decodeBigQuantity(tipHex)
+}
+
+// erc20BalanceOfSelector balanceOf(address) 函数选择器
+const erc20BalanceOfSelector = "0x70a08231"
+
+// ERC20BalanceOf 通过 eth_call 调用代币合约的 balanceOf(owner)，返回最小单位余额
+func (c *RPCClient) ERC20BalanceOf(ctx context.Context, token, owner string) (*big.Int, error) {
+	address := strings.TrimPrefix(strings.ToLower(owner), "0x")
+	if len(address) != 40 {
+		return nil, errors.Errorf("invalid owner address %s", owner)
+	}
+
+	output, err := c.Call(ctx, token, erc20BalanceOfSelector+strings.Repeat("0", 24)+address)
+	if err != nil {
+		return nil, err
+	}
+	// 没有代码的地址返回 "0x"，不能当作余额为 0
+	if len(output) != 66 {
+		return nil, errors.Errorf("token %s returned invalid balanceOf result %q", token, output)
+	}
+	return decodeBigQuantity(output)
+}
+
+// decodeBigQuantity 解析可能超过 uint64 的十六进制数量（如 wei 金额）
+func decodeBigQuantity(quantity string) (*big.Int, error) {
+	if !strings.HasPrefix(quantity, "0x") && !strings.HasPrefix(quantity, "0X") {
+		return nil, errors.Errorf("quantity %q is missing 0x prefix", quantity)
+	}
+	if quantity == "0x" || quantity == "0X" {
+		return new(big.Int), nil
+	}
+	value, ok := new(big.Int).SetString(quantity[2:], 16)
+	if !ok {
+		return nil, errors.Errorf("invalid quantity %q", quantity)
+	}
+	return value, nil
+}
+
+// decodeQuantity 解析 JSON-RPC 的十六进制数量（"0x" 前缀，无前导零）
+func decodeQuantity(quantity string) (uint64, error) {
+	if !strings.HasPrefix(quantity, "0x") && !strings.HasPrefix(quantity, "0X") {
+		return 0, errors.Errorf("quantity %q is missing 0x prefix", quantity)
+	}
+	value, err := strconv.ParseUint(quantity[2:], 16, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid quantity %q", quantity)
+	}
+	return value, nil
+}
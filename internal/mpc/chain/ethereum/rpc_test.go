@@ -0,0 +1,140 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCClientFailover(t *testing.T) {
+	var unavailableCalls atomic.Int32
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		unavailableCalls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xde0b6b3a7640000"}`))
+	}))
+	defer healthy.Close()
+
+	client := NewRPCClient(unavailable.URL, healthy.URL)
+
+	balance, err := client.GetBalance(context.Background(), "0x0000000000000000000000000000000000000000")
+	require.NoError(t, err)
+	assert.Equal(t, "1000000000000000000", balance.String())
+
+	// 之后的调用优先使用上次成功的端点
+	_, err = client.GetBalance(context.Background(), "0x0000000000000000000000000000000000000000")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), unavailableCalls.Load())
+}
+
+func TestRPCClientErrorDoesNotFailover(t *testing.T) {
+	var secondCalls atomic.Int32
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"nonce too low"}}`))
+	}))
+	defer rejecting.Close()
+
+	second := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secondCalls.Add(1)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer second.Close()
+
+	_, err := NewRPCClient(rejecting.URL, second.URL).SendRawTransaction(context.Background(), "0x00")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nonce too low")
+	assert.Equal(t, int32(0), secondCalls.Load())
+
+	_, err = NewRPCClient().GetGasPrice(context.Background())
+	assert.Error(t, err)
+}
+
+func TestRPCClientTransactionQueries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		switch req.Method {
+		case "eth_getTransactionReceipt":
+			if req.Params[0] == "0xmined" {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"0xmined","blockNumber":"0x10","blockHash":"0xabc","status":"0x0","gasUsed":"0x5208"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`))
+		case "eth_getTransactionByHash":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0xpooled","from":"0x01","nonce":"0x7","blockNumber":null}}`))
+		case "eth_blockNumber":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1b4"}`))
+		case "eth_getTransactionCount":
+			// 数量不补零，不能按字节解码
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewRPCClient(server.URL)
+	ctx := context.Background()
+
+	receipt, err := client.GetTransactionReceipt(ctx, "0xmined")
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	assert.Equal(t, uint64(16), receipt.BlockNumber)
+	assert.Equal(t, uint64(0), receipt.Status)
+	assert.Equal(t, uint64(21000), receipt.GasUsed)
+
+	receipt, err = client.GetTransactionReceipt(ctx, "0xunknown")
+	require.NoError(t, err)
+	assert.Nil(t, receipt)
+
+	info, err := client.GetTransactionByHash(ctx, "0xpooled")
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, uint64(7), info.Nonce)
+	assert.Nil(t, info.BlockNumber)
+
+	head, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(436), head)
+
+	nonce, err := client.GetTransactionCount(ctx, "0x01")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+}
+
+func TestRPCClientERC20BalanceOf(t *testing.T) {
+	const token = "0x00000000000000000000000000000000000000cc"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_call", req.Method)
+
+		call := req.Params[0].(map[string]interface{})
+		if call["to"] != token {
+			// 没有代码的地址
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x"}`))
+			return
+		}
+		assert.Equal(t, "0x70a0823100000000000000000000000000000000000000000000000000000000000000aa", call["data"])
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x00000000000000000000000000000000000000000000000000000000000f4240"}`))
+	}))
+	defer server.Close()
+
+	client := NewRPCClient(server.URL)
+
+	balance, err := client.ERC20BalanceOf(context.Background(), token, "0x00000000000000000000000000000000000000AA")
+	require.NoError(t, err)
+	assert.Equal(t, "1000000", balance.String())
+
+	_, err = client.ERC20BalanceOf(context.Background(), "0x00000000000000000000000000000000000000dd", "0x00000000000000000000000000000000000000aa")
+	assert.Error(t, err)
+}
@@ -0,0 +1,85 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// evmFeeHistoryBlocks 统计优先费的最近区块数
+	evmFeeHistoryBlocks = 20
+	// evmBaseFeeMultiplier MaxFeePerGas 预留的 base fee 倍数，可承受连续 6 个满区块的 base fee 上涨
+	evmBaseFeeMultiplier = 2
+)
+
+// evmFeePercentiles 慢、正常、快三个档位对应的区块内优先费百分位数
+var evmFeePercentiles = []float64{10, 50, 90}
+
+// EstimateFees 根据 eth_feeHistory 估算 EIP-1559 费用
+// 各档位的优先费取最近区块对应百分位数的中位数，MaxFeePerGas = 2 * base fee + 优先费；
+// 不支持 EIP-1559 的链所有档位均为 eth_gasPrice
+func (a *EthereumAdapter) EstimateFees(ctx context.Context) (*FeeEstimate, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+
+	history, err := a.rpcClient.FeeHistory(ctx, evmFeeHistoryBlocks, evmFeePercentiles)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseFee *big.Int
+	if len(history.BaseFeePerGas) > 0 {
+		baseFee = history.BaseFeePerGas[len(history.BaseFeePerGas)-1]
+	}
+	if baseFee == nil || baseFee.Sign() == 0 {
+		gasPrice, err := a.rpcClient.GetGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tier := func() *FeeTier { return &FeeTier{FeeRate: new(big.Int).Set(gasPrice)} }
+		return &FeeEstimate{Unit: FeeUnitWeiPerGas, Slow: tier(), Normal: tier(), Fast: tier()}, nil
+	}
+
+	tips := make([]*big.Int, len(evmFeePercentiles))
+	for i := range evmFeePercentiles {
+		var rewards []*big.Int
+		for _, blockRewards := range history.Reward {
+			if i < len(blockRewards) {
+				rewards = append(rewards, blockRewards[i])
+			}
+		}
+		tips[i] = medianBigInt(rewards)
+	}
+
+	// 节点未返回区块奖励时（空区块或节点不支持）使用节点建议的优先费
+	if tips[1] == nil {
+		suggested, err := a.rpcClient.MaxPriorityFeePerGas(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range tips {
+			tips[i] = suggested
+		}
+	}
+	// 保证档位单调递增
+	for i := 1; i < len(tips); i++ {
+		if tips[i].Cmp(tips[i-1]) < 0 {
+			tips[i] = tips[i-1]
+		}
+	}
+
+	tier := func(tip *big.Int) *FeeTier {
+		maxFee := new(big.Int).Mul(baseFee, big.NewInt(evmBaseFeeMultiplier))
+		return &FeeTier{FeeRate: maxFee.Add(maxFee, tip), PriorityFee: new(big.Int).Set(tip)}
+	}
+	return &FeeEstimate{
+		Unit:    FeeUnitWeiPerGas,
+		BaseFee: new(big.Int).Set(baseFee),
+		Slow:    tier(tips[0]),
+		Normal:  tier(tips[1]),
+		Fast:    tier(tips[2]),
+	}, nil
+}
@@ -0,0 +1,164 @@
+package chain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthereumBuildAndAssembleTransaction(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+	to := "0x3535353535353535353535353535353535353535"
+
+	tests := []struct {
+		name   string
+		req    *BuildTxRequest
+		txType uint8
+	}{
+		{
+			name: "eip-1559",
+			req: &BuildTxRequest{
+				To:                   to,
+				Amount:               big.NewInt(1e18),
+				Nonce:                7,
+				MaxFeePerGas:         big.NewInt(30e9),
+				MaxPriorityFeePerGas: big.NewInt(2e9),
+			},
+			txType: types.DynamicFeeTxType,
+		},
+		{
+			name: "eip-2930",
+			req: &BuildTxRequest{
+				To:        to,
+				Amount:    big.NewInt(1e18),
+				Nonce:     8,
+				EVMTxType: types.AccessListTxType,
+				GasPrice:  big.NewInt(20e9),
+				GasLimit:  60000,
+				Data:      []byte{0xa9, 0x05, 0x9c, 0xbb},
+				AccessList: types.AccessList{
+					{Address: common.HexToAddress(to), StorageKeys: []common.Hash{{1}}},
+				},
+			},
+			txType: types.AccessListTxType,
+		},
+	}
+
+	adapter := NewEthereumAdapter(big.NewInt(11155111), "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsigned, err := adapter.BuildTransaction(tt.req)
+			require.NoError(t, err)
+			require.Len(t, unsigned.SigningHashes, 1)
+			assert.Empty(t, unsigned.Hash)
+
+			// MPC 签名不包含恢复 ID
+			sig := compactToRS(t, privKey, unsigned.SigningHashes[0])
+			signed, err := adapter.AssembleSignedTx(unsigned.Raw, sig, pubKey)
+			require.NoError(t, err)
+
+			raw, err := hexutil.Decode(signed.Raw)
+			require.NoError(t, err)
+			tx := new(types.Transaction)
+			require.NoError(t, tx.UnmarshalBinary(raw))
+
+			assert.Equal(t, tt.txType, tx.Type())
+			assert.Equal(t, tt.req.Nonce, tx.Nonce())
+			assert.Equal(t, tx.Hash().Hex(), signed.Hash)
+
+			sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(11155111)), tx)
+			require.NoError(t, err)
+			assert.Equal(t, crypto.PubkeyToAddress(*privKey.PubKey().ToECDSA()), sender)
+		})
+	}
+}
+
+func TestEthereumAssembleSignedTxValidation(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	adapter := NewEthereumAdapter(big.NewInt(1), "")
+	unsigned, err := adapter.BuildTransaction(&BuildTxRequest{
+		To:      "0x3535353535353535353535353535353535353535",
+		Amount:  big.NewInt(1),
+		FeeRate: 1e9,
+	})
+	require.NoError(t, err)
+	sig := compactToRS(t, privKey, unsigned.SigningHashes[0])
+
+	// 签名与公钥不匹配
+	_, err = adapter.AssembleSignedTx(unsigned.Raw, sig, otherKey.PubKey().SerializeCompressed())
+	assert.Error(t, err)
+
+	// 链 ID 不匹配
+	_, err = NewEthereumAdapter(big.NewInt(137), "").AssembleSignedTx(unsigned.Raw, sig, privKey.PubKey().SerializeCompressed())
+	assert.Error(t, err)
+}
+
+func TestEthereumBuildTransactionValidation(t *testing.T) {
+	to := "0x3535353535353535353535353535353535353535"
+	tests := []struct {
+		name string
+		req  *BuildTxRequest
+	}{
+		{"invalid to", &BuildTxRequest{To: "0x1234", Amount: big.NewInt(1), FeeRate: 1}},
+		{"missing amount", &BuildTxRequest{To: to, FeeRate: 1}},
+		{"missing fee", &BuildTxRequest{To: to, Amount: big.NewInt(1)}},
+		{"contract call without gas limit", &BuildTxRequest{To: to, Amount: big.NewInt(0), FeeRate: 1, Data: []byte{1}}},
+		{"tip exceeds fee cap", &BuildTxRequest{To: to, Amount: big.NewInt(1), MaxFeePerGas: big.NewInt(1), MaxPriorityFeePerGas: big.NewInt(2)}},
+		{"unsupported type", &BuildTxRequest{To: to, Amount: big.NewInt(1), FeeRate: 1, EVMTxType: types.BlobTxType}},
+		{"token transfer without gas limit", &BuildTxRequest{To: to, Amount: big.NewInt(1), FeeRate: 1, TokenContract: to}},
+		{"invalid token contract", &BuildTxRequest{To: to, Amount: big.NewInt(1), FeeRate: 1, GasLimit: 65000, TokenContract: "0x12"}},
+		{"token transfer with data", &BuildTxRequest{To: to, Amount: big.NewInt(1), FeeRate: 1, GasLimit: 65000, TokenContract: to, Data: []byte{1}}},
+	}
+
+	adapter := NewEthereumAdapter(big.NewInt(1), "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := adapter.BuildTransaction(tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEthereumBuildERC20Transfer(t *testing.T) {
+	const (
+		recipient = "0x3535353535353535353535353535353535353535"
+		usdc      = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	)
+
+	adapter := NewEthereumAdapter(big.NewInt(1), "")
+	unsigned, err := adapter.BuildTransaction(&BuildTxRequest{
+		To:            recipient,
+		Amount:        big.NewInt(2_500_000), // 2.5 USDC
+		Nonce:         3,
+		MaxFeePerGas:  big.NewInt(30e9),
+		GasLimit:      65000,
+		TokenContract: usdc,
+	})
+	require.NoError(t, err)
+
+	raw, err := hexutil.Decode(unsigned.Raw)
+	require.NoError(t, err)
+	tx := new(types.Transaction)
+	require.NoError(t, tx.UnmarshalBinary(raw))
+
+	assert.Equal(t, common.HexToAddress(usdc), *tx.To())
+	assert.Zero(t, tx.Value().Sign())
+	assert.Equal(t,
+		"0xa9059cbb"+
+			"0000000000000000000000003535353535353535353535353535353535353535"+
+			"00000000000000000000000000000000000000000000000000000000002625a0",
+		hexutil.Encode(tx.Data()))
+}
@@ -0,0 +1,105 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/ethereum"
+)
+
+// ERC20TransferTopic Transfer(address,address,uint256) 事件签名，indexed 参数 from、to 分别为 topics[1]、topics[2]
+const ERC20TransferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// erc20DecimalsSelector decimals() 函数选择器
+const erc20DecimalsSelector = "0x313ce567"
+
+// erc20TransferSelector transfer(address,uint256) 函数选择器
+var erc20TransferSelector = []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+// ERC20Transfer 解析后的 ERC-20 Transfer 事件
+type ERC20Transfer struct {
+	Token    string // 代币合约地址（小写）
+	From     string // 小写
+	To       string // 小写
+	Value    *big.Int
+	TxHash   string
+	LogIndex uint64
+	Block    uint64
+}
+
+// AddressTopic 将地址左补零为 32 字节的 topic，用于按 indexed 地址参数过滤日志
+func AddressTopic(address string) string {
+	return common.BytesToHash(common.HexToAddress(address).Bytes()).Hex()
+}
+
+// ParseERC20Transfer 解析 Transfer 事件日志，非 ERC-20 Transfer（如 ERC-721 的 tokenId 在 topics[3]）返回错误
+func ParseERC20Transfer(log *ethereum.Log) (*ERC20Transfer, error) {
+	if len(log.Topics) != 3 || !strings.EqualFold(log.Topics[0], ERC20TransferTopic) {
+		return nil, errors.New("log is not an ERC-20 Transfer event")
+	}
+	data, err := hexutil.Decode(log.Data)
+	if err != nil || len(data) != 32 {
+		return nil, errors.New("invalid ERC-20 Transfer event data")
+	}
+
+	return &ERC20Transfer{
+		Token:    strings.ToLower(log.Address),
+		From:     strings.ToLower(common.HexToAddress(log.Topics[1]).Hex()),
+		To:       strings.ToLower(common.HexToAddress(log.Topics[2]).Hex()),
+		Value:    new(big.Int).SetBytes(data),
+		TxHash:   log.TxHash,
+		LogIndex: log.LogIndex,
+		Block:    log.BlockNumber,
+	}, nil
+}
+
+// ERC20TransferData 编码 transfer(to, amount) 调用数据
+func ERC20TransferData(to common.Address, amount *big.Int) []byte {
+	data := make([]byte, 0, 4+32+32)
+	data = append(data, erc20TransferSelector...)
+	data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+	return append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+}
+
+// GetTokenBalance 查询地址的 ERC-20 代币余额（最小单位）
+func (a *EthereumAdapter) GetTokenBalance(ctx context.Context, token, owner string) (*big.Int, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	if !common.IsHexAddress(token) {
+		return nil, errors.Errorf("invalid token address %s", token)
+	}
+	if !common.IsHexAddress(owner) {
+		return nil, errors.Errorf("invalid owner address %s", owner)
+	}
+	return a.rpcClient.ERC20BalanceOf(ctx, token, owner)
+}
+
+// ERC20Decimals 调用代币合约的 decimals()
+func (a *EthereumAdapter) ERC20Decimals(ctx context.Context, token string) (uint8, error) {
+	if a.rpcClient == nil {
+		return 0, errors.New("RPC client not configured")
+	}
+	if !common.IsHexAddress(token) {
+		return 0, errors.Errorf("invalid token address %s", token)
+	}
+
+	output, err := a.rpcClient.Call(ctx, token, erc20DecimalsSelector)
+	if err != nil {
+		return 0, err
+	}
+	data, err := hexutil.Decode(output)
+	if err != nil || len(data) != 32 {
+		return 0, errors.Errorf("token %s returned invalid decimals", token)
+	}
+	decimals := new(big.Int).SetBytes(data)
+	if !decimals.IsUint64() || decimals.Uint64() > 255 {
+		return 0, errors.Errorf("token %s returned invalid decimals", token)
+	}
+	return uint8(decimals.Uint64()), nil
+}
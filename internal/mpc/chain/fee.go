@@ -0,0 +1,69 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+	"sort"
+)
+
+// 手续费估算的费率单位
+const (
+	FeeUnitWeiPerGas          = "wei/gas"
+	FeeUnitSatPerVByte        = "sat/vB"
+	FeeUnitMicroLamportsPerCU = "micro-lamports/CU"
+)
+
+// FeeTier 一个速度档位的建议费率
+type FeeTier struct {
+	// FeeRate 建议费率：EVM 为 MaxFeePerGas，Bitcoin 为 BuildTxRequest.FeeRate，Solana 为计算单元价格
+	FeeRate *big.Int
+	// PriorityFee EVM 为 MaxPriorityFeePerGas，Solana 与 FeeRate 相同，Bitcoin 为空
+	PriorityFee *big.Int
+	// TargetBlocks 预计确认所需的区块数，只有 Bitcoin 提供
+	TargetBlocks uint64
+}
+
+// FeeEstimate 按慢、正常、快三个档位给出的手续费估算
+type FeeEstimate struct {
+	Unit string
+	// BaseFee EVM 为下一个区块的 base fee（wei/gas），Solana 为每个签名的基础手续费（lamports），Bitcoin 为空
+	BaseFee *big.Int
+	Slow    *FeeTier
+	Normal  *FeeTier
+	Fast    *FeeTier
+}
+
+// FeeEstimator 支持查询当前网络手续费的链适配器
+type FeeEstimator interface {
+	EstimateFees(ctx context.Context) (*FeeEstimate, error)
+}
+
+var (
+	_ FeeEstimator = (*EthereumAdapter)(nil)
+	_ FeeEstimator = (*BitcoinAdapter)(nil)
+	_ FeeEstimator = (*SolanaAdapter)(nil)
+)
+
+// medianBigInt 中位数（偶数个时取较低的一个），values 为空时返回 nil
+func medianBigInt(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := append([]*big.Int(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	return new(big.Int).Set(sorted[(len(sorted)-1)/2])
+}
+
+// percentileUint64 最近秩法计算百分位数，values 为空时返回 0
+func percentileUint64(values []uint64, percentile int) uint64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]uint64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := (percentile*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
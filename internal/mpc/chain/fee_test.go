@@ -0,0 +1,135 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// feeNode 按方法名返回固定结果的 JSON-RPC 节点，Bitcoin 按 estimatesmartfee 的目标区块数返回
+func feeNode(t *testing.T, results map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		key := req.Method
+		if req.Method == "estimatesmartfee" {
+			key += " " + string(req.Params[0])
+		}
+		result, ok := results[key]
+		if !ok {
+			t.Errorf("unexpected call %s", key)
+			result = "null"
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + result + `}`))
+	}))
+}
+
+func TestEthereumEstimateFees(t *testing.T) {
+	node := feeNode(t, map[string]string{
+		// 下一个区块的 base fee 为 20 gwei；三个区块的优先费中位数分别为 1、2、5 gwei
+		"eth_feeHistory": `{"oldestBlock":"0x10","baseFeePerGas":["0x2540be400","0x2540be400","0x4a817c800","0x4a817c800"],
+			"gasUsedRatio":[0.5,0.9,0.4],
+			"reward":[["0x3b9aca00","0x77359400","0x12a05f200"],["0x3b9aca00","0x77359400","0x165a0bc00"],["0x77359400","0xb2d05e00","0x12a05f200"]]}`,
+	})
+	defer node.Close()
+
+	estimate, err := NewEthereumAdapter(nil, node.URL).EstimateFees(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, FeeUnitWeiPerGas, estimate.Unit)
+	assert.Equal(t, "20000000000", estimate.BaseFee.String())
+	assert.Equal(t, "1000000000", estimate.Slow.PriorityFee.String())
+	assert.Equal(t, "41000000000", estimate.Slow.FeeRate.String())
+	assert.Equal(t, "2000000000", estimate.Normal.PriorityFee.String())
+	assert.Equal(t, "5000000000", estimate.Fast.PriorityFee.String())
+	assert.Equal(t, "45000000000", estimate.Fast.FeeRate.String())
+}
+
+func TestEthereumEstimateFeesFallbacks(t *testing.T) {
+	// 没有区块奖励时使用节点建议的优先费
+	node := feeNode(t, map[string]string{
+		"eth_feeHistory":           `{"oldestBlock":"0x10","baseFeePerGas":["0x3b9aca00","0x3b9aca00"],"gasUsedRatio":[0],"reward":[]}`,
+		"eth_maxPriorityFeePerGas": `"0x5f5e100"`,
+	})
+	defer node.Close()
+
+	estimate, err := NewEthereumAdapter(nil, node.URL).EstimateFees(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "100000000", estimate.Slow.PriorityFee.String())
+	assert.Equal(t, "2100000000", estimate.Fast.FeeRate.String())
+
+	// 不支持 EIP-1559 的链所有档位均为 gas price
+	legacy := feeNode(t, map[string]string{
+		"eth_feeHistory": `{"oldestBlock":"0x10","baseFeePerGas":["0x0","0x0"],"gasUsedRatio":[0.5]}`,
+		"eth_gasPrice":   `"0x3b9aca00"`,
+	})
+	defer legacy.Close()
+
+	estimate, err = NewEthereumAdapter(nil, legacy.URL).EstimateFees(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, estimate.BaseFee)
+	assert.Equal(t, "1000000000", estimate.Normal.FeeRate.String())
+	assert.Nil(t, estimate.Normal.PriorityFee)
+
+	_, err = NewEthereumAdapter(nil).EstimateFees(context.Background())
+	assert.Error(t, err)
+}
+
+func TestBitcoinEstimateFees(t *testing.T) {
+	node := feeNode(t, map[string]string{
+		"estimatesmartfee 2":  `{"feerate":0.00020001,"blocks":2}`,
+		"estimatesmartfee 6":  `{"feerate":0.0001,"blocks":6}`,
+		"estimatesmartfee 24": `{"feerate":0.000005,"blocks":25}`,
+	})
+	defer node.Close()
+
+	adapter := NewBitcoinAdapterWithRPC(&chaincfg.RegressionNetParams, BitcoinAddressP2WPKH, node.URL)
+	estimate, err := adapter.EstimateFees(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, FeeUnitSatPerVByte, estimate.Unit)
+	assert.Nil(t, estimate.BaseFee)
+	assert.Equal(t, "21", estimate.Fast.FeeRate.String()) // 20.001 向上取整
+	assert.Equal(t, uint64(2), estimate.Fast.TargetBlocks)
+	assert.Equal(t, "10", estimate.Normal.FeeRate.String())
+	assert.Equal(t, "1", estimate.Slow.FeeRate.String()) // 0.5 向上取整
+	assert.Equal(t, uint64(25), estimate.Slow.TargetBlocks)
+
+	// 切换地址类型后共享 RPC 客户端
+	_, err = adapter.WithAddressType(BitcoinAddressP2TR).EstimateFees(context.Background())
+	require.NoError(t, err)
+
+	// 节点数据不足时没有 feerate
+	insufficient := feeNode(t, map[string]string{
+		"estimatesmartfee 24": `{"errors":["Insufficient data or no feerate found"],"blocks":0}`,
+	})
+	defer insufficient.Close()
+
+	_, err = NewBitcoinAdapterWithRPC(nil, "", insufficient.URL).EstimateFees(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Insufficient data")
+}
+
+func TestSolanaEstimateFees(t *testing.T) {
+	node := feeNode(t, map[string]string{
+		"getRecentPrioritizationFees": `[{"slot":1,"prioritizationFee":0},{"slot":2,"prioritizationFee":100},
+			{"slot":3,"prioritizationFee":500},{"slot":4,"prioritizationFee":1000},{"slot":5,"prioritizationFee":20000}]`,
+	})
+	defer node.Close()
+
+	estimate, err := NewSolanaAdapterWithRPC(node.URL).EstimateFees(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, FeeUnitMicroLamportsPerCU, estimate.Unit)
+	assert.Equal(t, "5000", estimate.BaseFee.String())
+	assert.Equal(t, "100", estimate.Slow.FeeRate.String())
+	assert.Equal(t, "500", estimate.Normal.FeeRate.String())
+	assert.Equal(t, "20000", estimate.Fast.PriorityFee.String())
+}
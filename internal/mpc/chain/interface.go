@@ -2,6 +2,9 @@ package chain
 
 import (
 	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // BuildTxRequest 描述构建交易所需的通用参数
@@ -10,14 +13,63 @@ type BuildTxRequest struct {
 	To      string
 	Amount  *big.Int
 	Nonce   uint64
-	FeeRate uint64
+	FeeRate uint64 // Bitcoin 为 sat/vB
 	Data    []byte
+
+	// UTXO 模型链（Bitcoin）专用
+	UTXOs            []UTXO                // 可花费的 UTXO
+	ChangeAddress    string                // 找零地址，为空时使用 From
+	CoinSelection    CoinSelectionStrategy // 选币策略，为空时使用 branch-and-bound
+	BitcoinPublicKey []byte                // 发送方公钥，From 为 P2SH-P2WPKH 地址时用于重建 redeemScript
+
+	// EVM 链专用，gas 价格未指定时使用 FeeRate（wei/gas）
+	EVMTxType            uint8            // types.DynamicFeeTxType（默认）或 types.AccessListTxType
+	GasLimit             uint64           // 为 0 时使用 21000，合约调用必须指定
+	GasPrice             *big.Int         // EIP-2930
+	MaxFeePerGas         *big.Int         // EIP-1559
+	MaxPriorityFeePerGas *big.Int         // EIP-1559，为空时为 0
+	AccessList           types.AccessList // EIP-2930 访问列表
+	TokenContract        string           // ERC-20 合约地址，设置后 To 为代币接收方、Amount 为代币最小单位，交易 value 为 0
+
+	// Solana 专用，Amount 为 lamports 或代币最小单位，Data 作为 Memo 附言
+	RecentBlockhash      string               // Base58 区块哈希，BuildTransactionWithLatestBlockhash 会自动填入
+	SolanaMessageVersion SolanaMessageVersion // legacy（默认）或 v0
+	TokenMint            string               // SPL 代币 Mint 地址，为空时转账 SOL
+	TokenDecimals        uint8                // SPL 代币精度，TransferChecked 校验
+	CreateTokenAccount   bool                 // 接收方关联代币账户不存在时由发送方创建
+
+	// Tron 专用，Amount 为 sun 或 TRC-20 最小单位，TokenContract 为 TRC-20 合约地址，Data 作为备注
+	TronRefBlock   *TronBlockRef // 引用区块，BuildTransactionWithLatestBlock 会自动填入
+	TronFeeLimit   uint64        // TRC-20 转账消耗能量的费用上限（sun），必须指定
+	TronExpiration time.Duration // 交易有效期（从引用区块时间起算），为 0 时为 10 分钟，最长 24 小时
+
+	// Cosmos 专用，Amount 为 denom 最小单位，Nonce 为账户序列号（sequence），Data 作为 memo
+	CosmosAccountNumber uint64   // 账户编号，BuildTransactionWithAccount 会连同序列号自动填入
+	CosmosPublicKey     []byte   // 发送方 secp256k1 公钥，写入 SignerInfo，必须对应 From
+	CosmosGasLimit      uint64   // 为 0 时为 200000
+	CosmosFee           *big.Int // 手续费（denom 最小单位），为空时按链配置的 gas 价格计算
+
+	// Aptos 专用，Amount 为 octas，Nonce 为账户序列号
+	AptosMaxGasAmount uint64    // 为 0 时为 10000
+	AptosGasUnitPrice uint64    // gas 单价（octas），BuildTransactionWithAccount 未指定时使用节点建议值
+	AptosExpiration   time.Time // 交易过期时间，为空时为 10 分钟后
+
+	// Sui 专用，Amount 为 MIST，从 gas coin 中拆分
+	SuiGasPayment []SuiObjectRef // 支付 gas 的 SUI Coin 对象，BuildTransactionWithGasCoins 会自动选择
+	SuiGasPrice   uint64         // gas 价格（MIST），BuildTransactionWithGasCoins 未指定时使用参考 gas 价格
+	SuiGasBudget  uint64         // gas 预算（MIST），为 0 时为 10000000
 }
 
 // Transaction 统一封装原始交易和其哈希
 type Transaction struct {
 	Raw  string
 	Hash string
+
+	// SigningHashes 需要阈值签名的摘要，按输入顺序排列（Bitcoin 每个输入一个 BIP-143 或 BIP-341 sighash，
+	// Solana 为完整的消息字节）
+	SigningHashes [][]byte
+	// Fee 交易手续费（链上最小单位）
+	Fee *big.Int
 }
 
 // Adapter 定义链适配器需要实现的最小能力
@@ -25,3 +77,14 @@ type Adapter interface {
 	GenerateAddress(pubKey []byte) (string, error)
 	BuildTransaction(req *BuildTxRequest) (*Transaction, error)
 }
+
+// nonEmptyEndpoints 过滤空的 RPC 端点
+func nonEmptyEndpoints(endpoints []string) []string {
+	var result []string
+	for _, endpoint := range endpoints {
+		if endpoint != "" {
+			result = append(result, endpoint)
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package chain
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Aptos 和 Sui 均为 Move 链：账户地址为 32 字节，交易使用 BCS（Binary Canonical Serialization）编码
+// BCS 只实现构建转账交易需要的部分：序列长度和枚举变体为 ULEB128，整数为小端序
+
+// moveAddressLength Move 账户地址长度
+const moveAddressLength = 32
+
+// parseMoveAddress 解析 0x 前缀的十六进制地址，短格式（如 0x1）左侧补零，返回 32 字节地址
+func parseMoveAddress(address string) ([]byte, error) {
+	hexPart := strings.TrimPrefix(strings.TrimSpace(address), "0x")
+	if hexPart == "" || len(hexPart) > 2*moveAddressLength {
+		return nil, errors.Errorf("invalid address %q", address)
+	}
+	if len(hexPart)%2 == 1 {
+		hexPart = "0" + hexPart
+	}
+	decoded, err := hex.DecodeString(hexPart)
+	if err != nil {
+		return nil, errors.Errorf("invalid address %q", address)
+	}
+
+	parsed := make([]byte, moveAddressLength)
+	copy(parsed[moveAddressLength-len(decoded):], decoded)
+	return parsed, nil
+}
+
+// encodeMoveAddress 将 32 字节地址编码为 0x 前缀的 64 位十六进制（长格式）
+func encodeMoveAddress(address []byte) string {
+	return "0x" + hex.EncodeToString(address)
+}
+
+// bcsAppendULEB128 追加 ULEB128 编码的长度或枚举变体序号
+func bcsAppendULEB128(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// bcsAppendU16 追加小端序 u16
+func bcsAppendU16(b []byte, v uint16) []byte {
+	return binary.LittleEndian.AppendUint16(b, v)
+}
+
+// bcsAppendU64 追加小端序 u64
+func bcsAppendU64(b []byte, v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(b, v)
+}
+
+// bcsAppendBytes 追加长度前缀的字节序列（vector<u8>）
+func bcsAppendBytes(b []byte, data []byte) []byte {
+	return append(bcsAppendULEB128(b, uint64(len(data))), data...)
+}
+
+// bcsAppendString 追加长度前缀的 UTF-8 字符串
+func bcsAppendString(b []byte, s string) []byte {
+	return bcsAppendBytes(b, []byte(s))
+}
+
+// bcsU64 u64 的 BCS 编码，用作交易参数
+func bcsU64(v uint64) []byte {
+	return bcsAppendU64(nil, v)
+}
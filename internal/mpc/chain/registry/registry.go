@@ -0,0 +1,421 @@
+package registry
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Family 链家族，决定使用的适配器
+type Family string
+
+const (
+	FamilyBitcoin Family = "bitcoin"
+	FamilyEVM     Family = "evm"
+	FamilySolana  Family = "solana"
+	FamilyTron    Family = "tron"
+	FamilyCosmos  Family = "cosmos"
+	FamilyAptos   Family = "aptos"
+	FamilySui     Family = "sui"
+)
+
+// 未配置 confirmations 时的默认确认数
+const (
+	defaultBitcoinConfirmations = 6
+	defaultEVMConfirmations     = 12
+	defaultTronConfirmations    = 19 // 超过 2/3 超级代表确认后区块固化
+	defaultCosmosConfirmations  = 1  // CometBFT 出块即最终确认
+)
+
+// maxAptosChainID Aptos 交易中的链 ID 为 u8
+const maxAptosChainID = 255
+
+// ErrUnknownChain 注册表中不存在的链
+var ErrUnknownChain = errors.New("unknown chain")
+
+// ErrUnknownToken 链上未配置的代币
+var ErrUnknownToken = errors.New("unknown token")
+
+// Chain 注册表中的一条链，Name 和 Aliases 即钱包的 chain_type
+type Chain struct {
+	Name         string   `json:"name"`
+	Aliases      []string `json:"aliases,omitempty"`
+	Family       Family   `json:"family"`
+	Network      string   `json:"network"`            // mainnet、sepolia、testnet、devnet、regtest 等
+	ChainID      uint64   `json:"chain_id,omitempty"` // EVM 链 ID（EIP-155）或 Aptos 链 ID
+	RPCEndpoints []string `json:"rpc_endpoints,omitempty"`
+	Symbol       string   `json:"symbol"`   // 原生代币符号
+	Decimals     int      `json:"decimals"` // 原生代币精度
+
+	// Cosmos SDK 链专用
+	CosmosChainID string `json:"cosmos_chain_id,omitempty"` // 如 cosmoshub-4
+	Bech32Prefix  string `json:"bech32_prefix,omitempty"`   // 账户地址前缀，如 cosmos、osmo
+	Denom         string `json:"denom,omitempty"`           // 原生代币最小单位，如 uatom
+	GasPrice      string `json:"gas_price,omitempty"`       // 每单位 gas 的手续费（denom），如 0.005，为空时为 0
+
+	// Confirmations 交易视为最终确认所需的区块确认数，为 0 时 Bitcoin 使用 6、EVM 使用 12、Tron 使用 19、Cosmos 使用 1；
+	// Solana 以 finalized 承诺级别为准，Aptos 和 Sui 交易执行即最终确认
+	Confirmations uint64 `json:"confirmations,omitempty"`
+
+	// Tokens 该网络上可查询余额和转账的代币（EVM 为 ERC-20，Solana 为 SPL，Tron 为 TRC-20）
+	Tokens []Token `json:"tokens,omitempty"`
+
+	bitcoinParams *chaincfg.Params
+	bitcoin       *chain.BitcoinAdapter
+	ethereum      *chain.EthereumAdapter
+	solana        *chain.SolanaAdapter
+	tron          *chain.TronAdapter
+	cosmos        *chain.CosmosAdapter
+	aptos         *chain.AptosAdapter
+	sui           *chain.SuiAdapter
+}
+
+// Token 代币配置，同一条链上符号和地址不能重复
+type Token struct {
+	Symbol   string `json:"symbol"`
+	Address  string `json:"address"` // ERC-20 合约地址（统一小写）、SPL Mint 地址或 TRC-20 合约地址（Base58Check）
+	Decimals int    `json:"decimals"`
+}
+
+// Registry 按名称解析链配置和适配器，可以同时注册主网、测试网和本地开发网
+type Registry struct {
+	chains []*Chain
+	byName map[string]*Chain
+}
+
+// DefaultChains 未提供链配置文件时使用的内置主网配置（不包含 RPC 端点），Bitcoin 网络由 MPC_BITCOIN_NETWORK 决定
+func DefaultChains(bitcoinNetwork string) []Chain {
+	if bitcoinNetwork == "" {
+		bitcoinNetwork = "mainnet"
+	}
+	return []Chain{
+		{Name: "bitcoin", Aliases: []string{"btc"}, Family: FamilyBitcoin, Network: bitcoinNetwork, Symbol: "BTC", Decimals: 8},
+		{Name: "ethereum", Aliases: []string{"eth", "evm"}, Family: FamilyEVM, Network: "mainnet", ChainID: 1, Symbol: "ETH", Decimals: 18,
+			Tokens: []Token{
+				{Symbol: "USDC", Address: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", Decimals: 6},
+				{Symbol: "USDT", Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Decimals: 6},
+			}},
+		{Name: "solana", Aliases: []string{"sol"}, Family: FamilySolana, Network: "mainnet-beta", Symbol: "SOL", Decimals: 9,
+			Tokens: []Token{
+				{Symbol: "USDC", Address: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", Decimals: 6},
+				{Symbol: "USDT", Address: "Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB", Decimals: 6},
+			}},
+		{Name: "tron", Aliases: []string{"trx"}, Family: FamilyTron, Network: "mainnet", Symbol: "TRX", Decimals: 6,
+			Tokens: []Token{
+				{Symbol: "USDT", Address: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", Decimals: 6},
+			}},
+		{Name: "cosmos", Aliases: []string{"atom", "cosmoshub"}, Family: FamilyCosmos, Network: "mainnet", CosmosChainID: "cosmoshub-4",
+			Bech32Prefix: "cosmos", Denom: "uatom", GasPrice: "0.005", Symbol: "ATOM", Decimals: 6},
+		{Name: "aptos", Aliases: []string{"apt"}, Family: FamilyAptos, Network: "mainnet", ChainID: 1, Symbol: "APT", Decimals: 8},
+		{Name: "sui", Family: FamilySui, Network: "mainnet", Symbol: "SUI", Decimals: 9},
+	}
+}
+
+// Default 内置主网注册表
+func Default() *Registry {
+	r, err := New(DefaultChains(""))
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Load 从 JSON 文件加载注册表，文件内容为 Chain 数组
+func Load(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read chain registry %s", path)
+	}
+
+	var chains []Chain
+	if err := json.Unmarshal(data, &chains); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse chain registry %s", path)
+	}
+	return New(chains)
+}
+
+// New 校验链配置并创建注册表，名称和别名不区分大小写且不能重复
+func New(chains []Chain) (*Registry, error) {
+	if len(chains) == 0 {
+		return nil, errors.New("chain registry is empty")
+	}
+
+	r := &Registry{byName: make(map[string]*Chain)}
+	for i := range chains {
+		c := chains[i]
+		if err := c.init(); err != nil {
+			return nil, errors.Wrapf(err, "invalid chain %q", c.Name)
+		}
+
+		for _, name := range append([]string{c.Name}, c.Aliases...) {
+			key := strings.ToLower(strings.TrimSpace(name))
+			if key == "" {
+				return nil, errors.Errorf("chain %q has an empty alias", c.Name)
+			}
+			if _, ok := r.byName[key]; ok {
+				return nil, errors.Errorf("duplicate chain name %q", name)
+			}
+			r.byName[key] = &c
+		}
+		r.chains = append(r.chains, &c)
+	}
+
+	return r, nil
+}
+
+// init 校验配置并创建适配器
+func (c *Chain) init() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("name is required")
+	}
+	if c.Symbol == "" {
+		return errors.New("symbol is required")
+	}
+	if c.Decimals < 0 || c.Decimals > 36 {
+		return errors.Errorf("invalid decimals %d", c.Decimals)
+	}
+
+	var endpoints []string
+	for _, endpoint := range c.RPCEndpoints {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	c.RPCEndpoints = endpoints
+
+	switch c.Family {
+	case FamilyBitcoin:
+		params, err := chain.BitcoinNetworkParams(c.Network)
+		if err != nil {
+			return err
+		}
+		c.bitcoinParams = params
+		c.bitcoin = chain.NewBitcoinAdapterWithRPC(params, chain.BitcoinAddressP2PKH, c.RPCEndpoints...)
+		if c.Confirmations == 0 {
+			c.Confirmations = defaultBitcoinConfirmations
+		}
+	case FamilyEVM:
+		if c.ChainID == 0 {
+			return errors.New("chain_id is required for evm chains")
+		}
+		c.ethereum = chain.NewEthereumAdapter(new(big.Int).SetUint64(c.ChainID), c.RPCEndpoints...)
+		if c.Confirmations == 0 {
+			c.Confirmations = defaultEVMConfirmations
+		}
+	case FamilySolana:
+		c.solana = chain.NewSolanaAdapterWithRPC(c.RPCEndpoints...)
+	case FamilyTron:
+		c.tron = chain.NewTronAdapter(c.RPCEndpoints...)
+		if c.Confirmations == 0 {
+			c.Confirmations = defaultTronConfirmations
+		}
+	case FamilyCosmos:
+		config, err := c.cosmosConfig()
+		if err != nil {
+			return err
+		}
+		c.cosmos = chain.NewCosmosAdapter(config, c.RPCEndpoints...)
+		if c.Confirmations == 0 {
+			c.Confirmations = defaultCosmosConfirmations
+		}
+	case FamilyAptos:
+		if c.ChainID == 0 || c.ChainID > maxAptosChainID {
+			return errors.Errorf("chain_id must be between 1 and %d for aptos chains", maxAptosChainID)
+		}
+		c.aptos = chain.NewAptosAdapter(uint8(c.ChainID), c.RPCEndpoints...)
+	case FamilySui:
+		c.sui = chain.NewSuiAdapter(c.RPCEndpoints...)
+	default:
+		return errors.Errorf("unsupported chain family %q", c.Family)
+	}
+
+	return c.initTokens()
+}
+
+// cosmosConfig 校验 Cosmos 链参数
+func (c *Chain) cosmosConfig() (chain.CosmosConfig, error) {
+	config := chain.CosmosConfig{
+		ChainID:      strings.TrimSpace(c.CosmosChainID),
+		Bech32Prefix: strings.TrimSpace(c.Bech32Prefix),
+		Denom:        strings.TrimSpace(c.Denom),
+	}
+	if config.ChainID == "" {
+		return config, errors.New("cosmos_chain_id is required for cosmos chains")
+	}
+	if config.Bech32Prefix == "" || strings.ToLower(config.Bech32Prefix) != config.Bech32Prefix {
+		return config, errors.Errorf("invalid bech32_prefix %q", c.Bech32Prefix)
+	}
+	if config.Denom == "" {
+		return config, errors.New("denom is required for cosmos chains")
+	}
+	if c.GasPrice != "" {
+		gasPrice, ok := new(big.Rat).SetString(c.GasPrice)
+		if !ok || gasPrice.Sign() < 0 {
+			return config, errors.Errorf("invalid gas_price %q", c.GasPrice)
+		}
+		config.GasPrice = gasPrice
+	}
+	return config, nil
+}
+
+// initTokens 校验代币配置，EVM 合约地址统一小写，Tron 合约地址统一为 Base58Check 格式
+func (c *Chain) initTokens() error {
+	if len(c.Tokens) > 0 && c.Family != FamilyEVM && c.Family != FamilySolana && c.Family != FamilyTron {
+		return errors.Errorf("tokens are not supported for %s chains", c.Family)
+	}
+
+	tokens := make([]Token, 0, len(c.Tokens))
+	seen := make(map[string]bool)
+	for _, token := range c.Tokens {
+		token.Symbol = strings.TrimSpace(token.Symbol)
+		token.Address = strings.TrimSpace(token.Address)
+		if token.Symbol == "" {
+			return errors.New("token symbol is required")
+		}
+		if strings.EqualFold(token.Symbol, c.Symbol) || strings.EqualFold(token.Symbol, "native") {
+			return errors.Errorf("token symbol %s is reserved for the native currency", token.Symbol)
+		}
+		if token.Decimals < 0 || token.Decimals > 255 {
+			return errors.Errorf("invalid decimals %d for token %s", token.Decimals, token.Symbol)
+		}
+
+		switch c.Family {
+		case FamilyEVM:
+			if !common.IsHexAddress(token.Address) {
+				return errors.Errorf("invalid contract address %q for token %s", token.Address, token.Symbol)
+			}
+			token.Address = strings.ToLower(token.Address)
+		case FamilySolana:
+			if _, err := chain.ParseSolanaPublicKey(token.Address); err != nil {
+				return errors.Wrapf(err, "invalid mint for token %s", token.Symbol)
+			}
+		case FamilyTron:
+			address, err := chain.ParseTronAddress(token.Address)
+			if err != nil {
+				return errors.Wrapf(err, "invalid contract address for token %s", token.Symbol)
+			}
+			token.Address = chain.EncodeTronAddress(address)
+		}
+
+		symbol := strings.ToLower(token.Symbol)
+		if seen[symbol] || seen[token.Address] {
+			return errors.Errorf("duplicate token %s", token.Symbol)
+		}
+		seen[symbol], seen[token.Address] = true, true
+		tokens = append(tokens, token)
+	}
+	c.Tokens = tokens
+
+	return nil
+}
+
+// Lookup 按名称或别名查找链
+func (r *Registry) Lookup(name string) (*Chain, error) {
+	c, ok := r.byName[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownChain, "chain %q is not registered", name)
+	}
+	return c, nil
+}
+
+// Chains 按配置顺序返回所有链
+func (r *Registry) Chains() []*Chain {
+	return r.chains
+}
+
+// Token 按符号（不区分大小写）或地址查找代币，EVM 合约地址不区分大小写
+func (c *Chain) Token(asset string) (*Token, error) {
+	asset = strings.TrimSpace(asset)
+	for i := range c.Tokens {
+		token := &c.Tokens[i]
+		if strings.EqualFold(token.Symbol, asset) || token.Address == asset ||
+			(c.Family == FamilyEVM && strings.EqualFold(token.Address, asset)) {
+			return token, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrUnknownToken, "token %q is not configured on chain %s", asset, c.Name)
+}
+
+// BitcoinParams Bitcoin 链参数
+func (c *Chain) BitcoinParams() (*chaincfg.Params, error) {
+	if c.Family != FamilyBitcoin {
+		return nil, errors.Errorf("chain %s is not a bitcoin chain", c.Name)
+	}
+	return c.bitcoinParams, nil
+}
+
+// BitcoinAdapter 生成 addressType 类型地址的 Bitcoin 适配器，配置 RPC 端点时可估算手续费
+func (c *Chain) BitcoinAdapter(addressType chain.BitcoinAddressType) (*chain.BitcoinAdapter, error) {
+	if c.Family != FamilyBitcoin {
+		return nil, errors.Errorf("chain %s is not a bitcoin chain", c.Name)
+	}
+	return c.bitcoin.WithAddressType(addressType), nil
+}
+
+// FeeEstimator 链适配器的手续费估算能力
+func (c *Chain) FeeEstimator() (chain.FeeEstimator, error) {
+	switch c.Family {
+	case FamilyBitcoin:
+		return c.bitcoin, nil
+	case FamilyEVM:
+		return c.ethereum, nil
+	case FamilySolana:
+		return c.solana, nil
+	default:
+		return nil, errors.Errorf("fee estimation is not supported for chain %s", c.Name)
+	}
+}
+
+// EthereumAdapter EVM 链适配器，多个 RPC 端点按顺序故障转移
+func (c *Chain) EthereumAdapter() (*chain.EthereumAdapter, error) {
+	if c.Family != FamilyEVM {
+		return nil, errors.Errorf("chain %s is not an evm chain", c.Name)
+	}
+	return c.ethereum, nil
+}
+
+// SolanaAdapter Solana 链适配器，多个 RPC 端点按顺序故障转移
+func (c *Chain) SolanaAdapter() (*chain.SolanaAdapter, error) {
+	if c.Family != FamilySolana {
+		return nil, errors.Errorf("chain %s is not a solana chain", c.Name)
+	}
+	return c.solana, nil
+}
+
+// TronAdapter Tron 链适配器，多个全节点 HTTP API 端点按顺序故障转移
+func (c *Chain) TronAdapter() (*chain.TronAdapter, error) {
+	if c.Family != FamilyTron {
+		return nil, errors.Errorf("chain %s is not a tron chain", c.Name)
+	}
+	return c.tron, nil
+}
+
+// CosmosAdapter Cosmos SDK 链适配器，多个 LCD 端点按顺序故障转移
+func (c *Chain) CosmosAdapter() (*chain.CosmosAdapter, error) {
+	if c.Family != FamilyCosmos {
+		return nil, errors.Errorf("chain %s is not a cosmos chain", c.Name)
+	}
+	return c.cosmos, nil
+}
+
+// AptosAdapter Aptos 链适配器，多个 REST API 端点按顺序故障转移
+func (c *Chain) AptosAdapter() (*chain.AptosAdapter, error) {
+	if c.Family != FamilyAptos {
+		return nil, errors.Errorf("chain %s is not an aptos chain", c.Name)
+	}
+	return c.aptos, nil
+}
+
+// SuiAdapter Sui 链适配器，多个 JSON-RPC 端点按顺序故障转移
+func (c *Chain) SuiAdapter() (*chain.SuiAdapter, error) {
+	if c.Family != FamilySui {
+		return nil, errors.Errorf("chain %s is not a sui chain", c.Name)
+	}
+	return c.sui, nil
+}
@@ -0,0 +1,171 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	r, err := Load("testdata/chains.json")
+	require.NoError(t, err)
+	assert.Len(t, r.Chains(), 13)
+
+	// 名称和别名不区分大小写
+	for _, name := range []string{"ethereum", "ETH", "evm"} {
+		c, err := r.Lookup(name)
+		require.NoError(t, err)
+		assert.Equal(t, "ethereum", c.Name)
+		assert.Equal(t, uint64(1), c.ChainID)
+		assert.Len(t, c.RPCEndpoints, 2)
+	}
+
+	sepolia, err := r.Lookup("sepolia")
+	require.NoError(t, err)
+	assert.Equal(t, FamilyEVM, sepolia.Family)
+	_, err = sepolia.EthereumAdapter()
+	assert.NoError(t, err)
+	_, err = sepolia.SolanaAdapter()
+	assert.Error(t, err)
+	assert.Equal(t, uint64(12), sepolia.Confirmations)
+	usdc, err := sepolia.Token("usdc")
+	require.NoError(t, err)
+	assert.Equal(t, "0x1c7d4b196cb0c7b01d743fbc6116a902379c7238", usdc.Address)
+
+	anvil, err := r.Lookup("anvil")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), anvil.Confirmations)
+
+	testnet, err := r.Lookup("bitcoin-testnet")
+	require.NoError(t, err)
+	params, err := testnet.BitcoinParams()
+	require.NoError(t, err)
+	assert.Equal(t, chaincfg.TestNet3Params.Name, params.Name)
+
+	// TRC-20 合约地址统一为 Base58Check 格式
+	trx, err := r.Lookup("trx")
+	require.NoError(t, err)
+	_, err = trx.TronAdapter()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(19), trx.Confirmations)
+	usdt, err := trx.Token("USDT")
+	require.NoError(t, err)
+	assert.Equal(t, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", usdt.Address)
+
+	// Cosmos 链默认出块即确认
+	osmo, err := r.Lookup("osmo")
+	require.NoError(t, err)
+	_, err = osmo.CosmosAdapter()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), osmo.Confirmations)
+	_, err = osmo.TronAdapter()
+	assert.Error(t, err)
+
+	apt, err := r.Lookup("apt")
+	require.NoError(t, err)
+	_, err = apt.AptosAdapter()
+	assert.NoError(t, err)
+	_, err = apt.SuiAdapter()
+	assert.Error(t, err)
+	suiTestnet, err := r.Lookup("sui-testnet")
+	require.NoError(t, err)
+	_, err = suiTestnet.SuiAdapter()
+	assert.NoError(t, err)
+
+	_, err = r.Lookup("polkadot")
+	assert.True(t, errors.Is(err, ErrUnknownChain))
+}
+
+func TestDefaultChains(t *testing.T) {
+	r, err := New(DefaultChains("regtest"))
+	require.NoError(t, err)
+
+	btc, err := r.Lookup("btc")
+	require.NoError(t, err)
+	params, err := btc.BitcoinParams()
+	require.NoError(t, err)
+	assert.Equal(t, chaincfg.RegressionNetParams.Name, params.Name)
+
+	sol, err := Default().Lookup("sol")
+	require.NoError(t, err)
+	assert.Equal(t, 9, sol.Decimals)
+}
+
+func TestChainToken(t *testing.T) {
+	eth, err := Default().Lookup("ethereum")
+	require.NoError(t, err)
+
+	// 符号和合约地址均不区分大小写
+	for _, asset := range []string{"USDC", "usdc", "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"} {
+		token, err := eth.Token(asset)
+		require.NoError(t, err)
+		assert.Equal(t, "USDC", token.Symbol)
+		assert.Equal(t, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", token.Address)
+		assert.Equal(t, 6, token.Decimals)
+	}
+
+	_, err = eth.Token("DAI")
+	assert.True(t, errors.Is(err, ErrUnknownToken))
+
+	// Solana Mint 地址区分大小写
+	sol, err := Default().Lookup("solana")
+	require.NoError(t, err)
+	token, err := sol.Token("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	require.NoError(t, err)
+	assert.Equal(t, "USDC", token.Symbol)
+	_, err = sol.Token("epjfwdd5aufqssqem2qn1xzybapc8g4weggkzwytdt1v")
+	assert.Error(t, err)
+}
+
+func TestNewValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		chains []Chain
+	}{
+		{"empty", nil},
+		{"missing name", []Chain{{Family: FamilyEVM, ChainID: 1, Symbol: "ETH", Decimals: 18}}},
+		{"missing symbol", []Chain{{Name: "ethereum", Family: FamilyEVM, ChainID: 1, Decimals: 18}}},
+		{"evm without chain id", []Chain{{Name: "ethereum", Family: FamilyEVM, Symbol: "ETH", Decimals: 18}}},
+		{"unknown bitcoin network", []Chain{{Name: "litecoin", Family: FamilyBitcoin, Network: "ltc", Symbol: "LTC", Decimals: 8}}},
+		{"unknown family", []Chain{{Name: "polkadot", Family: "substrate", Symbol: "DOT", Decimals: 10}}},
+		{"token on bitcoin", []Chain{{Name: "bitcoin", Family: FamilyBitcoin, Network: "mainnet", Symbol: "BTC", Decimals: 8,
+			Tokens: []Token{{Symbol: "USDT", Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Decimals: 6}}}}},
+		{"invalid token contract", []Chain{{Name: "ethereum", Family: FamilyEVM, ChainID: 1, Symbol: "ETH", Decimals: 18,
+			Tokens: []Token{{Symbol: "USDT", Address: "0x1234", Decimals: 6}}}}},
+		{"invalid mint", []Chain{{Name: "solana", Family: FamilySolana, Symbol: "SOL", Decimals: 9,
+			Tokens: []Token{{Symbol: "USDC", Address: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", Decimals: 6}}}}},
+		{"invalid trc20 contract", []Chain{{Name: "tron", Family: FamilyTron, Symbol: "TRX", Decimals: 6,
+			Tokens: []Token{{Symbol: "USDT", Address: "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c", Decimals: 6}}}}},
+		{"cosmos without chain id", []Chain{{Name: "cosmoshub", Family: FamilyCosmos, Bech32Prefix: "cosmos", Denom: "uatom", Symbol: "ATOM", Decimals: 6}}},
+		{"cosmos without denom", []Chain{{Name: "cosmoshub", Family: FamilyCosmos, CosmosChainID: "cosmoshub-4", Bech32Prefix: "cosmos", Symbol: "ATOM", Decimals: 6}}},
+		{"uppercase bech32 prefix", []Chain{{Name: "cosmoshub", Family: FamilyCosmos, CosmosChainID: "cosmoshub-4", Bech32Prefix: "Cosmos", Denom: "uatom", Symbol: "ATOM", Decimals: 6}}},
+		{"invalid gas price", []Chain{{Name: "cosmoshub", Family: FamilyCosmos, CosmosChainID: "cosmoshub-4", Bech32Prefix: "cosmos", Denom: "uatom", GasPrice: "-0.1", Symbol: "ATOM", Decimals: 6}}},
+		{"token on cosmos", []Chain{{Name: "cosmoshub", Family: FamilyCosmos, CosmosChainID: "cosmoshub-4", Bech32Prefix: "cosmos", Denom: "uatom", Symbol: "ATOM", Decimals: 6,
+			Tokens: []Token{{Symbol: "USDC", Address: "ibc/F663521BF1836B00F5F177680F74BFB9A8B5654A694D0D2BC249E03CF2509013", Decimals: 6}}}}},
+		{"aptos without chain id", []Chain{{Name: "aptos", Family: FamilyAptos, Symbol: "APT", Decimals: 8}}},
+		{"aptos chain id overflows u8", []Chain{{Name: "aptos", Family: FamilyAptos, ChainID: 256, Symbol: "APT", Decimals: 8}}},
+		{"token on sui", []Chain{{Name: "sui", Family: FamilySui, Symbol: "SUI", Decimals: 9,
+			Tokens: []Token{{Symbol: "USDC", Address: "0xdba34672e30cb065b1f93e3ab55318768fd6fef66c15942c9f7cb846e2f900e7::usdc::USDC", Decimals: 6}}}}},
+		{"token shadows native symbol", []Chain{{Name: "ethereum", Family: FamilyEVM, ChainID: 1, Symbol: "ETH", Decimals: 18,
+			Tokens: []Token{{Symbol: "eth", Address: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", Decimals: 18}}}}},
+		{"duplicate token", []Chain{{Name: "ethereum", Family: FamilyEVM, ChainID: 1, Symbol: "ETH", Decimals: 18,
+			Tokens: []Token{
+				{Symbol: "USDC", Address: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", Decimals: 6},
+				{Symbol: "USDC.e", Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", Decimals: 6},
+			}}}},
+		{"duplicate alias", []Chain{
+			{Name: "ethereum", Aliases: []string{"eth"}, Family: FamilyEVM, ChainID: 1, Symbol: "ETH", Decimals: 18},
+			{Name: "sepolia", Aliases: []string{"ETH"}, Family: FamilyEVM, ChainID: 11155111, Symbol: "ETH", Decimals: 18},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.chains)
+			assert.Error(t, err)
+		})
+	}
+}
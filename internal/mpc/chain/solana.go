@@ -1,18 +1,36 @@
 package chain
 
 import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/solana"
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/pkg/errors"
 )
 
 // SolanaAdapter 用于 Solana 链的适配器
-type SolanaAdapter struct{}
+type SolanaAdapter struct {
+	rpcClient *solana.RPCClient
+}
 
 // NewSolanaAdapter 创建一个 Solana 适配器
 func NewSolanaAdapter() *SolanaAdapter {
 	return &SolanaAdapter{}
 }
 
+// NewSolanaAdapterWithRPC 创建连接 RPC 节点的 Solana 适配器，用于获取最新区块哈希和广播交易
+// 配置多个 RPC 端点时按顺序故障转移
+func NewSolanaAdapterWithRPC(rpcEndpoints ...string) *SolanaAdapter {
+	adapter := &SolanaAdapter{}
+	if endpoints := nonEmptyEndpoints(rpcEndpoints); len(endpoints) > 0 {
+		adapter.rpcClient = solana.NewRPCClient(endpoints...)
+	}
+	return adapter
+}
+
 // GenerateAddress 根据公钥生成 Solana 地址（Base58 编码）
 // Solana 地址就是 Ed25519 公钥的 Base58 表示
 func (a *SolanaAdapter) GenerateAddress(pubKey []byte) (string, error) {
@@ -28,8 +46,216 @@ func (a *SolanaAdapter) GenerateAddress(pubKey []byte) (string, error) {
 	return base58.Encode(pubKey), nil
 }
 
-// BuildTransaction 构建 Solana 交易（暂未实现）
+// GetBalance 查询余额（lamports）
+func (a *SolanaAdapter) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	balance, err := a.rpcClient.GetBalance(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetUint64(balance), nil
+}
+
+// GetTokenBalance 查询地址在 SPL 代币上的余额（最小单位），汇总该 Mint 下的所有代币账户
+func (a *SolanaAdapter) GetTokenBalance(ctx context.Context, owner, mint string) (*big.Int, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	if _, err := ParseSolanaPublicKey(mint); err != nil {
+		return nil, errors.Wrap(err, "invalid mint")
+	}
+
+	accounts, err := a.rpcClient.GetTokenAccountsByOwner(ctx, owner, mint)
+	if err != nil {
+		return nil, err
+	}
+	balance := new(big.Int)
+	for _, account := range accounts {
+		amount, ok := new(big.Int).SetString(account.Amount, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid amount %q in token account %s", account.Amount, account.Address)
+		}
+		balance.Add(balance, amount)
+	}
+	return balance, nil
+}
+
+// BroadcastTransaction 广播 AssembleSignedTx 返回的交易，返回交易签名
+func (a *SolanaAdapter) BroadcastTransaction(ctx context.Context, rawTx string) (string, error) {
+	if a.rpcClient == nil {
+		return "", errors.New("RPC client not configured")
+	}
+	return a.rpcClient.SendTransaction(ctx, rawTx)
+}
+
+// GetSignatureStatus 查询交易签名状态，节点不知道该交易时返回 (nil, nil)
+func (a *SolanaAdapter) GetSignatureStatus(ctx context.Context, signature string) (*solana.SignatureStatus, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	statuses, err := a.rpcClient.GetSignatureStatuses(ctx, []string{signature})
+	if err != nil {
+		return nil, err
+	}
+	return statuses[0], nil
+}
+
+// GetSignaturesForAddress 按时间倒序查询涉及地址的 finalized 交易签名
+func (a *SolanaAdapter) GetSignaturesForAddress(ctx context.Context, address, before, until string, limit int) ([]*solana.SignatureInfo, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.GetSignaturesForAddress(ctx, address, before, until, limit)
+}
+
+// GetTransaction 查询 finalized 交易详情，节点没有该交易时返回 (nil, nil)
+func (a *SolanaAdapter) GetTransaction(ctx context.Context, signature string) (*solana.TransactionDetail, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.GetTransaction(ctx, signature)
+}
+
+// BuildTransactionWithLatestBlockhash 从 RPC 节点获取最新区块哈希后构建交易
+// 区块哈希约 60 秒后失效，应在发起阈值签名前调用
+func (a *SolanaAdapter) BuildTransactionWithLatestBlockhash(ctx context.Context, req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+
+	blockhash, _, err := a.rpcClient.GetLatestBlockhash(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest blockhash")
+	}
+
+	withBlockhash := *req
+	withBlockhash.RecentBlockhash = blockhash
+	return a.BuildTransaction(&withBlockhash)
+}
+
+// BuildTransaction 构建 SOL 转账或 SPL 代币转账（设置 TokenMint 时）交易
+// 返回的 Raw 为 Base64 编码的未签名交易（签名位置为零），SigningHashes 仅包含完整的消息字节，
+// 即 Ed25519 ThresholdSign 的签名原文；交易签名即交易 ID，因此 Hash 为空
 func (a *SolanaAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, error) {
-	// TODO: 实现 Solana 交易构建
-	return nil, errors.New("not implemented")
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if req.Amount == nil || req.Amount.Sign() < 0 || !req.Amount.IsUint64() {
+		return nil, errors.New("amount must be a non-negative 64-bit integer")
+	}
+	if req.RecentBlockhash == "" {
+		return nil, errors.New("recent blockhash is required")
+	}
+
+	from, err := ParseSolanaPublicKey(req.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+	to, err := ParseSolanaPublicKey(req.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid to address")
+	}
+	blockhash, err := ParseSolanaPublicKey(req.RecentBlockhash)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid recent blockhash")
+	}
+
+	var instructions []solanaInstruction
+	if req.TokenMint == "" {
+		instructions = append(instructions, solanaSystemTransferInstruction(from, to, req.Amount.Uint64()))
+	} else {
+		mint, err := ParseSolanaPublicKey(req.TokenMint)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid token mint")
+		}
+		source, err := FindSolanaAssociatedTokenAddress(from, mint)
+		if err != nil {
+			return nil, err
+		}
+		// To 为接收方钱包地址，转入其关联代币账户
+		destination, err := FindSolanaAssociatedTokenAddress(to, mint)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.CreateTokenAccount {
+			instructions = append(instructions, solanaCreateATAIdempotentInstruction(from, destination, to, mint))
+		}
+		instructions = append(instructions, solanaTransferCheckedInstruction(source, mint, destination, from, req.Amount.Uint64(), req.TokenDecimals))
+	}
+	if len(req.Data) > 0 {
+		instructions = append(instructions, solanaMemoInstruction(from, req.Data))
+	}
+
+	message, err := compileSolanaMessage(req.SolanaMessageVersion, from, instructions, blockhash)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := solanaMessageSigners(message)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make([][]byte, len(signers))
+	for i := range signatures {
+		signatures[i] = make([]byte, solanaSignatureLength)
+	}
+
+	return &Transaction{
+		Raw:           base64.StdEncoding.EncodeToString(serializeSolanaTransaction(signatures, message)),
+		SigningHashes: [][]byte{message},
+		Fee:           big.NewInt(int64(len(signers)) * solanaLamportsPerSignature),
+	}, nil
+}
+
+// AssembleSignedTx 将 Ed25519 签名写入 BuildTransaction 生成的交易中对应 pubKey 的签名位置，
+// 返回 Base64 编码的可广播交易，Hash 为 Base58 编码的第一个签名（交易 ID）
+func (a *SolanaAdapter) AssembleSignedTx(rawTx string, signature []byte, pubKey []byte) (*Transaction, error) {
+	raw, err := base64.StdEncoding.DecodeString(rawTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid raw transaction")
+	}
+	signatures, message, err := parseSolanaTransaction(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction")
+	}
+	signers, err := solanaMessageSigners(message)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction message")
+	}
+	if len(signers) != len(signatures) {
+		return nil, errors.Errorf("transaction has %d signatures but message requires %d", len(signatures), len(signers))
+	}
+
+	if len(pubKey) != ed25519.PublicKeySize {
+		return nil, errors.Errorf("invalid public key length: expected %d bytes, got %d", ed25519.PublicKeySize, len(pubKey))
+	}
+	if len(signature) != solanaSignatureLength {
+		return nil, errors.Errorf("invalid signature length: expected %d bytes, got %d", solanaSignatureLength, len(signature))
+	}
+	if !ed25519.Verify(pubKey, message, signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	signed := false
+	for i, signer := range signers {
+		if string(signer[:]) == string(pubKey) {
+			signatures[i] = signature
+			signed = true
+		}
+	}
+	if !signed {
+		return nil, errors.New("public key is not a signer of the transaction")
+	}
+
+	return &Transaction{
+		Raw:  base64.StdEncoding.EncodeToString(serializeSolanaTransaction(signatures, message)),
+		Hash: base58.Encode(signatures[0]),
+		Fee:  big.NewInt(int64(len(signers)) * solanaLamportsPerSignature),
+	}, nil
 }
@@ -0,0 +1,430 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RPCClient Solana JSON-RPC 客户端，配置多个端点时按顺序故障转移
+type RPCClient struct {
+	endpoints []string
+	current   atomic.Int32 // 最近一次调用成功的端点，下次调用优先使用
+	client    *http.Client
+}
+
+// NewRPCClient 创建 Solana JSON-RPC 客户端
+func NewRPCClient(endpoints ...string) *RPCClient {
+	return &RPCClient{
+		endpoints: endpoints,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// RPCRequest RPC 请求
+type RPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	ID      int           `json:"id"`
+}
+
+// RPCResponse RPC 响应
+type RPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+	ID      int             `json:"id"`
+}
+
+// RPCError RPC 错误
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("RPC error: %s (code: %d)", e.Message, e.Code)
+}
+
+// call 执行 RPC 调用，端点不可用（网络错误或 5xx/429 响应）时依次尝试下一个端点，
+// 节点返回的 RPC 错误直接返回
+func (c *RPCClient) call(ctx context.Context, method string, params []interface{}) (json.RawMessage, error) {
+	if len(c.endpoints) == 0 {
+		return nil, errors.New("no RPC endpoint configured")
+	}
+
+	req := &RPCRequest{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+		ID:      1,
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal RPC request")
+	}
+
+	start := int(c.current.Load())
+	for i := 0; i < len(c.endpoints); i++ {
+		idx := (start + i) % len(c.endpoints)
+		result, err := c.callEndpoint(ctx, c.endpoints[idx], reqBody)
+		if err == nil {
+			c.current.Store(int32(idx))
+			return result, nil
+		}
+
+		var rpcErr *RPCError
+		if errors.As(err, &rpcErr) || ctx.Err() != nil || i == len(c.endpoints)-1 {
+			return nil, err
+		}
+	}
+
+	return nil, errors.New("no RPC endpoint available")
+}
+
+// callEndpoint 向单个端点发送请求
+func (c *RPCClient) callEndpoint(ctx context.Context, endpoint string, reqBody []byte) (json.RawMessage, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create HTTP request")
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute HTTP request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return nil, errors.Errorf("RPC endpoint returned HTTP %d", resp.StatusCode)
+	}
+
+	var rpcResp RPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return nil, errors.Wrap(err, "failed to decode RPC response")
+	}
+
+	if rpcResp.Error != nil {
+		return nil, rpcResp.Error
+	}
+
+	return rpcResp.Result, nil
+}
+
+// GetBalance 查询余额（lamports）
+func (c *RPCClient) GetBalance(ctx context.Context, address string) (uint64, error) {
+	result, err := c.call(ctx, "getBalance", []interface{}{address, map[string]string{"commitment": "confirmed"}})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to call getBalance")
+	}
+
+	var balance struct {
+		Value uint64 `json:"value"`
+	}
+	if err := json.Unmarshal(result, &balance); err != nil {
+		return 0, errors.Wrap(err, "failed to unmarshal balance")
+	}
+
+	return balance.Value, nil
+}
+
+// TokenAccount 所有者持有的 SPL 代币账户
+type TokenAccount struct {
+	Address  string // 代币账户地址
+	Mint     string
+	Amount   string // 最小单位的十进制字符串
+	Decimals int
+}
+
+// GetTokenAccountsByOwner 查询所有者在指定 Mint 上的全部代币账户（包括关联代币账户和其他代币账户）
+func (c *RPCClient) GetTokenAccountsByOwner(ctx context.Context, owner, mint string) ([]*TokenAccount, error) {
+	result, err := c.call(ctx, "getTokenAccountsByOwner", []interface{}{
+		owner,
+		map[string]string{"mint": mint},
+		map[string]string{"commitment": "confirmed", "encoding": "jsonParsed"},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call getTokenAccountsByOwner")
+	}
+
+	var response struct {
+		Value []struct {
+			Pubkey  string `json:"pubkey"`
+			Account struct {
+				Data struct {
+					Parsed struct {
+						Info struct {
+							Mint        string `json:"mint"`
+							TokenAmount struct {
+								Amount   string `json:"amount"`
+								Decimals int    `json:"decimals"`
+							} `json:"tokenAmount"`
+						} `json:"info"`
+					} `json:"parsed"`
+				} `json:"data"`
+			} `json:"account"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(result, &response); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal token accounts")
+	}
+
+	accounts := make([]*TokenAccount, 0, len(response.Value))
+	for _, value := range response.Value {
+		info := value.Account.Data.Parsed.Info
+		accounts = append(accounts, &TokenAccount{
+			Address:  value.Pubkey,
+			Mint:     info.Mint,
+			Amount:   info.TokenAmount.Amount,
+			Decimals: info.TokenAmount.Decimals,
+		})
+	}
+	return accounts, nil
+}
+
+// PrioritizationFee 最近区块中交易支付的最低优先费
+type PrioritizationFee struct {
+	Slot              uint64 `json:"slot"`
+	PrioritizationFee uint64 `json:"prioritizationFee"` // micro-lamports / 计算单元
+}
+
+// GetRecentPrioritizationFees 查询最近 150 个区块的优先费，accounts 非空时只统计写锁定这些账户的交易
+func (c *RPCClient) GetRecentPrioritizationFees(ctx context.Context, accounts []string) ([]*PrioritizationFee, error) {
+	params := []interface{}{}
+	if len(accounts) > 0 {
+		params = append(params, accounts)
+	}
+	result, err := c.call(ctx, "getRecentPrioritizationFees", params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call getRecentPrioritizationFees")
+	}
+
+	var fees []*PrioritizationFee
+	if err := json.Unmarshal(result, &fees); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal prioritization fees")
+	}
+	return fees, nil
+}
+
+// GetLatestBlockhash 获取最新区块哈希（Base58）及其有效的最大区块高度
+func (c *RPCClient) GetLatestBlockhash(ctx context.Context) (string, uint64, error) {
+	result, err := c.call(ctx, "getLatestBlockhash", []interface{}{map[string]string{"commitment": "finalized"}})
+	if err != nil {
+		return "", 0, errors.Wrap(err, "failed to call getLatestBlockhash")
+	}
+
+	var latest struct {
+		Value struct {
+			Blockhash            string `json:"blockhash"`
+			LastValidBlockHeight uint64 `json:"lastValidBlockHeight"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(result, &latest); err != nil {
+		return "", 0, errors.Wrap(err, "failed to unmarshal latest blockhash")
+	}
+
+	return latest.Value.Blockhash, latest.Value.LastValidBlockHeight, nil
+}
+
+// SendTransaction 广播 Base64 编码的已签名交易，返回交易签名
+func (c *RPCClient) SendTransaction(ctx context.Context, rawTx string) (string, error) {
+	result, err := c.call(ctx, "sendTransaction", []interface{}{rawTx, map[string]string{"encoding": "base64"}})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to call sendTransaction")
+	}
+
+	var signature string
+	if err := json.Unmarshal(result, &signature); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal transaction signature")
+	}
+
+	return signature, nil
+}
+
+// SignatureStatus 交易签名状态
+type SignatureStatus struct {
+	Slot               uint64          `json:"slot"`
+	Confirmations      *uint64         `json:"confirmations"`      // finalized 后为空
+	Err                json.RawMessage `json:"err"`                // 执行失败时为错误详情，成功时为 null
+	ConfirmationStatus string          `json:"confirmationStatus"` // processed, confirmed, finalized
+}
+
+// Failed 交易已上链但执行失败
+func (s *SignatureStatus) Failed() bool {
+	return len(s.Err) > 0 && string(s.Err) != "null"
+}
+
+// GetSignatureStatuses 批量查询交易签名状态（包含历史交易），节点不知道的签名对应位置为 nil
+func (c *RPCClient) GetSignatureStatuses(ctx context.Context, signatures []string) ([]*SignatureStatus, error) {
+	result, err := c.call(ctx, "getSignatureStatuses", []interface{}{signatures, map[string]bool{"searchTransactionHistory": true}})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call getSignatureStatuses")
+	}
+
+	var statuses struct {
+		Value []*SignatureStatus `json:"value"`
+	}
+	if err := json.Unmarshal(result, &statuses); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal signature statuses")
+	}
+	if len(statuses.Value) != len(signatures) {
+		return nil, errors.Errorf("expected %d signature statuses, got %d", len(signatures), len(statuses.Value))
+	}
+
+	return statuses.Value, nil
+}
+
+// SignatureInfo 涉及某个地址的交易签名
+type SignatureInfo struct {
+	Signature string          `json:"signature"`
+	Slot      uint64          `json:"slot"`
+	BlockTime *int64          `json:"blockTime"` // Unix 秒，节点未记录时为空
+	Err       json.RawMessage `json:"err"`
+}
+
+// Failed 交易已上链但执行失败
+func (s *SignatureInfo) Failed() bool {
+	return len(s.Err) > 0 && string(s.Err) != "null"
+}
+
+// GetSignaturesForAddress 按时间倒序查询涉及地址的 finalized 交易签名
+// before 为空时从最新的交易开始，until 为空时不设下限，limit 最大 1000
+func (c *RPCClient) GetSignaturesForAddress(ctx context.Context, address, before, until string, limit int) ([]*SignatureInfo, error) {
+	options := map[string]interface{}{"commitment": "finalized", "limit": limit}
+	if before != "" {
+		options["before"] = before
+	}
+	if until != "" {
+		options["until"] = until
+	}
+
+	result, err := c.call(ctx, "getSignaturesForAddress", []interface{}{address, options})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call getSignaturesForAddress")
+	}
+
+	var signatures []*SignatureInfo
+	if err := json.Unmarshal(result, &signatures); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal signatures")
+	}
+	return signatures, nil
+}
+
+// TokenBalance 交易前后 SPL 代币账户的余额
+type TokenBalance struct {
+	AccountIndex int    // 在 TransactionDetail.AccountKeys 中的位置
+	Mint         string // 代币 Mint 地址
+	Owner        string // 代币账户所有者
+	Amount       string // 最小单位的十进制字符串
+	Decimals     int
+}
+
+// TransactionDetail 交易详情（只包含索引转入交易需要的字段）
+type TransactionDetail struct {
+	Slot              uint64
+	BlockTime         *int64
+	AccountKeys       []string // 静态账户在前，之后为地址查找表加载的可写、只读账户
+	Err               json.RawMessage
+	PreBalances       []uint64 // 按 AccountKeys 顺序的 lamports 余额
+	PostBalances      []uint64
+	PreTokenBalances  []TokenBalance
+	PostTokenBalances []TokenBalance
+}
+
+// Failed 交易已上链但执行失败
+func (d *TransactionDetail) Failed() bool {
+	return len(d.Err) > 0 && string(d.Err) != "null"
+}
+
+// GetTransaction 查询 finalized 交易详情，节点没有该交易时返回 (nil, nil)
+func (c *RPCClient) GetTransaction(ctx context.Context, signature string) (*TransactionDetail, error) {
+	options := map[string]interface{}{
+		"encoding":                       "json",
+		"commitment":                     "finalized",
+		"maxSupportedTransactionVersion": 0,
+	}
+	result, err := c.call(ctx, "getTransaction", []interface{}{signature, options})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call getTransaction")
+	}
+
+	type rawTokenBalance struct {
+		AccountIndex  int    `json:"accountIndex"`
+		Mint          string `json:"mint"`
+		Owner         string `json:"owner"`
+		UITokenAmount struct {
+			Amount   string `json:"amount"`
+			Decimals int    `json:"decimals"`
+		} `json:"uiTokenAmount"`
+	}
+	var raw *struct {
+		Slot      uint64 `json:"slot"`
+		BlockTime *int64 `json:"blockTime"`
+		Meta      *struct {
+			Err               json.RawMessage   `json:"err"`
+			PreBalances       []uint64          `json:"preBalances"`
+			PostBalances      []uint64          `json:"postBalances"`
+			PreTokenBalances  []rawTokenBalance `json:"preTokenBalances"`
+			PostTokenBalances []rawTokenBalance `json:"postTokenBalances"`
+			LoadedAddresses   *struct {
+				Writable []string `json:"writable"`
+				Readonly []string `json:"readonly"`
+			} `json:"loadedAddresses"`
+		} `json:"meta"`
+		Transaction struct {
+			Message struct {
+				AccountKeys []string `json:"accountKeys"`
+			} `json:"message"`
+		} `json:"transaction"`
+	}
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal transaction")
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	if raw.Meta == nil {
+		return nil, errors.Errorf("transaction %s has no status metadata", signature)
+	}
+
+	detail := &TransactionDetail{
+		Slot:         raw.Slot,
+		BlockTime:    raw.BlockTime,
+		AccountKeys:  raw.Transaction.Message.AccountKeys,
+		Err:          raw.Meta.Err,
+		PreBalances:  raw.Meta.PreBalances,
+		PostBalances: raw.Meta.PostBalances,
+	}
+	if loaded := raw.Meta.LoadedAddresses; loaded != nil {
+		detail.AccountKeys = append(append(detail.AccountKeys, loaded.Writable...), loaded.Readonly...)
+	}
+	convert := func(balances []rawTokenBalance) []TokenBalance {
+		converted := make([]TokenBalance, 0, len(balances))
+		for _, balance := range balances {
+			converted = append(converted, TokenBalance{
+				AccountIndex: balance.AccountIndex,
+				Mint:         balance.Mint,
+				Owner:        balance.Owner,
+				Amount:       balance.UITokenAmount.Amount,
+				Decimals:     balance.UITokenAmount.Decimals,
+			})
+		}
+		return converted
+	}
+	detail.PreTokenBalances = convert(raw.Meta.PreTokenBalances)
+	detail.PostTokenBalances = convert(raw.Meta.PostTokenBalances)
+
+	return detail, nil
+}
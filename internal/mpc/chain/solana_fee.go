@@ -0,0 +1,38 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// EstimateFees 根据最近 150 个区块的优先费（getRecentPrioritizationFees）估算计算单元价格
+// 慢、正常、快分别取第 25、50、90 百分位数；BaseFee 为每个签名的基础手续费
+func (a *SolanaAdapter) EstimateFees(ctx context.Context) (*FeeEstimate, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+
+	fees, err := a.rpcClient.GetRecentPrioritizationFees(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]uint64, 0, len(fees))
+	for _, fee := range fees {
+		values = append(values, fee.PrioritizationFee)
+	}
+
+	tier := func(percentile int) *FeeTier {
+		price := new(big.Int).SetUint64(percentileUint64(values, percentile))
+		return &FeeTier{FeeRate: price, PriorityFee: new(big.Int).Set(price)}
+	}
+	return &FeeEstimate{
+		Unit:    FeeUnitMicroLamportsPerCU,
+		BaseFee: big.NewInt(solanaLamportsPerSignature),
+		Slow:    tier(25),
+		Normal:  tier(50),
+		Fast:    tier(90),
+	}, nil
+}
@@ -0,0 +1,259 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/pkg/errors"
+)
+
+// SolanaMessageVersion Solana 交易消息版本
+type SolanaMessageVersion string
+
+const (
+	// SolanaMessageLegacy 传统消息格式
+	SolanaMessageLegacy SolanaMessageVersion = "legacy"
+	// SolanaMessageV0 版本化消息（v0），不使用地址查找表
+	SolanaMessageV0 SolanaMessageVersion = "v0"
+)
+
+const (
+	// solanaSignatureLength Ed25519 签名长度
+	solanaSignatureLength = 64
+	// solanaVersionPrefix 版本化消息首字节的最高位
+	solanaVersionPrefix = 0x80
+	// solanaLamportsPerSignature 每个签名的基础手续费
+	solanaLamportsPerSignature = 5000
+)
+
+// SolanaPublicKey Solana 账户地址（32 字节）
+type SolanaPublicKey [32]byte
+
+// ParseSolanaPublicKey 解析 Base58 编码的 Solana 地址
+func ParseSolanaPublicKey(address string) (SolanaPublicKey, error) {
+	var key SolanaPublicKey
+	decoded := base58.Decode(address)
+	if len(decoded) != len(key) {
+		return key, errors.Errorf("invalid solana address %s", address)
+	}
+	copy(key[:], decoded)
+	return key, nil
+}
+
+// mustSolanaPublicKey 解析内置的程序地址
+func mustSolanaPublicKey(address string) SolanaPublicKey {
+	key, err := ParseSolanaPublicKey(address)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// String 返回 Base58 编码的地址
+func (k SolanaPublicKey) String() string {
+	return base58.Encode(k[:])
+}
+
+// solanaAccountMeta 指令引用的账户及其权限
+type solanaAccountMeta struct {
+	pubKey     SolanaPublicKey
+	isSigner   bool
+	isWritable bool
+}
+
+// solanaInstruction 未编译的指令
+type solanaInstruction struct {
+	programID SolanaPublicKey
+	accounts  []solanaAccountMeta
+	data      []byte
+}
+
+// compileSolanaMessage 将指令编译为消息字节，即 Ed25519 签名的原文
+// 账户顺序：付费账户、可写签名者、只读签名者、可写非签名者、只读非签名者，组内保持首次出现的顺序
+func compileSolanaMessage(version SolanaMessageVersion, payer SolanaPublicKey, instructions []solanaInstruction, recentBlockhash SolanaPublicKey) ([]byte, error) {
+	metas := []solanaAccountMeta{{pubKey: payer, isSigner: true, isWritable: true}}
+	index := map[SolanaPublicKey]int{payer: 0}
+	addAccount := func(meta solanaAccountMeta) {
+		if i, ok := index[meta.pubKey]; ok {
+			metas[i].isSigner = metas[i].isSigner || meta.isSigner
+			metas[i].isWritable = metas[i].isWritable || meta.isWritable
+			return
+		}
+		index[meta.pubKey] = len(metas)
+		metas = append(metas, meta)
+	}
+	for _, ix := range instructions {
+		for _, account := range ix.accounts {
+			addAccount(account)
+		}
+		addAccount(solanaAccountMeta{pubKey: ix.programID})
+	}
+
+	var ordered []solanaAccountMeta
+	for _, group := range []struct{ signer, writable bool }{{true, true}, {true, false}, {false, true}, {false, false}} {
+		for _, meta := range metas {
+			if meta.isSigner == group.signer && meta.isWritable == group.writable {
+				ordered = append(ordered, meta)
+			}
+		}
+	}
+	if len(ordered) > 256 {
+		return nil, errors.Errorf("too many accounts in transaction: %d", len(ordered))
+	}
+
+	var numSigners, numReadonlySigned, numReadonlyUnsigned byte
+	keyIndex := make(map[SolanaPublicKey]byte, len(ordered))
+	for i, meta := range ordered {
+		keyIndex[meta.pubKey] = byte(i)
+		switch {
+		case meta.isSigner && !meta.isWritable:
+			numSigners++
+			numReadonlySigned++
+		case meta.isSigner:
+			numSigners++
+		case !meta.isWritable:
+			numReadonlyUnsigned++
+		}
+	}
+
+	var buf bytes.Buffer
+	switch version {
+	case SolanaMessageLegacy, "":
+	case SolanaMessageV0:
+		buf.WriteByte(solanaVersionPrefix)
+	default:
+		return nil, errors.Errorf("unsupported solana message version: %s", version)
+	}
+
+	buf.Write([]byte{numSigners, numReadonlySigned, numReadonlyUnsigned})
+	writeCompactU16(&buf, len(ordered))
+	for _, meta := range ordered {
+		buf.Write(meta.pubKey[:])
+	}
+	buf.Write(recentBlockhash[:])
+
+	writeCompactU16(&buf, len(instructions))
+	for _, ix := range instructions {
+		buf.WriteByte(keyIndex[ix.programID])
+		writeCompactU16(&buf, len(ix.accounts))
+		for _, account := range ix.accounts {
+			buf.WriteByte(keyIndex[account.pubKey])
+		}
+		writeCompactU16(&buf, len(ix.data))
+		buf.Write(ix.data)
+	}
+
+	if version == SolanaMessageV0 {
+		// 地址查找表数量
+		writeCompactU16(&buf, 0)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// solanaMessageSigners 解析消息头，返回需要签名的账户（按签名顺序）
+func solanaMessageSigners(message []byte) ([]SolanaPublicKey, error) {
+	r := bytes.NewReader(message)
+	first, err := r.ReadByte()
+	if err != nil {
+		return nil, errors.New("message is empty")
+	}
+	if first&solanaVersionPrefix != 0 {
+		if first != solanaVersionPrefix {
+			return nil, errors.Errorf("unsupported solana message version %d", first&^solanaVersionPrefix)
+		}
+	} else if err := r.UnreadByte(); err != nil {
+		return nil, err
+	}
+
+	var header [3]byte
+	if n, _ := r.Read(header[:]); n != len(header) {
+		return nil, errors.New("message header is truncated")
+	}
+	numKeys, err := readCompactU16(r)
+	if err != nil {
+		return nil, err
+	}
+	if int(header[0]) > numKeys {
+		return nil, errors.New("message requires more signatures than accounts")
+	}
+
+	signers := make([]SolanaPublicKey, header[0])
+	for i := range signers {
+		if n, _ := r.Read(signers[i][:]); n != len(signers[i]) {
+			return nil, errors.New("message account keys are truncated")
+		}
+	}
+	return signers, nil
+}
+
+// serializeSolanaTransaction 交易线格式：签名数量、签名、消息
+func serializeSolanaTransaction(signatures [][]byte, message []byte) []byte {
+	var buf bytes.Buffer
+	writeCompactU16(&buf, len(signatures))
+	for _, sig := range signatures {
+		buf.Write(sig)
+	}
+	buf.Write(message)
+	return buf.Bytes()
+}
+
+// parseSolanaTransaction 解析交易线格式，返回签名和消息
+func parseSolanaTransaction(raw []byte) ([][]byte, []byte, error) {
+	r := bytes.NewReader(raw)
+	numSigs, err := readCompactU16(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.Len() < numSigs*solanaSignatureLength {
+		return nil, nil, errors.New("transaction signatures are truncated")
+	}
+
+	signatures := make([][]byte, numSigs)
+	for i := range signatures {
+		signatures[i] = make([]byte, solanaSignatureLength)
+		if _, err := r.Read(signatures[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	message := raw[len(raw)-r.Len():]
+	return signatures, message, nil
+}
+
+// writeCompactU16 Solana short_vec 长度编码（每字节 7 位，小端）
+func writeCompactU16(buf *bytes.Buffer, n int) {
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			buf.WriteByte(b)
+			return
+		}
+		buf.WriteByte(b | 0x80)
+	}
+}
+
+// readCompactU16 读取 short_vec 长度
+func readCompactU16(r *bytes.Reader) (int, error) {
+	n := 0
+	for i := 0; i < 3; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, errors.New("compact-u16 is truncated")
+		}
+		n |= int(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return n, nil
+		}
+	}
+	return 0, errors.New("compact-u16 is too long")
+}
+
+// solanaU64 小端编码 u64
+func solanaU64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, v)
+	return b
+}
@@ -0,0 +1,157 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolanaSystemTransferMessage(t *testing.T) {
+	from := SolanaPublicKey{1}
+	to := SolanaPublicKey{2}
+	blockhash := SolanaPublicKey{3}
+
+	message, err := compileSolanaMessage(SolanaMessageLegacy, from, []solanaInstruction{solanaSystemTransferInstruction(from, to, 1000)}, blockhash)
+	require.NoError(t, err)
+
+	var expected []byte
+	expected = append(expected, 1, 0, 1, 3) // 消息头、3 个账户
+	expected = append(expected, from[:]...)
+	expected = append(expected, to[:]...)
+	expected = append(expected, SolanaSystemProgramID[:]...)
+	expected = append(expected, blockhash[:]...)
+	expected = append(expected, 1, 2, 2, 0, 1, 12) // 1 条指令：程序索引、账户索引、数据长度
+	expected = append(expected, 2, 0, 0, 0, 0xe8, 0x03, 0, 0, 0, 0, 0, 0)
+	assert.Equal(t, expected, message)
+
+	// v0 消息：版本前缀 + 相同内容 + 空地址查找表
+	v0, err := compileSolanaMessage(SolanaMessageV0, from, []solanaInstruction{solanaSystemTransferInstruction(from, to, 1000)}, blockhash)
+	require.NoError(t, err)
+	assert.Equal(t, append(append([]byte{0x80}, expected...), 0), v0)
+}
+
+func TestFindSolanaAssociatedTokenAddress(t *testing.T) {
+	wallet, err := ParseSolanaPublicKey("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	require.NoError(t, err)
+	mint, err := ParseSolanaPublicKey("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	require.NoError(t, err)
+
+	// bump 255 得到的地址在曲线上，实际使用 bump 254
+	ata, err := FindSolanaAssociatedTokenAddress(wallet, mint)
+	require.NoError(t, err)
+	assert.Equal(t, "FGETo8T8wMcN2wCjav8VK6eh3dLk63evNDPxzLSJra8B", ata.String())
+}
+
+func TestSolanaBuildAndAssembleTransaction(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	from := base58.Encode(pubKey)
+	to := SolanaPublicKey{2}.String()
+	blockhash := SolanaPublicKey{3}.String()
+	mint := SolanaPublicKey{4}.String()
+
+	tests := []struct {
+		name string
+		req  *BuildTxRequest
+	}{
+		{"sol legacy", &BuildTxRequest{From: from, To: to, Amount: big.NewInt(1000), RecentBlockhash: blockhash}},
+		{"sol v0 with memo", &BuildTxRequest{From: from, To: to, Amount: big.NewInt(1000), RecentBlockhash: blockhash, SolanaMessageVersion: SolanaMessageV0, Data: []byte("invoice-42")}},
+		{"spl", &BuildTxRequest{From: from, To: to, Amount: big.NewInt(5e6), RecentBlockhash: blockhash, TokenMint: mint, TokenDecimals: 6, CreateTokenAccount: true}},
+	}
+
+	adapter := NewSolanaAdapter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsigned, err := adapter.BuildTransaction(tt.req)
+			require.NoError(t, err)
+			require.Len(t, unsigned.SigningHashes, 1)
+			assert.Equal(t, int64(5000), unsigned.Fee.Int64())
+
+			message := unsigned.SigningHashes[0]
+			signers, err := solanaMessageSigners(message)
+			require.NoError(t, err)
+			require.Len(t, signers, 1)
+			assert.Equal(t, from, signers[0].String())
+
+			signature := ed25519.Sign(privKey, message)
+			signed, err := adapter.AssembleSignedTx(unsigned.Raw, signature, pubKey)
+			require.NoError(t, err)
+			assert.Equal(t, base58.Encode(signature), signed.Hash)
+
+			raw, err := base64.StdEncoding.DecodeString(signed.Raw)
+			require.NoError(t, err)
+			signatures, signedMessage, err := parseSolanaTransaction(raw)
+			require.NoError(t, err)
+			require.Len(t, signatures, 1)
+			assert.Equal(t, signature, signatures[0])
+			assert.True(t, bytes.Equal(message, signedMessage))
+
+			// 其他密钥的签名不能写入交易
+			otherPub, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+			require.NoError(t, err)
+			_, err = adapter.AssembleSignedTx(unsigned.Raw, ed25519.Sign(otherPriv, message), otherPub)
+			assert.Error(t, err)
+			_, err = adapter.AssembleSignedTx(unsigned.Raw, signature[:63], pubKey)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSolanaBuildTransactionValidation(t *testing.T) {
+	from := SolanaPublicKey{1}.String()
+	to := SolanaPublicKey{2}.String()
+	blockhash := SolanaPublicKey{3}.String()
+
+	tests := []struct {
+		name string
+		req  *BuildTxRequest
+	}{
+		{"missing blockhash", &BuildTxRequest{From: from, To: to, Amount: big.NewInt(1)}},
+		{"invalid from", &BuildTxRequest{From: "abc", To: to, Amount: big.NewInt(1), RecentBlockhash: blockhash}},
+		{"invalid to", &BuildTxRequest{From: from, To: "0x1234", Amount: big.NewInt(1), RecentBlockhash: blockhash}},
+		{"negative amount", &BuildTxRequest{From: from, To: to, Amount: big.NewInt(-1), RecentBlockhash: blockhash}},
+		{"invalid mint", &BuildTxRequest{From: from, To: to, Amount: big.NewInt(1), RecentBlockhash: blockhash, TokenMint: "mint"}},
+		{"unsupported version", &BuildTxRequest{From: from, To: to, Amount: big.NewInt(1), RecentBlockhash: blockhash, SolanaMessageVersion: "v1"}},
+	}
+
+	adapter := NewSolanaAdapter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := adapter.BuildTransaction(tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSolanaGetTokenBalance(t *testing.T) {
+	const (
+		owner = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+		mint  = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+	)
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 关联代币账户和另一个代币账户的余额合计
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"context":{"slot":1},"value":[
+			{"pubkey":"ata","account":{"data":{"parsed":{"info":{"mint":"` + mint + `","owner":"` + owner + `","tokenAmount":{"amount":"1500000","decimals":6}},"type":"account"},"program":"spl-token"}}},
+			{"pubkey":"other","account":{"data":{"parsed":{"info":{"mint":"` + mint + `","owner":"` + owner + `","tokenAmount":{"amount":"250000","decimals":6}},"type":"account"},"program":"spl-token"}}}
+		]}}`))
+	}))
+	defer node.Close()
+
+	adapter := NewSolanaAdapterWithRPC(node.URL)
+	balance, err := adapter.GetTokenBalance(context.Background(), owner, mint)
+	require.NoError(t, err)
+	assert.Equal(t, "1750000", balance.String())
+
+	_, err = adapter.GetTokenBalance(context.Background(), owner, "not-a-mint")
+	assert.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package chain
+
+import (
+	"crypto/sha256"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/pkg/errors"
+)
+
+// Solana 内置程序地址
+var (
+	SolanaSystemProgramID          = mustSolanaPublicKey("11111111111111111111111111111111")
+	SolanaTokenProgramID           = mustSolanaPublicKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	SolanaAssociatedTokenProgramID = mustSolanaPublicKey("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL")
+	SolanaMemoProgramID            = mustSolanaPublicKey("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr")
+)
+
+const (
+	// System Program 指令编号（u32）
+	solanaSystemTransfer = 2
+	// Token Program 指令编号（u8）
+	solanaTokenTransferChecked = 12
+	// Associated Token Account Program 指令编号（u8）
+	solanaATACreateIdempotent = 1
+
+	solanaPDAMarker = "ProgramDerivedAddress"
+)
+
+// FindSolanaProgramAddress 从 bump 255 开始查找第一个不在 Ed25519 曲线上的程序派生地址（PDA）
+func FindSolanaProgramAddress(seeds [][]byte, programID SolanaPublicKey) (SolanaPublicKey, byte, error) {
+	withBump := append(append([][]byte{}, seeds...), nil)
+	for bump := 255; bump >= 0; bump-- {
+		withBump[len(seeds)] = []byte{byte(bump)}
+		address, err := createSolanaProgramAddress(withBump, programID)
+		if err == nil {
+			return address, byte(bump), nil
+		}
+	}
+	return SolanaPublicKey{}, 0, errors.New("unable to find a valid program address")
+}
+
+// createSolanaProgramAddress sha256(seeds || programID || "ProgramDerivedAddress")，结果必须不在曲线上
+func createSolanaProgramAddress(seeds [][]byte, programID SolanaPublicKey) (SolanaPublicKey, error) {
+	var address SolanaPublicKey
+	h := sha256.New()
+	for _, seed := range seeds {
+		if len(seed) > 32 {
+			return address, errors.New("program address seed exceeds 32 bytes")
+		}
+		h.Write(seed)
+	}
+	h.Write(programID[:])
+	h.Write([]byte(solanaPDAMarker))
+	copy(address[:], h.Sum(nil))
+
+	if _, err := edwards.ParsePubKey(address[:]); err == nil {
+		return address, errors.New("program address is on the ed25519 curve")
+	}
+	return address, nil
+}
+
+// FindSolanaAssociatedTokenAddress 派生钱包在指定代币上的关联代币账户（ATA）
+func FindSolanaAssociatedTokenAddress(wallet, mint SolanaPublicKey) (SolanaPublicKey, error) {
+	address, _, err := FindSolanaProgramAddress([][]byte{wallet[:], SolanaTokenProgramID[:], mint[:]}, SolanaAssociatedTokenProgramID)
+	return address, err
+}
+
+// solanaSystemTransferInstruction System Program 转账 SOL
+func solanaSystemTransferInstruction(from, to SolanaPublicKey, lamports uint64) solanaInstruction {
+	data := []byte{solanaSystemTransfer, 0, 0, 0}
+	return solanaInstruction{
+		programID: SolanaSystemProgramID,
+		accounts: []solanaAccountMeta{
+			{pubKey: from, isSigner: true, isWritable: true},
+			{pubKey: to, isWritable: true},
+		},
+		data: append(data, solanaU64(lamports)...),
+	}
+}
+
+// solanaTransferCheckedInstruction Token Program TransferChecked，由代币合约校验精度
+func solanaTransferCheckedInstruction(source, mint, destination, owner SolanaPublicKey, amount uint64, decimals uint8) solanaInstruction {
+	data := append([]byte{solanaTokenTransferChecked}, solanaU64(amount)...)
+	return solanaInstruction{
+		programID: SolanaTokenProgramID,
+		accounts: []solanaAccountMeta{
+			{pubKey: source, isWritable: true},
+			{pubKey: mint},
+			{pubKey: destination, isWritable: true},
+			{pubKey: owner, isSigner: true},
+		},
+		data: append(data, decimals),
+	}
+}
+
+// solanaCreateATAIdempotentInstruction 创建关联代币账户，账户已存在时不报错
+func solanaCreateATAIdempotentInstruction(payer, ata, owner, mint SolanaPublicKey) solanaInstruction {
+	return solanaInstruction{
+		programID: SolanaAssociatedTokenProgramID,
+		accounts: []solanaAccountMeta{
+			{pubKey: payer, isSigner: true, isWritable: true},
+			{pubKey: ata, isWritable: true},
+			{pubKey: owner},
+			{pubKey: mint},
+			{pubKey: SolanaSystemProgramID},
+			{pubKey: SolanaTokenProgramID},
+		},
+		data: []byte{solanaATACreateIdempotent},
+	}
+}
+
+// solanaMemoInstruction Memo Program 附言，签名者为付费账户
+func solanaMemoInstruction(signer SolanaPublicKey, memo []byte) solanaInstruction {
+	return solanaInstruction{
+		programID: SolanaMemoProgramID,
+		accounts:  []solanaAccountMeta{{pubKey: signer, isSigner: true}},
+		data:      memo,
+	}
+}
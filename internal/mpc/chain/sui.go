@@ -0,0 +1,371 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/sui"
+)
+
+const (
+	// suiEd25519Flag Ed25519 签名方案标识，用于地址派生和序列化签名
+	suiEd25519Flag = 0x00
+	// suiDefaultGasBudget 未指定时的 gas 预算（MIST），足够覆盖一次 SUI 转账
+	suiDefaultGasBudget = 10_000_000
+	// suiMaxGasPaymentObjects 协议允许的 gas 支付对象上限
+	suiMaxGasPaymentObjects = 256
+	// suiDigestLength 对象和交易摘要长度
+	suiDigestLength = 32
+	// suiSerializedSignatureLength flag || Ed25519 签名 || 公钥
+	suiSerializedSignatureLength = 1 + ed25519.SignatureSize + ed25519.PublicKeySize
+	// suiTransactionDataSalt 交易摘要的域分隔前缀
+	suiTransactionDataSalt = "TransactionData::"
+)
+
+// suiTransactionIntent 交易签名的意图前缀：scope TransactionData、version V0、app Sui
+var suiTransactionIntent = []byte{0, 0, 0}
+
+// Sui 交易枚举变体（BCS 序号）
+const (
+	suiTransactionDataV1      = 0 // TransactionData::V1
+	suiProgrammableTx         = 0 // TransactionKind::ProgrammableTransaction
+	suiCallArgPure            = 0 // CallArg::Pure
+	suiCommandTransferObjects = 1 // Command::TransferObjects
+	suiCommandSplitCoins      = 2 // Command::SplitCoins
+	suiArgumentGasCoin        = 0 // Argument::GasCoin
+	suiArgumentInput          = 1 // Argument::Input
+	suiArgumentNestedResult   = 3 // Argument::NestedResult
+	suiExpirationNone         = 0 // TransactionExpiration::None
+)
+
+// SuiObjectRef 对象引用，gas 支付使用的 SUI Coin 对象
+type SuiObjectRef struct {
+	ObjectID string // 0x 前缀十六进制
+	Version  uint64
+	Digest   string // Base58
+}
+
+// SuiAdapter 实现 Sui 链基础能力，使用 Ed25519 账户转账 SUI，交易通过 JSON-RPC 查询 gas 对象和提交
+type SuiAdapter struct {
+	rpcClient *sui.RPCClient
+}
+
+// NewSuiAdapter 创建 Sui 适配器，配置多个 JSON-RPC 端点时按顺序故障转移
+func NewSuiAdapter(rpcEndpoints ...string) *SuiAdapter {
+	adapter := &SuiAdapter{}
+	if endpoints := nonEmptyEndpoints(rpcEndpoints); len(endpoints) > 0 {
+		adapter.rpcClient = sui.NewRPCClient(endpoints...)
+	}
+	return adapter
+}
+
+// GenerateAddress 根据 Ed25519 公钥生成账户地址
+func (a *SuiAdapter) GenerateAddress(pubKey []byte) (string, error) {
+	return SuiAddressFromPublicKey(pubKey)
+}
+
+// SuiAddressFromPublicKey 由 Ed25519 公钥生成 0x 前缀的 64 位十六进制地址，即 BLAKE2b-256(0x00 || 公钥)
+func SuiAddressFromPublicKey(pubKey []byte) (string, error) {
+	if len(pubKey) != ed25519.PublicKeySize {
+		return "", errors.Errorf("invalid public key length: expected %d bytes, got %d", ed25519.PublicKeySize, len(pubKey))
+	}
+	hash := blake2b.Sum256(append([]byte{suiEd25519Flag}, pubKey...))
+	return encodeMoveAddress(hash[:]), nil
+}
+
+// ParseSuiAddress 解析 0x 前缀的十六进制地址或对象 ID，短格式（如 0x2）左侧补零，返回 32 字节地址
+func ParseSuiAddress(address string) ([]byte, error) {
+	parsed, err := parseMoveAddress(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sui address")
+	}
+	return parsed, nil
+}
+
+// GetBalance 查询 SUI 余额（MIST）
+func (a *SuiAdapter) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	parsed, err := ParseSuiAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return a.rpcClient.GetBalance(ctx, encodeMoveAddress(parsed), sui.SUICoinType)
+}
+
+// BroadcastTransaction 提交 AssembleSignedTx 返回的已签名交易，返回交易摘要
+func (a *SuiAdapter) BroadcastTransaction(ctx context.Context, rawTx string) (string, error) {
+	if a.rpcClient == nil {
+		return "", errors.New("RPC client not configured")
+	}
+	raw, err := base64.StdEncoding.DecodeString(rawTx)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid raw transaction")
+	}
+	txData, signature, err := parseSuiSignedTransaction(raw)
+	if err != nil {
+		return "", err
+	}
+	return a.rpcClient.ExecuteTransactionBlock(ctx, base64.StdEncoding.EncodeToString(txData),
+		[]string{base64.StdEncoding.EncodeToString(signature)})
+}
+
+// BuildTransactionWithGasCoins 选择发送方的 SUI Coin 对象支付 gas（余额需覆盖转账金额和 gas 预算）后构建交易，
+// 未指定 gas 价格时使用参考 gas 价格
+func (a *SuiAdapter) BuildTransactionWithGasCoins(ctx context.Context, req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	if req.Amount == nil || req.Amount.Sign() <= 0 || !req.Amount.IsUint64() {
+		return nil, errors.New("amount must be a positive 64-bit integer")
+	}
+	owner, err := ParseSuiAddress(req.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+
+	withGas := *req
+	if withGas.SuiGasPrice == 0 {
+		if withGas.SuiGasPrice, err = a.rpcClient.GetReferenceGasPrice(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if withGas.SuiGasBudget == 0 {
+		withGas.SuiGasBudget = suiDefaultGasBudget
+	}
+	if len(withGas.SuiGasPayment) == 0 {
+		required := new(big.Int).Add(req.Amount, new(big.Int).SetUint64(withGas.SuiGasBudget))
+		if withGas.SuiGasPayment, err = a.selectGasCoins(ctx, encodeMoveAddress(owner), required); err != nil {
+			return nil, err
+		}
+	}
+	return a.BuildTransaction(&withGas)
+}
+
+// selectGasCoins 分页读取 SUI Coin 对象直到余额合计覆盖 required，按余额从大到小选择尽量少的对象
+func (a *SuiAdapter) selectGasCoins(ctx context.Context, owner string, required *big.Int) ([]SuiObjectRef, error) {
+	type candidate struct {
+		ref     SuiObjectRef
+		balance *big.Int
+	}
+
+	var candidates []candidate
+	total := new(big.Int)
+	cursor := ""
+	for total.Cmp(required) < 0 {
+		page, err := a.rpcClient.GetCoins(ctx, owner, sui.SUICoinType, cursor, 50)
+		if err != nil {
+			return nil, err
+		}
+		for _, coin := range page.Data {
+			version, err := strconv.ParseUint(coin.Version, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid version for coin %s", coin.CoinObjectID)
+			}
+			balance, ok := new(big.Int).SetString(coin.Balance, 10)
+			if !ok {
+				return nil, errors.Errorf("invalid balance for coin %s", coin.CoinObjectID)
+			}
+			candidates = append(candidates, candidate{
+				ref:     SuiObjectRef{ObjectID: coin.CoinObjectID, Version: version, Digest: coin.Digest},
+				balance: balance,
+			})
+			total.Add(total, balance)
+		}
+		if !page.HasNextPage || page.NextCursor == nil {
+			break
+		}
+		cursor = *page.NextCursor
+	}
+	if total.Cmp(required) < 0 {
+		return nil, errors.Errorf("insufficient SUI balance: have %s, need %s", total, required)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].balance.Cmp(candidates[j].balance) > 0
+	})
+	var selected []SuiObjectRef
+	sum := new(big.Int)
+	for _, c := range candidates {
+		if sum.Cmp(required) >= 0 {
+			break
+		}
+		if len(selected) == suiMaxGasPaymentObjects {
+			return nil, errors.Errorf("SUI balance is split across too many coins, merge coins before transferring")
+		}
+		selected = append(selected, c.ref)
+		sum.Add(sum, c.balance)
+	}
+	return selected, nil
+}
+
+// BuildTransaction 构建从 gas coin 拆分金额并转给接收方的可编程交易（SplitCoins + TransferObjects）
+// 返回的 Raw 为 Base64 编码的 TransactionData，SigningHashes 中唯一的摘要为 BLAKE2b-256(意图前缀 || TransactionData)，
+// 即 Ed25519 ThresholdSign 的消息；Hash 为 Base58 编码的交易摘要
+func (a *SuiAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if req.Amount == nil || req.Amount.Sign() <= 0 || !req.Amount.IsUint64() {
+		return nil, errors.New("amount must be a positive 64-bit integer")
+	}
+	if req.SuiGasPrice == 0 {
+		return nil, errors.New("gas price is required")
+	}
+	if len(req.SuiGasPayment) == 0 || len(req.SuiGasPayment) > suiMaxGasPaymentObjects {
+		return nil, errors.Errorf("between 1 and %d gas payment objects are required", suiMaxGasPaymentObjects)
+	}
+	budget := req.SuiGasBudget
+	if budget == 0 {
+		budget = suiDefaultGasBudget
+	}
+
+	sender, err := ParseSuiAddress(req.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+	to, err := ParseSuiAddress(req.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid to address")
+	}
+
+	var txData []byte
+	txData = bcsAppendULEB128(txData, suiTransactionDataV1)
+	txData = bcsAppendULEB128(txData, suiProgrammableTx)
+
+	// inputs：[0] 转账金额，[1] 接收方地址
+	txData = bcsAppendULEB128(txData, 2)
+	txData = bcsAppendULEB128(txData, suiCallArgPure)
+	txData = bcsAppendBytes(txData, bcsU64(req.Amount.Uint64()))
+	txData = bcsAppendULEB128(txData, suiCallArgPure)
+	txData = bcsAppendBytes(txData, to)
+
+	// commands：SplitCoins(GasCoin, [Input(0)])，TransferObjects([NestedResult(0, 0)], Input(1))
+	txData = bcsAppendULEB128(txData, 2)
+	txData = bcsAppendULEB128(txData, suiCommandSplitCoins)
+	txData = bcsAppendULEB128(txData, suiArgumentGasCoin)
+	txData = bcsAppendULEB128(txData, 1)
+	txData = bcsAppendULEB128(txData, suiArgumentInput)
+	txData = bcsAppendU16(txData, 0)
+	txData = bcsAppendULEB128(txData, suiCommandTransferObjects)
+	txData = bcsAppendULEB128(txData, 1)
+	txData = bcsAppendULEB128(txData, suiArgumentNestedResult)
+	txData = bcsAppendU16(txData, 0)
+	txData = bcsAppendU16(txData, 0)
+	txData = bcsAppendULEB128(txData, suiArgumentInput)
+	txData = bcsAppendU16(txData, 1)
+
+	txData = append(txData, sender...)
+
+	// gas_data：payment、owner、price、budget
+	txData = bcsAppendULEB128(txData, uint64(len(req.SuiGasPayment)))
+	for _, ref := range req.SuiGasPayment {
+		objectID, err := ParseSuiAddress(ref.ObjectID)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid gas object id")
+		}
+		digest := base58.Decode(ref.Digest)
+		if len(digest) != suiDigestLength {
+			return nil, errors.Errorf("invalid digest for gas object %s", ref.ObjectID)
+		}
+		txData = append(txData, objectID...)
+		txData = bcsAppendU64(txData, ref.Version)
+		txData = bcsAppendBytes(txData, digest)
+	}
+	txData = append(txData, sender...)
+	txData = bcsAppendU64(txData, req.SuiGasPrice)
+	txData = bcsAppendU64(txData, budget)
+
+	txData = bcsAppendULEB128(txData, suiExpirationNone)
+
+	signingDigest := suiSigningDigest(txData)
+	return &Transaction{
+		Raw:           base64.StdEncoding.EncodeToString(txData),
+		Hash:          suiTransactionDigest(txData),
+		SigningHashes: [][]byte{signingDigest[:]},
+		Fee:           new(big.Int).SetUint64(budget), // gas 预算，实际按消耗收取
+	}, nil
+}
+
+// AssembleSignedTx 将 Ed25519 签名与 BuildTransaction 生成的 TransactionData 组装为 BCS 编码的 SenderSignedData，
+// 返回 Base64 编码的已签名交易，Hash 为 Base58 编码的交易摘要
+func (a *SuiAdapter) AssembleSignedTx(rawTx string, signature []byte, pubKey []byte) (*Transaction, error) {
+	txData, err := base64.StdEncoding.DecodeString(rawTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid raw transaction")
+	}
+
+	// BuildTransaction 生成的交易以 gas owner（即发送方）、price、budget 和 TransactionExpiration::None 结尾
+	ownerEnd := len(txData) - 1 - 16
+	if ownerEnd-moveAddressLength < 0 || txData[len(txData)-1] != suiExpirationNone {
+		return nil, errors.New("unsupported transaction data")
+	}
+	signer, err := SuiAddressFromPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if signer != encodeMoveAddress(txData[ownerEnd-moveAddressLength:ownerEnd]) {
+		return nil, errors.New("public key is not the gas owner of the transaction")
+	}
+
+	if len(signature) != ed25519.SignatureSize {
+		return nil, errors.Errorf("invalid signature length: expected %d bytes, got %d", ed25519.SignatureSize, len(signature))
+	}
+	signingDigest := suiSigningDigest(txData)
+	if !ed25519.Verify(pubKey, signingDigest[:], signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	serialized := append(append([]byte{suiEd25519Flag}, signature...), pubKey...)
+	var signed []byte
+	signed = bcsAppendULEB128(signed, 1) // SenderSignedData：单个 SenderSignedTransaction
+	signed = append(signed, suiTransactionIntent...)
+	signed = append(signed, txData...)
+	signed = bcsAppendULEB128(signed, 1) // tx_signatures
+	signed = bcsAppendBytes(signed, serialized)
+
+	return &Transaction{
+		Raw:  base64.StdEncoding.EncodeToString(signed),
+		Hash: suiTransactionDigest(txData),
+	}, nil
+}
+
+// suiSigningDigest 签名原文：BLAKE2b-256(意图前缀 || TransactionData)
+func suiSigningDigest(txData []byte) [32]byte {
+	return blake2b.Sum256(append(append([]byte(nil), suiTransactionIntent...), txData...))
+}
+
+// suiTransactionDigest 交易摘要：Base58(BLAKE2b-256("TransactionData::" || TransactionData))
+func suiTransactionDigest(txData []byte) string {
+	digest := blake2b.Sum256(append([]byte(suiTransactionDataSalt), txData...))
+	return base58.Encode(digest[:])
+}
+
+// parseSuiSignedTransaction 从 AssembleSignedTx 生成的 SenderSignedData 中取出 TransactionData 和序列化签名
+// 只支持单个 Ed25519 签名：签名列表位于末尾，长度固定
+func parseSuiSignedTransaction(raw []byte) ([]byte, []byte, error) {
+	header := 1 + len(suiTransactionIntent)
+	trailer := 2 + suiSerializedSignatureLength // 签名个数、签名长度和签名
+	if len(raw) <= header+trailer || raw[0] != 1 || !bytes.Equal(raw[1:header], suiTransactionIntent) {
+		return nil, nil, errors.New("invalid signed transaction")
+	}
+
+	signatures := raw[len(raw)-trailer:]
+	if signatures[0] != 1 || signatures[1] != suiSerializedSignatureLength || signatures[2] != suiEd25519Flag {
+		return nil, nil, errors.New("signed transaction must carry a single Ed25519 signature")
+	}
+	return raw[header : len(raw)-trailer], signatures[2:], nil
+}
@@ -0,0 +1,236 @@
+package sui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SUICoinType 原生代币 SUI 的币种
+const SUICoinType = "0x2::sui::SUI"
+
+// RPCClient Sui JSON-RPC 客户端，配置多个端点时按顺序故障转移
+type RPCClient struct {
+	endpoints []string
+	current   atomic.Int32 // 最近一次调用成功的端点，下次调用优先使用
+	client    *http.Client
+}
+
+// NewRPCClient 创建 Sui JSON-RPC 客户端（如 https://fullnode.mainnet.sui.io:443）
+func NewRPCClient(endpoints ...string) *RPCClient {
+	return &RPCClient{
+		endpoints: endpoints,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// RPCRequest RPC 请求
+type RPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	ID      int           `json:"id"`
+}
+
+// RPCResponse RPC 响应
+type RPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+	ID      int             `json:"id"`
+}
+
+// RPCError RPC 错误
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("RPC error: %s (code: %d)", e.Message, e.Code)
+}
+
+// ExecutionError 交易已提交但执行失败（如 gas 不足），交易仍会上链并扣除 gas
+type ExecutionError struct {
+	Digest  string
+	Message string
+}
+
+func (e *ExecutionError) Error() string {
+	return fmt.Sprintf("transaction %s failed: %s", e.Digest, e.Message)
+}
+
+// call 执行 RPC 调用，端点不可用（网络错误或 5xx/429 响应）时依次尝试下一个端点，
+// 节点返回的 RPC 错误直接返回
+func (c *RPCClient) call(ctx context.Context, method string, params []interface{}) (json.RawMessage, error) {
+	if len(c.endpoints) == 0 {
+		return nil, errors.New("no RPC endpoint configured")
+	}
+
+	req := &RPCRequest{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+		ID:      1,
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal RPC request")
+	}
+
+	start := int(c.current.Load())
+	for i := 0; i < len(c.endpoints); i++ {
+		idx := (start + i) % len(c.endpoints)
+		result, err := c.callEndpoint(ctx, c.endpoints[idx], reqBody)
+		if err == nil {
+			c.current.Store(int32(idx))
+			return result, nil
+		}
+
+		var rpcErr *RPCError
+		if errors.As(err, &rpcErr) || ctx.Err() != nil || i == len(c.endpoints)-1 {
+			return nil, err
+		}
+	}
+
+	return nil, errors.New("no RPC endpoint available")
+}
+
+// callEndpoint 向单个端点发送请求
+func (c *RPCClient) callEndpoint(ctx context.Context, endpoint string, reqBody []byte) (json.RawMessage, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create HTTP request")
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute HTTP request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return nil, errors.Errorf("RPC endpoint returned HTTP %d", resp.StatusCode)
+	}
+
+	var rpcResp RPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return nil, errors.Wrap(err, "failed to decode RPC response")
+	}
+
+	if rpcResp.Error != nil {
+		return nil, rpcResp.Error
+	}
+
+	return rpcResp.Result, nil
+}
+
+// GetBalance 查询地址在 coinType 上的总余额（最小单位）
+func (c *RPCClient) GetBalance(ctx context.Context, owner, coinType string) (*big.Int, error) {
+	result, err := c.call(ctx, "suix_getBalance", []interface{}{owner, coinType})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call suix_getBalance")
+	}
+
+	var balance struct {
+		TotalBalance string `json:"totalBalance"`
+	}
+	if err := json.Unmarshal(result, &balance); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal balance")
+	}
+
+	value, ok := new(big.Int).SetString(balance.TotalBalance, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance %q", balance.TotalBalance)
+	}
+	return value, nil
+}
+
+// Coin 地址持有的 Coin 对象
+type Coin struct {
+	CoinObjectID string `json:"coinObjectId"`
+	Version      string `json:"version"`
+	Digest       string `json:"digest"` // Base58
+	Balance      string `json:"balance"`
+}
+
+// CoinPage 分页查询 Coin 对象的结果
+type CoinPage struct {
+	Data        []*Coin `json:"data"`
+	NextCursor  *string `json:"nextCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+// GetCoins 分页查询地址持有的 coinType 类型 Coin 对象，cursor 为空时从第一页开始
+func (c *RPCClient) GetCoins(ctx context.Context, owner, coinType, cursor string, limit int) (*CoinPage, error) {
+	params := []interface{}{owner, coinType, nil, limit}
+	if cursor != "" {
+		params[2] = cursor
+	}
+	result, err := c.call(ctx, "suix_getCoins", params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call suix_getCoins")
+	}
+
+	var page CoinPage
+	if err := json.Unmarshal(result, &page); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal coins")
+	}
+	return &page, nil
+}
+
+// GetReferenceGasPrice 查询当前纪元的参考 gas 价格（MIST）
+func (c *RPCClient) GetReferenceGasPrice(ctx context.Context) (uint64, error) {
+	result, err := c.call(ctx, "suix_getReferenceGasPrice", []interface{}{})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to call suix_getReferenceGasPrice")
+	}
+
+	var price string
+	if err := json.Unmarshal(result, &price); err != nil {
+		return 0, errors.Wrap(err, "failed to unmarshal reference gas price")
+	}
+	return strconv.ParseUint(price, 10, 64)
+}
+
+// ExecuteTransactionBlock 提交 Base64 编码的交易数据和签名，返回交易摘要；执行失败时返回 ExecutionError
+func (c *RPCClient) ExecuteTransactionBlock(ctx context.Context, txBytes string, signatures []string) (string, error) {
+	options := map[string]bool{"showEffects": true}
+	result, err := c.call(ctx, "sui_executeTransactionBlock", []interface{}{txBytes, signatures, options})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to call sui_executeTransactionBlock")
+	}
+
+	var response struct {
+		Digest  string `json:"digest"`
+		Effects *struct {
+			Status struct {
+				Status string `json:"status"`
+				Error  string `json:"error"`
+			} `json:"status"`
+		} `json:"effects"`
+	}
+	if err := json.Unmarshal(result, &response); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal transaction response")
+	}
+	if response.Digest == "" {
+		return "", errors.New("node returned an empty transaction digest")
+	}
+	if response.Effects != nil && response.Effects.Status.Status == "failure" {
+		return "", &ExecutionError{Digest: response.Digest, Message: response.Effects.Status.Error}
+	}
+	return response.Digest, nil
+}
@@ -0,0 +1,215 @@
+package chain
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/sui"
+)
+
+const suiReceiver = "0x5678"
+
+func suiGasCoin(id byte, version uint64) SuiObjectRef {
+	return SuiObjectRef{ObjectID: encodeMoveAddress(common32(id)), Version: version, Digest: base58.Encode(common32(id + 100))}
+}
+
+func TestSuiAddress(t *testing.T) {
+	pubKey := zeroSeedEd25519Key().Public().(ed25519.PublicKey)
+	address, err := NewSuiAdapter().GenerateAddress(pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, "0x7a1378aafadef8ce743b72e8b248295c8f61c102c94040161146ea4d51a182b6", address)
+
+	_, err = SuiAddressFromPublicKey(append(pubKey, 0))
+	assert.Error(t, err)
+
+	parsed, err := ParseSuiAddress("0x2")
+	require.NoError(t, err)
+	assert.Equal(t, common32(2), parsed)
+	_, err = ParseSuiAddress("sui")
+	assert.Error(t, err)
+}
+
+func TestSuiBuildAndAssembleTransfer(t *testing.T) {
+	privKey := zeroSeedEd25519Key()
+	pubKey := privKey.Public().(ed25519.PublicKey)
+
+	adapter := NewSuiAdapter()
+	from, err := adapter.GenerateAddress(pubKey)
+	require.NoError(t, err)
+
+	gasCoin := suiGasCoin(1, 77)
+	unsigned, err := adapter.BuildTransaction(&BuildTxRequest{
+		From: from, To: suiReceiver, Amount: big.NewInt(2500000000),
+		SuiGasPayment: []SuiObjectRef{gasCoin}, SuiGasPrice: 750, SuiGasBudget: 3000000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "3000000", unsigned.Fee.String())
+
+	txData, err := base64.StdEncoding.DecodeString(unsigned.Raw)
+	require.NoError(t, err)
+	sender, _ := ParseSuiAddress(from)
+	receiver, _ := ParseSuiAddress(suiReceiver)
+
+	// TransactionData::V1(ProgrammableTransaction { inputs: [Pure(amount), Pure(to)],
+	// commands: [SplitCoins(GasCoin, [Input(0)]), TransferObjects([NestedResult(0, 0)], Input(1))] })
+	expected := []byte{0, 0, 2, 0, 8}
+	expected = binary.LittleEndian.AppendUint64(expected, 2500000000)
+	expected = append(expected, 0, 32)
+	expected = append(expected, receiver...)
+	expected = append(expected, 2, 2, 0, 1, 1, 0, 0, 1, 1, 3, 0, 0, 0, 0, 1, 1, 0)
+	expected = append(expected, sender...)
+	expected = append(expected, 1)
+	expected = append(expected, common32(1)...)
+	expected = binary.LittleEndian.AppendUint64(expected, 77)
+	expected = append(expected, 32)
+	expected = append(expected, common32(101)...)
+	expected = append(expected, sender...)
+	expected = binary.LittleEndian.AppendUint64(expected, 750)
+	expected = binary.LittleEndian.AppendUint64(expected, 3000000)
+	expected = append(expected, 0)
+	assert.Equal(t, expected, txData)
+
+	signingDigest := blake2b.Sum256(append([]byte{0, 0, 0}, txData...))
+	require.Len(t, unsigned.SigningHashes, 1)
+	assert.Equal(t, signingDigest[:], unsigned.SigningHashes[0])
+	txDigest := blake2b.Sum256(append([]byte("TransactionData::"), txData...))
+	assert.Equal(t, base58.Encode(txDigest[:]), unsigned.Hash)
+
+	signature := ed25519.Sign(privKey, unsigned.SigningHashes[0])
+	signed, err := adapter.AssembleSignedTx(unsigned.Raw, signature, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, unsigned.Hash, signed.Hash)
+
+	senderSigned, err := base64.StdEncoding.DecodeString(signed.Raw)
+	require.NoError(t, err)
+	expectedSigned := append([]byte{1, 0, 0, 0}, txData...)
+	expectedSigned = append(expectedSigned, 1, 97, 0)
+	expectedSigned = append(append(expectedSigned, signature...), pubKey...)
+	assert.Equal(t, expectedSigned, senderSigned)
+
+	parsedTx, parsedSig, err := parseSuiSignedTransaction(senderSigned)
+	require.NoError(t, err)
+	assert.Equal(t, txData, parsedTx)
+	assert.Equal(t, expectedSigned[len(expectedSigned)-97:], parsedSig)
+
+	// 签名与交易不匹配或公钥不是 gas owner 时拒绝组装
+	_, err = adapter.AssembleSignedTx(unsigned.Raw, ed25519.Sign(privKey, txData), pubKey)
+	assert.Error(t, err)
+	otherKey := ed25519.NewKeyFromSeed(common32(7))
+	_, err = adapter.AssembleSignedTx(unsigned.Raw, ed25519.Sign(otherKey, unsigned.SigningHashes[0]), otherKey.Public().(ed25519.PublicKey))
+	assert.Error(t, err)
+}
+
+func TestSuiBuildTransactionValidation(t *testing.T) {
+	from, err := SuiAddressFromPublicKey(zeroSeedEd25519Key().Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+	gas := []SuiObjectRef{suiGasCoin(1, 1)}
+
+	tx, err := NewSuiAdapter().BuildTransaction(&BuildTxRequest{From: from, To: suiReceiver, Amount: big.NewInt(1), SuiGasPayment: gas, SuiGasPrice: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, "10000000", tx.Fee.String())
+
+	tests := []struct {
+		name string
+		req  *BuildTxRequest
+	}{
+		{"nil request", nil},
+		{"zero amount", &BuildTxRequest{From: from, To: suiReceiver, Amount: big.NewInt(0), SuiGasPayment: gas, SuiGasPrice: 1000}},
+		{"missing gas price", &BuildTxRequest{From: from, To: suiReceiver, Amount: big.NewInt(1), SuiGasPayment: gas}},
+		{"missing gas payment", &BuildTxRequest{From: from, To: suiReceiver, Amount: big.NewInt(1), SuiGasPrice: 1000}},
+		{"invalid gas digest", &BuildTxRequest{From: from, To: suiReceiver, Amount: big.NewInt(1), SuiGasPrice: 1000,
+			SuiGasPayment: []SuiObjectRef{{ObjectID: "0x1", Version: 1, Digest: "abc"}}}},
+		{"invalid to", &BuildTxRequest{From: from, To: "bob", Amount: big.NewInt(1), SuiGasPayment: gas, SuiGasPrice: 1000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSuiAdapter().BuildTransaction(tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSuiJSONRPC(t *testing.T) {
+	privKey := zeroSeedEd25519Key()
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	from, err := SuiAddressFromPublicKey(pubKey)
+	require.NoError(t, err)
+	owner, _ := ParseSuiAddress(from)
+
+	coins := []SuiObjectRef{suiGasCoin(1, 10), suiGasCoin(2, 11), suiGasCoin(3, 12)}
+	balances := []string{"400", "3000000", "10000000"}
+	var executed []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req sui.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result interface{}
+		switch req.Method {
+		case "suix_getBalance":
+			assert.Equal(t, []interface{}{from, sui.SUICoinType}, req.Params)
+			result = map[string]string{"coinType": sui.SUICoinType, "totalBalance": "13000400"}
+		case "suix_getReferenceGasPrice":
+			result = "750"
+		case "suix_getCoins":
+			// 每页一个 Coin 对象
+			page := 0
+			if req.Params[2] != nil {
+				page = int(req.Params[2].(string)[0] - '0')
+			}
+			next := string(rune('0' + page + 1))
+			result = map[string]interface{}{
+				"data": []map[string]string{{"coinObjectId": coins[page].ObjectID, "version": strconv.FormatUint(coins[page].Version, 10),
+					"digest": coins[page].Digest, "balance": balances[page]}},
+				"nextCursor": next, "hasNextPage": page < len(coins)-1,
+			}
+		case "sui_executeTransactionBlock":
+			executed = req.Params
+			result = map[string]interface{}{"digest": "D1g3st", "effects": map[string]interface{}{"status": map[string]string{"status": "success"}}}
+		default:
+			t.Fatalf("unexpected method %s", req.Method)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	adapter := NewSuiAdapter(server.URL)
+
+	balance, err := adapter.GetBalance(ctx, from)
+	require.NoError(t, err)
+	assert.Equal(t, "13000400", balance.String())
+
+	// 需要 2500000 + 10000000（默认预算），读取全部三页后选择余额最大的两个对象
+	unsigned, err := adapter.BuildTransactionWithGasCoins(ctx, &BuildTxRequest{From: from, To: suiReceiver, Amount: big.NewInt(2500000)})
+	require.NoError(t, err)
+	txData, _ := base64.StdEncoding.DecodeString(unsigned.Raw)
+	assert.Contains(t, string(txData), string(append(append([]byte{2}, common32(3)...), binary.LittleEndian.AppendUint64(nil, 12)...)))
+	assert.Contains(t, string(txData), string(append(append(common32(102), owner...), binary.LittleEndian.AppendUint64(nil, 750)...)))
+
+	signature := ed25519.Sign(privKey, unsigned.SigningHashes[0])
+	signed, err := adapter.AssembleSignedTx(unsigned.Raw, signature, pubKey)
+	require.NoError(t, err)
+	digest, err := adapter.BroadcastTransaction(ctx, signed.Raw)
+	require.NoError(t, err)
+	assert.Equal(t, "D1g3st", digest)
+	require.Len(t, executed, 3)
+	assert.Equal(t, unsigned.Raw, executed[0])
+	serialized := append(append([]byte{0}, signature...), pubKey...)
+	assert.Equal(t, []interface{}{base64.StdEncoding.EncodeToString(serialized)}, executed[1])
+
+	_, err = adapter.BuildTransactionWithGasCoins(ctx, &BuildTxRequest{From: from, To: suiReceiver, Amount: big.NewInt(5000000)})
+	assert.ErrorContains(t, err, "insufficient SUI balance")
+}
@@ -0,0 +1,306 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/SafeMPC/mpc-service/internal/mpc/chain/tron"
+)
+
+const (
+	// tronDefaultExpiration 未指定有效期时交易从引用区块时间起的有效期，需要覆盖 MPC 签名耗时
+	tronDefaultExpiration = 10 * time.Minute
+	// tronMaxExpiration 节点接受的最长有效期
+	tronMaxExpiration = 24 * time.Hour
+	// tronSignatureRecoveryOffset 签名末字节为恢复 ID + 27
+	tronSignatureRecoveryOffset = 27
+)
+
+// Tron 合约类型（protocol.Transaction.Contract.ContractType）
+const (
+	tronTransferContract        = 1
+	tronTriggerSmartContract    = 31
+	tronTransferContractURL     = "type.googleapis.com/protocol.TransferContract"
+	tronTriggerSmartContractURL = "type.googleapis.com/protocol.TriggerSmartContract"
+)
+
+// TronBlockRef 交易引用的区块（TaPoS），节点只接受引用最近 65536 个区块内的交易
+type TronBlockRef struct {
+	Number    uint64
+	Hash      []byte // 32 字节区块哈希
+	Timestamp int64  // 毫秒
+}
+
+// TronAdapter 实现 Tron 链基础能力，交易通过全节点 HTTP API 获取引用区块和广播
+type TronAdapter struct {
+	rpcClient *tron.RPCClient
+}
+
+// NewTronAdapter 创建 Tron 适配器，配置多个全节点 HTTP API 端点时按顺序故障转移
+func NewTronAdapter(rpcEndpoints ...string) *TronAdapter {
+	adapter := &TronAdapter{}
+	if endpoints := nonEmptyEndpoints(rpcEndpoints); len(endpoints) > 0 {
+		adapter.rpcClient = tron.NewRPCClient(endpoints...)
+	}
+	return adapter
+}
+
+// GenerateAddress 根据 secp256k1 公钥生成 T 开头的 Base58Check 地址
+func (a *TronAdapter) GenerateAddress(pubKey []byte) (string, error) {
+	return TronAddressFromPublicKey(pubKey)
+}
+
+// GetBalance 查询 TRX 余额（sun）
+func (a *TronAdapter) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	return a.rpcClient.GetBalance(ctx, address)
+}
+
+// GetTokenBalance 查询地址的 TRC-20 代币余额（最小单位）
+func (a *TronAdapter) GetTokenBalance(ctx context.Context, token, owner string) (*big.Int, error) {
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+	if _, err := ParseTronAddress(token); err != nil {
+		return nil, errors.Wrap(err, "invalid token address")
+	}
+	ownerAddress, err := ParseTronAddress(owner)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid owner address")
+	}
+
+	parameter := hex.EncodeToString(common.LeftPadBytes(ownerAddress[1:], 32))
+	output, err := a.rpcClient.TriggerConstantContract(ctx, owner, token, "balanceOf(address)", parameter)
+	if err != nil {
+		return nil, err
+	}
+	if len(output) != 32 {
+		return nil, errors.Errorf("token %s returned invalid balance", token)
+	}
+	return new(big.Int).SetBytes(output), nil
+}
+
+// BroadcastTransaction 广播 AssembleSignedTx 返回的已签名交易，返回交易 ID
+func (a *TronAdapter) BroadcastTransaction(ctx context.Context, rawTx string) (string, error) {
+	if a.rpcClient == nil {
+		return "", errors.New("RPC client not configured")
+	}
+	return a.rpcClient.BroadcastHex(ctx, rawTx)
+}
+
+// BuildTransactionWithLatestBlock 以节点最新区块为引用区块构建交易
+func (a *TronAdapter) BuildTransactionWithLatestBlock(ctx context.Context, req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if a.rpcClient == nil {
+		return nil, errors.New("RPC client not configured")
+	}
+
+	block, err := a.rpcClient.GetNowBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest block")
+	}
+	hash, err := hex.DecodeString(block.ID)
+	if err != nil || len(hash) != 32 {
+		return nil, errors.Errorf("invalid block id %s", block.ID)
+	}
+
+	withBlock := *req
+	withBlock.TronRefBlock = &TronBlockRef{Number: block.Number, Hash: hash, Timestamp: block.Timestamp}
+	return a.BuildTransaction(&withBlock)
+}
+
+// BuildTransaction 构建 TRX 转账（TransferContract）或 TRC-20 转账（设置 TokenContract 时为 TriggerSmartContract）交易
+// 返回的 Raw 为 raw_data 的 protobuf 编码（十六进制），Hash 为交易 ID，即 SHA-256(raw_data)，
+// 也是 SigningHashes 中唯一需要 ECDSA 签名的摘要
+func (a *TronAdapter) BuildTransaction(req *BuildTxRequest) (*Transaction, error) {
+	if req == nil {
+		return nil, errors.New("build request is nil")
+	}
+	if req.Amount == nil || req.Amount.Sign() < 0 {
+		return nil, errors.New("amount must not be negative")
+	}
+	ref := req.TronRefBlock
+	if ref == nil {
+		return nil, errors.New("reference block is required")
+	}
+	if len(ref.Hash) != 32 {
+		return nil, errors.New("reference block hash must be 32 bytes")
+	}
+	expiration := req.TronExpiration
+	if expiration == 0 {
+		expiration = tronDefaultExpiration
+	}
+	if expiration < 0 || expiration > tronMaxExpiration {
+		return nil, errors.Errorf("expiration must be between 0 and %s", tronMaxExpiration)
+	}
+
+	owner, err := ParseTronAddress(req.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+	to, err := ParseTronAddress(req.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid to address")
+	}
+
+	var contract []byte
+	var fee *big.Int
+	if req.TokenContract != "" {
+		token, err := ParseTronAddress(req.TokenContract)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid token contract address")
+		}
+		if req.Amount.BitLen() > 256 {
+			return nil, errors.New("token amount exceeds uint256")
+		}
+		if req.TronFeeLimit == 0 || req.TronFeeLimit > math.MaxInt64 {
+			return nil, errors.New("fee limit is required for TRC-20 transfers")
+		}
+		// TRC-20 与 ERC-20 ABI 相同，地址参数为去掉 0x41 前缀的 20 字节
+		data := ERC20TransferData(common.BytesToAddress(to[1:]), req.Amount)
+		contract = tronContract(tronTriggerSmartContract, tronTriggerSmartContractURL, tronTriggerSmartContractValue(owner, token, data))
+		fee = new(big.Int).SetUint64(req.TronFeeLimit)
+	} else {
+		if req.Amount.Sign() == 0 || !req.Amount.IsInt64() {
+			return nil, errors.New("amount must be a positive 64-bit integer")
+		}
+		if bytes.Equal(owner, to) {
+			return nil, errors.New("cannot transfer TRX to the sending address")
+		}
+		contract = tronContract(tronTransferContract, tronTransferContractURL, tronTransferContractValue(owner, to, req.Amount.Int64()))
+	}
+
+	// raw_data 字段按字段号升序编码，与节点的 protobuf 序列化一致
+	refBlockBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(refBlockBytes, ref.Number)
+	var raw []byte
+	raw = protowire.AppendTag(raw, 1, protowire.BytesType) // ref_block_bytes：区块高度的低 2 字节
+	raw = protowire.AppendBytes(raw, refBlockBytes[6:8])
+	raw = protowire.AppendTag(raw, 4, protowire.BytesType) // ref_block_hash：区块哈希的第 8-16 字节
+	raw = protowire.AppendBytes(raw, ref.Hash[8:16])
+	raw = protowire.AppendTag(raw, 8, protowire.VarintType) // expiration
+	raw = protowire.AppendVarint(raw, uint64(ref.Timestamp+expiration.Milliseconds()))
+	if len(req.Data) > 0 {
+		raw = protowire.AppendTag(raw, 10, protowire.BytesType) // data：备注
+		raw = protowire.AppendBytes(raw, req.Data)
+	}
+	raw = protowire.AppendTag(raw, 11, protowire.BytesType) // contract
+	raw = protowire.AppendBytes(raw, contract)
+	raw = protowire.AppendTag(raw, 14, protowire.VarintType) // timestamp
+	raw = protowire.AppendVarint(raw, uint64(ref.Timestamp))
+	if req.TokenContract != "" {
+		raw = protowire.AppendTag(raw, 18, protowire.VarintType) // fee_limit
+		raw = protowire.AppendVarint(raw, req.TronFeeLimit)
+	}
+
+	txID := sha256.Sum256(raw)
+	return &Transaction{
+		Raw:           hex.EncodeToString(raw),
+		Hash:          hex.EncodeToString(txID[:]),
+		SigningHashes: [][]byte{txID[:]},
+		Fee:           fee, // TRC-20 为最大手续费，TRX 转账只消耗带宽
+	}, nil
+}
+
+// AssembleSignedTx 将 MPC 签名附加到 BuildTransaction 生成的 raw_data，返回可广播的 Transaction protobuf（十六进制）
+// signature 可以是 DER 编码、64 字节 r||s 或 65 字节 r||s||v；恢复 ID 通过与 pubKey 比对公钥恢复结果确定
+func (a *TronAdapter) AssembleSignedTx(rawTx string, signature []byte, pubKey []byte) (*Transaction, error) {
+	raw, err := hex.DecodeString(rawTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid raw transaction")
+	}
+	if len(raw) == 0 {
+		return nil, errors.New("raw transaction is empty")
+	}
+	txID := sha256.Sum256(raw)
+
+	publicKey, err := btcec.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	expected := publicKey.SerializeUncompressed()
+
+	if len(signature) == crypto.SignatureLength {
+		signature = signature[:64]
+	}
+	sig, err := parseECDSASignature(signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+	rs, err := compactSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	for v := byte(0); v < 2; v++ {
+		recovered, err := crypto.Ecrecover(txID[:], append(rs, v))
+		if err != nil || !bytes.Equal(recovered, expected) {
+			continue
+		}
+
+		var signed []byte
+		signed = protowire.AppendTag(signed, 1, protowire.BytesType) // raw_data
+		signed = protowire.AppendBytes(signed, raw)
+		signed = protowire.AppendTag(signed, 2, protowire.BytesType) // signature
+		signed = protowire.AppendBytes(signed, append(rs, v+tronSignatureRecoveryOffset))
+		return &Transaction{
+			Raw:  hex.EncodeToString(signed),
+			Hash: hex.EncodeToString(txID[:]),
+		}, nil
+	}
+
+	return nil, errors.New("signature does not match public key")
+}
+
+// tronContract 编码 Transaction.Contract，参数为 google.protobuf.Any
+func tronContract(contractType uint64, typeURL string, value []byte) []byte {
+	var parameter []byte
+	parameter = protowire.AppendTag(parameter, 1, protowire.BytesType) // type_url
+	parameter = protowire.AppendString(parameter, typeURL)
+	parameter = protowire.AppendTag(parameter, 2, protowire.BytesType) // value
+	parameter = protowire.AppendBytes(parameter, value)
+
+	var contract []byte
+	contract = protowire.AppendTag(contract, 1, protowire.VarintType) // type
+	contract = protowire.AppendVarint(contract, contractType)
+	contract = protowire.AppendTag(contract, 2, protowire.BytesType) // parameter
+	return protowire.AppendBytes(contract, parameter)
+}
+
+// tronTransferContractValue 编码 TransferContract
+func tronTransferContractValue(owner, to []byte, amount int64) []byte {
+	var value []byte
+	value = protowire.AppendTag(value, 1, protowire.BytesType) // owner_address
+	value = protowire.AppendBytes(value, owner)
+	value = protowire.AppendTag(value, 2, protowire.BytesType) // to_address
+	value = protowire.AppendBytes(value, to)
+	value = protowire.AppendTag(value, 3, protowire.VarintType) // amount
+	return protowire.AppendVarint(value, uint64(amount))
+}
+
+// tronTriggerSmartContractValue 编码不附带 TRX 的 TriggerSmartContract
+func tronTriggerSmartContractValue(owner, contract, data []byte) []byte {
+	var value []byte
+	value = protowire.AppendTag(value, 1, protowire.BytesType) // owner_address
+	value = protowire.AppendBytes(value, owner)
+	value = protowire.AppendTag(value, 2, protowire.BytesType) // contract_address
+	value = protowire.AppendBytes(value, contract)
+	value = protowire.AppendTag(value, 4, protowire.BytesType) // data
+	return protowire.AppendBytes(value, data)
+}
@@ -0,0 +1,202 @@
+package tron
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// RPCClient Tron 全节点 HTTP API 客户端（/wallet/*），配置多个端点时按顺序故障转移
+// 地址参数均使用 Base58Check 格式（visible=true）
+type RPCClient struct {
+	endpoints []string
+	current   atomic.Int32 // 最近一次调用成功的端点，下次调用优先使用
+	client    *http.Client
+}
+
+// NewRPCClient 创建 Tron 全节点客户端，端点为节点 HTTP API 根地址（如 https://api.trongrid.io）
+func NewRPCClient(endpoints ...string) *RPCClient {
+	return &RPCClient{
+		endpoints: endpoints,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// APIError 节点拒绝请求时返回的错误（如广播时的签名或余额错误）
+type APIError struct {
+	Code    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("tron API error: %s (code: %s)", e.Message, e.Code)
+}
+
+// newAPIError 节点返回的 message 通常是十六进制编码的文本，能解码时使用解码结果
+func newAPIError(code, message string) *APIError {
+	if decoded, err := hex.DecodeString(message); err == nil && utf8.Valid(decoded) {
+		message = string(decoded)
+	}
+	return &APIError{Code: code, Message: message}
+}
+
+// call 调用节点 HTTP API，端点不可用（网络错误或 5xx/429 响应）时依次尝试下一个端点，
+// 节点返回的 API 错误由调用方从响应中解析
+func (c *RPCClient) call(ctx context.Context, path string, params interface{}, result interface{}) error {
+	if len(c.endpoints) == 0 {
+		return errors.New("no RPC endpoint configured")
+	}
+
+	reqBody, err := json.Marshal(params)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request")
+	}
+
+	start := int(c.current.Load())
+	for i := 0; i < len(c.endpoints); i++ {
+		idx := (start + i) % len(c.endpoints)
+		err := c.callEndpoint(ctx, strings.TrimRight(c.endpoints[idx], "/")+path, reqBody, result)
+		if err == nil {
+			c.current.Store(int32(idx))
+			return nil
+		}
+
+		if ctx.Err() != nil || i == len(c.endpoints)-1 {
+			return err
+		}
+	}
+
+	return errors.New("no RPC endpoint available")
+}
+
+// callEndpoint 向单个端点发送请求
+func (c *RPCClient) callEndpoint(ctx context.Context, url string, reqBody []byte, result interface{}) error {
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to create HTTP request")
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute HTTP request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return errors.Errorf("RPC endpoint returned HTTP %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Wrap(err, "failed to decode response")
+	}
+	return nil
+}
+
+// Block 区块头中构建交易需要的字段
+type Block struct {
+	ID        string // 区块哈希（十六进制），前 8 字节为区块高度
+	Number    uint64
+	Timestamp int64 // 毫秒
+}
+
+// GetNowBlock 获取最新区块
+func (c *RPCClient) GetNowBlock(ctx context.Context) (*Block, error) {
+	var raw struct {
+		BlockID     string `json:"blockID"`
+		BlockHeader struct {
+			RawData struct {
+				Number    uint64 `json:"number"`
+				Timestamp int64  `json:"timestamp"`
+			} `json:"raw_data"`
+		} `json:"block_header"`
+	}
+	if err := c.call(ctx, "/wallet/getnowblock", struct{}{}, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to call getnowblock")
+	}
+	if raw.BlockID == "" {
+		return nil, errors.New("node returned an empty block")
+	}
+
+	return &Block{
+		ID:        raw.BlockID,
+		Number:    raw.BlockHeader.RawData.Number,
+		Timestamp: raw.BlockHeader.RawData.Timestamp,
+	}, nil
+}
+
+// GetBalance 查询 TRX 余额（sun），未激活的账户余额为 0
+func (c *RPCClient) GetBalance(ctx context.Context, address string) (*big.Int, error) {
+	var account struct {
+		Balance int64 `json:"balance"`
+	}
+	params := map[string]interface{}{"address": address, "visible": true}
+	if err := c.call(ctx, "/wallet/getaccount", params, &account); err != nil {
+		return nil, errors.Wrap(err, "failed to call getaccount")
+	}
+	return big.NewInt(account.Balance), nil
+}
+
+// TriggerConstantContract 只读调用合约，parameter 为 ABI 编码的参数（十六进制，不含函数选择器），返回第一个结果
+func (c *RPCClient) TriggerConstantContract(ctx context.Context, owner, contract, functionSelector, parameter string) ([]byte, error) {
+	var resp struct {
+		Result struct {
+			Result  bool   `json:"result"`
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"result"`
+		ConstantResult []string `json:"constant_result"`
+	}
+	params := map[string]interface{}{
+		"owner_address":     owner,
+		"contract_address":  contract,
+		"function_selector": functionSelector,
+		"parameter":         parameter,
+		"visible":           true,
+	}
+	if err := c.call(ctx, "/wallet/triggerconstantcontract", params, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to call triggerconstantcontract")
+	}
+	if !resp.Result.Result {
+		return nil, newAPIError(resp.Result.Code, resp.Result.Message)
+	}
+	if len(resp.ConstantResult) == 0 {
+		return nil, errors.New("contract call returned no result")
+	}
+
+	output, err := hex.DecodeString(resp.ConstantResult[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid contract call result")
+	}
+	return output, nil
+}
+
+// BroadcastHex 广播十六进制编码的已签名交易（Transaction protobuf），返回交易 ID
+func (c *RPCClient) BroadcastHex(ctx context.Context, rawTx string) (string, error) {
+	var resp struct {
+		Result  bool   `json:"result"`
+		TxID    string `json:"txid"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.call(ctx, "/wallet/broadcasthex", map[string]string{"transaction": rawTx}, &resp); err != nil {
+		return "", errors.Wrap(err, "failed to call broadcasthex")
+	}
+	if !resp.Result {
+		return "", newAPIError(resp.Code, resp.Message)
+	}
+	return resp.TxID, nil
+}
@@ -0,0 +1,56 @@
+package chain
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	// tronAddressPrefix Tron 主网和测试网地址的版本字节，Base58Check 编码后以 T 开头
+	tronAddressPrefix = 0x41
+	// tronAddressLength 版本字节 + 20 字节账户哈希
+	tronAddressLength = 21
+)
+
+// TronAddressFromPublicKey 由 secp256k1 公钥（压缩或非压缩）生成 Base58Check 编码的 Tron 地址
+// 账户哈希与以太坊相同：Keccak256(X||Y) 的后 20 字节
+func TronAddressFromPublicKey(pubKey []byte) (string, error) {
+	if len(pubKey) == 0 {
+		return "", errors.New("public key is required")
+	}
+	publicKey, err := btcec.ParsePubKey(pubKey)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid secp256k1 public key")
+	}
+
+	hash := crypto.Keccak256(publicKey.SerializeUncompressed()[1:])
+	return EncodeTronAddress(append([]byte{tronAddressPrefix}, hash[12:]...)), nil
+}
+
+// EncodeTronAddress 将 21 字节地址（0x41 前缀）编码为 Base58Check
+func EncodeTronAddress(address []byte) string {
+	return base58.CheckEncode(address[1:], address[0])
+}
+
+// ParseTronAddress 解析 Base58Check（T...）或十六进制（41...）格式的 Tron 地址，返回 21 字节地址
+func ParseTronAddress(address string) ([]byte, error) {
+	address = strings.TrimSpace(address)
+	if len(address) == 2*tronAddressLength {
+		decoded, err := hex.DecodeString(address)
+		if err != nil || decoded[0] != tronAddressPrefix {
+			return nil, errors.Errorf("invalid tron address %q", address)
+		}
+		return decoded, nil
+	}
+
+	payload, version, err := base58.CheckDecode(address)
+	if err != nil || version != tronAddressPrefix || len(payload) != tronAddressLength-1 {
+		return nil, errors.Errorf("invalid tron address %q", address)
+	}
+	return append([]byte{version}, payload...), nil
+}
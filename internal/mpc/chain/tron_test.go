@@ -0,0 +1,249 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	tronUSDT     = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	tronUSDTHex  = "41a614f803b6fd780986a42c78ec9c7f77e6ded13c"
+	tronReceiver = "T9yD14Nj9j7xAB4dbGeiX9h8unkKHxuWwb" // 全零地址
+)
+
+// decodeProto 解析一层 protobuf 消息，返回长度分隔字段和 varint 字段（重复字段取最后一个）
+func decodeProto(t *testing.T, b []byte) (map[protowire.Number][]byte, map[protowire.Number]uint64) {
+	t.Helper()
+	fields, varints := map[protowire.Number][]byte{}, map[protowire.Number]uint64{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		switch typ {
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			require.GreaterOrEqual(t, n, 0)
+			fields[num] = v
+			b = b[n:]
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			require.GreaterOrEqual(t, n, 0)
+			varints[num] = v
+			b = b[n:]
+		default:
+			t.Fatalf("unexpected wire type %d", typ)
+		}
+	}
+	return fields, varints
+}
+
+func TestTronAddress(t *testing.T) {
+	// 私钥 1 对应的以太坊地址为 0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf
+	privKey, _ := btcec.PrivKeyFromBytes(common32(1))
+	for _, pubKey := range [][]byte{privKey.PubKey().SerializeCompressed(), privKey.PubKey().SerializeUncompressed()} {
+		address, err := NewTronAdapter().GenerateAddress(pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC", address)
+	}
+
+	for _, address := range []string{tronUSDT, tronUSDTHex} {
+		parsed, err := ParseTronAddress(address)
+		require.NoError(t, err)
+		assert.Equal(t, tronUSDTHex, hex.EncodeToString(parsed))
+		assert.Equal(t, tronUSDT, EncodeTronAddress(parsed))
+	}
+
+	for _, invalid := range []string{"", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c",
+		"1BoatSLRHtKNngkdXEeobR76b53LETtpyT"} {
+		_, err := ParseTronAddress(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+// common32 32 字节大端整数
+func common32(n byte) []byte {
+	b := make([]byte, 32)
+	b[31] = n
+	return b
+}
+
+func tronRefBlock() *TronBlockRef {
+	hash, _ := hex.DecodeString("0000000003a2b1c4" + "1122334455667788" + "99aabbccddeeff00" + "0102030405060708")
+	return &TronBlockRef{Number: 0x03a2b1c4, Hash: hash, Timestamp: 1700000000000}
+}
+
+func TestTronBuildAndAssembleTransfer(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+	from, err := TronAddressFromPublicKey(pubKey)
+	require.NoError(t, err)
+
+	adapter := NewTronAdapter()
+	unsigned, err := adapter.BuildTransaction(&BuildTxRequest{
+		From: from, To: tronReceiver, Amount: big.NewInt(1500000), Data: []byte("invoice 42"), TronRefBlock: tronRefBlock(),
+	})
+	require.NoError(t, err)
+
+	raw, err := hex.DecodeString(unsigned.Raw)
+	require.NoError(t, err)
+	txID := sha256.Sum256(raw)
+	assert.Equal(t, hex.EncodeToString(txID[:]), unsigned.Hash)
+	require.Len(t, unsigned.SigningHashes, 1)
+	assert.Equal(t, txID[:], unsigned.SigningHashes[0])
+
+	fields, varints := decodeProto(t, raw)
+	assert.Equal(t, []byte{0xb1, 0xc4}, fields[1])
+	assert.Equal(t, "1122334455667788", hex.EncodeToString(fields[4]))
+	assert.Equal(t, uint64(1700000000000+600000), varints[8])
+	assert.Equal(t, uint64(1700000000000), varints[14])
+	assert.Equal(t, "invoice 42", string(fields[10]))
+
+	contract, contractVarints := decodeProto(t, fields[11])
+	assert.Equal(t, uint64(tronTransferContract), contractVarints[1])
+	parameter, _ := decodeProto(t, contract[2])
+	assert.Equal(t, tronTransferContractURL, string(parameter[1]))
+	transfer, transferVarints := decodeProto(t, parameter[2])
+	owner, _ := ParseTronAddress(from)
+	assert.Equal(t, owner, transfer[1])
+	assert.Equal(t, make([]byte, 20), transfer[2][1:])
+	assert.Equal(t, uint64(1500000), transferVarints[3])
+
+	// MPC 签名不包含恢复 ID
+	signed, err := adapter.AssembleSignedTx(unsigned.Raw, compactToRS(t, privKey, unsigned.SigningHashes[0]), pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, unsigned.Hash, signed.Hash)
+
+	signedRaw, err := hex.DecodeString(signed.Raw)
+	require.NoError(t, err)
+	tx, _ := decodeProto(t, signedRaw)
+	assert.Equal(t, raw, tx[1])
+	require.Len(t, tx[2], 65)
+	assert.Contains(t, []byte{27, 28}, tx[2][64])
+
+	recoverable := append(append([]byte(nil), tx[2][:64]...), tx[2][64]-27)
+	recovered, err := crypto.Ecrecover(txID[:], recoverable)
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey().SerializeUncompressed(), recovered)
+
+	other, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	_, err = adapter.AssembleSignedTx(unsigned.Raw, compactToRS(t, other, unsigned.SigningHashes[0]), pubKey)
+	assert.Error(t, err)
+}
+
+func TestTronBuildTRC20Transfer(t *testing.T) {
+	from := "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC"
+	unsigned, err := NewTronAdapter().BuildTransaction(&BuildTxRequest{
+		From: from, To: tronReceiver, Amount: big.NewInt(25000000), TokenContract: tronUSDT,
+		TronFeeLimit: 30000000, TronRefBlock: tronRefBlock(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "30000000", unsigned.Fee.String())
+
+	raw, err := hex.DecodeString(unsigned.Raw)
+	require.NoError(t, err)
+	fields, varints := decodeProto(t, raw)
+	assert.Equal(t, uint64(30000000), varints[18])
+	assert.NotContains(t, fields, protowire.Number(10))
+
+	contract, contractVarints := decodeProto(t, fields[11])
+	assert.Equal(t, uint64(tronTriggerSmartContract), contractVarints[1])
+	parameter, _ := decodeProto(t, contract[2])
+	assert.Equal(t, tronTriggerSmartContractURL, string(parameter[1]))
+	trigger, triggerVarints := decodeProto(t, parameter[2])
+	assert.Equal(t, tronUSDTHex, hex.EncodeToString(trigger[2]))
+	assert.NotContains(t, triggerVarints, protowire.Number(3)) // 不附带 TRX
+	assert.Equal(t, "a9059cbb"+
+		"0000000000000000000000000000000000000000000000000000000000000000"+
+		"00000000000000000000000000000000000000000000000000000000017d7840", hex.EncodeToString(trigger[4]))
+}
+
+func TestTronBuildTransactionValidation(t *testing.T) {
+	from := "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC"
+	ref := tronRefBlock()
+	tests := []struct {
+		name string
+		req  *BuildTxRequest
+	}{
+		{"nil request", nil},
+		{"missing reference block", &BuildTxRequest{From: from, To: tronReceiver, Amount: big.NewInt(1)}},
+		{"short block hash", &BuildTxRequest{From: from, To: tronReceiver, Amount: big.NewInt(1), TronRefBlock: &TronBlockRef{Hash: []byte{1}}}},
+		{"invalid from", &BuildTxRequest{From: "0x7e5f4552091a69125d5dfcb7b8c2659029395bdf", To: tronReceiver, Amount: big.NewInt(1), TronRefBlock: ref}},
+		{"invalid to", &BuildTxRequest{From: from, To: "T123", Amount: big.NewInt(1), TronRefBlock: ref}},
+		{"zero trx", &BuildTxRequest{From: from, To: tronReceiver, Amount: big.NewInt(0), TronRefBlock: ref}},
+		{"self transfer", &BuildTxRequest{From: from, To: from, Amount: big.NewInt(1), TronRefBlock: ref}},
+		{"expiration too long", &BuildTxRequest{From: from, To: tronReceiver, Amount: big.NewInt(1), TronRefBlock: ref, TronExpiration: 25 * 3600e9}},
+		{"trc20 without fee limit", &BuildTxRequest{From: from, To: tronReceiver, Amount: big.NewInt(1), TokenContract: tronUSDT, TronRefBlock: ref}},
+		{"invalid token contract", &BuildTxRequest{From: from, To: tronReceiver, Amount: big.NewInt(1), TokenContract: "T1", TronFeeLimit: 1, TronRefBlock: ref}},
+	}
+
+	adapter := NewTronAdapter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := adapter.BuildTransaction(tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTronNodeAPI(t *testing.T) {
+	const owner = "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC"
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+
+		switch r.URL.Path {
+		case "/wallet/getnowblock":
+			_, _ = w.Write([]byte(`{"blockID":"0000000003a2b1c4112233445566778899aabbccddeeff000102030405060708",
+				"block_header":{"raw_data":{"number":60993988,"timestamp":1700000000000}}}`))
+		case "/wallet/getaccount":
+			assert.Equal(t, owner, params["address"])
+			_, _ = w.Write([]byte(`{"address":"` + owner + `","balance":12345678}`))
+		case "/wallet/triggerconstantcontract":
+			assert.Equal(t, tronUSDT, params["contract_address"])
+			assert.Equal(t, "balanceOf(address)", params["function_selector"])
+			assert.Equal(t, "0000000000000000000000007e5f4552091a69125d5dfcb7b8c2659029395bdf", params["parameter"])
+			_, _ = w.Write([]byte(`{"result":{"result":true},"constant_result":["00000000000000000000000000000000000000000000000000000000017d7840"]}`))
+		case "/wallet/broadcasthex":
+			// 节点返回十六进制编码的错误信息
+			_, _ = w.Write([]byte(`{"result":false,"code":"SIGERROR","message":"` + hex.EncodeToString([]byte("validate signature error")) + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer node.Close()
+
+	adapter := NewTronAdapter(node.URL + "/")
+	ctx := context.Background()
+
+	balance, err := adapter.GetBalance(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, "12345678", balance.String())
+
+	tokenBalance, err := adapter.GetTokenBalance(ctx, tronUSDT, owner)
+	require.NoError(t, err)
+	assert.Equal(t, "25000000", tokenBalance.String())
+
+	unsigned, err := adapter.BuildTransactionWithLatestBlock(ctx, &BuildTxRequest{From: owner, To: tronReceiver, Amount: big.NewInt(1)})
+	require.NoError(t, err)
+	expected, err := adapter.BuildTransaction(&BuildTxRequest{From: owner, To: tronReceiver, Amount: big.NewInt(1), TronRefBlock: tronRefBlock()})
+	require.NoError(t, err)
+	assert.Equal(t, expected.Raw, unsigned.Raw)
+
+	_, err = adapter.BroadcastTransaction(ctx, "0a00")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "validate signature error")
+}
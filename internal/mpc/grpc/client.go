@@ -481,12 +481,74 @@ func (c *GRPCClient) CloseConnection(nodeID string) error {
 	return nil
 }
 
-// 注意：StartResharing 在 V2 架构中暂未实现
-// 密钥轮换功能将在后续版本中添加
-// 注意：StartResharing 在 V2 架构中暂未实现
-// 密钥轮换功能将在后续版本中添加
-func (c *GRPCClient) SendStartResharing(ctx context.Context, nodeID string, req interface{}) (interface{}, error) {
-	return nil, errors.New("StartResharing is not implemented in V2 architecture")
+// SendStartResharing 调用参与者的 StartReshare RPC
+// 旧节点集合与新节点集合中的每个 Signer 都需要收到该调用
+func (c *GRPCClient) SendStartResharing(ctx context.Context, nodeID string, req *pb.StartReshareRequest) (*pb.StartReshareResponse, error) {
+	log.Debug().
+		Str("node_id", nodeID).
+		Str("key_id", req.KeyId).
+		Str("session_id", req.SessionId).
+		Msg("Sending StartReshare RPC to participant")
+
+	client, err := c.getOrCreateSignerConnection(ctx, nodeID)
+	if err != nil {
+		log.Error().Err(err).Str("node_id", nodeID).Msg("Failed to get gRPC connection")
+		return nil, errors.Wrapf(err, "failed to get connection to node %s", nodeID)
+	}
+
+	resp, err := client.StartReshare(ctx, req)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("node_id", nodeID).
+			Str("key_id", req.KeyId).
+			Str("session_id", req.SessionId).
+			Msg("StartReshare RPC call failed")
+		return nil, err
+	}
+
+	log.Debug().
+		Str("node_id", nodeID).
+		Str("key_id", req.KeyId).
+		Str("session_id", req.SessionId).
+		Bool("started", resp.Started).
+		Str("message", resp.Message).
+		Msg("StartReshare RPC call succeeded")
+
+	return resp, nil
+}
+
+// SendDeleteShare 调用节点的 DeleteShare RPC，删除该节点持有的密钥分片
+func (c *GRPCClient) SendDeleteShare(ctx context.Context, nodeID string, req *pb.DeleteShareRequest) (*pb.DeleteShareResponse, error) {
+	log.Debug().
+		Str("node_id", nodeID).
+		Str("key_id", req.KeyId).
+		Msg("Sending DeleteShare RPC to participant")
+
+	client, err := c.getOrCreateSignerConnection(ctx, nodeID)
+	if err != nil {
+		log.Error().Err(err).Str("node_id", nodeID).Msg("Failed to get gRPC connection")
+		return nil, errors.Wrapf(err, "failed to get connection to node %s", nodeID)
+	}
+
+	resp, err := client.DeleteShare(ctx, req)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("node_id", nodeID).
+			Str("key_id", req.KeyId).
+			Msg("DeleteShare RPC call failed")
+		return nil, err
+	}
+
+	log.Debug().
+		Str("node_id", nodeID).
+		Str("key_id", req.KeyId).
+		Bool("deleted", resp.Deleted).
+		Str("message", resp.Message).
+		Msg("DeleteShare RPC call succeeded")
+
+	return resp, nil
 }
 
 // Close 关闭所有连接
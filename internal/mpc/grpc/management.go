@@ -96,6 +96,11 @@ func (s *ManagementServer) ReportResult(ctx context.Context, req *pb.ReportResul
 			log.Error().Err(err).Str("session_id", req.SessionId).Msg("Failed to complete keygen session")
 			return nil, err
 		}
+	case "RESHARE_PUBKEY":
+		if err := s.sessions.CompleteReshareSession(ctx, req.SessionId, req.NodeId, req.Data); err != nil {
+			log.Error().Err(err).Str("session_id", req.SessionId).Msg("Failed to complete reshare session")
+			return nil, err
+		}
 	default:
 		if err := s.sessions.CompleteSession(ctx, req.SessionId, req.Data); err != nil {
 			log.Error().Err(err).Str("session_id", req.SessionId).Msg("Failed to complete signing session")
@@ -10,6 +10,9 @@ import (
 	"github.com/SafeMPC/mpc-service/internal/config"
 )
 
+// testAuditSigningKey is the base64 encoded HMAC key used to sign audit entries in test servers.
+const testAuditSigningKey = "dGVzdC1hdWRpdC1zaWduaW5nLWtleS0wMTIzNDU2Nzg5" // "test-audit-signing-key-0123456789"
+
 // WithTestServer returns a fully configured server (using the default server config).
 func WithTestServer(t *testing.T, closure func(s *api.Server)) {
 	t.Helper()
@@ -70,6 +73,11 @@ func execClosureNewTestServer(ctx context.Context, t *testing.T, config config.S
 	config.Push.UseFCMProvider = false
 	config.Push.UseMockProvider = true
 
+	// audit requires a signing key, use a fixed one unless the test brings its own
+	if config.MPC.EnableAudit && config.MPC.AuditSigningKey == "" {
+		config.MPC.AuditSigningKey = testAuditSigningKey
+	}
+
 	s, err := api.InitNewServerWithDB(config, db, t)
 	if err != nil {
 		t.Fatalf("Failed to initialize server: %v", err)
@@ -88,7 +88,7 @@ var getUserInfoResponseScopesItemsEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["app","cms"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["app","cms","audit"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -20,16 +20,20 @@ import (
 // swagger:model postCreateWalletPayload
 type PostCreateWalletPayload struct {
 
+	// Bitcoin 地址类型（仅 chain_type 为 bitcoin 时生效，默认使用 MPC_BITCOIN_ADDRESS_TYPE）；p2tr 需要 Schnorr 算法，其余需要 ECDSA，转账接口不能花费 p2pkh 钱包的资金
+	// Example: p2wpkh
+	// Enum: [p2pkh p2wpkh p2sh-p2wpkh p2tr]
+	AddressType string `json:"address_type,omitempty"`
+
 	// 签名算法
 	// Example: ECDSA
 	// Required: true
 	// Enum: [ECDSA EdDSA]
 	Algorithm *string `json:"algorithm"`
 
-	// 区块链类型
+	// 链注册表中的链名称或别名（如 ethereum、sepolia、bitcoin、solana）
 	// Example: ethereum
 	// Required: true
-	// Enum: [ethereum bitcoin solana polkadot]
 	ChainType *string `json:"chain_type"`
 
 	// 椭圆曲线
@@ -50,6 +54,10 @@ type PostCreateWalletPayload struct {
 func (m *PostCreateWalletPayload) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAddressType(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateAlgorithm(formats); err != nil {
 		res = append(res, err)
 	}
@@ -72,92 +80,100 @@ func (m *PostCreateWalletPayload) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-var postCreateWalletPayloadTypeAlgorithmPropEnum []interface{}
+var postCreateWalletPayloadTypeAddressTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ECDSA","EdDSA"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["p2pkh","p2wpkh","p2sh-p2wpkh","p2tr"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
-		postCreateWalletPayloadTypeAlgorithmPropEnum = append(postCreateWalletPayloadTypeAlgorithmPropEnum, v)
+		postCreateWalletPayloadTypeAddressTypePropEnum = append(postCreateWalletPayloadTypeAddressTypePropEnum, v)
 	}
 }
 
 const (
 
-	// PostCreateWalletPayloadAlgorithmECDSA captures enum value "ECDSA"
-	PostCreateWalletPayloadAlgorithmECDSA string = "ECDSA"
+	// PostCreateWalletPayloadAddressTypeP2pkh captures enum value "p2pkh"
+	PostCreateWalletPayloadAddressTypeP2pkh string = "p2pkh"
 
-	// PostCreateWalletPayloadAlgorithmEdDSA captures enum value "EdDSA"
-	PostCreateWalletPayloadAlgorithmEdDSA string = "EdDSA"
+	// PostCreateWalletPayloadAddressTypeP2wpkh captures enum value "p2wpkh"
+	PostCreateWalletPayloadAddressTypeP2wpkh string = "p2wpkh"
+
+	// PostCreateWalletPayloadAddressTypeP2shDashP2wpkh captures enum value "p2sh-p2wpkh"
+	PostCreateWalletPayloadAddressTypeP2shDashP2wpkh string = "p2sh-p2wpkh"
+
+	// PostCreateWalletPayloadAddressTypeP2tr captures enum value "p2tr"
+	PostCreateWalletPayloadAddressTypeP2tr string = "p2tr"
 )
 
 // prop value enum
-func (m *PostCreateWalletPayload) validateAlgorithmEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, postCreateWalletPayloadTypeAlgorithmPropEnum, true); err != nil {
+func (m *PostCreateWalletPayload) validateAddressTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postCreateWalletPayloadTypeAddressTypePropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (m *PostCreateWalletPayload) validateAlgorithm(formats strfmt.Registry) error {
-
-	if err := validate.Required("algorithm", "body", m.Algorithm); err != nil {
-		return err
+func (m *PostCreateWalletPayload) validateAddressType(formats strfmt.Registry) error {
+	if swag.IsZero(m.AddressType) { // not required
+		return nil
 	}
 
 	// value enum
-	if err := m.validateAlgorithmEnum("algorithm", "body", *m.Algorithm); err != nil {
+	if err := m.validateAddressTypeEnum("address_type", "body", m.AddressType); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var postCreateWalletPayloadTypeChainTypePropEnum []interface{}
+var postCreateWalletPayloadTypeAlgorithmPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ethereum","bitcoin","solana","polkadot"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ECDSA","EdDSA"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
-		postCreateWalletPayloadTypeChainTypePropEnum = append(postCreateWalletPayloadTypeChainTypePropEnum, v)
+		postCreateWalletPayloadTypeAlgorithmPropEnum = append(postCreateWalletPayloadTypeAlgorithmPropEnum, v)
 	}
 }
 
 const (
 
-	// PostCreateWalletPayloadChainTypeEthereum captures enum value "ethereum"
-	PostCreateWalletPayloadChainTypeEthereum string = "ethereum"
-
-	// PostCreateWalletPayloadChainTypeBitcoin captures enum value "bitcoin"
-	PostCreateWalletPayloadChainTypeBitcoin string = "bitcoin"
-
-	// PostCreateWalletPayloadChainTypeSolana captures enum value "solana"
-	PostCreateWalletPayloadChainTypeSolana string = "solana"
+	// PostCreateWalletPayloadAlgorithmECDSA captures enum value "ECDSA"
+	PostCreateWalletPayloadAlgorithmECDSA string = "ECDSA"
 
-	// PostCreateWalletPayloadChainTypePolkadot captures enum value "polkadot"
-	PostCreateWalletPayloadChainTypePolkadot string = "polkadot"
+	// PostCreateWalletPayloadAlgorithmEdDSA captures enum value "EdDSA"
+	PostCreateWalletPayloadAlgorithmEdDSA string = "EdDSA"
 )
 
 // prop value enum
-func (m *PostCreateWalletPayload) validateChainTypeEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, postCreateWalletPayloadTypeChainTypePropEnum, true); err != nil {
+func (m *PostCreateWalletPayload) validateAlgorithmEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postCreateWalletPayloadTypeAlgorithmPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (m *PostCreateWalletPayload) validateChainType(formats strfmt.Registry) error {
+func (m *PostCreateWalletPayload) validateAlgorithm(formats strfmt.Registry) error {
 
-	if err := validate.Required("chain_type", "body", m.ChainType); err != nil {
+	if err := validate.Required("algorithm", "body", m.Algorithm); err != nil {
 		return err
 	}
 
 	// value enum
-	if err := m.validateChainTypeEnum("chain_type", "body", *m.ChainType); err != nil {
+	if err := m.validateAlgorithmEnum("algorithm", "body", *m.Algorithm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostCreateWalletPayload) validateChainType(formats strfmt.Registry) error {
+
+	if err := validate.Required("chain_type", "body", m.ChainType); err != nil {
 		return err
 	}
 
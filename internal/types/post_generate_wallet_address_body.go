@@ -23,7 +23,7 @@ type PostGenerateWalletAddressBody struct {
 	// 区块链类型
 	// Example: ethereum
 	// Required: true
-	// Enum: [ethereum bitcoin solana polkadot]
+	// Enum: [ethereum bitcoin solana polkadot tron cosmos aptos sui]
 	ChainType *string `json:"chain_type"`
 
 	// BIP44 派生路径（可选）
@@ -49,7 +49,7 @@ var postGenerateWalletAddressBodyTypeChainTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ethereum","bitcoin","solana","polkadot"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ethereum","bitcoin","solana","polkadot","tron","cosmos","aptos","sui"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -70,6 +70,18 @@ const (
 
 	// PostGenerateWalletAddressBodyChainTypePolkadot captures enum value "polkadot"
 	PostGenerateWalletAddressBodyChainTypePolkadot string = "polkadot"
+
+	// PostGenerateWalletAddressBodyChainTypeTron captures enum value "tron"
+	PostGenerateWalletAddressBodyChainTypeTron string = "tron"
+
+	// PostGenerateWalletAddressBodyChainTypeCosmos captures enum value "cosmos"
+	PostGenerateWalletAddressBodyChainTypeCosmos string = "cosmos"
+
+	// PostGenerateWalletAddressBodyChainTypeAptos captures enum value "aptos"
+	PostGenerateWalletAddressBodyChainTypeAptos string = "aptos"
+
+	// PostGenerateWalletAddressBodyChainTypeSui captures enum value "sui"
+	PostGenerateWalletAddressBodyChainTypeSui string = "sui"
 )
 
 // prop value enum
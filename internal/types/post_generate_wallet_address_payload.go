@@ -23,7 +23,7 @@ type PostGenerateWalletAddressPayload struct {
 	// 区块链类型
 	// Example: ethereum
 	// Required: true
-	// Enum: [ethereum bitcoin solana polkadot]
+	// Enum: [ethereum bitcoin solana polkadot tron cosmos aptos sui]
 	ChainType *string `json:"chain_type"`
 
 	// BIP44 派生路径（可选）
@@ -49,7 +49,7 @@ var postGenerateWalletAddressPayloadTypeChainTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ethereum","bitcoin","solana","polkadot"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ethereum","bitcoin","solana","polkadot","tron","cosmos","aptos","sui"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -70,6 +70,18 @@ const (
 
 	// PostGenerateWalletAddressPayloadChainTypePolkadot captures enum value "polkadot"
 	PostGenerateWalletAddressPayloadChainTypePolkadot string = "polkadot"
+
+	// PostGenerateWalletAddressPayloadChainTypeTron captures enum value "tron"
+	PostGenerateWalletAddressPayloadChainTypeTron string = "tron"
+
+	// PostGenerateWalletAddressPayloadChainTypeCosmos captures enum value "cosmos"
+	PostGenerateWalletAddressPayloadChainTypeCosmos string = "cosmos"
+
+	// PostGenerateWalletAddressPayloadChainTypeAptos captures enum value "aptos"
+	PostGenerateWalletAddressPayloadChainTypeAptos string = "aptos"
+
+	// PostGenerateWalletAddressPayloadChainTypeSui captures enum value "sui"
+	PostGenerateWalletAddressPayloadChainTypeSui string = "sui"
 )
 
 // prop value enum
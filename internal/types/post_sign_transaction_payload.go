@@ -23,7 +23,7 @@ type PostSignTransactionPayload struct {
 	// chain type
 	// Example: ethereum
 	// Required: true
-	// Enum: [ethereum bitcoin solana]
+	// Enum: [ethereum bitcoin solana tron cosmos aptos sui]
 	ChainType *string `json:"chain_type"`
 
 	// 派生路径（可选）
@@ -65,7 +65,7 @@ var postSignTransactionPayloadTypeChainTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ethereum","bitcoin","solana"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ethereum","bitcoin","solana","tron","cosmos","aptos","sui"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -83,6 +83,18 @@ const (
 
 	// PostSignTransactionPayloadChainTypeSolana captures enum value "solana"
 	PostSignTransactionPayloadChainTypeSolana string = "solana"
+
+	// PostSignTransactionPayloadChainTypeTron captures enum value "tron"
+	PostSignTransactionPayloadChainTypeTron string = "tron"
+
+	// PostSignTransactionPayloadChainTypeCosmos captures enum value "cosmos"
+	PostSignTransactionPayloadChainTypeCosmos string = "cosmos"
+
+	// PostSignTransactionPayloadChainTypeAptos captures enum value "aptos"
+	PostSignTransactionPayloadChainTypeAptos string = "aptos"
+
+	// PostSignTransactionPayloadChainTypeSui captures enum value "sui"
+	PostSignTransactionPayloadChainTypeSui string = "sui"
 )
 
 // prop value enum
@@ -39,6 +39,7 @@ func (o *SwaggerSpec) initHandlerCache() {
 	o.Handlers["DELETE"]["/api/v1/auth/account"] = true
 	o.Handlers["GET"]["/.well-known/assetlinks.json"] = true
 	o.Handlers["GET"]["/.well-known/apple-app-site-association"] = true
+	o.Handlers["GET"]["/api/v1/audit"] = true
 	o.Handlers["GET"]["/api/v1/auth/register"] = true
 	o.Handlers["GET"]["/-/healthy"] = true
 	o.Handlers["GET"]["/-/ready"] = true
@@ -54,14 +55,42 @@ func (o *SwaggerSpec) initHandlerCache() {
 	o.Handlers["POST"]["/api/v1/auth/refresh"] = true
 	o.Handlers["POST"]["/api/v1/auth/register"] = true
 	o.Handlers["PUT"]["/api/v1/push/token"] = true
+	o.Handlers["GET"]["/v1/chains/{chain}/fees"] = true
 	o.Handlers["GET"]["/v1/sessions/{sessionId}"] = true
 	o.Handlers["GET"]["/v1/wallets/{walletId}"] = true
 	o.Handlers["GET"]["/v1/wallets/{walletId}/balance"] = true
+	o.Handlers["GET"]["/v1/wallets/{walletId}/invitations"] = true
+	o.Handlers["GET"]["/v1/wallets/{walletId}/member-changes"] = true
+	o.Handlers["GET"]["/v1/wallets/{walletId}/members"] = true
+	o.Handlers["GET"]["/v1/wallets/{walletId}/policy"] = true
+	o.Handlers["GET"]["/v1/wallets/{walletId}/refresh-history"] = true
+	o.Handlers["GET"]["/v1/wallets/{walletId}/share-deletions"] = true
+	o.Handlers["GET"]["/v1/wallets/{walletId}/sign-requests"] = true
+	o.Handlers["GET"]["/v1/wallets/{walletId}/sign-requests/{requestId}"] = true
 	o.Handlers["GET"]["/v1/wallets/{walletId}/transactions"] = true
 	o.Handlers["GET"]["/v1/wallets"] = true
+	o.Handlers["POST"]["/v1/wallet-invitations/{token}/accept"] = true
 	o.Handlers["POST"]["/v1/wallets"] = true
 	o.Handlers["POST"]["/v1/wallets/{walletId}/addresses"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/cancel-deletion"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/disable"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/enable"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/invitations"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/member-changes/{changeId}/approve"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/member-changes/{changeId}/reject"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/members"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/reshare"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/schedule-deletion"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/share-deletions/{nodeId}/confirm"] = true
 	o.Handlers["POST"]["/v1/wallets/{walletId}/sign"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/sign-requests/{requestId}/approve"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/sign-requests/{requestId}/reject"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/sign/challenge"] = true
+	o.Handlers["POST"]["/v1/wallets/{walletId}/transfers"] = true
+	o.Handlers["PUT"]["/v1/wallets/{walletId}/members/{credentialId}"] = true
+	o.Handlers["PUT"]["/v1/wallets/{walletId}/policy"] = true
+	o.Handlers["DELETE"]["/v1/wallets/{walletId}/invitations/{invitationId}"] = true
+	o.Handlers["DELETE"]["/v1/wallets/{walletId}/members/{credentialId}"] = true
 	o.Handlers["POST"]["/v1/auth/webauthn/login/begin"] = true
 	o.Handlers["POST"]["/v1/auth/webauthn/login/finish"] = true
 	o.Handlers["POST"]["/v1/auth/webauthn/register/begin"] = true
@@ -20,16 +20,36 @@ import (
 // swagger:model transaction
 type Transaction struct {
 
+	// 原生币为链的原生代币符号，代币为合约地址或 Mint 地址
+	// Example: ETH
+	Asset string `json:"asset,omitempty"`
+
 	// block number
 	BlockNumber int64 `json:"block_number,omitempty"`
 
+	// confirmations
+	// Example: 12
+	Confirmations int64 `json:"confirmations,omitempty"`
+
+	// direction
+	// Example: outgoing
+	// Enum: [outgoing incoming]
+	Direction string `json:"direction,omitempty"`
+
+	// 交易失败或被丢弃的原因
+	Error string `json:"error,omitempty"`
+
 	// from
 	// Example: 0x...
 	From string `json:"from,omitempty"`
 
+	// 被相同 nonce 的交易替换时为替换交易的哈希（status 为 dropped）
+	// Example: 0x...
+	ReplacedBy string `json:"replaced_by,omitempty"`
+
 	// status
 	// Example: confirmed
-	// Enum: [pending confirmed failed]
+	// Enum: [pending confirmed failed dropped]
 	Status string `json:"status,omitempty"`
 
 	// timestamp
@@ -53,6 +73,10 @@ type Transaction struct {
 func (m *Transaction) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDirection(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -67,11 +91,53 @@ func (m *Transaction) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var transactionTypeDirectionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["outgoing","incoming"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		transactionTypeDirectionPropEnum = append(transactionTypeDirectionPropEnum, v)
+	}
+}
+
+const (
+
+	// TransactionDirectionOutgoing captures enum value "outgoing"
+	TransactionDirectionOutgoing string = "outgoing"
+
+	// TransactionDirectionIncoming captures enum value "incoming"
+	TransactionDirectionIncoming string = "incoming"
+)
+
+// prop value enum
+func (m *Transaction) validateDirectionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, transactionTypeDirectionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Transaction) validateDirection(formats strfmt.Registry) error {
+	if swag.IsZero(m.Direction) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateDirectionEnum("direction", "body", m.Direction); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var transactionTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["pending","confirmed","failed"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["pending","confirmed","failed","dropped"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -89,6 +155,9 @@ const (
 
 	// TransactionStatusFailed captures enum value "failed"
 	TransactionStatusFailed string = "failed"
+
+	// TransactionStatusDropped captures enum value "dropped"
+	TransactionStatusDropped string = "dropped"
 )
 
 // prop value enum
@@ -23,12 +23,8 @@ type TransactionsResponse struct {
 	// limit
 	Limit int64 `json:"limit,omitempty"`
 
-	// offset
-	Offset int64 `json:"offset,omitempty"`
-
-	// total
-	// Required: true
-	Total *int64 `json:"total"`
+	// 下一页游标，没有更多条目时为空
+	NextCursor string `json:"next_cursor,omitempty"`
 
 	// transactions
 	// Required: true
@@ -39,10 +35,6 @@ type TransactionsResponse struct {
 func (m *TransactionsResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateTotal(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if err := m.validateTransactions(formats); err != nil {
 		res = append(res, err)
 	}
@@ -53,15 +45,6 @@ func (m *TransactionsResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *TransactionsResponse) validateTotal(formats strfmt.Registry) error {
-
-	if err := validate.Required("total", "body", m.Total); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (m *TransactionsResponse) validateTransactions(formats strfmt.Registry) error {
 
 	if err := validate.Required("transactions", "body", m.Transactions); err != nil {
@@ -37,6 +37,10 @@ type WalletBalanceResponse struct {
 	// Example: ETH
 	// Required: true
 	Symbol *string `json:"symbol"`
+
+	// 代币合约或 Mint 地址，原生币为空
+	// Example: 0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48
+	TokenAddress string `json:"token_address,omitempty"`
 }
 
 // Validate validates this wallet balance response
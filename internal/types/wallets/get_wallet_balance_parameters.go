@@ -31,6 +31,10 @@ type GetWalletBalanceParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*代币符号或合约/Mint 地址，为空或 native 时查询原生币余额
+	  In: query
+	*/
+	Asset *string `query:"asset"`
 	/*区块链类型
 	  Required: true
 	  In: query
@@ -54,6 +58,11 @@ func (o *GetWalletBalanceParams) BindRequest(r *http.Request, route *middleware.
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAsset, qhkAsset, _ := qs.GetOK("asset")
+	if err := o.bindAsset(qAsset, qhkAsset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qChainType, qhkChainType, _ := qs.GetOK("chain_type")
 	if err := o.bindChainType(qChainType, qhkChainType, route.Formats); err != nil {
 		res = append(res, err)
@@ -73,6 +82,10 @@ func (o *GetWalletBalanceParams) BindRequest(r *http.Request, route *middleware.
 func (o *GetWalletBalanceParams) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	// asset
+	// Required: false
+	// AllowEmptyValue: false
+
 	// chain_type
 	// Required: true
 	// AllowEmptyValue: false
@@ -90,6 +103,24 @@ func (o *GetWalletBalanceParams) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindAsset binds and validates parameter Asset from query.
+func (o *GetWalletBalanceParams) bindAsset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Asset = &raw
+
+	return nil
+}
+
 // bindChainType binds and validates parameter ChainType from query.
 func (o *GetWalletBalanceParams) bindChainType(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
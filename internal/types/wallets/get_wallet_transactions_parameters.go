@@ -23,14 +23,11 @@ func NewGetWalletTransactionsParams() GetWalletTransactionsParams {
 	var (
 		// initialize parameters with default values
 
-		limitDefault  = int64(20)
-		offsetDefault = int64(0)
+		limitDefault = int64(20)
 	)
 
 	return GetWalletTransactionsParams{
 		Limit: &limitDefault,
-
-		Offset: &offsetDefault,
 	}
 }
 
@@ -43,21 +40,38 @@ type GetWalletTransactionsParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*资产过滤，native 或原生代币符号表示原生币，其他为代币合约或 Mint 地址
+	  In: query
+	*/
+	Asset *string `query:"asset"`
 	/*区块链类型
 	  Required: true
 	  In: query
 	*/
 	ChainType string `query:"chain_type"`
+	/*上一页响应中的 next_cursor
+	  In: query
+	*/
+	Cursor *string `query:"cursor"`
+	/*只返回转出或转入
+	  In: query
+	*/
+	Direction *string `query:"direction"`
+	/*只返回该时间及之后的条目
+	  In: query
+	*/
+	From *strfmt.DateTime `query:"from"`
 	/*每页数量
+	  Maximum: 100
+	  Minimum: 1
 	  In: query
 	  Default: 20
 	*/
 	Limit *int64 `query:"limit"`
-	/*偏移量
+	/*只返回该时间之前的条目
 	  In: query
-	  Default: 0
 	*/
-	Offset *int64 `query:"offset"`
+	To *strfmt.DateTime `query:"to"`
 	/*
 	  Required: true
 	  In: path
@@ -76,18 +90,38 @@ func (o *GetWalletTransactionsParams) BindRequest(r *http.Request, route *middle
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAsset, qhkAsset, _ := qs.GetOK("asset")
+	if err := o.bindAsset(qAsset, qhkAsset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qChainType, qhkChainType, _ := qs.GetOK("chain_type")
 	if err := o.bindChainType(qChainType, qhkChainType, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDirection, qhkDirection, _ := qs.GetOK("direction")
+	if err := o.bindDirection(qDirection, qhkDirection, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFrom, qhkFrom, _ := qs.GetOK("from")
+	if err := o.bindFrom(qFrom, qhkFrom, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
-	qOffset, qhkOffset, _ := qs.GetOK("offset")
-	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+	qTo, qhkTo, _ := qs.GetOK("to")
+	if err := o.bindTo(qTo, qhkTo, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -105,6 +139,10 @@ func (o *GetWalletTransactionsParams) BindRequest(r *http.Request, route *middle
 func (o *GetWalletTransactionsParams) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	// asset
+	// Required: false
+	// AllowEmptyValue: false
+
 	// chain_type
 	// Required: true
 	// AllowEmptyValue: false
@@ -112,14 +150,42 @@ func (o *GetWalletTransactionsParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	// cursor
+	// Required: false
+	// AllowEmptyValue: false
+
+	// direction
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateDirection(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// from
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateFrom(formats); err != nil {
+		res = append(res, err)
+	}
+
 	// limit
 	// Required: false
 	// AllowEmptyValue: false
 
-	// offset
+	if err := o.validateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// to
 	// Required: false
 	// AllowEmptyValue: false
 
+	if err := o.validateTo(formats); err != nil {
+		res = append(res, err)
+	}
+
 	// walletId
 	// Required: true
 	// Parameter is provided by construction from the route
@@ -130,6 +196,24 @@ func (o *GetWalletTransactionsParams) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindAsset binds and validates parameter Asset from query.
+func (o *GetWalletTransactionsParams) bindAsset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Asset = &raw
+
+	return nil
+}
+
 // bindChainType binds and validates parameter ChainType from query.
 func (o *GetWalletTransactionsParams) bindChainType(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
@@ -151,6 +235,97 @@ func (o *GetWalletTransactionsParams) bindChainType(rawData []string, hasKey boo
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetWalletTransactionsParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindDirection binds and validates parameter Direction from query.
+func (o *GetWalletTransactionsParams) bindDirection(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Direction = &raw
+
+	if err := o.validateDirection(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDirection carries on validations for parameter Direction
+func (o *GetWalletTransactionsParams) validateDirection(formats strfmt.Registry) error {
+	if o.Direction == nil {
+		return nil
+	}
+
+	if err := validate.EnumCase("direction", "query", *o.Direction, []interface{}{"outgoing", "incoming"}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindFrom binds and validates parameter From from query.
+func (o *GetWalletTransactionsParams) bindFrom(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("from", "query", "strfmt.DateTime", raw)
+	}
+	o.From = (value.(*strfmt.DateTime))
+
+	if err := o.validateFrom(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFrom carries on validations for parameter From
+func (o *GetWalletTransactionsParams) validateFrom(formats strfmt.Registry) error {
+	if o.From == nil {
+		return nil
+	}
+
+	if err := validate.FormatOf("from", "query", "date-time", o.From.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *GetWalletTransactionsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -171,11 +346,31 @@ func (o *GetWalletTransactionsParams) bindLimit(rawData []string, hasKey bool, f
 	}
 	o.Limit = &value
 
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// bindOffset binds and validates parameter Offset from query.
-func (o *GetWalletTransactionsParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+// validateLimit carries on validations for parameter Limit
+func (o *GetWalletTransactionsParams) validateLimit(formats strfmt.Registry) error {
+	if o.Limit == nil {
+		return nil
+	}
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 100, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindTo binds and validates parameter To from query.
+func (o *GetWalletTransactionsParams) bindTo(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
 	if len(rawData) > 0 {
 		raw = rawData[len(rawData)-1]
@@ -184,16 +379,32 @@ func (o *GetWalletTransactionsParams) bindOffset(rawData []string, hasKey bool,
 	// Required: false
 	// AllowEmptyValue: false
 	if raw == "" { // empty values pass all other validations
-		// Default values have been previously initialized by NewGetWalletTransactionsParams()
 		return nil
 	}
 
-	value, err := swag.ConvertInt64(raw)
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
 	if err != nil {
-		return errors.InvalidType("offset", "query", "int64", raw)
+		return errors.InvalidType("to", "query", "strfmt.DateTime", raw)
 	}
-	o.Offset = &value
+	o.To = (value.(*strfmt.DateTime))
 
+	if err := o.validateTo(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTo carries on validations for parameter To
+func (o *GetWalletTransactionsParams) validateTo(formats strfmt.Registry) error {
+	if o.To == nil {
+		return nil
+	}
+
+	if err := validate.FormatOf("to", "query", "date-time", o.To.String(), formats); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18,6 +18,8 @@ const (
 	CTXKeyAppPermissions contextKey = "app_permissions"
 	CTXKeyAppTenantID    contextKey = "app_tenant_id"
 	CTXKeyAppID          contextKey = "app_id"
+	CTXKeyClientIP       contextKey = "client_ip"
+	CTXKeyWalletMember   contextKey = "wallet_member"
 )
 
 //nolint:containedctx
@@ -52,6 +54,12 @@ func RequestIDFromContext(ctx context.Context) (string, error) {
 	return id, nil
 }
 
+// ClientIPFromContext returns the IP address of the (HTTP) client, returning an empty string if it is not present.
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(CTXKeyClientIP).(string)
+	return ip
+}
+
 // ShouldDisableLogger checks whether the logger instance should be disabled for the provided context.
 // `util.LogFromContext` will use this function to check whether it should return a default logger if
 // none has been set by our logging middleware before, or fall back to the disabled logger, suppressing
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- 分片定期刷新（Proactive Refresh）历史记录，每次尝试一行
+CREATE TABLE key_refresh_history (
+    id bigserial PRIMARY KEY,
+    key_id varchar(255) NOT NULL,
+    trigger varchar(50) NOT NULL,
+    attempt integer NOT NULL DEFAULT 1,
+    status varchar(50) NOT NULL,
+    error text,
+    started_at timestamptz NOT NULL DEFAULT NOW(),
+    completed_at timestamptz,
+    duration_ms integer,
+    FOREIGN KEY (key_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_key_refresh_history_key_id ON key_refresh_history (key_id);
+
+CREATE INDEX idx_key_refresh_history_status ON key_refresh_history (status);
+
+CREATE INDEX idx_key_refresh_history_started_at ON key_refresh_history (started_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS key_refresh_history;
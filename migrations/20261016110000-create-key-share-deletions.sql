@@ -0,0 +1,19 @@
+-- +migrate Up
+-- 密钥删除时各节点分片删除的确认记录，所有节点确认后密钥才进入 Deleted
+CREATE TABLE key_share_deletions (
+    key_id varchar(255) NOT NULL,
+    node_id varchar(255) NOT NULL,
+    status varchar(50) NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+    requested_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    confirmed_at timestamptz,
+    PRIMARY KEY (key_id, node_id),
+    FOREIGN KEY (key_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_key_share_deletions_status ON key_share_deletions (status);
+
+-- +migrate Down
+DROP TABLE IF EXISTS key_share_deletions;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- 协议会话 WAL：Coordinator 重启后据此判断进行中的会话能否恢复
+CREATE TABLE session_wal (
+    session_id varchar(255) NOT NULL,
+    sequence bigint NOT NULL,
+    round integer NOT NULL DEFAULT 0,
+    event_type varchar(50) NOT NULL,
+    payload bytea,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (session_id, sequence),
+    FOREIGN KEY (session_id) REFERENCES signing_sessions (session_id) ON DELETE CASCADE
+);
+
+-- 会话失败原因（例如 Coordinator 重启后无法恢复）
+ALTER TABLE signing_sessions
+    ADD COLUMN IF NOT EXISTS error_message text;
+
+-- +migrate Down
+ALTER TABLE signing_sessions
+    DROP COLUMN IF EXISTS error_message;
+
+DROP TABLE IF EXISTS session_wal;
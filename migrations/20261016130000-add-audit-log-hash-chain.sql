@@ -0,0 +1,13 @@
+-- +migrate Up
+-- 审计日志哈希链：每条记录保存上一条记录的哈希、自身哈希以及 Coordinator 签名（HMAC-SHA256 或 Ed25519），
+-- 删除或篡改任一记录都会导致链校验失败（app audit verify）
+ALTER TABLE audit_logs
+    ADD COLUMN IF NOT EXISTS prev_hash varchar(64),
+    ADD COLUMN IF NOT EXISTS entry_hash varchar(64),
+    ADD COLUMN IF NOT EXISTS signature text;
+
+-- +migrate Down
+ALTER TABLE audit_logs
+    DROP COLUMN IF EXISTS signature,
+    DROP COLUMN IF EXISTS entry_hash,
+    DROP COLUMN IF EXISTS prev_hash;
@@ -0,0 +1,33 @@
+-- +migrate Up
+-- 已广播的交易及其链上确认状态，由交易跟踪器定期轮询更新
+CREATE TABLE transactions (
+    id bigserial PRIMARY KEY,
+    wallet_id varchar(255) NOT NULL,
+    chain_type varchar(50) NOT NULL,
+    tx_hash varchar(255) NOT NULL,
+    from_address varchar(255) NOT NULL,
+    to_address varchar(255),
+    value varchar(100) NOT NULL DEFAULT '0',
+    nonce bigint,
+    raw_tx text NOT NULL,
+    status varchar(50) NOT NULL,
+    block_number bigint,
+    confirmations bigint NOT NULL DEFAULT 0,
+    replaced_by varchar(255),
+    error text,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    confirmed_at timestamptz,
+    UNIQUE (chain_type, tx_hash),
+    FOREIGN KEY (wallet_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_transactions_wallet_id ON transactions (wallet_id, chain_type, created_at);
+
+CREATE INDEX idx_transactions_status ON transactions (status);
+
+-- 检测相同 nonce 的替换交易
+CREATE INDEX idx_transactions_nonce ON transactions (chain_type, from_address, nonce);
+
+-- +migrate Down
+DROP TABLE IF EXISTS transactions;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- EVM 地址的 nonce 预留：并发签名时每笔交易使用不同的 nonce，签名失败释放的 nonce 会被优先复用
+CREATE TABLE nonce_reservations (
+    chain_type varchar(50) NOT NULL,
+    address varchar(255) NOT NULL,
+    nonce bigint NOT NULL,
+    status varchar(50) NOT NULL,
+    tx_hash varchar(255),
+    replaces_tx_hash varchar(255),
+    reserved_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chain_type, address, nonce)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS nonce_reservations;
@@ -0,0 +1,42 @@
+-- +migrate Up
+-- 转出交易记录资产（为空表示原生币，否则为代币合约或 Mint 地址）
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS asset varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS decimals smallint NOT NULL DEFAULT 0;
+
+-- 索引器扫描区块、日志或地址签名发现的转入记录，与 transactions 合并为钱包交易历史
+CREATE TABLE incoming_transfers (
+    id bigserial PRIMARY KEY,
+    wallet_id varchar(255) NOT NULL,
+    chain_type varchar(50) NOT NULL,
+    tx_hash varchar(255) NOT NULL,
+    log_index bigint NOT NULL DEFAULT -1,
+    asset varchar(255) NOT NULL DEFAULT '',
+    decimals smallint NOT NULL DEFAULT 0,
+    from_address varchar(255) NOT NULL,
+    to_address varchar(255) NOT NULL,
+    value varchar(100) NOT NULL,
+    block_number bigint NOT NULL,
+    block_time timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    UNIQUE (chain_type, tx_hash, wallet_id, asset, log_index),
+    FOREIGN KEY (wallet_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_incoming_transfers_wallet_id ON incoming_transfers (wallet_id, chain_type, block_time);
+
+-- 索引器扫描进度：EVM 按链记录已扫描的区块高度（address 为空），Solana 按地址记录最新处理的签名
+CREATE TABLE indexer_cursors (
+    chain_type varchar(50) NOT NULL,
+    address varchar(255) NOT NULL DEFAULT '',
+    last_seen varchar(255) NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chain_type, address)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS indexer_cursors;
+DROP TABLE IF EXISTS incoming_transfers;
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS decimals,
+    DROP COLUMN IF EXISTS asset;
@@ -0,0 +1,26 @@
+-- +migrate Up
+-- 签名策略（此前仅有 sqlboiler 模型，没有建表迁移）
+CREATE TABLE IF NOT EXISTS signing_policies (
+    wallet_id varchar(255) NOT NULL PRIMARY KEY,
+    policy_type varchar(50) NOT NULL DEFAULT 'single',
+    min_signatures integer NOT NULL DEFAULT 1,
+    created_at timestamptz DEFAULT NOW(),
+    updated_at timestamptz DEFAULT NOW()
+);
+
+-- 按顺序评估的策略规则，以及没有规则匹配时的结果
+ALTER TABLE signing_policies
+    ADD COLUMN IF NOT EXISTS rules jsonb NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS default_action varchar(50) NOT NULL DEFAULT 'allow';
+
+-- 创建会话前的策略评估结果，用于审计
+ALTER TABLE signing_sessions
+    ADD COLUMN IF NOT EXISTS policy_decision jsonb;
+
+-- +migrate Down
+ALTER TABLE signing_sessions
+    DROP COLUMN IF EXISTS policy_decision;
+
+ALTER TABLE signing_policies
+    DROP COLUMN IF EXISTS default_action,
+    DROP COLUMN IF EXISTS rules;
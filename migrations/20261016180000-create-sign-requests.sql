@@ -0,0 +1,43 @@
+-- +migrate Up
+-- 策略要求审批的签名请求，收集到足够的钱包成员审批后执行阈值签名
+CREATE TABLE sign_requests (
+    request_id varchar(255) NOT NULL PRIMARY KEY,
+    wallet_id varchar(255) NOT NULL,
+    chain_type varchar(50) NOT NULL DEFAULT '',
+    message_hex text NOT NULL,
+    derivation_path varchar(255) NOT NULL DEFAULT '',
+    destination varchar(255) NOT NULL DEFAULT '',
+    amount varchar(100) NOT NULL DEFAULT '',
+    asset varchar(255) NOT NULL DEFAULT '',
+    mobile_node_id varchar(255) NOT NULL DEFAULT '',
+    request_hash varchar(64) NOT NULL,
+    status varchar(50) NOT NULL,
+    required_approvals integer NOT NULL,
+    policy_decision jsonb,
+    session_id varchar(255),
+    signature text,
+    error_message text,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (wallet_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_sign_requests_wallet_id ON sign_requests (wallet_id, created_at);
+
+CREATE INDEX idx_sign_requests_status ON sign_requests (status);
+
+-- 每个 Passkey 凭证对同一请求只能审批一次
+CREATE TABLE sign_request_approvals (
+    request_id varchar(255) NOT NULL,
+    credential_id varchar(255) NOT NULL,
+    approved boolean NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (request_id, credential_id),
+    FOREIGN KEY (request_id) REFERENCES sign_requests (request_id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS sign_request_approvals;
+
+DROP TABLE IF EXISTS sign_requests;
@@ -0,0 +1,21 @@
+-- +migrate Up
+-- 签名交易的 WebAuthn challenge，绑定钱包和消息哈希，使用后记录 consumed_at 防止重放
+CREATE TABLE sign_challenges (
+    challenge varchar(255) NOT NULL PRIMARY KEY,
+    wallet_id varchar(255) NOT NULL,
+    message_hash varchar(64) NOT NULL,
+    expires_at timestamptz NOT NULL,
+    consumed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (wallet_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+-- 认证器签名计数器，验证签名交易的 assertion 时要求单调递增
+ALTER TABLE IF EXISTS passkeys
+    ADD COLUMN IF NOT EXISTS sign_count bigint NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE IF EXISTS passkeys
+    DROP COLUMN IF EXISTS sign_count;
+
+DROP TABLE IF EXISTS sign_challenges;
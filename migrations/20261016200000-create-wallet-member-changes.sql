@@ -0,0 +1,40 @@
+-- +migrate Up
+-- 钱包成员，以 Passkey 凭证标识，角色从高到低为 owner、admin、approver、viewer
+CREATE TABLE IF NOT EXISTS wallet_members (
+    wallet_id varchar(255) NOT NULL,
+    credential_id varchar(512) NOT NULL,
+    role varchar(50) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (wallet_id, credential_id),
+    FOREIGN KEY (wallet_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+-- 之前写入的其他角色按原有的审批权限归为 approver
+UPDATE wallet_members SET role = 'approver' WHERE role NOT IN ('owner', 'admin', 'approver', 'viewer');
+
+ALTER TABLE wallet_members
+    ADD CONSTRAINT wallet_members_role_check CHECK (role IN ('owner', 'admin', 'approver', 'viewer'));
+
+-- 成员变更请求，owner 用 Passkey 批准后才修改 wallet_members
+CREATE TABLE wallet_member_changes (
+    change_id varchar(255) NOT NULL PRIMARY KEY,
+    wallet_id varchar(255) NOT NULL,
+    action varchar(50) NOT NULL,
+    credential_id varchar(512) NOT NULL,
+    role varchar(50) NOT NULL DEFAULT '',
+    proposed_by varchar(512) NOT NULL,
+    decided_by varchar(512),
+    change_hash varchar(64) NOT NULL,
+    status varchar(50) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    decided_at timestamptz,
+    FOREIGN KEY (wallet_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_wallet_member_changes_wallet_id ON wallet_member_changes (wallet_id, created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS wallet_member_changes;
+
+ALTER TABLE wallet_members
+    DROP CONSTRAINT IF EXISTS wallet_members_role_check;
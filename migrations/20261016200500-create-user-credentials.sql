@@ -0,0 +1,14 @@
+-- +migrate Up
+-- Passkey 凭证所属的用户（Passkey 登录 JWT 的 subject），钱包成员和签名审批按用户解析凭证
+CREATE TABLE IF NOT EXISTS user_credentials (
+    user_id varchar(255) NOT NULL,
+    credential_id varchar(255) NOT NULL,
+    device_name varchar(255) NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, credential_id),
+    CONSTRAINT user_credentials_credential_id_key UNIQUE (credential_id),
+    FOREIGN KEY (credential_id) REFERENCES passkeys (credential_id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_credentials;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- 钱包邮件邀请，token 为邮件链接中的一次性令牌，受邀人用 Passkey 凭证接受后加入 wallet_members
+CREATE TABLE wallet_invitations (
+    invitation_id varchar(255) NOT NULL PRIMARY KEY,
+    wallet_id varchar(255) NOT NULL,
+    email varchar(255) NOT NULL,
+    role varchar(50) NOT NULL,
+    token uuid NOT NULL,
+    invited_by varchar(512) NOT NULL,
+    status varchar(50) NOT NULL,
+    valid_until timestamptz NOT NULL,
+    accepted_credential_id varchar(512),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    accepted_at timestamptz,
+    revoked_at timestamptz,
+    CONSTRAINT wallet_invitations_token_key UNIQUE (token),
+    CONSTRAINT wallet_invitations_role_check CHECK (role IN ('owner', 'admin', 'approver', 'viewer')),
+    FOREIGN KEY (wallet_id) REFERENCES keys (key_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_wallet_invitations_wallet_id ON wallet_invitations (wallet_id, created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS wallet_invitations;
@@ -0,0 +1,13 @@
+-- +migrate Up
+-- 审批按用户计数：同一用户的多个 Passkey 凭证对同一请求只能审批一次
+ALTER TABLE sign_request_approvals
+    ADD COLUMN user_id varchar(255) NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX idx_sign_request_approvals_user ON sign_request_approvals (request_id, user_id)
+WHERE user_id <> '';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_sign_request_approvals_user;
+
+ALTER TABLE sign_request_approvals
+    DROP COLUMN IF EXISTS user_id;
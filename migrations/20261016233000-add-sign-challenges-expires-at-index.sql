@@ -0,0 +1,6 @@
+-- +migrate Up
+-- 签发 challenge 时按过期时间删除过期的 challenge
+CREATE INDEX IF NOT EXISTS idx_sign_challenges_expires_at ON sign_challenges (expires_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_sign_challenges_expires_at;
@@ -153,7 +153,7 @@ type ReportResultRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ResultType    string                 `protobuf:"bytes,3,opt,name=result_type,json=resultType,proto3" json:"result_type,omitempty"` // "DKG_PUBKEY" or "SIGNATURE"
+	ResultType    string                 `protobuf:"bytes,3,opt,name=result_type,json=resultType,proto3" json:"result_type,omitempty"` // "DKG_PUBKEY", "RESHARE_PUBKEY" or "SIGNATURE"
 	Data          string                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`                               // hex encoded result
 	Signature     []byte                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`                     // 对结果的签名（防篡改）
 	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                             // 如果失败，填错误信息
@@ -461,6 +461,278 @@ func (x *SignStatusResponse) GetError() string {
 	return ""
 }
 
+type StartReshareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 重分享会话ID
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                  // 例：ECDSA
+	Curve         string                 `protobuf:"bytes,4,opt,name=curve,proto3" json:"curve,omitempty"`                          // 例：secp256k1
+	PublicKey     string                 `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // 当前公钥（hex），新分片必须对应同一公钥
+	OldThreshold  int32                  `protobuf:"varint,6,opt,name=old_threshold,json=oldThreshold,proto3" json:"old_threshold,omitempty"`
+	OldNodeIds    []string               `protobuf:"bytes,7,rep,name=old_node_ids,json=oldNodeIds,proto3" json:"old_node_ids,omitempty"` // 持有旧分片的节点列表
+	NewThreshold  int32                  `protobuf:"varint,8,opt,name=new_threshold,json=newThreshold,proto3" json:"new_threshold,omitempty"`
+	NewNodeIds    []string               `protobuf:"bytes,9,rep,name=new_node_ids,json=newNodeIds,proto3" json:"new_node_ids,omitempty"` // 接收新分片的节点列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartReshareRequest) Reset() {
+	*x = StartReshareRequest{}
+	mi := &file_mpc_v1_signer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReshareRequest) ProtoMessage() {}
+
+func (x *StartReshareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mpc_v1_signer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReshareRequest.ProtoReflect.Descriptor instead.
+func (*StartReshareRequest) Descriptor() ([]byte, []int) {
+	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StartReshareRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StartReshareRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *StartReshareRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *StartReshareRequest) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *StartReshareRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *StartReshareRequest) GetOldThreshold() int32 {
+	if x != nil {
+		return x.OldThreshold
+	}
+	return 0
+}
+
+func (x *StartReshareRequest) GetOldNodeIds() []string {
+	if x != nil {
+		return x.OldNodeIds
+	}
+	return nil
+}
+
+func (x *StartReshareRequest) GetNewThreshold() int32 {
+	if x != nil {
+		return x.NewThreshold
+	}
+	return 0
+}
+
+func (x *StartReshareRequest) GetNewNodeIds() []string {
+	if x != nil {
+		return x.NewNodeIds
+	}
+	return nil
+}
+
+type StartReshareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Started       bool                   `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartReshareResponse) Reset() {
+	*x = StartReshareResponse{}
+	mi := &file_mpc_v1_signer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReshareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReshareResponse) ProtoMessage() {}
+
+func (x *StartReshareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mpc_v1_signer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReshareResponse.ProtoReflect.Descriptor instead.
+func (*StartReshareResponse) Descriptor() ([]byte, []int) {
+	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StartReshareResponse) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *StartReshareResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // 目标节点ID，Signer 需校验与自身一致
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`               // 删除原因（用于审计）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShareRequest) Reset() {
+	*x = DeleteShareRequest{}
+	mi := &file_mpc_v1_signer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareRequest) ProtoMessage() {}
+
+func (x *DeleteShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mpc_v1_signer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShareRequest) Descriptor() ([]byte, []int) {
+	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteShareRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *DeleteShareRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *DeleteShareRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"` // 分片已删除（分片本就不存在时也返回 true）
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShareResponse) Reset() {
+	*x = DeleteShareResponse{}
+	mi := &file_mpc_v1_signer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareResponse) ProtoMessage() {}
+
+func (x *DeleteShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mpc_v1_signer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShareResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShareResponse) Descriptor() ([]byte, []int) {
+	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteShareResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DeleteShareResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type RelayMessageRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -477,7 +749,7 @@ type RelayMessageRequest struct {
 
 func (x *RelayMessageRequest) Reset() {
 	*x = RelayMessageRequest{}
-	mi := &file_mpc_v1_signer_proto_msgTypes[6]
+	mi := &file_mpc_v1_signer_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -489,7 +761,7 @@ func (x *RelayMessageRequest) String() string {
 func (*RelayMessageRequest) ProtoMessage() {}
 
 func (x *RelayMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mpc_v1_signer_proto_msgTypes[6]
+	mi := &file_mpc_v1_signer_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +774,7 @@ func (x *RelayMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelayMessageRequest.ProtoReflect.Descriptor instead.
 func (*RelayMessageRequest) Descriptor() ([]byte, []int) {
-	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{6}
+	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RelayMessageRequest) GetSessionId() string {
@@ -575,7 +847,7 @@ type RelayMessageResponse struct {
 
 func (x *RelayMessageResponse) Reset() {
 	*x = RelayMessageResponse{}
-	mi := &file_mpc_v1_signer_proto_msgTypes[7]
+	mi := &file_mpc_v1_signer_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -587,7 +859,7 @@ func (x *RelayMessageResponse) String() string {
 func (*RelayMessageResponse) ProtoMessage() {}
 
 func (x *RelayMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mpc_v1_signer_proto_msgTypes[7]
+	mi := &file_mpc_v1_signer_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +872,7 @@ func (x *RelayMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelayMessageResponse.ProtoReflect.Descriptor instead.
 func (*RelayMessageResponse) Descriptor() ([]byte, []int) {
-	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{7}
+	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RelayMessageResponse) GetAccepted() bool {
@@ -648,7 +920,7 @@ type PingRequest struct {
 
 func (x *PingRequest) Reset() {
 	*x = PingRequest{}
-	mi := &file_mpc_v1_signer_proto_msgTypes[8]
+	mi := &file_mpc_v1_signer_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +932,7 @@ func (x *PingRequest) String() string {
 func (*PingRequest) ProtoMessage() {}
 
 func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mpc_v1_signer_proto_msgTypes[8]
+	mi := &file_mpc_v1_signer_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +945,7 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{8}
+	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PingRequest) GetFromService() string {
@@ -701,7 +973,7 @@ type PongResponse struct {
 
 func (x *PongResponse) Reset() {
 	*x = PongResponse{}
-	mi := &file_mpc_v1_signer_proto_msgTypes[9]
+	mi := &file_mpc_v1_signer_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +985,7 @@ func (x *PongResponse) String() string {
 func (*PongResponse) ProtoMessage() {}
 
 func (x *PongResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mpc_v1_signer_proto_msgTypes[9]
+	mi := &file_mpc_v1_signer_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +998,7 @@ func (x *PongResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PongResponse.ProtoReflect.Descriptor instead.
 func (*PongResponse) Descriptor() ([]byte, []int) {
-	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{9}
+	return file_mpc_v1_signer_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PongResponse) GetAlive() bool {
@@ -799,7 +1071,31 @@ const file_mpc_v1_signer_proto_rawDesc = "" +
 	"\rcurrent_round\x18\x03 \x01(\x05R\fcurrentRound\x12!\n" +
 	"\ftotal_rounds\x18\x04 \x01(\x05R\vtotalRounds\x12\x1c\n" +
 	"\tsignature\x18\x05 \x01(\tR\tsignature\x12\x14\n" +
-	"\x05error\x18\x06 \x01(\tR\x05error\"\x99\x02\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xac\x02\n" +
+	"\x13StartReshareRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\x12\x1c\n" +
+	"\talgorithm\x18\x03 \x01(\tR\talgorithm\x12\x14\n" +
+	"\x05curve\x18\x04 \x01(\tR\x05curve\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x05 \x01(\tR\tpublicKey\x12#\n" +
+	"\rold_threshold\x18\x06 \x01(\x05R\foldThreshold\x12 \n" +
+	"\fold_node_ids\x18\a \x03(\tR\n" +
+	"oldNodeIds\x12#\n" +
+	"\rnew_threshold\x18\b \x01(\x05R\fnewThreshold\x12 \n" +
+	"\fnew_node_ids\x18\t \x03(\tR\n" +
+	"newNodeIds\"J\n" +
+	"\x14StartReshareResponse\x12\x18\n" +
+	"\astarted\x18\x01 \x01(\bR\astarted\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\\\n" +
+	"\x12DeleteShareRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"I\n" +
+	"\x13DeleteShareResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x99\x02\n" +
 	"\x13RelayMessageRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12 \n" +
@@ -826,12 +1122,14 @@ const file_mpc_v1_signer_proto_rawDesc = "" +
 	"\fPongResponse\x12\x14\n" +
 	"\x05alive\x18\x01 \x01(\bR\x05alive\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\tR\ttimestamp2\xfa\x03\n" +
+	"\ttimestamp\x18\x03 \x01(\tR\ttimestamp2\x8d\x05\n" +
 	"\rSignerService\x12=\n" +
 	"\bStartDKG\x12\x17.mpc.v1.StartDKGRequest\x1a\x18.mpc.v1.StartDKGResponse\x12F\n" +
 	"\fGetDKGStatus\x12\x1b.mpc.v1.GetDKGStatusRequest\x1a\x19.mpc.v1.DKGStatusResponse\x12@\n" +
 	"\tStartSign\x12\x18.mpc.v1.StartSignRequest\x1a\x19.mpc.v1.StartSignResponse\x12I\n" +
-	"\rGetSignStatus\x12\x1c.mpc.v1.GetSignStatusRequest\x1a\x1a.mpc.v1.SignStatusResponse\x12V\n" +
+	"\rGetSignStatus\x12\x1c.mpc.v1.GetSignStatusRequest\x1a\x1a.mpc.v1.SignStatusResponse\x12I\n" +
+	"\fStartReshare\x12\x1b.mpc.v1.StartReshareRequest\x1a\x1c.mpc.v1.StartReshareResponse\x12F\n" +
+	"\vDeleteShare\x12\x1a.mpc.v1.DeleteShareRequest\x1a\x1b.mpc.v1.DeleteShareResponse\x12V\n" +
 	"\x14RelayProtocolMessage\x12\x1b.mpc.v1.RelayMessageRequest\x1a\x1c.mpc.v1.RelayMessageResponse\"\x03\x88\x02\x01\x12J\n" +
 	"\vParticipate\x12\x1a.mpc.v1.ParticipateRequest\x1a\x1b.mpc.v1.ParticipateResponse(\x010\x01\x121\n" +
 	"\x04Ping\x12\x13.mpc.v1.PingRequest\x1a\x14.mpc.v1.PongResponseB.Z,github.com/SafeMPC/mpc-service/pb/mpc/v1;mpcb\x06proto3"
@@ -848,7 +1146,7 @@ func file_mpc_v1_signer_proto_rawDescGZIP() []byte {
 	return file_mpc_v1_signer_proto_rawDescData
 }
 
-var file_mpc_v1_signer_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_mpc_v1_signer_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_mpc_v1_signer_proto_goTypes = []any{
 	(*ParticipateRequest)(nil),   // 0: mpc.v1.ParticipateRequest
 	(*ParticipateResponse)(nil),  // 1: mpc.v1.ParticipateResponse
@@ -856,32 +1154,40 @@ var file_mpc_v1_signer_proto_goTypes = []any{
 	(*DKGStatusResponse)(nil),    // 3: mpc.v1.DKGStatusResponse
 	(*GetSignStatusRequest)(nil), // 4: mpc.v1.GetSignStatusRequest
 	(*SignStatusResponse)(nil),   // 5: mpc.v1.SignStatusResponse
-	(*RelayMessageRequest)(nil),  // 6: mpc.v1.RelayMessageRequest
-	(*RelayMessageResponse)(nil), // 7: mpc.v1.RelayMessageResponse
-	(*PingRequest)(nil),          // 8: mpc.v1.PingRequest
-	(*PongResponse)(nil),         // 9: mpc.v1.PongResponse
-	(*StartDKGRequest)(nil),      // 10: mpc.v1.StartDKGRequest
-	(*StartSignRequest)(nil),     // 11: mpc.v1.StartSignRequest
-	(*StartDKGResponse)(nil),     // 12: mpc.v1.StartDKGResponse
-	(*StartSignResponse)(nil),    // 13: mpc.v1.StartSignResponse
+	(*StartReshareRequest)(nil),  // 6: mpc.v1.StartReshareRequest
+	(*StartReshareResponse)(nil), // 7: mpc.v1.StartReshareResponse
+	(*DeleteShareRequest)(nil),   // 8: mpc.v1.DeleteShareRequest
+	(*DeleteShareResponse)(nil),  // 9: mpc.v1.DeleteShareResponse
+	(*RelayMessageRequest)(nil),  // 10: mpc.v1.RelayMessageRequest
+	(*RelayMessageResponse)(nil), // 11: mpc.v1.RelayMessageResponse
+	(*PingRequest)(nil),          // 12: mpc.v1.PingRequest
+	(*PongResponse)(nil),         // 13: mpc.v1.PongResponse
+	(*StartDKGRequest)(nil),      // 14: mpc.v1.StartDKGRequest
+	(*StartSignRequest)(nil),     // 15: mpc.v1.StartSignRequest
+	(*StartDKGResponse)(nil),     // 16: mpc.v1.StartDKGResponse
+	(*StartSignResponse)(nil),    // 17: mpc.v1.StartSignResponse
 }
 var file_mpc_v1_signer_proto_depIdxs = []int32{
-	10, // 0: mpc.v1.SignerService.StartDKG:input_type -> mpc.v1.StartDKGRequest
+	14, // 0: mpc.v1.SignerService.StartDKG:input_type -> mpc.v1.StartDKGRequest
 	2,  // 1: mpc.v1.SignerService.GetDKGStatus:input_type -> mpc.v1.GetDKGStatusRequest
-	11, // 2: mpc.v1.SignerService.StartSign:input_type -> mpc.v1.StartSignRequest
+	15, // 2: mpc.v1.SignerService.StartSign:input_type -> mpc.v1.StartSignRequest
 	4,  // 3: mpc.v1.SignerService.GetSignStatus:input_type -> mpc.v1.GetSignStatusRequest
-	6,  // 4: mpc.v1.SignerService.RelayProtocolMessage:input_type -> mpc.v1.RelayMessageRequest
-	0,  // 5: mpc.v1.SignerService.Participate:input_type -> mpc.v1.ParticipateRequest
-	8,  // 6: mpc.v1.SignerService.Ping:input_type -> mpc.v1.PingRequest
-	12, // 7: mpc.v1.SignerService.StartDKG:output_type -> mpc.v1.StartDKGResponse
-	3,  // 8: mpc.v1.SignerService.GetDKGStatus:output_type -> mpc.v1.DKGStatusResponse
-	13, // 9: mpc.v1.SignerService.StartSign:output_type -> mpc.v1.StartSignResponse
-	5,  // 10: mpc.v1.SignerService.GetSignStatus:output_type -> mpc.v1.SignStatusResponse
-	7,  // 11: mpc.v1.SignerService.RelayProtocolMessage:output_type -> mpc.v1.RelayMessageResponse
-	1,  // 12: mpc.v1.SignerService.Participate:output_type -> mpc.v1.ParticipateResponse
-	9,  // 13: mpc.v1.SignerService.Ping:output_type -> mpc.v1.PongResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	6,  // 4: mpc.v1.SignerService.StartReshare:input_type -> mpc.v1.StartReshareRequest
+	8,  // 5: mpc.v1.SignerService.DeleteShare:input_type -> mpc.v1.DeleteShareRequest
+	10, // 6: mpc.v1.SignerService.RelayProtocolMessage:input_type -> mpc.v1.RelayMessageRequest
+	0,  // 7: mpc.v1.SignerService.Participate:input_type -> mpc.v1.ParticipateRequest
+	12, // 8: mpc.v1.SignerService.Ping:input_type -> mpc.v1.PingRequest
+	16, // 9: mpc.v1.SignerService.StartDKG:output_type -> mpc.v1.StartDKGResponse
+	3,  // 10: mpc.v1.SignerService.GetDKGStatus:output_type -> mpc.v1.DKGStatusResponse
+	17, // 11: mpc.v1.SignerService.StartSign:output_type -> mpc.v1.StartSignResponse
+	5,  // 12: mpc.v1.SignerService.GetSignStatus:output_type -> mpc.v1.SignStatusResponse
+	7,  // 13: mpc.v1.SignerService.StartReshare:output_type -> mpc.v1.StartReshareResponse
+	9,  // 14: mpc.v1.SignerService.DeleteShare:output_type -> mpc.v1.DeleteShareResponse
+	11, // 15: mpc.v1.SignerService.RelayProtocolMessage:output_type -> mpc.v1.RelayMessageResponse
+	1,  // 16: mpc.v1.SignerService.Participate:output_type -> mpc.v1.ParticipateResponse
+	13, // 17: mpc.v1.SignerService.Ping:output_type -> mpc.v1.PongResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -899,7 +1205,7 @@ func file_mpc_v1_signer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mpc_v1_signer_proto_rawDesc), len(file_mpc_v1_signer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,8 @@ const (
 	SignerService_GetDKGStatus_FullMethodName         = "/mpc.v1.SignerService/GetDKGStatus"
 	SignerService_StartSign_FullMethodName            = "/mpc.v1.SignerService/StartSign"
 	SignerService_GetSignStatus_FullMethodName        = "/mpc.v1.SignerService/GetSignStatus"
+	SignerService_StartReshare_FullMethodName         = "/mpc.v1.SignerService/StartReshare"
+	SignerService_DeleteShare_FullMethodName          = "/mpc.v1.SignerService/DeleteShare"
 	SignerService_RelayProtocolMessage_FullMethodName = "/mpc.v1.SignerService/RelayProtocolMessage"
 	SignerService_Participate_FullMethodName          = "/mpc.v1.SignerService/Participate"
 	SignerService_Ping_FullMethodName                 = "/mpc.v1.SignerService/Ping"
@@ -41,6 +43,10 @@ type SignerServiceClient interface {
 	// 签名相关（使用 mpc.proto 中的 StartSignRequest/Response）
 	StartSign(ctx context.Context, in *StartSignRequest, opts ...grpc.CallOption) (*StartSignResponse, error)
 	GetSignStatus(ctx context.Context, in *GetSignStatusRequest, opts ...grpc.CallOption) (*SignStatusResponse, error)
+	// 密钥重分享（Resharing）：在旧节点集合与新节点集合之间迁移分片，公钥保持不变
+	StartReshare(ctx context.Context, in *StartReshareRequest, opts ...grpc.CallOption) (*StartReshareResponse, error)
+	// 删除密钥分片：密钥删除时由 Service 扇出到所有持有分片的节点
+	DeleteShare(ctx context.Context, in *DeleteShareRequest, opts ...grpc.CallOption) (*DeleteShareResponse, error)
 	// Deprecated: Do not use.
 	// 协议消息中继（从 Client 通过 Service 中继到 Signer）
 	RelayProtocolMessage(ctx context.Context, in *RelayMessageRequest, opts ...grpc.CallOption) (*RelayMessageResponse, error)
@@ -98,6 +104,26 @@ func (c *signerServiceClient) GetSignStatus(ctx context.Context, in *GetSignStat
 	return out, nil
 }
 
+func (c *signerServiceClient) StartReshare(ctx context.Context, in *StartReshareRequest, opts ...grpc.CallOption) (*StartReshareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartReshareResponse)
+	err := c.cc.Invoke(ctx, SignerService_StartReshare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerServiceClient) DeleteShare(ctx context.Context, in *DeleteShareRequest, opts ...grpc.CallOption) (*DeleteShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteShareResponse)
+	err := c.cc.Invoke(ctx, SignerService_DeleteShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *signerServiceClient) RelayProtocolMessage(ctx context.Context, in *RelayMessageRequest, opts ...grpc.CallOption) (*RelayMessageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
@@ -145,6 +171,10 @@ type SignerServiceServer interface {
 	// 签名相关（使用 mpc.proto 中的 StartSignRequest/Response）
 	StartSign(context.Context, *StartSignRequest) (*StartSignResponse, error)
 	GetSignStatus(context.Context, *GetSignStatusRequest) (*SignStatusResponse, error)
+	// 密钥重分享（Resharing）：在旧节点集合与新节点集合之间迁移分片，公钥保持不变
+	StartReshare(context.Context, *StartReshareRequest) (*StartReshareResponse, error)
+	// 删除密钥分片：密钥删除时由 Service 扇出到所有持有分片的节点
+	DeleteShare(context.Context, *DeleteShareRequest) (*DeleteShareResponse, error)
 	// Deprecated: Do not use.
 	// 协议消息中继（从 Client 通过 Service 中继到 Signer）
 	RelayProtocolMessage(context.Context, *RelayMessageRequest) (*RelayMessageResponse, error)
@@ -174,6 +204,12 @@ func (UnimplementedSignerServiceServer) StartSign(context.Context, *StartSignReq
 func (UnimplementedSignerServiceServer) GetSignStatus(context.Context, *GetSignStatusRequest) (*SignStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSignStatus not implemented")
 }
+func (UnimplementedSignerServiceServer) StartReshare(context.Context, *StartReshareRequest) (*StartReshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReshare not implemented")
+}
+func (UnimplementedSignerServiceServer) DeleteShare(context.Context, *DeleteShareRequest) (*DeleteShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShare not implemented")
+}
 func (UnimplementedSignerServiceServer) RelayProtocolMessage(context.Context, *RelayMessageRequest) (*RelayMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RelayProtocolMessage not implemented")
 }
@@ -276,6 +312,42 @@ func _SignerService_GetSignStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SignerService_StartReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServiceServer).StartReshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignerService_StartReshare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServiceServer).StartReshare(ctx, req.(*StartReshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignerService_DeleteShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServiceServer).DeleteShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignerService_DeleteShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServiceServer).DeleteShare(ctx, req.(*DeleteShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SignerService_RelayProtocolMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RelayMessageRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +414,14 @@ var SignerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSignStatus",
 			Handler:    _SignerService_GetSignStatus_Handler,
 		},
+		{
+			MethodName: "StartReshare",
+			Handler:    _SignerService_StartReshare_Handler,
+		},
+		{
+			MethodName: "DeleteShare",
+			Handler:    _SignerService_DeleteShare_Handler,
+		},
 		{
 			MethodName: "RelayProtocolMessage",
 			Handler:    _SignerService_RelayProtocolMessage_Handler,